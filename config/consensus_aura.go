@@ -0,0 +1,16 @@
+//go:build !babe
+
+package config
+
+import (
+	"github.com/LimeChain/gosemble/constants/aura"
+	am "github.com/LimeChain/gosemble/frame/aura/module"
+)
+
+// consensusModule is the block authoring module of the runtime. Aura is used, unless the
+// runtime is built with the `babe` tag.
+var consensusModule = am.NewAuraModule()
+
+const consensusModuleIndex = aura.ModuleIndex
+
+func registerConsensus() {}
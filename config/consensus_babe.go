@@ -0,0 +1,20 @@
+//go:build babe
+
+package config
+
+import (
+	"github.com/LimeChain/gosemble/constants/babe"
+	fb "github.com/LimeChain/gosemble/frame/babe"
+	bbm "github.com/LimeChain/gosemble/frame/babe/module"
+	fs "github.com/LimeChain/gosemble/frame/session"
+)
+
+// consensusModule is the block authoring module of the runtime, which is BABE in runtimes built with the `babe` tag.
+var consensusModule = bbm.NewBabeModule()
+
+const consensusModuleIndex = babe.ModuleIndex
+
+// registerConsensus ends the sessions together with the BABE epochs.
+func registerConsensus() {
+	fs.RegisterShouldEndSession(fb.ShouldEpochChange)
+}
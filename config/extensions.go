@@ -0,0 +1,46 @@
+package config
+
+import (
+	sc "github.com/LimeChain/goscale"
+	system "github.com/LimeChain/gosemble/frame/system/extensions"
+	"github.com/LimeChain/gosemble/frame/transaction_payment"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// SignedExtensions contains the ordered signed extensions used by the runtime.
+// Their order defines the encoding of the extra and the additional signed data of the extrinsics,
+// the order in which the checks are performed and the signed extensions in the metadata.
+//
+// The fees are paid in the native currency by ChargeTransactionPayment. To pay them in an asset,
+// replace it with transaction_payment.NewChargeAssetTxPayment(sc.NewU128FromUint64(0), sc.NewOption[types.AssetId](nil)),
+// which is then exposed in the metadata. Its extra appends an Option<AssetId> to the tip,
+// so the extrinsics of existing clients no longer decode: bump constants.TransactionVersion with the switch.
+var SignedExtensions = []types.SignedExtension{
+	system.CheckNonZeroAddress{},
+	system.CheckSpecVersion{},
+	system.CheckTxVersion{},
+	system.CheckGenesis{},
+	system.CheckMortality{},
+	system.CheckNonce{},
+	system.CheckWeight{},
+	transaction_payment.NewChargeTransactionPayment(sc.NewU128FromUint64(0)),
+}
+
+// SignedExtra contains the signed extensions of the runtime, without any extrinsic data.
+// It decodes the extra of the signed extrinsics and validates the unsigned ones.
+var SignedExtra = types.NewSignedExtra(SignedExtensions...)
+
+// BalanceToAsset converts transaction fees to the asset, in which they are paid,
+// when ChargeAssetTxPayment is used instead of ChargeTransactionPayment.
+var BalanceToAsset transaction_payment.BalanceToAsset = transaction_payment.MinBalanceRatio{}
+
+// OnChargeAssetTransaction withdraws the transaction fees, which are paid in an asset, and handles them
+// after dispatch. The paid fees are burned. Use transaction_payment.CreditAssetTo to credit them to an account.
+var OnChargeAssetTransaction transaction_payment.OnChargeAssetTransaction = transaction_payment.FungiblesAdapter{
+	Credit: transaction_payment.BurnAssetCredit{},
+}
+
+func init() {
+	transaction_payment.RegisterBalanceToAsset(BalanceToAsset)
+	transaction_payment.RegisterOnChargeAssetTransaction(OnChargeAssetTransaction)
+}
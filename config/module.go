@@ -1,21 +1,40 @@
 package config
 
 import (
+	"sort"
+
 	sc "github.com/LimeChain/goscale"
-	"github.com/LimeChain/gosemble/constants/aura"
+	"github.com/LimeChain/gosemble/constants/assets"
 	"github.com/LimeChain/gosemble/constants/balances"
 	"github.com/LimeChain/gosemble/constants/grandpa"
+	"github.com/LimeChain/gosemble/constants/indices"
+	"github.com/LimeChain/gosemble/constants/session"
+	"github.com/LimeChain/gosemble/constants/sudo"
 	"github.com/LimeChain/gosemble/constants/system"
 	"github.com/LimeChain/gosemble/constants/testable"
 	"github.com/LimeChain/gosemble/constants/timestamp"
 	"github.com/LimeChain/gosemble/constants/transaction_payment"
-	am "github.com/LimeChain/gosemble/frame/aura/module"
+	"github.com/LimeChain/gosemble/constants/utility"
+	ext "github.com/LimeChain/gosemble/execution/types"
+	ad "github.com/LimeChain/gosemble/frame/assets/dispatchables"
+	am "github.com/LimeChain/gosemble/frame/assets/module"
+	bd "github.com/LimeChain/gosemble/frame/balances/dispatchables"
 	bm "github.com/LimeChain/gosemble/frame/balances/module"
 	gm "github.com/LimeChain/gosemble/frame/grandpa/module"
+	fi "github.com/LimeChain/gosemble/frame/indices"
+	id "github.com/LimeChain/gosemble/frame/indices/dispatchables"
+	im "github.com/LimeChain/gosemble/frame/indices/module"
+	fm "github.com/LimeChain/gosemble/frame/migrations"
+	fs "github.com/LimeChain/gosemble/frame/session"
+	ssm "github.com/LimeChain/gosemble/frame/session/module"
+	sdm "github.com/LimeChain/gosemble/frame/sudo/module"
 	sm "github.com/LimeChain/gosemble/frame/system/module"
 	tm "github.com/LimeChain/gosemble/frame/testable/module"
+	tsd "github.com/LimeChain/gosemble/frame/timestamp/dispatchables"
 	tsm "github.com/LimeChain/gosemble/frame/timestamp/module"
+	ftp "github.com/LimeChain/gosemble/frame/transaction_payment"
 	tpm "github.com/LimeChain/gosemble/frame/transaction_payment/module"
+	um "github.com/LimeChain/gosemble/frame/utility/module"
 	"github.com/LimeChain/gosemble/primitives/types"
 )
 
@@ -23,9 +42,56 @@ import (
 var Modules = map[sc.U8]types.Module{
 	system.ModuleIndex:              sm.NewSystemModule(),
 	timestamp.ModuleIndex:           tsm.NewTimestampModule(),
-	aura.ModuleIndex:                am.NewAuraModule(),
+	consensusModuleIndex:            consensusModule,
 	grandpa.ModuleIndex:             gm.NewGrandpaModule(),
 	balances.ModuleIndex:            bm.NewBalancesModule(),
 	transaction_payment.ModuleIndex: tpm.NewTransactionPaymentModule(),
+	sudo.ModuleIndex:                sdm.NewSudoModule(),
+	utility.ModuleIndex:             um.NewUtilityModule(),
+	indices.ModuleIndex:             im.NewIndicesModule(),
+	session.ModuleIndex:             ssm.NewSessionModule(),
+	assets.ModuleIndex:              am.NewAssetsModule(),
 	testable.ModuleIndex:            tm.NewTestingModule(),
 }
+
+// SessionHandlers contains the modules, notified of session changes, in the order of their session keys.
+var SessionHandlers = []types.SessionHandler{
+	consensusModule,
+	gm.NewGrandpaModule(),
+}
+
+// TimestampHandlers contains the modules, notified when the timestamp of the block is set.
+var TimestampHandlers = []types.OnTimestampSet{
+	consensusModule,
+}
+
+// Migrations contains the storage migrations, executed in order after a runtime upgrade.
+var Migrations = []types.SteppedMigration{}
+
+func init() {
+	ext.RegisterModules(Modules)
+	types.RegisterAccountIndexLookup(fi.AccountIndexLookup{})
+	ftp.RegisterAssets(ad.Fungibles{})
+	ad.RegisterCurrency(bd.Currency{})
+	id.RegisterCurrency(bd.Currency{})
+	fs.RegisterHandlers(SessionHandlers)
+	fs.RegisterSessionManager(fs.StaticValidators{})
+	fs.RegisterValidatorIdOf(fs.CurrentValidatorOf)
+	tsd.RegisterOnTimestampSetHandlers(TimestampHandlers)
+	fm.RegisterMigrations(Migrations)
+	registerConsensus()
+}
+
+// ModuleIndices returns the indices of the runtime modules in ascending order.
+func ModuleIndices() []sc.U8 {
+	indices := make([]sc.U8, 0, len(Modules))
+	for index := range Modules {
+		indices = append(indices, index)
+	}
+
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
+
+	return indices
+}
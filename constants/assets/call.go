@@ -0,0 +1,41 @@
+package assets
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+)
+
+const (
+	ModuleIndex                    = sc.U8(10)
+	FunctionCreateIndex            = 0
+	FunctionForceCreateIndex       = 1
+	FunctionStartDestroyIndex      = 2
+	FunctionDestroyAccountsIndex   = 3
+	FunctionDestroyApprovalsIndex  = 4
+	FunctionFinishDestroyIndex     = 5
+	FunctionMintIndex              = 6
+	FunctionBurnIndex              = 7
+	FunctionTransferIndex          = 8
+	FunctionTransferKeepAliveIndex = 9
+	FunctionFreezeIndex            = 10
+	FunctionThawIndex              = 11
+	FunctionSetMetadataIndex       = 12
+	FunctionApproveTransferIndex   = 13
+	FunctionTransferApprovedIndex  = 14
+)
+
+var (
+	// AssetDeposit is the deposit, which is reserved from an account for creating an asset.
+	AssetDeposit = sc.NewU128FromUint64(100 * constants.Dollar)
+	// MetadataDepositBase is the base deposit, which is reserved for setting the metadata of an asset.
+	MetadataDepositBase = sc.NewU128FromUint64(10 * constants.Dollar)
+	// MetadataDepositPerByte is the additional deposit per byte of the name and the symbol of an asset.
+	MetadataDepositPerByte = sc.NewU128FromUint64(1 * constants.Dollar)
+	// ApprovalDeposit is the deposit, which is reserved for approving a transfer by a delegate.
+	ApprovalDeposit = sc.NewU128FromUint64(1 * constants.Dollar)
+	// StringLimit is the maximum length of the name and the symbol of an asset.
+	StringLimit = sc.U32(50)
+	// RemoveItemsLimit is the maximum number of accounts or approvals, which are removed in a single call,
+	// while an asset is destroyed.
+	RemoveItemsLimit = sc.U32(1000)
+)
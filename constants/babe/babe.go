@@ -0,0 +1,40 @@
+package babe
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/session"
+	"github.com/LimeChain/gosemble/constants/timestamp"
+)
+
+var (
+	EngineId  = [4]byte{'B', 'A', 'B', 'E'}
+	KeyTypeId = [4]byte{'b', 'a', 'b', 'e'}
+)
+
+const (
+	// AllowedSlotsPrimary allows only primary slots.
+	AllowedSlotsPrimary sc.U8 = iota
+	// AllowedSlotsPrimaryAndSecondaryPlain allows primary and secondary plain slots.
+	AllowedSlotsPrimaryAndSecondaryPlain
+	// AllowedSlotsPrimaryAndSecondaryVRF allows primary and secondary VRF slots.
+	AllowedSlotsPrimaryAndSecondaryVRF
+)
+
+var (
+	// EpochDuration is the number of slots in an epoch. Sessions end with the epochs, so they are as long as sessions.
+	EpochDuration = sc.U64(session.Period)
+	// ExpectedBlockTime is the expected average block time in milliseconds, which is the duration of a slot.
+	ExpectedBlockTime = sc.U64(timestamp.MinimumPeriod * 2)
+	// MaxAuthorities is the maximum number of authorities that the module supports.
+	MaxAuthorities sc.U32 = 100
+	// PrimaryProbability is the probability of an authority to be assigned a primary slot, as a (numerator, denominator) pair.
+	PrimaryProbability = [2]sc.U64{1, 4}
+	// GenesisAllowedSlots are the types of slots, which can be claimed in the genesis epoch.
+	GenesisAllowedSlots = AllowedSlotsPrimaryAndSecondaryVRF
+	// ReportLongevity is the number of blocks, for which an equivocation report is valid in the transaction pool.
+	ReportLongevity = EpochDuration
+	// UnderConstructionSegmentLength is the number of VRF outputs, stored in a single segment of the randomness under construction.
+	UnderConstructionSegmentLength sc.U32 = 256
+	// MaxSkippedEpochs is the maximum number of skipped epochs, mapped to the session in which they ended.
+	MaxSkippedEpochs = 100
+)
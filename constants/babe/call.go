@@ -0,0 +1,12 @@
+package babe
+
+// ModuleIndex is the index of the block authoring module, since BABE replaces Aura in runtimes built with it.
+const (
+	ModuleIndex = 2
+)
+
+const (
+	FunctionReportEquivocationIndex = iota
+	FunctionReportEquivocationUnsignedIndex
+	FunctionPlanConfigChangeIndex
+)
@@ -3,3 +3,9 @@ package grandpa
 const (
 	ModuleIndex = 3
 )
+
+const (
+	FunctionReportEquivocationIndex = iota
+	FunctionReportEquivocationUnsignedIndex
+	FunctionNoteStalledIndex
+)
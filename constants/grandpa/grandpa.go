@@ -7,3 +7,13 @@ var (
 	EngineId               = [4]byte{'f', 'r', 'n', 'k'}
 	KeyTypeId              = [4]byte{'g', 'r', 'a', 'n'}
 )
+
+var (
+	// MaxAuthorities is the maximum number of authorities that the module supports.
+	MaxAuthorities sc.U32 = 100
+	// MaxSetIdSessionEntries is the maximum number of entries to keep in the set id to session index mapping.
+	// The current and the previous set are kept, since the session of the previous set bounds the sessions of the current one.
+	MaxSetIdSessionEntries sc.U64 = 2
+	// ReportLongevity is the number of blocks, for which an equivocation report is valid in the transaction pool.
+	ReportLongevity sc.U64 = 1_800
+)
@@ -0,0 +1,18 @@
+package indices
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+)
+
+const (
+	ModuleIndex                = sc.U8(8)
+	FunctionClaimIndex         = 0
+	FunctionTransferIndex      = 1
+	FunctionFreeIndex          = 2
+	FunctionForceTransferIndex = 3
+	FunctionFreezeIndex        = 4
+)
+
+// Deposit is the deposit, which is reserved from an account for claiming an index.
+var Deposit = sc.NewU128FromUint64(1 * constants.Dollar)
@@ -1,32 +1,80 @@
 package constants
 
 var (
-	KeySystem             = []byte("System")
-	KeyAccount            = []byte("Account")
-	KeyAllExtrinsicsLen   = []byte("AllExtrinsicsLen")
-	KeyAura               = []byte("Aura")
-	KeyAuthorities        = []byte("Authorities")
-	KeyBalances           = []byte("Balances")
-	KeyBlockHash          = []byte("BlockHash")
-	KeyBlockWeight        = []byte("BlockWeight")
-	KeyCurrentSlot        = []byte("CurrentSlot")
-	KeyDidUpdate          = []byte("DidUpdate")
-	KeyDigest             = []byte("Digest")
-	KeyEventCount         = []byte("EventCount")
-	KeyEvents             = []byte("Events")
-	KeyEventTopics        = []byte("EventTopics")
-	KeyExecutionPhase     = []byte("ExecutionPhase")
-	KeyExtrinsicCount     = []byte("ExtrinsicCount")
-	KeyExtrinsicData      = []byte("ExtrinsicData")
-	KeyExtrinsicIndex     = []byte(":extrinsic_index")
-	KeyGrandpaAuthorities = []byte(":grandpa_authorities")
-	KeyLastRuntimeUpgrade = []byte("LastRuntimeUpgrade")
-	KeyNextFeeMultiplier  = []byte("NextFeeMultiplier")
-	KeyNow                = []byte("Now")
-	KeyNumber             = []byte("Number")
-	KeyParentHash         = []byte("ParentHash")
-	KeyTimestamp          = []byte("Timestamp")
-	KeyTotalIssuance      = []byte("TotalIssuance")
-	KeyTransactionPayment = []byte("TransactionPayment")
-	TransactionLevelKey   = []byte(":transaction_level:")
+	KeySystem                   = []byte("System")
+	KeyAccount                  = []byte("Account")
+	KeyAccounts                 = []byte("Accounts")
+	KeyAllExtrinsicsLen         = []byte("AllExtrinsicsLen")
+	KeyApprovals                = []byte("Approvals")
+	KeyAsset                    = []byte("Asset")
+	KeyAssets                   = []byte("Assets")
+	KeyAura                     = []byte("Aura")
+	KeyAuthorVrfRandomness      = []byte("AuthorVrfRandomness")
+	KeyAuthorities              = []byte("Authorities")
+	KeyBabe                     = []byte("Babe")
+	KeyBalances                 = []byte("Balances")
+	KeyBlockHash                = []byte("BlockHash")
+	KeyBlockWeight              = []byte("BlockWeight")
+	KeyCode                     = []byte(":code")
+	KeyCurrentIndex             = []byte("CurrentIndex")
+	KeyCurrentSetId             = []byte("CurrentSetId")
+	KeyCurrentSlot              = []byte("CurrentSlot")
+	KeyCursor                   = []byte("Cursor")
+	KeyDidUpdate                = []byte("DidUpdate")
+	KeyDigest                   = []byte("Digest")
+	KeyDisabledValidators       = []byte("DisabledValidators")
+	KeyEpochConfig              = []byte("EpochConfig")
+	KeyEpochIndex               = []byte("EpochIndex")
+	KeyEpochStart               = []byte("EpochStart")
+	KeyEventCount               = []byte("EventCount")
+	KeyEvents                   = []byte("Events")
+	KeyEventTopics              = []byte("EventTopics")
+	KeyExecutionPhase           = []byte("ExecutionPhase")
+	KeyExtrinsicCount           = []byte("ExtrinsicCount")
+	KeyExtrinsicData            = []byte("ExtrinsicData")
+	KeyExtrinsicIndex           = []byte(":extrinsic_index")
+	KeyGenesisSlot              = []byte("GenesisSlot")
+	KeyGrandpa                  = []byte("Grandpa")
+	KeyGrandpaAuthorities       = []byte(":grandpa_authorities")
+	KeyHeapPages                = []byte(":heappages")
+	KeyInactiveIssuance         = []byte("InactiveIssuance")
+	KeyIndices                  = []byte("Indices")
+	KeyInitialized              = []byte("Initialized")
+	KeyKey                      = []byte("Key")
+	KeyKeyOwner                 = []byte("KeyOwner")
+	KeyLastRuntimeUpgrade       = []byte("LastRuntimeUpgrade")
+	KeyLateness                 = []byte("Lateness")
+	KeyLocks                    = []byte("Locks")
+	KeyMetadata                 = []byte("Metadata")
+	KeyMultiBlockMigrations     = []byte("MultiBlockMigrations")
+	KeyNextAuthorities          = []byte("NextAuthorities")
+	KeyNextEpochConfig          = []byte("NextEpochConfig")
+	KeyNextFeeMultiplier        = []byte("NextFeeMultiplier")
+	KeyNextForced               = []byte("NextForced")
+	KeyNextKeys                 = []byte("NextKeys")
+	KeyNextRandomness           = []byte("NextRandomness")
+	KeyNow                      = []byte("Now")
+	KeyNumber                   = []byte("Number")
+	KeyParentHash               = []byte("ParentHash")
+	KeyPendingChange            = []byte("PendingChange")
+	KeyPendingEpochConfigChange = []byte("PendingEpochConfigChange")
+	KeyQueuedChanged            = []byte("QueuedChanged")
+	KeyQueuedKeys               = []byte("QueuedKeys")
+	KeyRandomness               = []byte("Randomness")
+	KeyReserves                 = []byte("Reserves")
+	KeySegmentIndex             = []byte("SegmentIndex")
+	KeySession                  = []byte("Session")
+	KeySetIdSession             = []byte("SetIdSession")
+	KeySkippedEpochs            = []byte("SkippedEpochs")
+	KeyStalled                  = []byte("Stalled")
+	KeyState                    = []byte("State")
+	KeyStorageVersion           = []byte(":__STORAGE_VERSION__:")
+	KeyStorageVersionValue      = []byte("StorageVersion")
+	KeySudo                     = []byte("Sudo")
+	KeyTimestamp                = []byte("Timestamp")
+	KeyTotalIssuance            = []byte("TotalIssuance")
+	KeyTransactionPayment       = []byte("TransactionPayment")
+	KeyUnderConstruction        = []byte("UnderConstruction")
+	KeyValidators               = []byte("Validators")
+	TransactionLevelKey         = []byte(":transaction_level:")
 )
@@ -122,4 +122,130 @@ const (
 	ChargeTransactionPayment
 
 	Runtime
+
+	TypesFixedSequence8U8
+
+	TypesBalanceLock
+	TypesSequenceBalanceLock
+	TypesReasons
+	TypesReserveData
+	TypesSequenceReserveData
+
+	TypesSudoEvent
+	TypesSudoErrors
+	TypesOptionAddress32
+
+	TypesUtilityEvent
+	TypesUtilityErrors
+	TypesSequenceRuntimeCall
+	TypesRawOrigin
+	TypesOriginCaller
+
+	TypesIndicesEvent
+	TypesIndicesErrors
+	TypesTupleAddress32BalanceBool
+
+	TypesSessionEvent
+	TypesSessionErrors
+	TypesSessionKeys
+	TypesSequenceAddress32
+	TypesTupleAddress32SessionKeys
+	TypesSequenceTupleAddress32SessionKeys
+	TypesTupleKeyTypeIdSequenceU8
+
+	TypesGrandpaEvent
+	TypesGrandpaErrors
+	TypesGrandpaStoredState
+	TypesGrandpaStoredPendingChange
+	TypesOptionU32
+	TypesGrandpaEquivocationProof
+	TypesGrandpaEquivocation
+	TypesGrandpaEquivocationPrevote
+	TypesGrandpaEquivocationPrecommit
+	TypesGrandpaPrevote
+	TypesGrandpaPrecommit
+	TypesTupleGrandpaPrevoteSignature
+	TypesTupleGrandpaPrecommitSignature
+	TypesGrandpaAppSignature
+	TypesMembershipProof
+
+	TypesBabeErrors
+	TypesBabeAppPublic
+	TypesTupleBabeAppPublicU64
+	TypesSequenceTupleBabeAppPublicU64
+	TypesBabeSlot
+	TypesBabeAllowedSlots
+	TypesTupleU64U64
+	TypesBabeEpochConfiguration
+	TypesBabeNextConfigDescriptor
+	TypesBabeVrfSignature
+	TypesBabePrimaryPreDigest
+	TypesBabeSecondaryPlainPreDigest
+	TypesBabeSecondaryVRFPreDigest
+	TypesBabePreDigest
+	TypesOptionBabePreDigest
+	TypesSequenceFixedSequence32U8
+	TypesOptionFixedSequence32U8
+	TypesTupleU64U32
+	TypesSequenceTupleU64U32
+	TypesBabeEquivocationProof
+
+	TypesAssetsEvent
+	TypesAssetsErrors
+	TypesAssetDetails
+	TypesAssetStatus
+	TypesAssetAccount
+	TypesExistenceReason
+	TypesApproval
+	TypesAssetMetadata
+	TypesTupleU32Address32
+	TypesTupleU32Address32Address32
+
+	TypesRuntimeError
+
+	BabeCalls
+	SudoCalls
+	UtilityCalls
+	IndicesCalls
+	SessionCalls
+	AssetsCalls
+
+	ChargeAssetTxPayment
+
+	TypesSequenceU32
+	TypesOptionSequenceU8
+	TypesHeader
+	TypesSequenceUncheckedExtrinsics
+	TypesBlock
+	TypesTupleInherentIdentifierSequenceU8
+	TypesSequenceTupleInherentIdentifierSequenceU8
+	TypesInherentData
+	TypesCheckInherentsResult
+	TypesTransactionSource
+	TypesValidTransaction
+	TypesInvalidTransaction
+	TypesUnknownTransaction
+	TypesTransactionValidityError
+	TypesTransactionValidity
+	TypesResultEmptyTupleDispatchError
+	TypesApplyExtrinsicResult
+	TypesTupleSequenceU8KeyTypeId
+	TypesSequenceTupleSequenceU8KeyTypeId
+	TypesOptionSequenceTupleSequenceU8KeyTypeId
+	TypesGrandpaAppPublic
+	TypesTupleGrandpaAppPublicU64
+	TypesSequenceTupleGrandpaAppPublicU64
+	TypesOptionEmptyTuple
+	TypesOpaqueKeyOwnershipProof
+	TypesOptionOpaqueKeyOwnershipProof
+	TypesBabeConfiguration
+	TypesBabeEpoch
+	TypesRuntimeDispatchInfo
+	TypesInclusionFee
+	TypesOptionInclusionFee
+	TypesFeeDetails
+	TestableCalls
+	TypesKeyValue
+	TypesSequenceKeyValue
+	TypesSequenceSequenceU8
 )
@@ -0,0 +1,23 @@
+package migrations
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+const (
+	// FailedMigrationHandlingKeepStuck keeps the migrations stuck after a failed migration.
+	// Only mandatory extrinsics are applied until the cursor is cleared.
+	FailedMigrationHandlingKeepStuck sc.U8 = iota
+	// FailedMigrationHandlingForceUnstuck clears the cursor after a failed migration and resumes
+	// the extrinsics. The storage version of the failed migration is not changed, so it can be
+	// fixed and executed again by a later runtime upgrade.
+	FailedMigrationHandlingForceUnstuck
+)
+
+// MaxServiceRatio is the share of the maximum block weight, which can be used by the
+// steps of the ongoing migrations in each block.
+var MaxServiceRatio = types.NewPerbillFromPercent(50)
+
+// FailedMigrationHandling is how the migrations are handled once a migration fails.
+var FailedMigrationHandling = FailedMigrationHandlingForceUnstuck
@@ -36,7 +36,7 @@ var RuntimeVersion = types.RuntimeVersion{
 		},
 		{
 			Name:    sc.NewFixedSequence[sc.U8](8, 55, 227, 151, 252, 124, 145, 245, 228), // Metadata
-			Version: sc.U32(1),
+			Version: sc.U32(2),
 		},
 		{
 			Name:    sc.NewFixedSequence[sc.U8](8, 64, 254, 58, 212, 1, 248, 149, 154), // BlockBuilder
@@ -50,10 +50,7 @@ var RuntimeVersion = types.RuntimeVersion{
 			Name:    sc.NewFixedSequence[sc.U8](8, 247, 139, 39, 139, 229, 63, 69, 76), // OffchainWorkerApi
 			Version: sc.U32(2),
 		},
-		{
-			Name:    sc.NewFixedSequence[sc.U8](8, 221, 113, 141, 92, 197, 50, 98, 212), // AuraApi
-			Version: sc.U32(1),
-		},
+		consensusApi,
 		{
 			Name:    sc.NewFixedSequence[sc.U8](8, 171, 60, 5, 114, 41, 31, 235, 139), // SessionKeys
 			Version: sc.U32(1),
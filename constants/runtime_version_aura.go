@@ -0,0 +1,14 @@
+//go:build !babe
+
+package constants
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// consensusApi is the runtime API of the block authoring module.
+var consensusApi = types.ApiItem{
+	Name:    sc.NewFixedSequence[sc.U8](8, 221, 113, 141, 92, 197, 50, 98, 212), // AuraApi
+	Version: sc.U32(1),
+}
@@ -0,0 +1,14 @@
+//go:build babe
+
+package constants
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// consensusApi is the runtime API of the block authoring module.
+var consensusApi = types.ApiItem{
+	Name:    sc.NewFixedSequence[sc.U8](8, 203, 202, 37, 227, 159, 20, 35, 135), // BabeApi
+	Version: sc.U32(2),
+}
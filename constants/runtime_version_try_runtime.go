@@ -0,0 +1,15 @@
+//go:build tryruntime
+
+package constants
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+func init() {
+	RuntimeVersion.Apis = append(RuntimeVersion.Apis, types.ApiItem{
+		Name:    sc.NewFixedSequence[sc.U8](8, 224, 131, 127, 206, 9, 244, 130, 154), // TryRuntime
+		Version: sc.U32(1),
+	})
+}
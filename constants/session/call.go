@@ -0,0 +1,9 @@
+package session
+
+import sc "github.com/LimeChain/goscale"
+
+const (
+	ModuleIndex            = sc.U8(9)
+	FunctionSetKeysIndex   = 0
+	FunctionPurgeKeysIndex = 1
+)
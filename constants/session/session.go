@@ -0,0 +1,10 @@
+package session
+
+import sc "github.com/LimeChain/goscale"
+
+const (
+	// Period is the number of blocks in a session.
+	Period sc.U32 = 1_800
+	// Offset is the number of the block, in which the first session ends.
+	Offset sc.U32 = 0
+)
@@ -0,0 +1,12 @@
+package sudo
+
+import sc "github.com/LimeChain/goscale"
+
+const (
+	ModuleIndex                      = sc.U8(6)
+	FunctionSudoIndex                = 0
+	FunctionSudoUncheckedWeightIndex = 1
+	FunctionSetKeyIndex              = 2
+	FunctionSudoAsIndex              = 3
+	FunctionRemoveKeyIndex           = 4
+)
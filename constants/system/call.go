@@ -3,6 +3,13 @@ package system
 import sc "github.com/LimeChain/goscale"
 
 const (
-	ModuleIndex         = sc.U8(0)
-	FunctionRemarkIndex = 0
+	ModuleIndex                       = sc.U8(0)
+	FunctionRemarkIndex               = 0
+	FunctionSetHeapPagesIndex         = 1
+	FunctionSetCodeIndex              = 2
+	FunctionSetCodeWithoutChecksIndex = 3
+	FunctionSetStorageIndex           = 4
+	FunctionKillStorageIndex          = 5
+	FunctionKillPrefixIndex           = 6
+	FunctionRemarkWithEventIndex      = 7
 )
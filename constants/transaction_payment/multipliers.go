@@ -1,7 +1,25 @@
 package transaction_payment
 
-import sc "github.com/LimeChain/goscale"
+import (
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
 
 const (
 	OperationalFeeMultiplier = sc.U8(5)
 )
+
+var (
+	// TargetBlockFullness is the fullness of the normal dispatch class of a block, at which the fee multiplier
+	// stays the same. Fuller blocks increase the multiplier and emptier blocks decrease it.
+	TargetBlockFullness = types.NewPerquintillFromPercent(25)
+	// AdjustmentVariable is the sensitivity of the fee multiplier to the difference between the fullness
+	// of a block and the target fullness.
+	AdjustmentVariable = types.NewFixedU128FromRational(big.NewInt(3), big.NewInt(100_000), types.RoundingDown).Value
+	// MinimumMultiplier is the minimum value of the fee multiplier.
+	MinimumMultiplier = types.NewFixedU128FromRational(big.NewInt(1), big.NewInt(1_000_000_000), types.RoundingDown).Value
+	// MaximumMultiplier is the maximum value of the fee multiplier.
+	MaximumMultiplier = types.FixedU128Max()
+)
@@ -0,0 +1,15 @@
+package utility
+
+import sc "github.com/LimeChain/goscale"
+
+const (
+	ModuleIndex               = sc.U8(7)
+	FunctionBatchIndex        = 0
+	FunctionAsDerivativeIndex = 1
+	FunctionBatchAllIndex     = 2
+	FunctionDispatchAsIndex   = 3
+	FunctionForceBatchIndex   = 4
+)
+
+// BatchedCallsLimit is the limit on the number of batched calls.
+const BatchedCallsLimit = 10_922
@@ -15,11 +15,11 @@ const WeightRefTimePerNanos sc.U64 = 1_000
 
 // We assume that ~10% of the block weight is consumed by `on_initialize` handlers.
 // This is used to limit the maximal weight of a single extrinsic.
-var AverageOnInitializeRatio types.Perbill = types.Perbill{Percentage: 10}
+var AverageOnInitializeRatio types.Perbill = types.NewPerbillFromPercent(10)
 
 // We allow `Normal` extrinsics to fill up the block up to 75%, the rest can be used
 // by  Operational  extrinsics.
-var NormalDispatchRatio types.Perbill = types.Perbill{Percentage: 75}
+var NormalDispatchRatio types.Perbill = types.NewPerbillFromPercent(75)
 
 // Block resource limits configuration structures.
 //
@@ -78,6 +78,31 @@ var DbWeight types.RuntimeDbWeight = types.RuntimeDbWeight{
 	Write: 100_000 * WeightRefTimePerNanos,
 }
 
-var WeightToFee types.WeightToFee = types.IdentityFee{}
-var LengthToFee types.WeightToFee = types.IdentityFee{}
-var FeeMultiplierUpdate types.WeightToFee = types.NewConstantMultiplier(sc.NewU128FromUint64(1))
+// WeightToFeeCoefficients map one unit of ref time to one unit of fee.
+var WeightToFeeCoefficients = []types.WeightToFeeCoefficient{
+	{
+		CoeffInteger: sc.NewU128FromUint64(1),
+		CoeffFrac:    types.NewPerbillFromParts(0),
+		Negative:     false,
+		Degree:       1,
+	},
+}
+
+// ProofSizeToFeeCoefficients map the proof size to a fee. With no coefficients, the proof size is free.
+var ProofSizeToFeeCoefficients = []types.WeightToFeeCoefficient{}
+
+// LengthToFeeCoefficients map one byte of an extrinsic to one unit of fee.
+var LengthToFeeCoefficients = []types.WeightToFeeCoefficient{
+	{
+		CoeffInteger: sc.NewU128FromUint64(1),
+		CoeffFrac:    types.NewPerbillFromParts(0),
+		Negative:     false,
+		Degree:       1,
+	},
+}
+
+var WeightToFee types.WeightToFee = types.NewTwoDimensionalWeightToFee(
+	types.NewWeightToFeePolynomial(WeightToFeeCoefficients...),
+	types.NewWeightToFeePolynomial(ProofSizeToFeeCoefficients...),
+)
+var LengthToFee types.WeightToFee = types.NewWeightToFeePolynomial(LengthToFeeCoefficients...)
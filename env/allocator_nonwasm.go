@@ -2,14 +2,16 @@
 
 package env
 
+import "github.com/LimeChain/gosemble/utils"
+
 /*
 	Allocator: Provides functionality for calling into the memory allocator.
 */
 
 func ExtAllocatorFreeVersion1(ptr int32) {
-	panic("not implemented")
+	// the memory is released by the garbage collector
 }
 
 func ExtAllocatorMallocVersion1(size int32) int32 {
-	panic("not implemented")
+	return utils.Offset32(make([]byte, size))
 }
@@ -2,46 +2,114 @@
 
 package env
 
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/utils"
+)
+
 /*
 	Crypto: Interfaces for working with crypto related types from within the runtime.
 */
 
+const (
+	keyTypeIdLength      = 4
+	publicKeyLength      = 32
+	signatureLength      = 64
+	ecdsaSignatureLength = 65
+	messageHashLength    = 32
+	// ecdsaBadSignature is the EcdsaVerifyError returned for signatures, which cannot be recovered.
+	ecdsaBadSignature = 2
+)
+
 func ExtCryptoEd25519GenerateVersion1(key_type_id int32, seed int64) int32 {
-	panic("not implemented")
+	return generate(host.SchemeEd25519, key_type_id, seed)
 }
 
 func ExtCryptoEd25519VerifyVersion1(sig int32, msg int64, key int32) int32 {
-	panic("not implemented")
+	return verify(host.Ed25519Verify, sig, msg, key)
 }
 
 func ExtCryptoFinishBatchVerifyVersion1() int32 {
-	panic("not implemented")
+	return toInt32(host.DefaultBatchVerifier().FinishBatchVerify())
 }
 
 func ExtCryptoSecp256k1EcdsaRecoverVersion2(sig int32, msg int32) int64 {
-	panic("not implemented")
+	return ecdsaRecover(host.EcdsaRecover, sig, msg)
 }
 
 func ExtCryptoSecp256k1EcdsaRecoverCompressedVersion2(sig int32, msg int32) int64 {
-	panic("not implemented")
+	return ecdsaRecover(host.EcdsaRecoverCompressed, sig, msg)
 }
 
 func ExtCryptoSr25519GenerateVersion1(key_type_id int32, seed int64) int32 {
-	panic("not implemented")
+	return generate(host.SchemeSr25519, key_type_id, seed)
 }
 
 func ExtCryptoSr25519PublicKeysVersion1(key_type_id int32) int64 {
-	panic("not implemented")
+	keyTypeId := utils.ToWasmMemorySlice(key_type_id, keyTypeIdLength)
+
+	// Vec<[u8; 32]>
+	keys := host.DefaultKeystore().PublicKeys(host.SchemeSr25519, keyTypeId)
+	result := sc.ToCompact(len(keys)).Bytes()
+	for _, key := range keys {
+		result = append(result, key...)
+	}
+
+	return fromBytes(result)
 }
 
 func ExtCryptoSr25519SignVersion1(key_type_id int32, key int32, msg int64) int64 {
-	panic("not implemented")
+	keyTypeId := utils.ToWasmMemorySlice(key_type_id, keyTypeIdLength)
+	pubKey := utils.ToWasmMemorySlice(key, publicKeyLength)
+
+	// Option<[u8; 64]>
+	signature, ok := host.DefaultKeystore().Sign(host.SchemeSr25519, keyTypeId, pubKey, toBytes(msg))
+	if !ok {
+		return fromBytes([]byte{0})
+	}
+
+	return fromBytes(append([]byte{1}, signature...))
 }
 
 func ExtCryptoSr25519VerifyVersion2(sig int32, msg int64, key int32) int32 {
-	panic("not implemented")
+	return verify(host.Sr25519Verify, sig, msg, key)
 }
 
 func ExtCryptoStartBatchVerifyVersion1() {
-	panic("not implemented")
+	host.DefaultBatchVerifier().StartBatchVerify()
+}
+
+func generate(scheme host.Scheme, keyTypeIdOffset int32, seedOffsetSize int64) int32 {
+	keyTypeId := utils.ToWasmMemorySlice(keyTypeIdOffset, keyTypeIdLength)
+	seed, _ := decodeOptionBytes(toBytes(seedOffsetSize))
+
+	return utils.Offset32(host.DefaultKeystore().Generate(scheme, keyTypeId, seed))
+}
+
+func verify(verifier func(signature []byte, message []byte, pubKey []byte) bool, sig int32, msg int64, key int32) int32 {
+	signature := utils.ToWasmMemorySlice(sig, signatureLength)
+	pubKey := utils.ToWasmMemorySlice(key, publicKeyLength)
+
+	return toInt32(host.DefaultBatchVerifier().Record(verifier(signature, toBytes(msg), pubKey)))
+}
+
+func ecdsaRecover(recover func(signature []byte, msgHash []byte) ([]byte, bool), sig int32, msg int32) int64 {
+	signature := utils.ToWasmMemorySlice(sig, ecdsaSignatureLength)
+	msgHash := utils.ToWasmMemorySlice(msg, messageHashLength)
+
+	// Result<PublicKey, EcdsaVerifyError>
+	pubKey, ok := recover(signature, msgHash)
+	if !ok {
+		return fromBytes([]byte{1, ecdsaBadSignature})
+	}
+
+	return fromBytes(append([]byte{0}, pubKey...))
+}
+
+func toInt32(value bool) int32 {
+	if value {
+		return 1
+	}
+	return 0
 }
@@ -0,0 +1,55 @@
+//go:build nonwasmenv
+
+package env
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var keyTypeId = []byte("test")
+
+func Test_ExtCryptoSr25519_SignAndVerify(t *testing.T) {
+	host.Reset()
+
+	noSeed := utils.BytesToOffsetAndSize(sc.NewOption[sc.Sequence[sc.U8]](nil).Bytes())
+	pubKey := utils.ToWasmMemorySlice(ExtCryptoSr25519GenerateVersion1(utils.Offset32(keyTypeId), noSeed), publicKeyLength)
+
+	publicKeys := toBytes(ExtCryptoSr25519PublicKeysVersion1(utils.Offset32(keyTypeId)))
+	assert.Equal(t, append(sc.ToCompact(1).Bytes(), pubKey...), publicKeys)
+
+	message := utils.BytesToOffsetAndSize([]byte("message"))
+	signed := toBytes(ExtCryptoSr25519SignVersion1(utils.Offset32(keyTypeId), utils.Offset32(pubKey), message))
+	assert.Equal(t, byte(1), signed[0])
+
+	signature := signed[1:]
+	assert.Equal(t, int32(1), ExtCryptoSr25519VerifyVersion2(utils.Offset32(signature), message, utils.Offset32(pubKey)))
+
+	other := utils.BytesToOffsetAndSize([]byte("other"))
+	assert.Equal(t, int32(0), ExtCryptoSr25519VerifyVersion2(utils.Offset32(signature), other, utils.Offset32(pubKey)))
+}
+
+func Test_ExtCrypto_BatchVerify(t *testing.T) {
+	host.Reset()
+
+	signature := make([]byte, signatureLength)
+	pubKey := make([]byte, publicKeyLength)
+	message := utils.BytesToOffsetAndSize([]byte("message"))
+
+	ExtCryptoStartBatchVerifyVersion1()
+	assert.Equal(t, int32(1), ExtCryptoEd25519VerifyVersion1(utils.Offset32(signature), message, utils.Offset32(pubKey)))
+	assert.Equal(t, int32(0), ExtCryptoFinishBatchVerifyVersion1())
+}
+
+func Test_ExtCryptoSecp256k1EcdsaRecoverCompressedVersion2_BadSignature(t *testing.T) {
+	signature := make([]byte, ecdsaSignatureLength)
+	msgHash := make([]byte, messageHashLength)
+
+	result := toBytes(ExtCryptoSecp256k1EcdsaRecoverCompressedVersion2(utils.Offset32(signature), utils.Offset32(msgHash)))
+
+	assert.Equal(t, []byte{1, ecdsaBadSignature}, result)
+}
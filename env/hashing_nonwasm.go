@@ -2,26 +2,31 @@
 
 package env
 
+import (
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/utils"
+)
+
 /*
 	Hashing: Interface that provides functions for hashing with different algorithms.
 */
 
 func ExtHashingBlake2128Version1(data int64) int32 {
-	panic("not implemented")
+	return utils.Offset32(host.Blake2b128(toBytes(data)))
 }
 
 func ExtHashingBlake2256Version1(data int64) int32 {
-	panic("not implemented")
+	return utils.Offset32(host.Blake2b256(toBytes(data)))
 }
 
 func ExtHashingKeccak256Version1(data int64) int32 {
-	panic("not implemented")
+	return utils.Offset32(host.Keccak256(toBytes(data)))
 }
 
 func ExtHashingTwox128Version1(data int64) int32 {
-	panic("not implemented")
+	return utils.Offset32(host.Twox128(toBytes(data)))
 }
 
 func ExtHashingTwox64Version1(data int64) int32 {
-	panic("not implemented")
+	return utils.Offset32(host.Twox64(toBytes(data)))
 }
@@ -2,15 +2,28 @@
 
 package env
 
+import "fmt"
+
 /*
 	Log: Request to print a log message on the host. Note that this will be
 	only displayed if the host is enabled to display log messages with given level and target.
 */
 
+// logLevels are the names of the levels, passed by the runtime logger.
+var logLevels = []string{"CRITICAL", "WARN", "INFO", "DEBUG", "TRACE"}
+
+// logLevelFilterTrace is the maximum level filter of the host, which displays all messages.
+const logLevelFilterTrace = 5
+
 func ExtLoggingLogVersion1(level int32, target int64, message int64) {
-	panic("not implemented")
+	levelStr := ""
+	if level >= 0 && int(level) < len(logLevels) {
+		levelStr = logLevels[level]
+	}
+
+	fmt.Println(levelStr, " target="+string(toBytes(target)), " message="+string(toBytes(message)))
 }
 
 func ExtLoggingMaxLevelVersion1() int32 {
-	panic("not implemented")
+	return logLevelFilterTrace
 }
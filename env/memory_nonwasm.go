@@ -0,0 +1,54 @@
+//go:build nonwasmenv
+
+package env
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/utils"
+)
+
+/*
+	Memory: Helpers for exchanging data with the in-memory host of nonwasm builds.
+*/
+
+// toBytes returns the memory, addressed by the packed offset and size.
+func toBytes(offsetAndSize int64) []byte {
+	offset, size := utils.Int64ToOffsetAndSize(offsetAndSize)
+	return utils.ToWasmMemorySlice(offset, size)
+}
+
+// fromBytes returns the packed offset and size of the memory, returned to the runtime.
+func fromBytes(data []byte) int64 {
+	return utils.BytesToOffsetAndSize(data)
+}
+
+// decodeOptionBytes decodes a SCALE-encoded Option<Vec<u8>>. An empty input is `None`.
+func decodeOptionBytes(data []byte) ([]byte, bool) {
+	if len(data) == 0 {
+		return nil, false
+	}
+
+	option := sc.DecodeOptionWith(bytes.NewBuffer(data), sc.DecodeSequence[sc.U8])
+	if !option.HasValue {
+		return nil, false
+	}
+
+	return sc.SequenceU8ToBytes(option.Value), true
+}
+
+// decodeOptionU32 decodes a SCALE-encoded Option<u32>.
+func decodeOptionU32(data []byte) (uint32, bool) {
+	option := sc.DecodeOption[sc.U32](bytes.NewBuffer(data))
+	return uint32(option.Value), bool(option.HasValue)
+}
+
+// encodeOptionBytes SCALE-encodes the value as Option<Vec<u8>>.
+func encodeOptionBytes(value []byte, ok bool) []byte {
+	if !ok {
+		return sc.NewOption[sc.Sequence[sc.U8]](nil).Bytes()
+	}
+
+	return sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(value)).Bytes()
+}
@@ -2,18 +2,24 @@
 
 package env
 
+import (
+	"fmt"
+
+	"github.com/LimeChain/gosemble/primitives/host"
+)
+
 /*
 	Miscellaneous: Interface that provides miscellaneous functions for communicating between the runtime and the node.
 */
 
 func ExtMiscPrintHexVersion1(data int64) {
-	panic("not implemented")
+	fmt.Printf("0x%x\n", toBytes(data))
 }
 
 func ExtMiscPrintUtf8Version1(data int64) {
-	panic("not implemented")
+	fmt.Println(string(toBytes(data)))
 }
 
 func ExtMiscRuntimeVersionVersion1(data int64) int64 {
-	panic("not implemented")
+	return fromBytes(encodeOptionBytes(host.RuntimeVersion(toBytes(data))))
 }
@@ -0,0 +1,12 @@
+//go:build !nonwasmenv
+
+package env
+
+/*
+	Offchain: Interface that provides functions to access the offchain functionality.
+	The functions are only available in the offchain context of the runtime.
+*/
+
+//go:wasm-module env
+//go:export ext_offchain_submit_transaction_version_1
+func ExtOffchainSubmitTransactionVersion1(data int64) int64
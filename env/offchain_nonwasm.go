@@ -0,0 +1,17 @@
+//go:build nonwasmenv
+
+package env
+
+import "github.com/LimeChain/gosemble/primitives/host"
+
+/*
+	Offchain: Interface that provides functions to access the offchain functionality.
+	The functions are only available in the offchain context of the runtime.
+*/
+
+func ExtOffchainSubmitTransactionVersion1(data int64) int64 {
+	host.DefaultTransactionPool().Submit(toBytes(data))
+
+	// Result<(), ()>
+	return fromBytes([]byte{0})
+}
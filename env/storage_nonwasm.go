@@ -2,54 +2,89 @@
 
 package env
 
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/host"
+)
+
 /*
 	Storage: Interface for manipulating the storage from within the runtime.
 */
 
-func ExtStorageAppendVersion1(key int64, value int64) int64 {
-	panic("not implemented")
+const (
+	killStorageResultAllRemoved    = 0
+	killStorageResultSomeRemaining = 1
+)
+
+func ExtStorageAppendVersion1(key int64, value int64) {
+	host.DefaultStorage().Append(toBytes(key), toBytes(value))
 }
 
 func ExtStorageClearVersion1(key_data int64) {
-	panic("not implemented")
+	host.DefaultStorage().Clear(toBytes(key_data))
 }
 
 func ExtStorageClearPrefixVersion2(prefix int64, limit int64) int64 {
-	panic("not implemented")
+	var maxKeys *uint32
+	if value, ok := decodeOptionU32(toBytes(limit)); ok {
+		maxKeys = &value
+	}
+
+	removed, allRemoved := host.DefaultStorage().ClearPrefix(toBytes(prefix), maxKeys)
+
+	// KillStorageResult
+	result := []byte{killStorageResultSomeRemaining}
+	if allRemoved {
+		result = []byte{killStorageResultAllRemoved}
+	}
+
+	return fromBytes(append(result, sc.U32(removed).Bytes()...))
 }
 
 func ExtStorageCommitTransactionVersion() {
-	panic("not implemented")
+	host.DefaultStorage().CommitTransaction()
 }
 
 func ExtStorageExistsVersion1(key int64) int32 {
-	panic("not implemented")
+	return toInt32(host.DefaultStorage().Exists(toBytes(key)))
 }
 
 func ExtStorageGetVersion1(key int64) int64 {
-	panic("not implemented")
+	return fromBytes(encodeOptionBytes(host.DefaultStorage().Get(toBytes(key))))
 }
 
 func ExtStorageNextKeyVersion1(key int64) int64 {
-	panic("not implemented")
+	return fromBytes(encodeOptionBytes(host.DefaultStorage().NextKey(toBytes(key))))
 }
 
 func ExtStorageReadVersion1(key int64, value_out int64, offset int32) int64 {
-	panic("not implemented")
+	value, ok := host.DefaultStorage().Get(toBytes(key))
+	if !ok {
+		return fromBytes(sc.NewOption[sc.U32](nil).Bytes())
+	}
+
+	start := int(offset)
+	if start > len(value) {
+		start = len(value)
+	}
+
+	copy(toBytes(value_out), value[start:])
+
+	return fromBytes(sc.NewOption[sc.U32](sc.U32(len(value) - start)).Bytes())
 }
 
 func ExtStorageRollbackTransactionVersion1() {
-	panic("not implemented")
+	host.DefaultStorage().RollbackTransaction()
 }
 
 func ExtStorageRootVersion2(key int32) int64 {
-	panic("not implemented")
+	return fromBytes(host.DefaultStorage().Root(key))
 }
 
 func ExtStorageSetVersion1(key int64, value int64) {
-	panic("not implemented")
+	host.DefaultStorage().Set(toBytes(key), toBytes(value))
 }
 
 func ExtStorageStartTransactionVersion1() {
-	panic("not implemented")
+	host.DefaultStorage().StartTransaction()
 }
@@ -0,0 +1,78 @@
+//go:build nonwasmenv
+
+package env
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ExtStorage_SetGet(t *testing.T) {
+	host.Reset()
+
+	key := []byte("key")
+	ExtStorageSetVersion1(utils.BytesToOffsetAndSize(key), utils.BytesToOffsetAndSize([]byte{1, 2, 3}))
+
+	assert.Equal(t, int32(1), ExtStorageExistsVersion1(utils.BytesToOffsetAndSize(key)))
+	assert.Equal(t,
+		sc.NewOption[sc.Sequence[sc.U8]](sc.Sequence[sc.U8]{1, 2, 3}).Bytes(),
+		toBytes(ExtStorageGetVersion1(utils.BytesToOffsetAndSize(key))),
+	)
+
+	ExtStorageClearVersion1(utils.BytesToOffsetAndSize(key))
+
+	assert.Equal(t, int32(0), ExtStorageExistsVersion1(utils.BytesToOffsetAndSize(key)))
+	assert.Equal(t,
+		sc.NewOption[sc.Sequence[sc.U8]](nil).Bytes(),
+		toBytes(ExtStorageGetVersion1(utils.BytesToOffsetAndSize(key))),
+	)
+}
+
+func Test_ExtStorage_Read(t *testing.T) {
+	host.Reset()
+	host.DefaultStorage().Set([]byte("key"), []byte{1, 2, 3})
+
+	valueOut := make([]byte, 2)
+	result := ExtStorageReadVersion1(utils.BytesToOffsetAndSize([]byte("key")), utils.BytesToOffsetAndSize(valueOut), 1)
+
+	assert.Equal(t, sc.NewOption[sc.U32](sc.U32(2)).Bytes(), toBytes(result))
+	assert.Equal(t, []byte{2, 3}, valueOut)
+}
+
+func Test_ExtStorage_ClearPrefixVersion2(t *testing.T) {
+	host.Reset()
+	host.DefaultStorage().Set([]byte("pa"), []byte{})
+	host.DefaultStorage().Set([]byte("pb"), []byte{})
+	host.DefaultStorage().Set([]byte("q"), []byte{})
+
+	prefix := utils.BytesToOffsetAndSize([]byte("p"))
+
+	result := ExtStorageClearPrefixVersion2(prefix, utils.BytesToOffsetAndSize(sc.NewOption[sc.U32](sc.U32(1)).Bytes()))
+	assert.Equal(t, []byte{killStorageResultSomeRemaining, 1, 0, 0, 0}, toBytes(result))
+
+	result = ExtStorageClearPrefixVersion2(prefix, utils.BytesToOffsetAndSize(sc.NewOption[sc.U32](nil).Bytes()))
+	assert.Equal(t, []byte{killStorageResultAllRemoved, 1, 0, 0, 0}, toBytes(result))
+
+	assert.Equal(t, [][]byte{[]byte("q")}, host.DefaultStorage().Keys())
+}
+
+func Test_ExtStorage_Transactions(t *testing.T) {
+	host.Reset()
+	key := utils.BytesToOffsetAndSize([]byte("key"))
+
+	ExtStorageStartTransactionVersion1()
+	ExtStorageAppendVersion1(key, utils.BytesToOffsetAndSize([]byte{1}))
+	ExtStorageRollbackTransactionVersion1()
+
+	assert.Equal(t, int32(0), ExtStorageExistsVersion1(key))
+
+	ExtStorageStartTransactionVersion1()
+	ExtStorageAppendVersion1(key, utils.BytesToOffsetAndSize([]byte{1}))
+	ExtStorageCommitTransactionVersion()
+
+	assert.Equal(t, int32(1), ExtStorageExistsVersion1(key))
+}
@@ -2,10 +2,26 @@
 
 package env
 
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/utils"
+)
+
 /*
 	Trie: Interface that provides trie related functionality
 */
 
 func ExtTrieBlake2256OrderedRootVersion2(input int64, version int32) int32 {
-	panic("not implemented")
+	// Vec<Vec<u8>>
+	sequences := sc.DecodeSequenceWith(bytes.NewBuffer(toBytes(input)), sc.DecodeSequence[sc.U8])
+
+	values := make([][]byte, len(sequences))
+	for i, value := range sequences {
+		values[i] = sc.SequenceU8ToBytes(value)
+	}
+
+	return utils.Offset32(host.OrderedRoot(values, version))
 }
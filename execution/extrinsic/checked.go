@@ -4,18 +4,19 @@ import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/execution/types"
 	"github.com/LimeChain/gosemble/frame/support"
-	system "github.com/LimeChain/gosemble/frame/system/extensions"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
 type Checked types.CheckedExtrinsic
 
-func (xt Checked) Validate(validator UnsignedValidator, source primitives.TransactionSource, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
+// Validate validates the extrinsic for the transaction queue. Signed extrinsics are validated by the
+// extensions of their extra, and unsigned ones by the signed extensions of the runtime, `extensions`.
+func (xt Checked) Validate(validator UnsignedValidator, extensions primitives.SignedExtra, source primitives.TransactionSource, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
 	if xt.Signed.HasValue {
 		id, extra := xt.Signed.Value.Address32, xt.Signed.Value.SignedExtra
-		ok, err = system.Extra(extra).Validate(&id, &xt.Function, info, length)
+		ok, err = extra.Validate(&id, &xt.Function, info, length)
 	} else {
-		valid, err := system.Extra(primitives.SignedExtra{}).ValidateUnsigned(&xt.Function, info, length)
+		valid, err := extensions.ValidateUnsigned(&xt.Function, info, length)
 		if err != nil {
 			return ok, err
 		}
@@ -31,19 +32,23 @@ func (xt Checked) Validate(validator UnsignedValidator, source primitives.Transa
 	return ok, err
 }
 
-func (xt Checked) Apply(validator UnsignedValidator, info *primitives.DispatchInfo, length sc.Compact) (primitives.DispatchResultWithPostInfo[primitives.PostDispatchInfo], primitives.TransactionValidityError) {
+// Apply dispatches the extrinsic. Signed extrinsics are checked by the extensions of their extra,
+// and unsigned ones by the signed extensions of the runtime, `extensions`.
+func (xt Checked) Apply(validator UnsignedValidator, extensions primitives.SignedExtra, info *primitives.DispatchInfo, length sc.Compact) (primitives.DispatchResultWithPostInfo[primitives.PostDispatchInfo], primitives.TransactionValidityError) {
 	var (
 		maybeWho sc.Option[primitives.Address32]
-		maybePre sc.Option[primitives.Pre]
+		maybePre sc.Option[sc.Sequence[primitives.Pre]]
+		extra    primitives.SignedExtra
 	)
 
 	if xt.Signed.HasValue {
-		id, extra := xt.Signed.Value.Address32, xt.Signed.Value.SignedExtra
-		pre, err := system.Extra(extra).PreDispatch(&id, &xt.Function, info, length)
+		id := xt.Signed.Value.Address32
+		extra = xt.Signed.Value.SignedExtra
+		pre, err := extra.PreDispatch(&id, &xt.Function, info, length)
 		if err != nil {
 			return primitives.DispatchResultWithPostInfo[primitives.PostDispatchInfo]{}, err
 		}
-		maybeWho, maybePre = sc.NewOption[primitives.Address32](id), sc.NewOption[primitives.Pre](pre)
+		maybeWho, maybePre = sc.NewOption[primitives.Address32](id), sc.NewOption[sc.Sequence[primitives.Pre]](pre)
 	} else {
 		// Do any pre-flight stuff for an unsigned transaction.
 		//
@@ -53,7 +58,8 @@ func (xt Checked) Apply(validator UnsignedValidator, info *primitives.DispatchIn
 		//
 		// If you ever override this function, you need to make sure to always
 		// perform the same validation as in `ValidateUnsigned`.
-		_, err := system.Extra{}.PreDispatchUnsigned(&xt.Function, info, length)
+		extra = extensions
+		err := extra.PreDispatchUnsigned(&xt.Function, info, length)
 		if err != nil {
 			return primitives.DispatchResultWithPostInfo[primitives.PostDispatchInfo]{}, err
 		}
@@ -63,7 +69,7 @@ func (xt Checked) Apply(validator UnsignedValidator, info *primitives.DispatchIn
 			return primitives.DispatchResultWithPostInfo[primitives.PostDispatchInfo]{}, err
 		}
 
-		maybeWho, maybePre = sc.NewOption[primitives.Address32](nil), sc.NewOption[primitives.Pre](nil)
+		maybeWho, maybePre = sc.NewOption[primitives.Address32](nil), sc.NewOption[sc.Sequence[primitives.Pre]](nil)
 	}
 
 	var resWithInfo primitives.DispatchResultWithPostInfo[primitives.PostDispatchInfo]
@@ -80,18 +86,19 @@ func (xt Checked) Apply(validator UnsignedValidator, info *primitives.DispatchIn
 		},
 	)
 
-	var postInfo primitives.PostDispatchInfo
+	// Keep the post dispatch info of the call, which may refund weight or waive the fee,
+	// and fill in only what the call left out from the pre dispatch info.
+	postInfo := resWithInfo.Ok
 	if resWithInfo.HasError {
 		postInfo = resWithInfo.Err.PostInfo
-	} else {
-		postInfo = primitives.PostDispatchInfo{
-			ActualWeight: sc.NewOption[primitives.Weight](info.Weight),
-			PaysFee:      info.PaysFee[0].(sc.U8),
-		}
 	}
+	if !postInfo.ActualWeight.HasValue {
+		postInfo.ActualWeight = sc.NewOption[primitives.Weight](info.Weight)
+	}
+	postInfo.PaysFee = postInfo.Pays(info)[0].(sc.U8)
 
 	dispatchResult := primitives.NewDispatchResult(resWithInfo.Err)
-	_, err := system.Extra{}.PostDispatch(maybePre, info, &postInfo, length, &dispatchResult)
+	err := extra.PostDispatch(maybePre, info, &postInfo, length, &dispatchResult)
 
 	dispatchResultWithPostInfo := primitives.DispatchResultWithPostInfo[primitives.PostDispatchInfo]{}
 	// TODO: err should be checked, not resWithInfo again
@@ -0,0 +1,100 @@
+//go:build nonwasmenv
+
+package extrinsic
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/execution/types"
+	"github.com/LimeChain/gosemble/frame/sudo"
+	sudoDispatchables "github.com/LimeChain/gosemble/frame/sudo/dispatchables"
+	systemDispatchables "github.com/LimeChain/gosemble/frame/system/dispatchables"
+	"github.com/LimeChain/gosemble/primitives/host"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var alice = primitives.NewAddress32(append(make([]sc.U8, 31), 1)...)
+
+// postInfoRecorder records the post dispatch info, which is passed to the signed extensions.
+type postInfoRecorder struct {
+	primitives.DefaultSignedExtension
+	postInfo *primitives.PostDispatchInfo
+}
+
+func (r postInfoRecorder) Encode(_ *bytes.Buffer) {}
+
+func (r postInfoRecorder) Bytes() []byte {
+	return []byte{}
+}
+
+func (r postInfoRecorder) Decode(_ *bytes.Buffer) primitives.SignedExtension {
+	return r
+}
+
+func (r postInfoRecorder) Identifier() sc.Str {
+	return "PostInfoRecorder"
+}
+
+func (r postInfoRecorder) PostDispatch(_ sc.Option[primitives.Pre], _ *primitives.DispatchInfo, postInfo *primitives.PostDispatchInfo, _ sc.Compact, _ *primitives.DispatchResult) primitives.TransactionValidityError {
+	*r.postInfo = *postInfo
+	return nil
+}
+
+func (r postInfoRecorder) Metadata() primitives.MetadataSignedExtension {
+	return primitives.NewMetadataSignedExtension(r.Identifier(), metadata.TypesEmptyTuple, metadata.TypesEmptyTuple)
+}
+
+func Test_Checked_Apply_PostDispatchInfo(t *testing.T) {
+	remark := systemDispatchables.NewRemarkCall(sc.NewVaryingData(sc.Sequence[sc.U8]{}))
+	sudoRemark := sudoDispatchables.NewSudoCall(sc.NewVaryingData(remark))
+
+	var testExamples = []struct {
+		label       string
+		call        primitives.Call
+		expectation primitives.PostDispatchInfo
+	}{
+		{
+			label: "Fills in the pre dispatch info",
+			call:  remark,
+			expectation: primitives.PostDispatchInfo{
+				ActualWeight: sc.NewOption[primitives.Weight](primitives.GetDispatchInfo(remark).Weight),
+				PaysFee:      primitives.PaysYes,
+			},
+		},
+		{
+			label: "Keeps the fee waived by sudo",
+			call:  sudoRemark,
+			expectation: primitives.PostDispatchInfo{
+				ActualWeight: sc.NewOption[primitives.Weight](primitives.GetDispatchInfo(sudoRemark).Weight),
+				PaysFee:      primitives.PaysNo,
+			},
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			host.Reset()
+			sudo.GenesisConfig{Key: sc.NewOption[primitives.Address32](alice)}.BuildGenesis()
+
+			postInfo := primitives.PostDispatchInfo{}
+			xt := Checked(types.CheckedExtrinsic{
+				Signed: sc.NewOption[primitives.AccountIdExtra](primitives.AccountIdExtra{
+					Address32:   alice,
+					SignedExtra: primitives.NewSignedExtra(postInfoRecorder{postInfo: &postInfo}),
+				}),
+				Function: testExample.call,
+			})
+			info := primitives.GetDispatchInfo(testExample.call)
+
+			result, err := xt.Apply(nil, primitives.NewSignedExtra(), &info, sc.ToCompact(0))
+
+			assert.Nil(t, err)
+			assert.False(t, bool(result.HasError))
+			assert.Equal(t, testExample.expectation, postInfo)
+		})
+	}
+}
@@ -9,6 +9,16 @@ import (
 type Unchecked types.UncheckedExtrinsic
 
 func (uxt Unchecked) Check(lookup primitives.AccountIdLookup) (ok types.CheckedExtrinsic, err primitives.TransactionValidityError) {
+	return uxt.check(lookup, true)
+}
+
+// CheckWithoutSignature checks the extrinsic like Check, without verifying its signature.
+// It is meant only for the re-execution of blocks by the try-runtime checks.
+func (uxt Unchecked) CheckWithoutSignature(lookup primitives.AccountIdLookup) (ok types.CheckedExtrinsic, err primitives.TransactionValidityError) {
+	return uxt.check(lookup, false)
+}
+
+func (uxt Unchecked) check(lookup primitives.AccountIdLookup, signatureCheck bool) (ok types.CheckedExtrinsic, err primitives.TransactionValidityError) {
 	switch uxt.Signature.HasValue {
 	case true:
 		signer, signature, extra := uxt.Signature.Value.Signer, uxt.Signature.Value.Signature, uxt.Signature.Value.Extra
@@ -23,7 +33,7 @@ func (uxt Unchecked) Check(lookup primitives.AccountIdLookup) (ok types.CheckedE
 			return ok, err
 		}
 
-		if !signature.Verify(rawPayload.UsingEncoded(), signedAddress) {
+		if signatureCheck && !bool(signature.Verify(rawPayload.UsingEncoded(), signedAddress)) {
 			err := primitives.NewTransactionValidityError(primitives.NewInvalidTransactionBadProof())
 			return ok, err
 		}
@@ -21,7 +21,8 @@ func (b Block) Bytes() []byte {
 	return sc.EncodedBytes(b)
 }
 
-func DecodeBlock(buffer *bytes.Buffer) Block {
+// DecodeBlock decodes a block, whose signed extrinsics are decoded by the signed extensions of `extra`.
+func DecodeBlock(extra types.SignedExtra, buffer *bytes.Buffer) Block {
 	header := types.DecodeHeader(buffer)
 
 	size := sc.DecodeCompact(buffer)
@@ -29,7 +30,7 @@ func DecodeBlock(buffer *bytes.Buffer) Block {
 	extrinsics := make([]UncheckedExtrinsic, length.Int64())
 
 	for i := 0; i < len(extrinsics); i++ {
-		extrinsics[i] = DecodeUncheckedExtrinsic(buffer)
+		extrinsics[i] = DecodeUncheckedExtrinsic(extra, buffer)
 	}
 
 	return Block{
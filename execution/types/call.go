@@ -6,16 +6,25 @@ import (
 
 	sc "github.com/LimeChain/goscale"
 
-	"github.com/LimeChain/gosemble/config"
 	"github.com/LimeChain/gosemble/primitives/log"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
+// modules are the runtime modules, whose calls are decoded by DecodeCall.
+// They are registered by the runtime configuration, so that calls of modules which
+// dispatch other calls (e.g. sudo) can be decoded without an import cycle.
+var modules map[sc.U8]primitives.Module
+
+// RegisterModules sets the runtime modules, whose calls are decoded by DecodeCall.
+func RegisterModules(runtimeModules map[sc.U8]primitives.Module) {
+	modules = runtimeModules
+}
+
 func DecodeCall(buffer *bytes.Buffer) primitives.Call {
 	moduleIndex := sc.DecodeU8(buffer)
 	functionIndex := sc.DecodeU8(buffer)
 
-	module, ok := config.Modules[moduleIndex]
+	module, ok := modules[moduleIndex]
 	if !ok {
 		log.Critical(fmt.Sprintf("module with index [%d] not found", moduleIndex))
 	}
@@ -87,7 +87,9 @@ func (uxt UncheckedExtrinsic) Encode(buffer *bytes.Buffer) {
 	buffer.Write(tempBuffer.Bytes())
 }
 
-func DecodeUncheckedExtrinsic(buffer *bytes.Buffer) UncheckedExtrinsic {
+// DecodeUncheckedExtrinsic decodes an extrinsic, whose extra, if it is signed, is decoded by the signed
+// extensions of `extra`, configured by the runtime.
+func DecodeUncheckedExtrinsic(extra primitives.SignedExtra, buffer *bytes.Buffer) UncheckedExtrinsic {
 	// This is a little more complicated than usual since the binary format must be compatible
 	// with SCALE's generic `Vec<u8>` type. Basically this just means accepting that there
 	// will be a prefix of vector length.
@@ -103,7 +105,7 @@ func DecodeUncheckedExtrinsic(buffer *bytes.Buffer) UncheckedExtrinsic {
 
 	var extSignature sc.Option[primitives.ExtrinsicSignature]
 	if isSigned {
-		extSignature = sc.NewOption[primitives.ExtrinsicSignature](primitives.DecodeExtrinsicSignature(buffer))
+		extSignature = sc.NewOption[primitives.ExtrinsicSignature](primitives.DecodeExtrinsicSignature(extra, buffer))
 	}
 
 	// Decodes the dispatch call, including its arguments.
@@ -1,11 +1,17 @@
-package types
+//go:build nonwasmenv
+
+package types_test
 
 import (
 	"bytes"
 	"testing"
 
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/config"
+	ext "github.com/LimeChain/gosemble/execution/types"
 	"github.com/LimeChain/gosemble/frame/system/dispatchables"
+	system "github.com/LimeChain/gosemble/frame/system/extensions"
+	"github.com/LimeChain/gosemble/frame/transaction_payment"
 	"github.com/LimeChain/gosemble/primitives/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -15,12 +21,12 @@ var remarkCall = dispatchables.NewRemarkCall(sc.NewVaryingData(sc.Sequence[sc.U8
 func Test_EncodeUncheckedExtrinsic_Unsigned(t *testing.T) {
 	var testExamples = []struct {
 		label       string
-		input       UncheckedExtrinsic
+		input       ext.UncheckedExtrinsic
 		expectation []byte
 	}{
 		{
 			label:       "Encode(UnsignedUncheckedExtrinsic)",
-			input:       NewUnsignedUncheckedExtrinsic(remarkCall),
+			input:       ext.NewUnsignedUncheckedExtrinsic(remarkCall),
 			expectation: []byte{0x10, 0x4, 0x0, 0x0, 0x0},
 		},
 	}
@@ -41,12 +47,12 @@ func Test_DecodeUncheckedExtrinsic_Unsigned(t *testing.T) {
 	var testExamples = []struct {
 		label       string
 		input       []byte
-		expectation UncheckedExtrinsic
+		expectation ext.UncheckedExtrinsic
 	}{
 		{
 			label:       "Decode(UnsignedUncheckedExtrinsic)",
 			input:       []byte{0x10, 0x4, 0x0, 0x0, 0x0},
-			expectation: NewUnsignedUncheckedExtrinsic(remarkCall),
+			expectation: ext.NewUnsignedUncheckedExtrinsic(remarkCall),
 		},
 	}
 
@@ -55,7 +61,7 @@ func Test_DecodeUncheckedExtrinsic_Unsigned(t *testing.T) {
 			buffer := &bytes.Buffer{}
 			buffer.Write(testExample.input)
 
-			result := DecodeUncheckedExtrinsic(buffer)
+			result := ext.DecodeUncheckedExtrinsic(config.SignedExtra, buffer)
 
 			assert.Equal(t, testExample.expectation, result)
 		})
@@ -65,20 +71,25 @@ func Test_DecodeUncheckedExtrinsic_Unsigned(t *testing.T) {
 func Test_EncodeUncheckedExtrinsic_Signed(t *testing.T) {
 	signer := types.NewMultiAddressId(types.AccountId{Address32: types.NewAddress32(0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1)})
 	signature := types.NewMultiSignatureEd25519(types.NewEd25519(sc.FixedSequence[sc.U8]{0x00, 0x62, 0x37, 0x61, 0x33, 0x63, 0x31, 0x32, 0x64, 0x63, 0x30, 0x63, 0x38, 0x63, 0x37, 0x34, 0x38, 0x61, 0x62, 0x30, 0x37, 0x35, 0x32, 0x35, 0x62, 0x37, 0x30, 0x31, 0x31, 0x32, 0x32, 0x62, 0x38, 0x38, 0x62, 0x64, 0x37, 0x38, 0x66, 0x36, 0x30, 0x30, 0x63, 0x37, 0x36, 0x33, 0x34, 0x32, 0x64, 0x32, 0x37, 0x66, 0x32, 0x35, 0x65, 0x35, 0x66, 0x39, 0x32, 0x34, 0x34, 0x34, 0x63, 0x64}...))
-	extra := types.SignedExtra{
-		Era:   types.NewImmortalEra(),
-		Nonce: 0,
-		Fee:   sc.NewU128FromUint64(0),
-	}
+	extra := types.NewSignedExtra(
+		system.CheckNonZeroAddress{},
+		system.CheckSpecVersion{},
+		system.CheckTxVersion{},
+		system.CheckGenesis{},
+		system.NewCheckMortality(types.NewImmortalEra()),
+		system.NewCheckNonce(0),
+		system.CheckWeight{},
+		transaction_payment.NewChargeTransactionPayment(sc.NewU128FromUint64(0)),
+	)
 
 	var testExamples = []struct {
 		label       string
-		input       UncheckedExtrinsic
+		input       ext.UncheckedExtrinsic
 		expectation []byte
 	}{
 		{
 			label:       "Encode(SignedUncheckedExtrinsic)",
-			input:       NewSignedUncheckedExtrinsic(remarkCall, signer, signature, extra),
+			input:       ext.NewSignedUncheckedExtrinsic(remarkCall, signer, signature, extra),
 			expectation: []byte{0xa5, 0x1, 0x84, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x0, 0x0, 0x62, 0x37, 0x61, 0x33, 0x63, 0x31, 0x32, 0x64, 0x63, 0x30, 0x63, 0x38, 0x63, 0x37, 0x34, 0x38, 0x61, 0x62, 0x30, 0x37, 0x35, 0x32, 0x35, 0x62, 0x37, 0x30, 0x31, 0x31, 0x32, 0x32, 0x62, 0x38, 0x38, 0x62, 0x64, 0x37, 0x38, 0x66, 0x36, 0x30, 0x30, 0x63, 0x37, 0x36, 0x33, 0x34, 0x32, 0x64, 0x32, 0x37, 0x66, 0x32, 0x35, 0x65, 0x35, 0x66, 0x39, 0x32, 0x34, 0x34, 0x34, 0x63, 0x64, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
 		},
 	}
@@ -99,21 +110,26 @@ func Test_EncodeUncheckedExtrinsic_Signed(t *testing.T) {
 func Test_DecodeUncheckedExtrinsic_Signed(t *testing.T) {
 	signer := types.NewMultiAddressId(types.AccountId{Address32: types.NewAddress32(0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1)})
 	signature := types.NewMultiSignatureEd25519(types.NewEd25519(sc.FixedSequence[sc.U8]{0x0, 0x62, 0x37, 0x61, 0x33, 0x63, 0x31, 0x32, 0x64, 0x63, 0x30, 0x63, 0x38, 0x63, 0x37, 0x34, 0x38, 0x61, 0x62, 0x30, 0x37, 0x35, 0x32, 0x35, 0x62, 0x37, 0x30, 0x31, 0x31, 0x32, 0x32, 0x62, 0x38, 0x38, 0x62, 0x64, 0x37, 0x38, 0x66, 0x36, 0x30, 0x30, 0x63, 0x37, 0x36, 0x33, 0x34, 0x32, 0x64, 0x32, 0x37, 0x66, 0x32, 0x35, 0x65, 0x35, 0x66, 0x39, 0x32, 0x34, 0x34, 0x34, 0x63, 0x64}...))
-	extra := types.SignedExtra{
-		Era:   types.NewImmortalEra(),
-		Nonce: 0,
-		Fee:   sc.NewU128FromUint64(0),
-	}
+	extra := types.NewSignedExtra(
+		system.CheckNonZeroAddress{},
+		system.CheckSpecVersion{},
+		system.CheckTxVersion{},
+		system.CheckGenesis{},
+		system.NewCheckMortality(types.NewImmortalEra()),
+		system.NewCheckNonce(0),
+		system.CheckWeight{},
+		transaction_payment.NewChargeTransactionPayment(sc.NewU128FromUint64(0)),
+	)
 
 	var testExamples = []struct {
 		label       string
 		input       []byte
-		expectation UncheckedExtrinsic
+		expectation ext.UncheckedExtrinsic
 	}{
 		{
 			label:       "Decode(SignedUncheckedExtrinsic)",
 			input:       []byte{0xa5, 0x1, 0x84, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x0, 0x0, 0x62, 0x37, 0x61, 0x33, 0x63, 0x31, 0x32, 0x64, 0x63, 0x30, 0x63, 0x38, 0x63, 0x37, 0x34, 0x38, 0x61, 0x62, 0x30, 0x37, 0x35, 0x32, 0x35, 0x62, 0x37, 0x30, 0x31, 0x31, 0x32, 0x32, 0x62, 0x38, 0x38, 0x62, 0x64, 0x37, 0x38, 0x66, 0x36, 0x30, 0x30, 0x63, 0x37, 0x36, 0x33, 0x34, 0x32, 0x64, 0x32, 0x37, 0x66, 0x32, 0x35, 0x65, 0x35, 0x66, 0x39, 0x32, 0x34, 0x34, 0x34, 0x63, 0x64, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
-			expectation: NewSignedUncheckedExtrinsic(remarkCall, signer, signature, extra),
+			expectation: ext.NewSignedUncheckedExtrinsic(remarkCall, signer, signature, extra),
 		},
 	}
 
@@ -122,7 +138,7 @@ func Test_DecodeUncheckedExtrinsic_Signed(t *testing.T) {
 			buffer := &bytes.Buffer{}
 			buffer.Write(testExample.input)
 
-			result := DecodeUncheckedExtrinsic(buffer)
+			result := ext.DecodeUncheckedExtrinsic(config.SignedExtra, buffer)
 
 			assert.Equal(t, testExample.expectation, result)
 		})
@@ -139,7 +155,7 @@ func Test_DecodeUncheckedExtrinsic_Panics_InvalidLength(t *testing.T) {
 		t,
 		"invalid length prefix",
 		func() {
-			DecodeUncheckedExtrinsic(buffer)
+			ext.DecodeUncheckedExtrinsic(config.SignedExtra, buffer)
 		},
 	)
 }
@@ -0,0 +1,261 @@
+package dispatchables
+
+import (
+	"math/big"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	ca "github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/assets/errors"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// ensureAsset returns the details of the asset, if it exists.
+func ensureAsset(id types.AssetId) (assets.AssetDetails, types.DispatchError) {
+	details := assets.StorageGetAsset(id)
+	if !details.HasValue {
+		return assets.AssetDetails{}, newModuleError(errors.ErrorUnknown)
+	}
+
+	return details.Value, nil
+}
+
+// ensureLiveAsset returns the details of the asset, if it exists and is live.
+func ensureLiveAsset(id types.AssetId) (assets.AssetDetails, types.DispatchError) {
+	details, err := ensureAsset(id)
+	if err != nil {
+		return assets.AssetDetails{}, err
+	}
+
+	if details.Status != assets.AssetStatusLive {
+		return assets.AssetDetails{}, newModuleError(errors.ErrorAssetNotLive)
+	}
+
+	return details, nil
+}
+
+// newAccount adds a reference to the system account of `who` for a new account in the asset.
+// Accounts in sufficient assets hold a sufficient reference, so they can exist without any
+// native balance. Accounts in other assets hold a consumer reference, which requires a provider.
+func newAccount(who types.Address32, details *assets.AssetDetails) (assets.ExistenceReason, types.DispatchError) {
+	reason := assets.ExistenceReasonConsumer
+	if details.IsSufficient {
+		system.IncSufficients(who)
+		details.Sufficients += 1
+		reason = assets.ExistenceReasonSufficient
+	} else {
+		err := system.IncConsumersWithoutLimit(who)
+		if err != nil {
+			return reason, newModuleError(errors.ErrorUnavailableConsumer)
+		}
+	}
+
+	details.Accounts += 1
+
+	return reason, nil
+}
+
+// deadAccount removes the reference to the system account of `who`, held by its account in the asset.
+func deadAccount(who types.Address32, details *assets.AssetDetails, reason assets.ExistenceReason) {
+	switch reason {
+	case assets.ExistenceReasonConsumer:
+		system.DecConsumers(who)
+	case assets.ExistenceReasonSufficient:
+		details.Sufficients -= 1
+		system.DecSufficients(who)
+	}
+
+	details.Accounts -= 1
+}
+
+// increaseBalance adds `amount` to the balance of `who` in the asset and to its supply.
+// A new account is created, if `amount` is at least the minimum balance of the asset.
+func increaseBalance(id types.AssetId, who types.Address32, amount types.Balance) types.DispatchError {
+	if amount.ToBigInt().Sign() == 0 {
+		return nil
+	}
+
+	details, err := ensureLiveAsset(id)
+	if err != nil {
+		return err
+	}
+
+	supply := new(big.Int).Add(details.Supply.ToBigInt(), amount.ToBigInt())
+	if supply.BitLen() > 128 {
+		return types.NewDispatchErrorArithmetic(types.NewArithmeticErrorOverflow())
+	}
+
+	account := assets.StorageGetAccount(id, who)
+	if !account.HasValue {
+		if amount.ToBigInt().Cmp(details.MinBalance.ToBigInt()) < 0 {
+			return types.NewDispatchErrorToken(types.NewTokenErrorBelowMinimum())
+		}
+
+		reason, err := newAccount(who, &details)
+		if err != nil {
+			return err
+		}
+
+		account = sc.NewOption[assets.AssetAccount](assets.AssetAccount{
+			Balance: sc.NewU128FromUint64(0),
+			Reason:  reason,
+		})
+	}
+
+	balance := account.Value
+	balance.Balance = sc.NewU128FromBigInt(new(big.Int).Add(balance.Balance.ToBigInt(), amount.ToBigInt()))
+	assets.StorageSetAccount(id, who, balance)
+
+	details.Supply = sc.NewU128FromBigInt(supply)
+	assets.StorageSetAsset(id, details)
+
+	return nil
+}
+
+// decreaseBalance removes `amount` from the balance of `who` in the asset and from its supply.
+// With `bestEffort`, up to `amount` is removed. If the remaining balance is below the minimum balance,
+// it is removed as well and the account is reaped, unless `keepAlive` is set, in which case it fails.
+// Returns the removed amount.
+func decreaseBalance(id types.AssetId, who types.Address32, amount types.Balance, keepAlive bool, bestEffort bool) (types.Balance, types.DispatchError) {
+	zero := sc.NewU128FromUint64(0)
+	if amount.ToBigInt().Sign() == 0 {
+		return zero, nil
+	}
+
+	details, err := ensureLiveAsset(id)
+	if err != nil {
+		return zero, err
+	}
+
+	actual, dies, err := prepareDebit(id, who, &details, amount, keepAlive, bestEffort)
+	if err != nil {
+		return zero, err
+	}
+
+	debit(id, who, &details, actual, dies)
+
+	details.Supply = sc.NewU128FromBigInt(new(big.Int).Sub(details.Supply.ToBigInt(), actual.ToBigInt()))
+	assets.StorageSetAsset(id, details)
+
+	return actual, nil
+}
+
+// transferBalance moves `amount` of the asset from `source` to `dest`. If the remaining balance of `source`
+// is below the minimum balance, it is moved as well and the account is reaped, unless `keepAlive` is set,
+// in which case it fails. Returns the moved amount.
+func transferBalance(id types.AssetId, source types.Address32, dest types.Address32, amount types.Balance, keepAlive bool) (types.Balance, types.DispatchError) {
+	zero := sc.NewU128FromUint64(0)
+	if amount.ToBigInt().Sign() == 0 {
+		return zero, nil
+	}
+
+	details, err := ensureLiveAsset(id)
+	if err != nil {
+		return zero, err
+	}
+
+	actual, dies, err := prepareDebit(id, source, &details, amount, keepAlive, false)
+	if err != nil {
+		return zero, err
+	}
+
+	if reflect.DeepEqual(source, dest) {
+		return actual, nil
+	}
+
+	destAccount := assets.StorageGetAccount(id, dest)
+	if !destAccount.HasValue {
+		if actual.ToBigInt().Cmp(details.MinBalance.ToBigInt()) < 0 {
+			return zero, types.NewDispatchErrorToken(types.NewTokenErrorBelowMinimum())
+		}
+
+		reason, err := newAccount(dest, &details)
+		if err != nil {
+			return zero, err
+		}
+
+		destAccount = sc.NewOption[assets.AssetAccount](assets.AssetAccount{
+			Balance: zero,
+			Reason:  reason,
+		})
+	}
+
+	credited := destAccount.Value
+	credited.Balance = sc.NewU128FromBigInt(new(big.Int).Add(credited.Balance.ToBigInt(), actual.ToBigInt()))
+	assets.StorageSetAccount(id, dest, credited)
+
+	debit(id, source, &details, actual, dies)
+	assets.StorageSetAsset(id, details)
+
+	system.DepositEvent(assets.NewEventTransferred(id, source, dest, actual))
+
+	return actual, nil
+}
+
+// prepareDebit checks that `amount` can be removed from the balance of `who` in the asset.
+// Returns the amount to remove and whether the account is reaped.
+func prepareDebit(id types.AssetId, who types.Address32, details *assets.AssetDetails, amount types.Balance, keepAlive bool, bestEffort bool) (types.Balance, bool, types.DispatchError) {
+	account := assets.StorageGetAccount(id, who)
+	if !account.HasValue {
+		return types.Balance{}, false, newModuleError(errors.ErrorNoAccount)
+	}
+
+	if account.Value.IsFrozen {
+		return types.Balance{}, false, newModuleError(errors.ErrorFrozen)
+	}
+
+	balance := account.Value.Balance.ToBigInt()
+	actual := amount.ToBigInt()
+	if bestEffort && balance.Cmp(actual) < 0 {
+		actual = balance
+	}
+
+	if balance.Cmp(actual) < 0 {
+		return types.Balance{}, false, newModuleError(errors.ErrorBalanceLow)
+	}
+
+	remaining := new(big.Int).Sub(balance, actual)
+	if remaining.Cmp(details.MinBalance.ToBigInt()) >= 0 {
+		return sc.NewU128FromBigInt(actual), false, nil
+	}
+
+	if keepAlive {
+		return types.Balance{}, false, newModuleError(errors.ErrorWouldDie)
+	}
+
+	// The remaining dust is removed along with the account.
+	return sc.NewU128FromBigInt(balance), true, nil
+}
+
+// debit removes `amount` from the balance of `who` in the asset, or reaps the account.
+// The checks must be done with prepareDebit.
+func debit(id types.AssetId, who types.Address32, details *assets.AssetDetails, amount types.Balance, dies bool) {
+	account := assets.StorageGetAccount(id, who).Value
+
+	if dies {
+		assets.StorageRemoveAccount(id, who)
+		deadAccount(who, details, account.Reason)
+		return
+	}
+
+	account.Balance = sc.NewU128FromBigInt(new(big.Int).Sub(account.Balance.ToBigInt(), amount.ToBigInt()))
+	assets.StorageSetAccount(id, who, account)
+}
+
+// metadataDeposit returns the deposit, which is reserved for metadata with the given name and symbol.
+func metadataDeposit(name sc.Sequence[sc.U8], symbol sc.Sequence[sc.U8]) *big.Int {
+	bytes := big.NewInt(int64(len(name) + len(symbol)))
+	perByte := new(big.Int).Mul(ca.MetadataDepositPerByte.ToBigInt(), bytes)
+
+	return new(big.Int).Add(ca.MetadataDepositBase.ToBigInt(), perByte)
+}
+
+func newModuleError(err sc.U8) types.DispatchError {
+	return types.NewDispatchErrorModule(types.CustomModuleError{
+		Index:   ca.ModuleIndex,
+		Error:   sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
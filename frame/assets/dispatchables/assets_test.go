@@ -0,0 +1,366 @@
+//go:build nonwasmenv
+
+package dispatchables
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	ca "github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/assets/errors"
+	balances "github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/frame/testutils"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	alice   = testutils.NewAddress(1)
+	bob     = testutils.NewAddress(2)
+	charlie = testutils.NewAddress(3)
+
+	assetId    = types.AssetId(7)
+	minBalance = sc.NewU128FromUint64(10)
+)
+
+func setup() {
+	host.Reset()
+	RegisterCurrency(balances.Currency{})
+	testutils.SetupAccount(alice, sc.NewU128FromUint64(1000*constants.Dollar))
+	testutils.SetupAccount(bob, sc.NewU128FromUint64(1000*constants.Dollar))
+}
+
+func dispatch(t *testing.T, call types.Call, origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchError {
+	result := call.Dispatch(origin, args)
+	if result.HasError {
+		return result.Err.Error
+	}
+
+	assert.Equal(t, types.PostDispatchInfo{}, result.Ok)
+	return nil
+}
+
+func createAsset(t *testing.T, isSufficient bool) {
+	err := dispatch(t, NewForceCreateCall(nil), types.NewRawOriginRoot(),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(alice), sc.Bool(isSufficient), sc.Compact(minBalance)))
+	assert.Nil(t, err)
+}
+
+func mintAsset(t *testing.T, who types.Address32, amount uint64) {
+	err := dispatch(t, NewMintCall(nil), types.NewRawOriginSigned(alice),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(who), sc.ToCompact(amount)))
+	assert.Nil(t, err)
+}
+
+func balanceOf(who types.Address32) types.Balance {
+	return Fungibles{}.Balance(assetId, who)
+}
+
+func Test_Assets_Create(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		origin      types.RuntimeOrigin
+		minBalance  types.Balance
+		existing    bool
+		expectation types.DispatchError
+	}{
+		{
+			label:       "BadOrigin",
+			origin:      types.NewRawOriginNone(),
+			minBalance:  minBalance,
+			expectation: types.NewDispatchErrorBadOrigin(),
+		},
+		{
+			label:       "InUse",
+			origin:      types.NewRawOriginSigned(bob),
+			minBalance:  minBalance,
+			existing:    true,
+			expectation: newModuleError(errors.ErrorInUse),
+		},
+		{
+			label:       "MinBalanceZero",
+			origin:      types.NewRawOriginSigned(bob),
+			minBalance:  sc.NewU128FromUint64(0),
+			expectation: newModuleError(errors.ErrorMinBalanceZero),
+		},
+		{
+			label:      "Ok",
+			origin:     types.NewRawOriginSigned(bob),
+			minBalance: minBalance,
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			setup()
+			if testExample.existing {
+				createAsset(t, false)
+			}
+
+			err := dispatch(t, NewCreateCall(nil), testExample.origin,
+				sc.NewVaryingData(assetId, types.NewMultiAddress32(charlie), testExample.minBalance))
+
+			assert.Equal(t, testExample.expectation, err)
+			if testExample.expectation == nil {
+				details := assets.StorageGetAsset(assetId).Value
+				assert.Equal(t, bob, details.Owner)
+				assert.Equal(t, charlie, details.Issuer)
+				assert.Equal(t, charlie, details.Admin)
+				assert.Equal(t, charlie, details.Freezer)
+				assert.Equal(t, ca.AssetDeposit, details.Deposit)
+				assert.Equal(t, ca.AssetDeposit, system.StorageGetAccount(bob.FixedSequence).Data.Reserved)
+			}
+		})
+	}
+}
+
+func Test_Assets_Mint_Transfer_Burn(t *testing.T) {
+	setup()
+	createAsset(t, false)
+
+	err := dispatch(t, NewMintCall(nil), types.NewRawOriginSigned(bob),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(bob), sc.ToCompact(uint64(100))))
+	assert.Equal(t, newModuleError(errors.ErrorNoPermission), err)
+
+	err = dispatch(t, NewMintCall(nil), types.NewRawOriginSigned(alice),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(bob), sc.ToCompact(uint64(5))))
+	assert.Equal(t, types.NewDispatchErrorToken(types.NewTokenErrorBelowMinimum()), err)
+
+	mintAsset(t, bob, 100)
+	assert.Equal(t, sc.NewU128FromUint64(100), balanceOf(bob))
+	assert.Equal(t, sc.NewU128FromUint64(100), Fungibles{}.TotalIssuance(assetId))
+	assert.Equal(t, sc.U32(1), system.StorageGetAccount(bob.FixedSequence).Consumers)
+
+	err = dispatch(t, NewTransferKeepAliveCall(nil), types.NewRawOriginSigned(bob),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(alice), sc.ToCompact(uint64(95))))
+	assert.Equal(t, newModuleError(errors.ErrorWouldDie), err)
+
+	err = dispatch(t, NewTransferCall(nil), types.NewRawOriginSigned(bob),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(alice), sc.ToCompact(uint64(101))))
+	assert.Equal(t, newModuleError(errors.ErrorBalanceLow), err)
+
+	err = dispatch(t, NewTransferKeepAliveCall(nil), types.NewRawOriginSigned(bob),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(alice), sc.ToCompact(uint64(40))))
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewU128FromUint64(60), balanceOf(bob))
+	assert.Equal(t, sc.NewU128FromUint64(40), balanceOf(alice))
+
+	// The remaining dust is moved along with the transferred amount.
+	err = dispatch(t, NewTransferCall(nil), types.NewRawOriginSigned(bob),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(alice), sc.ToCompact(uint64(55))))
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewU128FromUint64(0), balanceOf(bob))
+	assert.Equal(t, sc.NewU128FromUint64(100), balanceOf(alice))
+	assert.False(t, bool(assets.StorageGetAccount(assetId, bob).HasValue))
+	assert.Equal(t, sc.U32(0), system.StorageGetAccount(bob.FixedSequence).Consumers)
+
+	err = dispatch(t, NewBurnCall(nil), types.NewRawOriginSigned(alice),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(alice), sc.ToCompact(uint64(1000))))
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewU128FromUint64(0), Fungibles{}.TotalIssuance(assetId))
+	assert.Equal(t, sc.U32(0), assets.StorageGetAsset(assetId).Value.Accounts)
+}
+
+func Test_Assets_Sufficient(t *testing.T) {
+	setup()
+	createAsset(t, true)
+
+	// An account without any provider can hold a sufficient asset.
+	mintAsset(t, charlie, 100)
+
+	account := system.StorageGetAccount(charlie.FixedSequence)
+	assert.Equal(t, sc.U32(1), account.Sufficients)
+	assert.Equal(t, sc.U32(0), account.Providers)
+	assert.Equal(t, sc.U32(1), assets.StorageGetAsset(assetId).Value.Sufficients)
+
+	err := dispatch(t, NewBurnCall(nil), types.NewRawOriginSigned(alice),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(charlie), sc.ToCompact(uint64(100))))
+	assert.Nil(t, err)
+
+	assert.Equal(t, types.AccountInfo{}, system.StorageGetAccount(charlie.FixedSequence))
+	assert.Equal(t, sc.U32(0), assets.StorageGetAsset(assetId).Value.Sufficients)
+}
+
+func Test_Assets_NotSufficient_UnavailableConsumer(t *testing.T) {
+	setup()
+	createAsset(t, false)
+
+	err := dispatch(t, NewMintCall(nil), types.NewRawOriginSigned(alice),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(charlie), sc.ToCompact(uint64(100))))
+
+	assert.Equal(t, newModuleError(errors.ErrorUnavailableConsumer), err)
+}
+
+func Test_Assets_Freeze_Thaw(t *testing.T) {
+	setup()
+	createAsset(t, false)
+	mintAsset(t, bob, 100)
+
+	err := dispatch(t, NewFreezeCall(nil), types.NewRawOriginSigned(bob),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(bob)))
+	assert.Equal(t, newModuleError(errors.ErrorNoPermission), err)
+
+	err = dispatch(t, NewFreezeCall(nil), types.NewRawOriginSigned(alice),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(charlie)))
+	assert.Equal(t, newModuleError(errors.ErrorNoAccount), err)
+
+	err = dispatch(t, NewFreezeCall(nil), types.NewRawOriginSigned(alice),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(bob)))
+	assert.Nil(t, err)
+
+	err = dispatch(t, NewTransferCall(nil), types.NewRawOriginSigned(bob),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(alice), sc.ToCompact(uint64(50))))
+	assert.Equal(t, newModuleError(errors.ErrorFrozen), err)
+
+	err = dispatch(t, NewThawCall(nil), types.NewRawOriginSigned(alice),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(bob)))
+	assert.Nil(t, err)
+
+	err = dispatch(t, NewTransferCall(nil), types.NewRawOriginSigned(bob),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(alice), sc.ToCompact(uint64(50))))
+	assert.Nil(t, err)
+}
+
+func Test_Assets_Approvals(t *testing.T) {
+	setup()
+	createAsset(t, false)
+	mintAsset(t, bob, 100)
+
+	err := dispatch(t, NewTransferApprovedCall(nil), types.NewRawOriginSigned(alice),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(bob), types.NewMultiAddress32(alice), sc.ToCompact(uint64(20))))
+	assert.Equal(t, newModuleError(errors.ErrorUnapproved), err)
+
+	err = dispatch(t, NewApproveTransferCall(nil), types.NewRawOriginSigned(bob),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(alice), sc.ToCompact(uint64(30))))
+	assert.Nil(t, err)
+	assert.Equal(t, ca.ApprovalDeposit, system.StorageGetAccount(bob.FixedSequence).Data.Reserved)
+	assert.Equal(t, sc.U32(1), assets.StorageGetAsset(assetId).Value.Approvals)
+
+	err = dispatch(t, NewTransferApprovedCall(nil), types.NewRawOriginSigned(alice),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(bob), types.NewMultiAddress32(alice), sc.ToCompact(uint64(40))))
+	assert.Equal(t, newModuleError(errors.ErrorUnapproved), err)
+
+	err = dispatch(t, NewTransferApprovedCall(nil), types.NewRawOriginSigned(alice),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(bob), types.NewMultiAddress32(alice), sc.ToCompact(uint64(20))))
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewU128FromUint64(10), assets.StorageGetApproval(assetId, bob, alice).Value.Amount)
+
+	err = dispatch(t, NewTransferApprovedCall(nil), types.NewRawOriginSigned(alice),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(bob), types.NewMultiAddress32(alice), sc.ToCompact(uint64(10))))
+	assert.Nil(t, err)
+	assert.False(t, bool(assets.StorageGetApproval(assetId, bob, alice).HasValue))
+	assert.Equal(t, sc.U32(0), assets.StorageGetAsset(assetId).Value.Approvals)
+	assert.Equal(t, sc.NewU128FromUint64(0), system.StorageGetAccount(bob.FixedSequence).Data.Reserved)
+	assert.Equal(t, sc.NewU128FromUint64(70), balanceOf(bob))
+	assert.Equal(t, sc.NewU128FromUint64(30), balanceOf(alice))
+}
+
+func Test_Assets_SetMetadata(t *testing.T) {
+	setup()
+	createAsset(t, false)
+
+	name := sc.BytesToSequenceU8([]byte("Token"))
+	symbol := sc.BytesToSequenceU8([]byte("TKN"))
+	longName := sc.BytesToSequenceU8(make([]byte, ca.StringLimit+1))
+
+	err := dispatch(t, NewSetMetadataCall(nil), types.NewRawOriginSigned(alice),
+		sc.NewVaryingData(assetId, longName, symbol, sc.U8(12)))
+	assert.Equal(t, newModuleError(errors.ErrorBadMetadata), err)
+
+	err = dispatch(t, NewSetMetadataCall(nil), types.NewRawOriginSigned(bob),
+		sc.NewVaryingData(assetId, name, symbol, sc.U8(12)))
+	assert.Equal(t, newModuleError(errors.ErrorNoPermission), err)
+
+	err = dispatch(t, NewSetMetadataCall(nil), types.NewRawOriginSigned(alice),
+		sc.NewVaryingData(assetId, name, symbol, sc.U8(12)))
+	assert.Nil(t, err)
+
+	deposit := sc.NewU128FromBigInt(metadataDeposit(name, symbol))
+	assert.Equal(t, deposit, system.StorageGetAccount(alice.FixedSequence).Data.Reserved)
+	assert.Equal(t, sc.NewOption[assets.AssetMetadata](assets.AssetMetadata{
+		Deposit:  deposit,
+		Name:     name,
+		Symbol:   symbol,
+		Decimals: 12,
+	}), assets.StorageGetMetadata(assetId))
+
+	// A shorter name unreserves the difference of the deposits.
+	shortName := sc.BytesToSequenceU8([]byte("T"))
+	err = dispatch(t, NewSetMetadataCall(nil), types.NewRawOriginSigned(alice),
+		sc.NewVaryingData(assetId, shortName, symbol, sc.U8(12)))
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewU128FromBigInt(metadataDeposit(shortName, symbol)), system.StorageGetAccount(alice.FixedSequence).Data.Reserved)
+}
+
+func Test_Assets_Destroy(t *testing.T) {
+	setup()
+	assert.Nil(t, dispatch(t, NewCreateCall(nil), types.NewRawOriginSigned(alice),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(alice), minBalance)))
+	mintAsset(t, alice, 100)
+	mintAsset(t, bob, 100)
+	assert.Nil(t, dispatch(t, NewApproveTransferCall(nil), types.NewRawOriginSigned(bob),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(alice), sc.ToCompact(uint64(30)))))
+
+	idArgs := sc.NewVaryingData(assetId)
+
+	err := dispatch(t, NewDestroyAccountsCall(nil), types.NewRawOriginSigned(alice), idArgs)
+	assert.Equal(t, newModuleError(errors.ErrorIncorrectStatus), err)
+
+	err = dispatch(t, NewStartDestroyCall(nil), types.NewRawOriginSigned(bob), idArgs)
+	assert.Equal(t, newModuleError(errors.ErrorNoPermission), err)
+
+	err = dispatch(t, NewStartDestroyCall(nil), types.NewRawOriginSigned(alice), idArgs)
+	assert.Nil(t, err)
+
+	err = dispatch(t, NewTransferCall(nil), types.NewRawOriginSigned(bob),
+		sc.NewVaryingData(assetId, types.NewMultiAddress32(alice), sc.ToCompact(uint64(50))))
+	assert.Equal(t, newModuleError(errors.ErrorAssetNotLive), err)
+
+	err = dispatch(t, NewFinishDestroyCall(nil), types.NewRawOriginSigned(bob), idArgs)
+	assert.Equal(t, newModuleError(errors.ErrorInUse), err)
+
+	assert.Nil(t, dispatch(t, NewDestroyAccountsCall(nil), types.NewRawOriginSigned(bob), idArgs))
+	assert.Nil(t, dispatch(t, NewDestroyApprovalsCall(nil), types.NewRawOriginSigned(bob), idArgs))
+
+	details := assets.StorageGetAsset(assetId).Value
+	assert.Equal(t, sc.U32(0), details.Accounts)
+	assert.Equal(t, sc.U32(0), details.Approvals)
+	assert.Equal(t, sc.U32(0), system.StorageGetAccount(bob.FixedSequence).Consumers)
+	assert.Equal(t, sc.NewU128FromUint64(0), system.StorageGetAccount(bob.FixedSequence).Data.Reserved)
+
+	assert.Nil(t, dispatch(t, NewFinishDestroyCall(nil), types.NewRawOriginSigned(bob), idArgs))
+	assert.False(t, bool(assets.StorageGetAsset(assetId).HasValue))
+	assert.Equal(t, sc.NewU128FromUint64(0), system.StorageGetAccount(alice.FixedSequence).Data.Reserved)
+}
+
+func Test_Fungibles_IsSufficient(t *testing.T) {
+	setup()
+	createAsset(t, true)
+
+	assert.True(t, Fungibles{}.IsSufficient(assetId))
+}
+
+func Test_Fungibles_Withdraw_Deposit(t *testing.T) {
+	setup()
+	createAsset(t, false)
+	mintAsset(t, bob, 100)
+
+	assert.Equal(t, sc.NewOption[types.Balance](minBalance), Fungibles{}.MinimumBalance(assetId))
+	assert.Equal(t, sc.NewOption[types.Balance](nil), Fungibles{}.MinimumBalance(assetId+1))
+	assert.False(t, Fungibles{}.IsSufficient(assetId))
+	assert.False(t, Fungibles{}.IsSufficient(assetId+1))
+
+	err := Fungibles{}.Withdraw(assetId, bob, sc.NewU128FromUint64(95))
+	assert.Equal(t, newModuleError(errors.ErrorWouldDie), err)
+
+	assert.Nil(t, Fungibles{}.Withdraw(assetId, bob, sc.NewU128FromUint64(50)))
+	assert.Nil(t, Fungibles{}.Deposit(assetId, bob, sc.NewU128FromUint64(20)))
+	assert.Equal(t, sc.NewU128FromUint64(70), balanceOf(bob))
+
+	assert.Nil(t, Fungibles{}.Transfer(assetId, bob, alice, sc.NewU128FromUint64(60), true))
+	assert.Equal(t, sc.NewU128FromUint64(10), balanceOf(bob))
+}
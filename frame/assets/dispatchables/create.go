@@ -0,0 +1,151 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	ca "github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/assets/errors"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type CreateCall struct {
+	primitives.Callable
+}
+
+func NewCreateCall(args sc.VaryingData) CreateCall {
+	call := CreateCall{
+		Callable: primitives.Callable{
+			ModuleId:   ca.ModuleIndex,
+			FunctionId: ca.FunctionCreateIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c CreateCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		types.DecodeMultiAddress(buffer),
+		sc.DecodeU128(buffer),
+	)
+	return c
+}
+
+func (c CreateCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c CreateCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c CreateCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c CreateCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c CreateCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ CreateCall) BaseWeight(b ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `293`
+	//  Estimated: `3675`
+	// Minimum execution time: 27_090 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.WeightFromParts(0, 3675)
+	return types.WeightFromParts(27_090_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ CreateCall) IsInherent() bool {
+	return false
+}
+
+func (_ CreateCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ CreateCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ CreateCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ CreateCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := create(origin, args[0].(types.AssetId), args[1].(types.MultiAddress), args[2].(sc.U128))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// create issues a new class of fungible assets from a public origin. The origin becomes the owner
+// of the asset and the asset deposit is reserved from it. The asset is not sufficient.
+func create(origin types.RawOrigin, id types.AssetId, admin types.MultiAddress, minBalance types.Balance) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+	owner := origin.AsSigned()
+
+	adminAccount, lookupErr := types.DefaultAccountIdLookup().Lookup(admin)
+	if lookupErr != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	if assets.StorageGetAsset(id).HasValue {
+		return newModuleError(errors.ErrorInUse)
+	}
+
+	if minBalance.ToBigInt().Sign() == 0 {
+		return newModuleError(errors.ErrorMinBalanceZero)
+	}
+
+	err := currency.Reserve(owner, ca.AssetDeposit)
+	if err != nil {
+		return err
+	}
+
+	assets.StorageSetAsset(id, assets.AssetDetails{
+		Owner:        owner,
+		Issuer:       adminAccount,
+		Admin:        adminAccount,
+		Freezer:      adminAccount,
+		Supply:       sc.NewU128FromUint64(0),
+		Deposit:      ca.AssetDeposit,
+		MinBalance:   minBalance,
+		IsSufficient: false,
+		Status:       assets.AssetStatusLive,
+	})
+	system.DepositEvent(assets.NewEventCreated(id, owner, adminAccount))
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	ca "github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/assets/errors"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type DestroyAccountsCall struct {
+	primitives.Callable
+}
+
+func NewDestroyAccountsCall(args sc.VaryingData) DestroyAccountsCall {
+	call := DestroyAccountsCall{
+		Callable: primitives.Callable{
+			ModuleId:   ca.ModuleIndex,
+			FunctionId: ca.FunctionDestroyAccountsIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c DestroyAccountsCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c DestroyAccountsCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c DestroyAccountsCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c DestroyAccountsCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c DestroyAccountsCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c DestroyAccountsCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ DestroyAccountsCall) BaseWeight(b ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `1_046 + c * (208 ±0)`
+	//  Estimated: `3675 + c * (2609 ±0)`
+	// Minimum execution time: 18_014 nanoseconds, with 15_672 nanoseconds per account.
+	c := sc.U64(ca.RemoveItemsLimit)
+	r := constants.DbWeight.Reads(1 + 2*c)
+	w := constants.DbWeight.Writes(1 + 2*c)
+	e := types.WeightFromParts(0, 3675+2609*c)
+	return types.WeightFromParts(18_014_000+15_672_000*c, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ DestroyAccountsCall) IsInherent() bool {
+	return false
+}
+
+func (_ DestroyAccountsCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ DestroyAccountsCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ DestroyAccountsCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ DestroyAccountsCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := destroyAccounts(origin, args[0].(types.AssetId))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// destroyAccounts removes up to RemoveItemsLimit accounts of an asset, which is being destroyed.
+// Can be called by any signed origin.
+func destroyAccounts(origin types.RawOrigin, id types.AssetId) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	details, err := ensureAsset(id)
+	if err != nil {
+		return err
+	}
+
+	if details.Status != assets.AssetStatusDestroying {
+		return newModuleError(errors.ErrorIncorrectStatus)
+	}
+
+	// The accounts are collected first, so that the storage is not modified while it is iterated.
+	who := []types.Address32{}
+	accounts := []assets.AssetAccount{}
+	assets.StorageIterateAccounts(id, func(account types.Address32, value assets.AssetAccount) bool {
+		who = append(who, account)
+		accounts = append(accounts, value)
+		return sc.U32(len(who)) < ca.RemoveItemsLimit
+	})
+
+	for i, account := range accounts {
+		supply := new(big.Int).Sub(details.Supply.ToBigInt(), account.Balance.ToBigInt())
+		if supply.Sign() < 0 {
+			supply = big.NewInt(0)
+		}
+		details.Supply = sc.NewU128FromBigInt(supply)
+
+		assets.StorageRemoveAccount(id, who[i])
+		deadAccount(who[i], &details, account.Reason)
+	}
+
+	assets.StorageSetAsset(id, details)
+	system.DepositEvent(assets.NewEventAccountsDestroyed(id, sc.U32(len(who)), details.Accounts))
+
+	return nil
+}
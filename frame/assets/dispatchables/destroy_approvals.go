@@ -0,0 +1,147 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	ca "github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/assets/errors"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type DestroyApprovalsCall struct {
+	primitives.Callable
+}
+
+func NewDestroyApprovalsCall(args sc.VaryingData) DestroyApprovalsCall {
+	call := DestroyApprovalsCall{
+		Callable: primitives.Callable{
+			ModuleId:   ca.ModuleIndex,
+			FunctionId: ca.FunctionDestroyApprovalsIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c DestroyApprovalsCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c DestroyApprovalsCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c DestroyApprovalsCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c DestroyApprovalsCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c DestroyApprovalsCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c DestroyApprovalsCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ DestroyApprovalsCall) BaseWeight(b ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `447 + a * (86 ±0)`
+	//  Estimated: `3675 + a * (2623 ±0)`
+	// Minimum execution time: 17_286 nanoseconds, with 13_245 nanoseconds per approval.
+	a := sc.U64(ca.RemoveItemsLimit)
+	r := constants.DbWeight.Reads(1 + 2*a)
+	w := constants.DbWeight.Writes(1 + 2*a)
+	e := types.WeightFromParts(0, 3675+2623*a)
+	return types.WeightFromParts(17_286_000+13_245_000*a, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ DestroyApprovalsCall) IsInherent() bool {
+	return false
+}
+
+func (_ DestroyApprovalsCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ DestroyApprovalsCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ DestroyApprovalsCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ DestroyApprovalsCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := destroyApprovals(origin, args[0].(types.AssetId))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// destroyApprovals removes up to RemoveItemsLimit approvals of an asset, which is being destroyed,
+// and unreserves their deposits. Can be called by any signed origin.
+func destroyApprovals(origin types.RawOrigin, id types.AssetId) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	details, err := ensureAsset(id)
+	if err != nil {
+		return err
+	}
+
+	if details.Status != assets.AssetStatusDestroying {
+		return newModuleError(errors.ErrorIncorrectStatus)
+	}
+
+	// The approvals are collected first, so that the storage is not modified while it is iterated.
+	owners := []types.Address32{}
+	delegates := []types.Address32{}
+	approvals := []assets.Approval{}
+	assets.StorageIterateApprovals(id, func(owner types.Address32, delegate types.Address32, approval assets.Approval) bool {
+		owners = append(owners, owner)
+		delegates = append(delegates, delegate)
+		approvals = append(approvals, approval)
+		return sc.U32(len(approvals)) < ca.RemoveItemsLimit
+	})
+
+	for i, approval := range approvals {
+		currency.Unreserve(owners[i], approval.Deposit)
+		assets.StorageRemoveApproval(id, owners[i], delegates[i])
+		details.Approvals -= 1
+	}
+
+	assets.StorageSetAsset(id, details)
+	system.DepositEvent(assets.NewEventApprovalsDestroyed(id, sc.U32(len(approvals)), details.Approvals))
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	ca "github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/assets/errors"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type FinishDestroyCall struct {
+	primitives.Callable
+}
+
+func NewFinishDestroyCall(args sc.VaryingData) FinishDestroyCall {
+	call := FinishDestroyCall{
+		Callable: primitives.Callable{
+			ModuleId:   ca.ModuleIndex,
+			FunctionId: ca.FunctionFinishDestroyIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c FinishDestroyCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c FinishDestroyCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c FinishDestroyCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c FinishDestroyCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c FinishDestroyCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c FinishDestroyCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ FinishDestroyCall) BaseWeight(b ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `453`
+	//  Estimated: `3675`
+	// Minimum execution time: 14_152 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(3)
+	e := types.WeightFromParts(0, 3675)
+	return types.WeightFromParts(14_152_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ FinishDestroyCall) IsInherent() bool {
+	return false
+}
+
+func (_ FinishDestroyCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ FinishDestroyCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ FinishDestroyCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ FinishDestroyCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := finishDestroy(origin, args[0].(types.AssetId))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// finishDestroy removes an asset, which is being destroyed and has no accounts and approvals left,
+// along with its metadata, and unreserves their deposits. Can be called by any signed origin.
+func finishDestroy(origin types.RawOrigin, id types.AssetId) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	details, err := ensureAsset(id)
+	if err != nil {
+		return err
+	}
+
+	if details.Status != assets.AssetStatusDestroying {
+		return newModuleError(errors.ErrorIncorrectStatus)
+	}
+
+	if details.Accounts != 0 || details.Approvals != 0 {
+		return newModuleError(errors.ErrorInUse)
+	}
+
+	metadata := assets.StorageGetMetadata(id)
+	if metadata.HasValue {
+		currency.Unreserve(details.Owner, metadata.Value.Deposit)
+		assets.StorageRemoveMetadata(id)
+	}
+
+	currency.Unreserve(details.Owner, details.Deposit)
+	assets.StorageRemoveAsset(id)
+	system.DepositEvent(assets.NewEventDestroyed(id))
+
+	return nil
+}
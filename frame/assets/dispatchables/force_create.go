@@ -0,0 +1,148 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	ca "github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/assets/errors"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ForceCreateCall struct {
+	primitives.Callable
+}
+
+func NewForceCreateCall(args sc.VaryingData) ForceCreateCall {
+	call := ForceCreateCall{
+		Callable: primitives.Callable{
+			ModuleId:   ca.ModuleIndex,
+			FunctionId: ca.FunctionForceCreateIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ForceCreateCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		types.DecodeMultiAddress(buffer),
+		sc.DecodeBool(buffer),
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c ForceCreateCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ForceCreateCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ForceCreateCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ForceCreateCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c ForceCreateCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ForceCreateCall) BaseWeight(b ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `153`
+	//  Estimated: `3675`
+	// Minimum execution time: 12_420 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.WeightFromParts(0, 3675)
+	return types.WeightFromParts(12_420_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ForceCreateCall) IsInherent() bool {
+	return false
+}
+
+func (_ ForceCreateCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ ForceCreateCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ForceCreateCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ForceCreateCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	minBalance := sc.U128(args[3].(sc.Compact))
+
+	err := forceCreate(origin, args[0].(types.AssetId), args[1].(types.MultiAddress), args[2].(sc.Bool), minBalance)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// forceCreate issues a new class of fungible assets, which is owned by `owner`, without any deposit.
+// Can only be called by ROOT.
+func forceCreate(origin types.RawOrigin, id types.AssetId, owner types.MultiAddress, isSufficient sc.Bool, minBalance types.Balance) types.DispatchError {
+	if !origin.IsRootOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	ownerAccount, lookupErr := types.DefaultAccountIdLookup().Lookup(owner)
+	if lookupErr != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	if assets.StorageGetAsset(id).HasValue {
+		return newModuleError(errors.ErrorInUse)
+	}
+
+	if minBalance.ToBigInt().Sign() == 0 {
+		return newModuleError(errors.ErrorMinBalanceZero)
+	}
+
+	assets.StorageSetAsset(id, assets.AssetDetails{
+		Owner:        ownerAccount,
+		Issuer:       ownerAccount,
+		Admin:        ownerAccount,
+		Freezer:      ownerAccount,
+		Supply:       sc.NewU128FromUint64(0),
+		Deposit:      sc.NewU128FromUint64(0),
+		MinBalance:   minBalance,
+		IsSufficient: isSufficient,
+		Status:       assets.AssetStatusLive,
+	})
+	system.DepositEvent(assets.NewEventForceCreated(id, ownerAccount))
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package dispatchables
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Fungibles implements the fungible assets interface over the assets of the module,
+// which allows other modules to hold and move balances in non-native assets.
+type Fungibles struct{}
+
+// MinimumBalance returns the minimum balance of an account in the asset, or none if the asset does not exist.
+func (f Fungibles) MinimumBalance(id types.AssetId) sc.Option[types.Balance] {
+	details := assets.StorageGetAsset(id)
+	if !details.HasValue {
+		return sc.NewOption[types.Balance](nil)
+	}
+
+	return sc.NewOption[types.Balance](details.Value.MinBalance)
+}
+
+// IsSufficient returns whether an account in the asset can exist without any native balance, or false if the asset does not exist.
+func (f Fungibles) IsSufficient(id types.AssetId) bool {
+	details := assets.StorageGetAsset(id)
+	if !details.HasValue {
+		return false
+	}
+
+	return bool(details.Value.IsSufficient)
+}
+
+// TotalIssuance returns the total supply of the asset, or zero if the asset does not exist.
+func (f Fungibles) TotalIssuance(id types.AssetId) types.Balance {
+	details := assets.StorageGetAsset(id)
+	if !details.HasValue {
+		return sc.NewU128FromUint64(0)
+	}
+
+	return details.Value.Supply
+}
+
+// Balance returns the balance of `who` in the asset, or zero if the account does not exist.
+func (f Fungibles) Balance(id types.AssetId, who types.Address32) types.Balance {
+	account := assets.StorageGetAccount(id, who)
+	if !account.HasValue {
+		return sc.NewU128FromUint64(0)
+	}
+
+	return account.Value.Balance
+}
+
+// Withdraw removes `amount` of the asset from the balance of `who`. Fails if the account would be reaped.
+func (f Fungibles) Withdraw(id types.AssetId, who types.Address32, amount types.Balance) types.DispatchError {
+	_, err := decreaseBalance(id, who, amount, true, false)
+	return err
+}
+
+// Deposit adds `amount` of the asset to the balance of `who`.
+func (f Fungibles) Deposit(id types.AssetId, who types.Address32, amount types.Balance) types.DispatchError {
+	return increaseBalance(id, who, amount)
+}
+
+// Transfer moves `amount` of the asset from `source` to `dest`. With `keepAlive`, it fails if the account of `source` would be reaped.
+func (f Fungibles) Transfer(id types.AssetId, source types.Address32, dest types.Address32, amount types.Balance, keepAlive bool) types.DispatchError {
+	_, err := transferBalance(id, source, dest, amount, keepAlive)
+	return err
+}
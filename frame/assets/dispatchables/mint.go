@@ -0,0 +1,144 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	ca "github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/assets/errors"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type MintCall struct {
+	primitives.Callable
+}
+
+func NewMintCall(args sc.VaryingData) MintCall {
+	call := MintCall{
+		Callable: primitives.Callable{
+			ModuleId:   ca.ModuleIndex,
+			FunctionId: ca.FunctionMintIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c MintCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		types.DecodeMultiAddress(buffer),
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c MintCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c MintCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c MintCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c MintCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c MintCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ MintCall) BaseWeight(b ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `351`
+	//  Estimated: `3675`
+	// Minimum execution time: 26_239 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.WeightFromParts(0, 3675)
+	return types.WeightFromParts(26_239_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ MintCall) IsInherent() bool {
+	return false
+}
+
+func (_ MintCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ MintCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ MintCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ MintCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	amount := sc.U128(args[2].(sc.Compact))
+
+	err := mint(origin, args[0].(types.AssetId), args[1].(types.MultiAddress), amount)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// mint issues `amount` of the asset to `beneficiary`. Can only be called by the issuer of the asset.
+func mint(origin types.RawOrigin, id types.AssetId, beneficiary types.MultiAddress, amount types.Balance) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+	who := origin.AsSigned()
+
+	beneficiaryAccount, lookupErr := types.DefaultAccountIdLookup().Lookup(beneficiary)
+	if lookupErr != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	details, err := ensureAsset(id)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(details.Issuer, who) {
+		return newModuleError(errors.ErrorNoPermission)
+	}
+
+	err = increaseBalance(id, beneficiaryAccount, amount)
+	if err != nil {
+		return err
+	}
+
+	system.DepositEvent(assets.NewEventIssued(id, beneficiaryAccount, amount))
+
+	return nil
+}
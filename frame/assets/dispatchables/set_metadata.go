@@ -0,0 +1,163 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	"math/big"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	ca "github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/assets/errors"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetMetadataCall struct {
+	primitives.Callable
+}
+
+func NewSetMetadataCall(args sc.VaryingData) SetMetadataCall {
+	call := SetMetadataCall{
+		Callable: primitives.Callable{
+			ModuleId:   ca.ModuleIndex,
+			FunctionId: ca.FunctionSetMetadataIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetMetadataCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		sc.DecodeSequence[sc.U8](buffer),
+		sc.DecodeSequence[sc.U8](buffer),
+		sc.DecodeU8(buffer),
+	)
+	return c
+}
+
+func (c SetMetadataCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetMetadataCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetMetadataCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetMetadataCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c SetMetadataCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ SetMetadataCall) BaseWeight(b ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `351`
+	//  Estimated: `3675`
+	// Minimum execution time: 30_438 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(1)
+	e := types.WeightFromParts(0, 3675)
+	return types.WeightFromParts(30_438_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ SetMetadataCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetMetadataCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ SetMetadataCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ SetMetadataCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetMetadataCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := setMetadata(origin, args[0].(types.AssetId), args[1].(sc.Sequence[sc.U8]), args[2].(sc.Sequence[sc.U8]), args[3].(sc.U8))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// setMetadata sets the name, symbol and decimals of the asset. The metadata deposit is reserved from
+// the owner, or the difference to the previous deposit is reserved or unreserved.
+// Can only be called by the owner of the asset.
+func setMetadata(origin types.RawOrigin, id types.AssetId, name sc.Sequence[sc.U8], symbol sc.Sequence[sc.U8], decimals sc.U8) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+	who := origin.AsSigned()
+
+	if sc.U32(len(name)) > ca.StringLimit || sc.U32(len(symbol)) > ca.StringLimit {
+		return newModuleError(errors.ErrorBadMetadata)
+	}
+
+	details, err := ensureLiveAsset(id)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(details.Owner, who) {
+		return newModuleError(errors.ErrorNoPermission)
+	}
+
+	oldDeposit := big.NewInt(0)
+	metadata := assets.StorageGetMetadata(id)
+	if metadata.HasValue {
+		oldDeposit = metadata.Value.Deposit.ToBigInt()
+	}
+
+	newDeposit := metadataDeposit(name, symbol)
+	switch newDeposit.Cmp(oldDeposit) {
+	case 1:
+		err := currency.Reserve(who, sc.NewU128FromBigInt(new(big.Int).Sub(newDeposit, oldDeposit)))
+		if err != nil {
+			return err
+		}
+	case -1:
+		currency.Unreserve(who, sc.NewU128FromBigInt(new(big.Int).Sub(oldDeposit, newDeposit)))
+	}
+
+	assets.StorageSetMetadata(id, assets.AssetMetadata{
+		Deposit:  sc.NewU128FromBigInt(newDeposit),
+		Name:     name,
+		Symbol:   symbol,
+		Decimals: decimals,
+	})
+	system.DepositEvent(assets.NewEventMetadataSet(id, name, symbol, decimals))
+
+	return nil
+}
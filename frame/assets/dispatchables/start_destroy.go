@@ -0,0 +1,137 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	ca "github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/assets/errors"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type StartDestroyCall struct {
+	primitives.Callable
+}
+
+func NewStartDestroyCall(args sc.VaryingData) StartDestroyCall {
+	call := StartDestroyCall{
+		Callable: primitives.Callable{
+			ModuleId:   ca.ModuleIndex,
+			FunctionId: ca.FunctionStartDestroyIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c StartDestroyCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c StartDestroyCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c StartDestroyCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c StartDestroyCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c StartDestroyCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c StartDestroyCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ StartDestroyCall) BaseWeight(b ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `385`
+	//  Estimated: `3675`
+	// Minimum execution time: 13_880 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.WeightFromParts(0, 3675)
+	return types.WeightFromParts(13_880_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ StartDestroyCall) IsInherent() bool {
+	return false
+}
+
+func (_ StartDestroyCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ StartDestroyCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ StartDestroyCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ StartDestroyCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := startDestroy(origin, args[0].(types.AssetId))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// startDestroy starts the destruction of an asset. Its accounts and approvals must then be removed
+// with destroy_accounts and destroy_approvals, before it is removed with finish_destroy.
+// Can be called by the owner of the asset or by ROOT.
+func startDestroy(origin types.RawOrigin, id types.AssetId) types.DispatchError {
+	if !origin.IsRootOrigin() && !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	details, err := ensureAsset(id)
+	if err != nil {
+		return err
+	}
+
+	if origin.IsSignedOrigin() && !sc.Bool(reflect.DeepEqual(details.Owner, origin.AsSigned())) {
+		return newModuleError(errors.ErrorNoPermission)
+	}
+
+	if details.Status != assets.AssetStatusLive {
+		return newModuleError(errors.ErrorAssetNotLive)
+	}
+
+	details.Status = assets.AssetStatusDestroying
+	assets.StorageSetAsset(id, details)
+	system.DepositEvent(assets.NewEventDestructionStarted(id))
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	ca "github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type TransferKeepAliveCall struct {
+	primitives.Callable
+}
+
+func NewTransferKeepAliveCall(args sc.VaryingData) TransferKeepAliveCall {
+	call := TransferKeepAliveCall{
+		Callable: primitives.Callable{
+			ModuleId:   ca.ModuleIndex,
+			FunctionId: ca.FunctionTransferKeepAliveIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c TransferKeepAliveCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		types.DecodeMultiAddress(buffer),
+		sc.DecodeCompact(buffer),
+	)
+	return c
+}
+
+func (c TransferKeepAliveCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c TransferKeepAliveCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c TransferKeepAliveCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c TransferKeepAliveCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c TransferKeepAliveCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ TransferKeepAliveCall) BaseWeight(b ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `498`
+	//  Estimated: `6208`
+	// Minimum execution time: 39_211 nanoseconds.
+	r := constants.DbWeight.Reads(4)
+	w := constants.DbWeight.Writes(4)
+	e := types.WeightFromParts(0, 6208)
+	return types.WeightFromParts(39_211_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ TransferKeepAliveCall) IsInherent() bool {
+	return false
+}
+
+func (_ TransferKeepAliveCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ TransferKeepAliveCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ TransferKeepAliveCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ TransferKeepAliveCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	amount := sc.U128(args[2].(sc.Compact))
+
+	err := transferKeepAlive(origin, args[0].(types.AssetId), args[1].(types.MultiAddress), amount)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// transferKeepAlive moves `amount` of the asset from the sender to `target`.
+// Fails, if the remaining balance of the sender would be below the minimum balance.
+func transferKeepAlive(origin types.RawOrigin, id types.AssetId, target types.MultiAddress, amount types.Balance) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+	source := origin.AsSigned()
+
+	dest, lookupErr := types.DefaultAccountIdLookup().Lookup(target)
+	if lookupErr != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	_, err := transferBalance(id, source, dest, amount, true)
+	return err
+}
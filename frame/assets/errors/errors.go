@@ -0,0 +1,21 @@
+package errors
+
+import sc "github.com/LimeChain/goscale"
+
+// Assets module errors.
+const (
+	ErrorBalanceLow sc.U8 = iota
+	ErrorNoAccount
+	ErrorNoPermission
+	ErrorUnknown
+	ErrorFrozen
+	ErrorInUse
+	ErrorMinBalanceZero
+	ErrorUnavailableConsumer
+	ErrorBadMetadata
+	ErrorUnapproved
+	ErrorWouldDie
+	ErrorAssetNotLive
+	ErrorIncorrectStatus
+	ErrorNotFrozen
+)
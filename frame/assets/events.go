@@ -0,0 +1,168 @@
+package assets
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Assets module events.
+const (
+	EventCreated sc.U8 = iota
+	EventIssued
+	EventTransferred
+	EventBurned
+	EventFrozen
+	EventThawed
+	EventAccountsDestroyed
+	EventApprovalsDestroyed
+	EventDestructionStarted
+	EventDestroyed
+	EventForceCreated
+	EventMetadataSet
+	EventApprovedTransfer
+	EventTransferredApproved
+)
+
+func NewEventCreated(id types.AssetId, creator types.Address32, owner types.Address32) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventCreated, id, creator, owner)
+}
+
+func NewEventIssued(id types.AssetId, owner types.Address32, amount types.Balance) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventIssued, id, owner, amount)
+}
+
+func NewEventTransferred(id types.AssetId, from types.Address32, to types.Address32, amount types.Balance) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventTransferred, id, from, to, amount)
+}
+
+func NewEventBurned(id types.AssetId, owner types.Address32, balance types.Balance) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventBurned, id, owner, balance)
+}
+
+func NewEventFrozen(id types.AssetId, who types.Address32) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventFrozen, id, who)
+}
+
+func NewEventThawed(id types.AssetId, who types.Address32) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventThawed, id, who)
+}
+
+func NewEventAccountsDestroyed(id types.AssetId, accountsDestroyed sc.U32, accountsRemaining sc.U32) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventAccountsDestroyed, id, accountsDestroyed, accountsRemaining)
+}
+
+func NewEventApprovalsDestroyed(id types.AssetId, approvalsDestroyed sc.U32, approvalsRemaining sc.U32) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventApprovalsDestroyed, id, approvalsDestroyed, approvalsRemaining)
+}
+
+func NewEventDestructionStarted(id types.AssetId) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventDestructionStarted, id)
+}
+
+func NewEventDestroyed(id types.AssetId) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventDestroyed, id)
+}
+
+func NewEventForceCreated(id types.AssetId, owner types.Address32) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventForceCreated, id, owner)
+}
+
+func NewEventMetadataSet(id types.AssetId, name sc.Sequence[sc.U8], symbol sc.Sequence[sc.U8], decimals sc.U8) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventMetadataSet, id, name, symbol, decimals)
+}
+
+func NewEventApprovedTransfer(id types.AssetId, source types.Address32, delegate types.Address32, amount types.Balance) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventApprovedTransfer, id, source, delegate, amount)
+}
+
+func NewEventTransferredApproved(id types.AssetId, owner types.Address32, delegate types.Address32, destination types.Address32, amount types.Balance) types.Event {
+	return types.NewEvent(assets.ModuleIndex, EventTransferredApproved, id, owner, delegate, destination, amount)
+}
+
+func DecodeEvent(buffer *bytes.Buffer) types.Event {
+	moduleIndex := sc.DecodeU8(buffer)
+	if moduleIndex != assets.ModuleIndex {
+		log.Critical("invalid assets.Event")
+	}
+
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case EventCreated:
+		id := sc.DecodeU32(buffer)
+		creator := types.DecodeAddress32(buffer)
+		owner := types.DecodeAddress32(buffer)
+		return NewEventCreated(id, creator, owner)
+	case EventIssued:
+		id := sc.DecodeU32(buffer)
+		owner := types.DecodeAddress32(buffer)
+		amount := sc.DecodeU128(buffer)
+		return NewEventIssued(id, owner, amount)
+	case EventTransferred:
+		id := sc.DecodeU32(buffer)
+		from := types.DecodeAddress32(buffer)
+		to := types.DecodeAddress32(buffer)
+		amount := sc.DecodeU128(buffer)
+		return NewEventTransferred(id, from, to, amount)
+	case EventBurned:
+		id := sc.DecodeU32(buffer)
+		owner := types.DecodeAddress32(buffer)
+		balance := sc.DecodeU128(buffer)
+		return NewEventBurned(id, owner, balance)
+	case EventFrozen:
+		id := sc.DecodeU32(buffer)
+		who := types.DecodeAddress32(buffer)
+		return NewEventFrozen(id, who)
+	case EventThawed:
+		id := sc.DecodeU32(buffer)
+		who := types.DecodeAddress32(buffer)
+		return NewEventThawed(id, who)
+	case EventAccountsDestroyed:
+		id := sc.DecodeU32(buffer)
+		accountsDestroyed := sc.DecodeU32(buffer)
+		accountsRemaining := sc.DecodeU32(buffer)
+		return NewEventAccountsDestroyed(id, accountsDestroyed, accountsRemaining)
+	case EventApprovalsDestroyed:
+		id := sc.DecodeU32(buffer)
+		approvalsDestroyed := sc.DecodeU32(buffer)
+		approvalsRemaining := sc.DecodeU32(buffer)
+		return NewEventApprovalsDestroyed(id, approvalsDestroyed, approvalsRemaining)
+	case EventDestructionStarted:
+		id := sc.DecodeU32(buffer)
+		return NewEventDestructionStarted(id)
+	case EventDestroyed:
+		id := sc.DecodeU32(buffer)
+		return NewEventDestroyed(id)
+	case EventForceCreated:
+		id := sc.DecodeU32(buffer)
+		owner := types.DecodeAddress32(buffer)
+		return NewEventForceCreated(id, owner)
+	case EventMetadataSet:
+		id := sc.DecodeU32(buffer)
+		name := sc.DecodeSequence[sc.U8](buffer)
+		symbol := sc.DecodeSequence[sc.U8](buffer)
+		decimals := sc.DecodeU8(buffer)
+		return NewEventMetadataSet(id, name, symbol, decimals)
+	case EventApprovedTransfer:
+		id := sc.DecodeU32(buffer)
+		source := types.DecodeAddress32(buffer)
+		delegate := types.DecodeAddress32(buffer)
+		amount := sc.DecodeU128(buffer)
+		return NewEventApprovedTransfer(id, source, delegate, amount)
+	case EventTransferredApproved:
+		id := sc.DecodeU32(buffer)
+		owner := types.DecodeAddress32(buffer)
+		delegate := types.DecodeAddress32(buffer)
+		destination := types.DecodeAddress32(buffer)
+		amount := sc.DecodeU128(buffer)
+		return NewEventTransferredApproved(id, owner, delegate, destination, amount)
+	default:
+		log.Critical("invalid assets.Event type")
+	}
+
+	panic("unreachable")
+}
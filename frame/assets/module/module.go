@@ -0,0 +1,560 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	ca "github.com/LimeChain/gosemble/constants/assets"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/assets"
+	"github.com/LimeChain/gosemble/frame/assets/dispatchables"
+	"github.com/LimeChain/gosemble/frame/assets/errors"
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type AssetsModule struct {
+	primitives.DefaultHooks
+	functions map[sc.U8]primitives.Call
+}
+
+func NewAssetsModule() AssetsModule {
+	functions := make(map[sc.U8]primitives.Call)
+	functions[ca.FunctionCreateIndex] = dispatchables.NewCreateCall(nil)
+	functions[ca.FunctionForceCreateIndex] = dispatchables.NewForceCreateCall(nil)
+	functions[ca.FunctionStartDestroyIndex] = dispatchables.NewStartDestroyCall(nil)
+	functions[ca.FunctionDestroyAccountsIndex] = dispatchables.NewDestroyAccountsCall(nil)
+	functions[ca.FunctionDestroyApprovalsIndex] = dispatchables.NewDestroyApprovalsCall(nil)
+	functions[ca.FunctionFinishDestroyIndex] = dispatchables.NewFinishDestroyCall(nil)
+	functions[ca.FunctionMintIndex] = dispatchables.NewMintCall(nil)
+	functions[ca.FunctionBurnIndex] = dispatchables.NewBurnCall(nil)
+	functions[ca.FunctionTransferIndex] = dispatchables.NewTransferCall(nil)
+	functions[ca.FunctionTransferKeepAliveIndex] = dispatchables.NewTransferKeepAliveCall(nil)
+	functions[ca.FunctionFreezeIndex] = dispatchables.NewFreezeCall(nil)
+	functions[ca.FunctionThawIndex] = dispatchables.NewThawCall(nil)
+	functions[ca.FunctionSetMetadataIndex] = dispatchables.NewSetMetadataCall(nil)
+	functions[ca.FunctionApproveTransferIndex] = dispatchables.NewApproveTransferCall(nil)
+	functions[ca.FunctionTransferApprovedIndex] = dispatchables.NewTransferApprovedCall(nil)
+
+	return AssetsModule{
+		functions: functions,
+	}
+}
+
+func (am AssetsModule) Functions() map[sc.U8]primitives.Call {
+	return am.functions
+}
+
+func (am AssetsModule) PreDispatch(_ primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (am AssetsModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+func (am AssetsModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
+	declaredTypes, metadataModule := am.declaration().Metadata()
+
+	return append(am.metadataTypes(), declaredTypes...), metadataModule
+}
+
+// declaration declares the storage, calls, events, errors and constants of the module,
+// from which its metadata is derived.
+func (am AssetsModule) declaration() support.ModuleDeclaration {
+	return support.ModuleDeclaration{
+		Name:    "Assets",
+		Index:   ca.ModuleIndex,
+		Path:    "pallet_assets",
+		Storage: assets.StorageDeclarations(),
+		Calls: &support.EnumDeclaration{
+			TypeId:   metadata.AssetsCalls,
+			Variants: callDeclarations,
+		},
+		Events: &support.EnumDeclaration{
+			TypeId:   metadata.TypesAssetsEvent,
+			Variants: eventDeclarations,
+		},
+		Errors: &support.EnumDeclaration{
+			TypeId:   metadata.TypesAssetsErrors,
+			Variants: errorDeclarations,
+		},
+		Constants: []support.ConstantDeclaration{
+			{
+				Name:  "RemoveItemsLimit",
+				Value: ca.RemoveItemsLimit,
+				Docs:  "Max number of items to destroy per `destroy_accounts` and `destroy_approvals` call.",
+			},
+			{
+				Name:  "AssetDeposit",
+				Value: ca.AssetDeposit,
+				Docs:  "The basic amount of funds that must be reserved for an asset.",
+			},
+			{
+				Name:  "MetadataDepositBase",
+				Value: ca.MetadataDepositBase,
+				Docs:  "The basic amount of funds that must be reserved when adding metadata to your asset.",
+			},
+			{
+				Name:  "MetadataDepositPerByte",
+				Value: ca.MetadataDepositPerByte,
+				Docs:  "The additional funds that must be reserved for the number of bytes you store in your metadata.",
+			},
+			{
+				Name:  "ApprovalDeposit",
+				Value: ca.ApprovalDeposit,
+				Docs:  "The amount of funds that must be reserved when creating a new approval.",
+			},
+			{
+				Name:  "StringLimit",
+				Value: ca.StringLimit,
+				Docs:  "The maximum length of a name or symbol stored on-chain.",
+			},
+		},
+	}
+}
+
+func (am AssetsModule) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithPath(metadata.TypesAssetStatus, "AssetStatus", sc.Sequence[sc.Str]{"pallet_assets", "types", "AssetStatus"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"Live",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					assets.AssetStatusLive,
+					"The asset is active and able to be used."),
+				primitives.NewMetadataDefinitionVariant(
+					"Frozen",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					assets.AssetStatusFrozen,
+					"Whether the asset is frozen for non-admin transfers."),
+				primitives.NewMetadataDefinitionVariant(
+					"Destroying",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					assets.AssetStatusDestroying,
+					"The asset is currently being destroyed, and all actions are no longer permitted on the asset."),
+			})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesExistenceReason, "ExistenceReason", sc.Sequence[sc.Str]{"pallet_assets", "types", "ExistenceReason"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"Consumer",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					assets.ExistenceReasonConsumer,
+					"A consumer reference was used to create this account."),
+				primitives.NewMetadataDefinitionVariant(
+					"Sufficient",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					assets.ExistenceReasonSufficient,
+					"The asset class is `sufficient` for this account."),
+			})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesAssetDetails, "AssetDetails", sc.Sequence[sc.Str]{"pallet_assets", "types", "AssetDetails"},
+			primitives.NewMetadataTypeDefinitionComposite(sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "owner", "AccountId"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "issuer", "AccountId"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "admin", "AccountId"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "freezer", "AccountId"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "supply", "Balance"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "deposit", "DepositBalance"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "min_balance", "Balance"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "is_sufficient", "bool"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "accounts", "u32"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "sufficients", "u32"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "approvals", "u32"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAssetStatus, "status", "AssetStatus"),
+			})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesAssetAccount, "AssetAccount", sc.Sequence[sc.Str]{"pallet_assets", "types", "AssetAccount"},
+			primitives.NewMetadataTypeDefinitionComposite(sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "balance", "Balance"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "is_frozen", "bool"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesExistenceReason, "reason", "ExistenceReason"),
+			})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesApproval, "Approval", sc.Sequence[sc.Str]{"pallet_assets", "types", "Approval"},
+			primitives.NewMetadataTypeDefinitionComposite(sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "Balance"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "deposit", "DepositBalance"),
+			})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesAssetMetadata, "AssetMetadata", sc.Sequence[sc.Str]{"pallet_assets", "types", "AssetMetadata"},
+			primitives.NewMetadataTypeDefinitionComposite(sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "deposit", "DepositBalance"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceU8, "name", "BoundedString"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceU8, "symbol", "BoundedString"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU8, "decimals", "u8"),
+			})),
+
+		primitives.NewMetadataType(metadata.TypesTupleU32Address32, "(AssetId, AccountId)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.ToCompact(metadata.TypesAddress32),
+			})),
+
+		primitives.NewMetadataType(metadata.TypesTupleU32Address32Address32, "(AssetId, AccountId, AccountId)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+				sc.ToCompact(metadata.TypesAddress32),
+				sc.ToCompact(metadata.TypesAddress32),
+			})),
+	}
+}
+
+var callDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "create",
+		Index: ca.FunctionCreateIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "id", "T::AssetIdParameter"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "admin", "AccountIdLookupOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "min_balance", "T::Balance"),
+		},
+		Docs: "Issue a new class of fungible assets from a public origin. The asset deposit is reserved from the sender, which becomes the owner of the asset.",
+	},
+	{
+		Name:  "force_create",
+		Index: ca.FunctionForceCreateIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "id", "T::AssetIdParameter"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "owner", "AccountIdLookupOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "is_sufficient", "bool"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "min_balance", "T::Balance"),
+		},
+		Docs: "Issue a new class of fungible assets from a privileged origin. This doesn't require a deposit. The origin must be `Root`.",
+	},
+	{
+		Name:  "start_destroy",
+		Index: ca.FunctionStartDestroyIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "id", "T::AssetIdParameter"),
+		},
+		Docs: "Start the process of destroying a fungible asset class. The origin must be the owner of the asset or `Root`.",
+	},
+	{
+		Name:  "destroy_accounts",
+		Index: ca.FunctionDestroyAccountsIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "id", "T::AssetIdParameter"),
+		},
+		Docs: "Destroy all accounts associated with a given asset, up to `RemoveItemsLimit` per call. The asset must be in the `Destroying` state.",
+	},
+	{
+		Name:  "destroy_approvals",
+		Index: ca.FunctionDestroyApprovalsIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "id", "T::AssetIdParameter"),
+		},
+		Docs: "Destroy all approvals associated with a given asset, up to `RemoveItemsLimit` per call. The asset must be in the `Destroying` state.",
+	},
+	{
+		Name:  "finish_destroy",
+		Index: ca.FunctionFinishDestroyIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "id", "T::AssetIdParameter"),
+		},
+		Docs: "Complete destroying an asset and unreserve the deposits. The asset must have no accounts and approvals left.",
+	},
+	{
+		Name:  "mint",
+		Index: ca.FunctionMintIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "id", "T::AssetIdParameter"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "beneficiary", "AccountIdLookupOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "amount", "T::Balance"),
+		},
+		Docs: "Mint assets of a particular class. The origin must be the issuer of the asset.",
+	},
+	{
+		Name:  "burn",
+		Index: ca.FunctionBurnIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "id", "T::AssetIdParameter"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "who", "AccountIdLookupOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "amount", "T::Balance"),
+		},
+		Docs: "Reduce the balance of `who` by as much as possible up to `amount` assets of `id`. The origin must be the admin of the asset.",
+	},
+	{
+		Name:  "transfer",
+		Index: ca.FunctionTransferIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "id", "T::AssetIdParameter"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "target", "AccountIdLookupOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "amount", "T::Balance"),
+		},
+		Docs: "Move some assets from the sender account to another. If the remaining balance of the sender is below the minimum balance, it is moved as well.",
+	},
+	{
+		Name:  "transfer_keep_alive",
+		Index: ca.FunctionTransferKeepAliveIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "id", "T::AssetIdParameter"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "target", "AccountIdLookupOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "amount", "T::Balance"),
+		},
+		Docs: "Move some assets from the sender account to another, keeping the sender account alive.",
+	},
+	{
+		Name:  "freeze",
+		Index: ca.FunctionFreezeIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "id", "T::AssetIdParameter"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "who", "AccountIdLookupOf<T>"),
+		},
+		Docs: "Disallow further unprivileged transfers of an asset `id` from an account `who`. The origin must be the freezer of the asset.",
+	},
+	{
+		Name:  "thaw",
+		Index: ca.FunctionThawIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "id", "T::AssetIdParameter"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "who", "AccountIdLookupOf<T>"),
+		},
+		Docs: "Allow unprivileged transfers to and from an account again. The origin must be the admin of the asset.",
+	},
+	{
+		Name:  "set_metadata",
+		Index: ca.FunctionSetMetadataIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "id", "T::AssetIdParameter"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceU8, "name", "Vec<u8>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceU8, "symbol", "Vec<u8>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU8, "decimals", "u8"),
+		},
+		Docs: "Set the metadata for an asset. Funds of the sender are reserved according to the formula `MetadataDepositBase + MetadataDepositPerByte * (name.len + symbol.len)`. The origin must be the owner of the asset.",
+	},
+	{
+		Name:  "approve_transfer",
+		Index: ca.FunctionApproveTransferIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "id", "T::AssetIdParameter"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "delegate", "AccountIdLookupOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "amount", "T::Balance"),
+		},
+		Docs: "Approve an amount of asset for transfer by a delegated third-party account. The approval deposit is reserved from the sender for a new approval.",
+	},
+	{
+		Name:  "transfer_approved",
+		Index: ca.FunctionTransferApprovedIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "id", "T::AssetIdParameter"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "owner", "AccountIdLookupOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "destination", "AccountIdLookupOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "amount", "T::Balance"),
+		},
+		Docs: "Transfer some asset balance from a previously delegated account to some third-party account. When the approval is used up, its deposit is unreserved.",
+	},
+}
+
+var eventDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "Created",
+		Index: assets.EventCreated,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "asset_id", "T::AssetId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "creator", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "owner", "T::AccountId"),
+		},
+		Docs: "Some asset class was created.",
+	},
+	{
+		Name:  "Issued",
+		Index: assets.EventIssued,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "asset_id", "T::AssetId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "owner", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
+		},
+		Docs: "Some assets were issued.",
+	},
+	{
+		Name:  "Transferred",
+		Index: assets.EventTransferred,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "asset_id", "T::AssetId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "from", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "to", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
+		},
+		Docs: "Some assets were transferred.",
+	},
+	{
+		Name:  "Burned",
+		Index: assets.EventBurned,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "asset_id", "T::AssetId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "owner", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "balance", "T::Balance"),
+		},
+		Docs: "Some assets were destroyed.",
+	},
+	{
+		Name:  "Frozen",
+		Index: assets.EventFrozen,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "asset_id", "T::AssetId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
+		},
+		Docs: "Some account `who` was frozen.",
+	},
+	{
+		Name:  "Thawed",
+		Index: assets.EventThawed,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "asset_id", "T::AssetId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
+		},
+		Docs: "Some account `who` was thawed.",
+	},
+	{
+		Name:  "AccountsDestroyed",
+		Index: assets.EventAccountsDestroyed,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "asset_id", "T::AssetId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "accounts_destroyed", "u32"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "accounts_remaining", "u32"),
+		},
+		Docs: "Accounts were destroyed for given asset.",
+	},
+	{
+		Name:  "ApprovalsDestroyed",
+		Index: assets.EventApprovalsDestroyed,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "asset_id", "T::AssetId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "approvals_destroyed", "u32"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "approvals_remaining", "u32"),
+		},
+		Docs: "Approvals were destroyed for given asset.",
+	},
+	{
+		Name:  "DestructionStarted",
+		Index: assets.EventDestructionStarted,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "asset_id", "T::AssetId"),
+		},
+		Docs: "An asset class is in the process of being destroyed.",
+	},
+	{
+		Name:  "Destroyed",
+		Index: assets.EventDestroyed,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "asset_id", "T::AssetId"),
+		},
+		Docs: "An asset class was destroyed.",
+	},
+	{
+		Name:  "ForceCreated",
+		Index: assets.EventForceCreated,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "asset_id", "T::AssetId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "owner", "T::AccountId"),
+		},
+		Docs: "Some asset class was force-created.",
+	},
+	{
+		Name:  "MetadataSet",
+		Index: assets.EventMetadataSet,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "asset_id", "T::AssetId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceU8, "name", "Vec<u8>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceU8, "symbol", "Vec<u8>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU8, "decimals", "u8"),
+		},
+		Docs: "New metadata has been set for an asset.",
+	},
+	{
+		Name:  "ApprovedTransfer",
+		Index: assets.EventApprovedTransfer,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "asset_id", "T::AssetId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "source", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "delegate", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
+		},
+		Docs: "(Additional) funds have been approved for transfer to a destination account.",
+	},
+	{
+		Name:  "TransferredApproved",
+		Index: assets.EventTransferredApproved,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "asset_id", "T::AssetId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "owner", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "delegate", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "destination", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
+		},
+		Docs: "An `amount` was transferred in its entirety from `owner` to `destination` by the approved `delegate`.",
+	},
+}
+
+var errorDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "BalanceLow",
+		Index: errors.ErrorBalanceLow,
+		Docs:  "Account balance must be greater than or equal to the transfer amount.",
+	},
+	{
+		Name:  "NoAccount",
+		Index: errors.ErrorNoAccount,
+		Docs:  "The account to alter does not exist.",
+	},
+	{
+		Name:  "NoPermission",
+		Index: errors.ErrorNoPermission,
+		Docs:  "The signing account has no permission to do the operation.",
+	},
+	{
+		Name:  "Unknown",
+		Index: errors.ErrorUnknown,
+		Docs:  "The given asset ID is unknown.",
+	},
+	{
+		Name:  "Frozen",
+		Index: errors.ErrorFrozen,
+		Docs:  "The origin account is frozen.",
+	},
+	{
+		Name:  "InUse",
+		Index: errors.ErrorInUse,
+		Docs:  "The asset ID is already taken.",
+	},
+	{
+		Name:  "MinBalanceZero",
+		Index: errors.ErrorMinBalanceZero,
+		Docs:  "Minimum balance should be non-zero.",
+	},
+	{
+		Name:  "UnavailableConsumer",
+		Index: errors.ErrorUnavailableConsumer,
+		Docs:  "Unable to increment the consumer reference counters on the account. Either no provider reference exists to allow a non-zero balance of a non-self-sufficient asset, or the maximum number of consumers has been reached.",
+	},
+	{
+		Name:  "BadMetadata",
+		Index: errors.ErrorBadMetadata,
+		Docs:  "Invalid metadata given.",
+	},
+	{
+		Name:  "Unapproved",
+		Index: errors.ErrorUnapproved,
+		Docs:  "No approval exists that would allow the transfer.",
+	},
+	{
+		Name:  "WouldDie",
+		Index: errors.ErrorWouldDie,
+		Docs:  "The source account would not survive the transfer and it needs to stay alive.",
+	},
+	{
+		Name:  "AssetNotLive",
+		Index: errors.ErrorAssetNotLive,
+		Docs:  "The asset is not live, and likely being destroyed.",
+	},
+	{
+		Name:  "IncorrectStatus",
+		Index: errors.ErrorIncorrectStatus,
+		Docs:  "The asset status is not the expected status.",
+	},
+	{
+		Name:  "NotFrozen",
+		Index: errors.ErrorNotFrozen,
+		Docs:  "The asset should be frozen before the given operation.",
+	},
+}
@@ -0,0 +1,121 @@
+package assets
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	storageAsset     = support.NewStorageMap[types.AssetId, AssetDetails](constants.KeyAssets, constants.KeyAsset, support.Blake2_128Concat{}, sc.DecodeU32, DecodeAssetDetails)
+	storageAccount   = support.NewStorageDoubleMap[types.AssetId, types.Address32, AssetAccount](constants.KeyAssets, constants.KeyAccount, support.Blake2_128Concat{}, support.Blake2_128Concat{}, sc.DecodeU32, types.DecodeAddress32, DecodeAssetAccount)
+	storageApprovals = support.NewStorageNMap[Approval](constants.KeyAssets, constants.KeyApprovals,
+		[]support.StorageKey{
+			support.NewStorageKey(support.Blake2_128Concat{}, sc.DecodeU32),
+			support.NewStorageKey(support.Blake2_128Concat{}, types.DecodeAddress32),
+			support.NewStorageKey(support.Blake2_128Concat{}, types.DecodeAddress32),
+		},
+		DecodeApproval)
+	storageMetadata = support.NewStorageMap[types.AssetId, AssetMetadata](constants.KeyAssets, constants.KeyMetadata, support.Blake2_128Concat{}, sc.DecodeU32, DecodeAssetMetadata)
+)
+
+func init() {
+	support.RegisterTypeId(metadata.TypesAssetDetails, support.TypeOf[AssetDetails]())
+	support.RegisterTypeId(metadata.TypesAssetAccount, support.TypeOf[AssetAccount]())
+	support.RegisterTypeId(metadata.TypesApproval, support.TypeOf[Approval]())
+	support.RegisterTypeId(metadata.TypesAssetMetadata, support.TypeOf[AssetMetadata]())
+	support.RegisterTypeId(metadata.TypesTupleU32Address32, support.TypeOf[types.AssetId](), support.TypeOf[types.Address32]())
+	support.RegisterTypeId(metadata.TypesTupleU32Address32Address32, support.TypeOf[types.AssetId](), support.TypeOf[types.Address32](), support.TypeOf[types.Address32]())
+}
+
+// StorageDeclarations returns the declarations of the storage items of the module, in the order of the metadata.
+func StorageDeclarations() []support.StorageDeclaration {
+	return []support.StorageDeclaration{
+		{
+			Item:     storageAsset,
+			Modifier: types.MetadataModuleStorageEntryModifierOptional,
+			Docs:     "Details of an asset.",
+		},
+		{
+			Item:     storageAccount,
+			Modifier: types.MetadataModuleStorageEntryModifierOptional,
+			Docs:     "The holdings of a specific account for a specific asset.",
+		},
+		{
+			Item:     storageApprovals,
+			Modifier: types.MetadataModuleStorageEntryModifierOptional,
+			Docs:     "Approved balance transfers. First balance is the amount approved for transfer. Second is the amount of `T::Currency` reserved for storing this. First key is the asset ID, second key is the owner and third key is the delegate.",
+		},
+		{
+			Item:     storageMetadata,
+			Modifier: types.MetadataModuleStorageEntryModifierOptional,
+			Docs:     "Metadata of an asset.",
+		},
+	}
+}
+
+// StorageGetAsset returns the details of the asset, if it exists.
+func StorageGetAsset(id types.AssetId) sc.Option[AssetDetails] {
+	return storageAsset.GetOption(id)
+}
+
+func StorageSetAsset(id types.AssetId, details AssetDetails) {
+	storageAsset.Put(id, details)
+}
+
+func StorageRemoveAsset(id types.AssetId) {
+	storageAsset.Remove(id)
+}
+
+// StorageGetAccount returns the balance of `who` in the asset, if the account exists.
+func StorageGetAccount(id types.AssetId, who types.Address32) sc.Option[AssetAccount] {
+	return storageAccount.GetOption(id, who)
+}
+
+func StorageSetAccount(id types.AssetId, who types.Address32, account AssetAccount) {
+	storageAccount.Put(id, who, account)
+}
+
+func StorageRemoveAccount(id types.AssetId, who types.Address32) {
+	storageAccount.Remove(id, who)
+}
+
+// StorageIterateAccounts calls f with each account in the asset, until f returns false.
+func StorageIterateAccounts(id types.AssetId, f func(who types.Address32, account AssetAccount) bool) {
+	storageAccount.IteratePrefix(id, f)
+}
+
+// StorageGetApproval returns the amount of the asset, which `delegate` may transfer from `owner`, if there is an approval.
+func StorageGetApproval(id types.AssetId, owner types.Address32, delegate types.Address32) sc.Option[Approval] {
+	return storageApprovals.GetOption(sc.NewVaryingData(id, owner, delegate))
+}
+
+func StorageSetApproval(id types.AssetId, owner types.Address32, delegate types.Address32, approval Approval) {
+	storageApprovals.Put(sc.NewVaryingData(id, owner, delegate), approval)
+}
+
+func StorageRemoveApproval(id types.AssetId, owner types.Address32, delegate types.Address32) {
+	storageApprovals.Remove(sc.NewVaryingData(id, owner, delegate))
+}
+
+// StorageIterateApprovals calls f with each approval of the asset, until f returns false.
+func StorageIterateApprovals(id types.AssetId, f func(owner types.Address32, delegate types.Address32, approval Approval) bool) {
+	storageApprovals.IteratePrefix(sc.NewVaryingData(id), func(keys sc.VaryingData, approval Approval) bool {
+		return f(keys[1].(types.Address32), keys[2].(types.Address32), approval)
+	})
+}
+
+// StorageGetMetadata returns the metadata of the asset, if it is set.
+func StorageGetMetadata(id types.AssetId) sc.Option[AssetMetadata] {
+	return storageMetadata.GetOption(id)
+}
+
+func StorageSetMetadata(id types.AssetId, assetMetadata AssetMetadata) {
+	storageMetadata.Put(id, assetMetadata)
+}
+
+func StorageRemoveMetadata(id types.AssetId) {
+	storageMetadata.Remove(id)
+}
@@ -0,0 +1,172 @@
+package assets
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// AssetStatus is the lifecycle status of an asset.
+type AssetStatus = sc.U8
+
+const (
+	// AssetStatusLive is the status of an asset, which can be used normally.
+	AssetStatusLive AssetStatus = iota
+	// AssetStatusFrozen is the status of an asset, whose balances cannot be moved.
+	AssetStatusFrozen
+	// AssetStatusDestroying is the status of an asset, which is being destroyed. Its accounts and approvals
+	// are removed in batches, before the asset itself is removed.
+	AssetStatusDestroying
+)
+
+// ExistenceReason is the reason, for which an account of an asset is allowed to exist.
+type ExistenceReason = sc.U8
+
+const (
+	// ExistenceReasonConsumer is the reason of an account, which holds a consumer reference on the system account.
+	ExistenceReasonConsumer ExistenceReason = iota
+	// ExistenceReasonSufficient is the reason of an account of a sufficient asset, which holds
+	// a sufficient reference on the system account.
+	ExistenceReasonSufficient
+)
+
+// AssetDetails are the details of an asset.
+type AssetDetails struct {
+	// Owner can destroy the asset and set its metadata. Holds the deposit of the asset.
+	Owner types.Address32
+	// Issuer can mint the asset.
+	Issuer types.Address32
+	// Admin can burn the asset and thaw accounts.
+	Admin types.Address32
+	// Freezer can freeze accounts.
+	Freezer types.Address32
+	// Supply is the total supply of the asset.
+	Supply types.Balance
+	// Deposit is the balance, reserved from the owner for the asset.
+	Deposit types.Balance
+	// MinBalance is the minimum balance of an account in the asset.
+	MinBalance types.Balance
+	// IsSufficient is whether an account in the asset can exist without any native balance.
+	IsSufficient sc.Bool
+	// Accounts is the number of accounts in the asset.
+	Accounts sc.U32
+	// Sufficients is the number of accounts, which exist only because of the asset.
+	Sufficients sc.U32
+	// Approvals is the number of approved transfers.
+	Approvals sc.U32
+	// Status is the lifecycle status of the asset.
+	Status AssetStatus
+}
+
+func (ad AssetDetails) Encode(buffer *bytes.Buffer) {
+	ad.Owner.Encode(buffer)
+	ad.Issuer.Encode(buffer)
+	ad.Admin.Encode(buffer)
+	ad.Freezer.Encode(buffer)
+	ad.Supply.Encode(buffer)
+	ad.Deposit.Encode(buffer)
+	ad.MinBalance.Encode(buffer)
+	ad.IsSufficient.Encode(buffer)
+	ad.Accounts.Encode(buffer)
+	ad.Sufficients.Encode(buffer)
+	ad.Approvals.Encode(buffer)
+	ad.Status.Encode(buffer)
+}
+
+func DecodeAssetDetails(buffer *bytes.Buffer) AssetDetails {
+	return AssetDetails{
+		Owner:        types.DecodeAddress32(buffer),
+		Issuer:       types.DecodeAddress32(buffer),
+		Admin:        types.DecodeAddress32(buffer),
+		Freezer:      types.DecodeAddress32(buffer),
+		Supply:       sc.DecodeU128(buffer),
+		Deposit:      sc.DecodeU128(buffer),
+		MinBalance:   sc.DecodeU128(buffer),
+		IsSufficient: sc.DecodeBool(buffer),
+		Accounts:     sc.DecodeU32(buffer),
+		Sufficients:  sc.DecodeU32(buffer),
+		Approvals:    sc.DecodeU32(buffer),
+		Status:       sc.DecodeU8(buffer),
+	}
+}
+
+func (ad AssetDetails) Bytes() []byte {
+	return sc.EncodedBytes(ad)
+}
+
+// AssetAccount is the balance of an account in an asset.
+type AssetAccount struct {
+	Balance  types.Balance
+	IsFrozen sc.Bool
+	Reason   ExistenceReason
+}
+
+func (aa AssetAccount) Encode(buffer *bytes.Buffer) {
+	aa.Balance.Encode(buffer)
+	aa.IsFrozen.Encode(buffer)
+	aa.Reason.Encode(buffer)
+}
+
+func DecodeAssetAccount(buffer *bytes.Buffer) AssetAccount {
+	return AssetAccount{
+		Balance:  sc.DecodeU128(buffer),
+		IsFrozen: sc.DecodeBool(buffer),
+		Reason:   sc.DecodeU8(buffer),
+	}
+}
+
+func (aa AssetAccount) Bytes() []byte {
+	return sc.EncodedBytes(aa)
+}
+
+// Approval is the amount of an asset, which a delegate may transfer from an owner, along with
+// the deposit, reserved from the owner for the approval.
+type Approval struct {
+	Amount  types.Balance
+	Deposit types.Balance
+}
+
+func (a Approval) Encode(buffer *bytes.Buffer) {
+	a.Amount.Encode(buffer)
+	a.Deposit.Encode(buffer)
+}
+
+func DecodeApproval(buffer *bytes.Buffer) Approval {
+	return Approval{
+		Amount:  sc.DecodeU128(buffer),
+		Deposit: sc.DecodeU128(buffer),
+	}
+}
+
+func (a Approval) Bytes() []byte {
+	return sc.EncodedBytes(a)
+}
+
+// AssetMetadata is the metadata of an asset, along with the deposit, reserved from the owner for it.
+type AssetMetadata struct {
+	Deposit  types.Balance
+	Name     sc.Sequence[sc.U8]
+	Symbol   sc.Sequence[sc.U8]
+	Decimals sc.U8
+}
+
+func (am AssetMetadata) Encode(buffer *bytes.Buffer) {
+	am.Deposit.Encode(buffer)
+	am.Name.Encode(buffer)
+	am.Symbol.Encode(buffer)
+	am.Decimals.Encode(buffer)
+}
+
+func DecodeAssetMetadata(buffer *bytes.Buffer) AssetMetadata {
+	return AssetMetadata{
+		Deposit:  sc.DecodeU128(buffer),
+		Name:     sc.DecodeSequence[sc.U8](buffer),
+		Symbol:   sc.DecodeSequence[sc.U8](buffer),
+		Decimals: sc.DecodeU8(buffer),
+	}
+}
+
+func (am AssetMetadata) Bytes() []byte {
+	return sc.EncodedBytes(am)
+}
@@ -5,11 +5,9 @@ import (
 	"reflect"
 
 	sc "github.com/LimeChain/goscale"
-	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/aura"
 	"github.com/LimeChain/gosemble/constants/timestamp"
 	"github.com/LimeChain/gosemble/frame/system"
-	"github.com/LimeChain/gosemble/primitives/hashing"
 	"github.com/LimeChain/gosemble/primitives/log"
 	"github.com/LimeChain/gosemble/primitives/storage"
 	"github.com/LimeChain/gosemble/primitives/types"
@@ -21,10 +19,7 @@ type Slot = sc.U64
 // Authorities returns current set of AuRa (Authority Round) authorities.
 // Returns a pointer-size of the SCALE-encoded set of authorities.
 func Authorities() int64 {
-	auraHash := hashing.Twox128(constants.KeyAura)
-	authoritiesHash := hashing.Twox128(constants.KeyAuthorities)
-
-	authorities := storage.Get(append(auraHash, authoritiesHash...))
+	authorities := storage.Get(storageAuthorities.Key())
 
 	if !authorities.HasValue {
 		return utils.BytesToOffsetAndSize([]byte{0})
@@ -40,12 +35,46 @@ func SlotDuration() int64 {
 	return utils.BytesToOffsetAndSize(slotDuration.Bytes())
 }
 
-func OnGenesisSession() {
-	// TODO: implement once Session module is added
+// Consensus log types, deposited by AuRa.
+const (
+	ConsensusLogAuthoritiesChange sc.U8 = iota + 1
+	ConsensusLogOnDisabled
+)
+
+// OnGenesisSession initializes the authorities with the keys of the genesis validators,
+// unless they are already set.
+func OnGenesisSession(validators []types.ValidatorKey) {
+	if len(validators) == 0 {
+		return
+	}
+
+	if len(storageGetAuthorities()) != 0 {
+		log.Critical("Authorities are already initialized!")
+	}
+
+	storageSetAuthorities(authoritiesFromValidators(validators))
+}
+
+// OnNewSession updates the authorities with the keys of the new session validators and
+// signals the change to the client, if the validator set or its keys have changed.
+func OnNewSession(changed bool, validators []types.ValidatorKey) {
+	if !changed {
+		return
+	}
+
+	next := authoritiesFromValidators(validators)
+	if reflect.DeepEqual(next, storageGetAuthorities()) {
+		return
+	}
+
+	storageSetAuthorities(next)
+
+	system.DepositLog(consensusLog(ConsensusLogAuthoritiesChange, next.Bytes()))
 }
 
-func OnNewSession() {
-	// TODO: implement once Session module is added
+// OnDisabled signals the client that the authority at `index` is disabled.
+func OnDisabled(index sc.U32) {
+	system.DepositLog(consensusLog(ConsensusLogOnDisabled, index.Bytes()))
 }
 
 func OnTimestampSet(now sc.U64) {
@@ -56,11 +85,7 @@ func OnTimestampSet(now sc.U64) {
 
 	timestampSlot := now / sc.U64(slotDuration)
 
-	auraHash := hashing.Twox128(constants.KeyAura)
-	currentSlotHash := hashing.Twox128(constants.KeyCurrentSlot)
-	currentSlot := storage.GetDecode(append(auraHash, currentSlotHash...), sc.DecodeU64)
-
-	if currentSlot != timestampSlot {
+	if storageGetCurrentSlot() != timestampSlot {
 		log.Critical("Timestamp slot must match `CurrentSlot`")
 	}
 }
@@ -68,16 +93,12 @@ func OnTimestampSet(now sc.U64) {
 func currentSlotFromDigests() sc.Option[Slot] {
 	digest := system.StorageGetDigest()
 
-	for keyDigest, dig := range digest {
-		if keyDigest == types.DigestTypePreRuntime {
-			for _, digestItem := range dig {
-				if reflect.DeepEqual(sc.FixedSequenceU8ToBytes(digestItem.Engine), aura.EngineId[:]) {
-					buffer := &bytes.Buffer{}
-					buffer.Write(sc.SequenceU8ToBytes(digestItem.Payload))
+	for _, digestItem := range digest.OfType(types.DigestTypePreRuntime) {
+		if reflect.DeepEqual(sc.FixedSequenceU8ToBytes(digestItem.Engine), aura.EngineId[:]) {
+			buffer := &bytes.Buffer{}
+			buffer.Write(sc.SequenceU8ToBytes(digestItem.Payload))
 
-					return sc.NewOption[Slot](sc.DecodeU64(buffer))
-				}
-			}
+			return sc.NewOption[Slot](sc.DecodeU64(buffer))
 		}
 	}
 
@@ -85,12 +106,9 @@ func currentSlotFromDigests() sc.Option[Slot] {
 }
 
 func totalAuthorities() sc.Option[sc.U64] {
-	auraHash := hashing.Twox128(constants.KeyAura)
-	authoritiesHash := hashing.Twox128(constants.KeyAuthorities)
-
 	// `Compact<u32>` is 5 bytes in maximum.
 	data := [5]byte{}
-	option := storage.Read(append(auraHash, authoritiesHash...), data[:], 0)
+	option := storage.Read(storageAuthorities.Key(), data[:], 0)
 
 	if !option.HasValue {
 		return sc.NewOption[sc.U64](nil)
@@ -114,3 +132,29 @@ func totalAuthorities() sc.Option[sc.U64] {
 func slotDuration() int {
 	return timestamp.MinimumPeriod * 2
 }
+
+func decodeAuthorities(buffer *bytes.Buffer) sc.Sequence[types.PublicKey] {
+	return sc.DecodeSequenceWith(buffer, types.DecodePublicKey)
+}
+
+// authoritiesFromValidators returns the keys of the validators, bounded by the maximum number of authorities.
+func authoritiesFromValidators(validators []types.ValidatorKey) sc.Sequence[types.PublicKey] {
+	if len(validators) > aura.MaxAuthorities {
+		log.Warn("next authorities list larger than the maximum number of authorities, truncating")
+		validators = validators[:aura.MaxAuthorities]
+	}
+
+	authorities := make(sc.Sequence[types.PublicKey], len(validators))
+	for i, validator := range validators {
+		authorities[i] = validator.Key
+	}
+
+	return authorities
+}
+
+func consensusLog(logType sc.U8, payload []byte) types.DigestItem {
+	return types.NewDigestItemConsensusMessage(
+		sc.BytesToFixedSequenceU8(aura.EngineId[:]),
+		sc.BytesToSequenceU8(append([]byte{byte(logType)}, payload...)),
+	)
+}
@@ -1,11 +1,12 @@
 package aura
 
 import (
+	"fmt"
+
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
-	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/frame/session"
 	"github.com/LimeChain/gosemble/primitives/log"
-	"github.com/LimeChain/gosemble/primitives/storage"
 	"github.com/LimeChain/gosemble/primitives/types"
 )
 
@@ -15,30 +16,18 @@ func OnInitialize() types.Weight {
 	if slot.HasValue {
 		newSlot := slot.Value
 
-		auraHash := hashing.Twox128(constants.KeyAura)
-		currentSlotHash := hashing.Twox128(constants.KeyCurrentSlot)
-
-		currentSlot := storage.GetDecode(append(auraHash, currentSlotHash...), sc.DecodeU64)
-
-		if currentSlot >= newSlot {
+		if storageGetCurrentSlot() >= newSlot {
 			log.Critical("Slot must increase")
 		}
 
-		storage.Set(append(auraHash, currentSlotHash...), newSlot.Bytes())
+		storageSetCurrentSlot(newSlot)
 
 		totalAuthorities := totalAuthorities()
-		if totalAuthorities.HasValue {
-			_ = currentSlot % totalAuthorities.Value
-
-			// TODO: implement once  Session module is added
-			/*
-				if T::DisabledValidators::is_disabled(authority_index as u32) {
-							panic!(
-								"Validator with index {:?} is disabled and should not be attempting to author blocks.",
-								authority_index,
-							);
-						}
-			*/
+		if totalAuthorities.HasValue && totalAuthorities.Value != 0 {
+			authorityIndex := newSlot % totalAuthorities.Value
+			if session.IsDisabled(sc.U32(authorityIndex)) {
+				log.Critical(fmt.Sprintf("Validator with index [%d] is disabled and should not be attempting to author blocks.", authorityIndex))
+			}
 		}
 
 		return constants.DbWeight.ReadsWrites(2, 1)
@@ -2,12 +2,15 @@ package module
 
 import (
 	sc "github.com/LimeChain/goscale"
-	"github.com/LimeChain/gosemble/constants/aura"
+	ca "github.com/LimeChain/gosemble/constants/aura"
 	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/aura"
+	"github.com/LimeChain/gosemble/frame/support"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
 type AuraModule struct {
+	primitives.DefaultHooks
 }
 
 func NewAuraModule() AuraModule {
@@ -26,29 +29,45 @@ func (am AuraModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitiv
 	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
 }
 
+func (am AuraModule) OnInitialize(_ primitives.BlockNumber) primitives.Weight {
+	return aura.OnInitialize()
+}
+
+func (am AuraModule) OnTimestampSet(now sc.U64) {
+	aura.OnTimestampSet(now)
+}
+
+func (am AuraModule) KeyTypeId() [4]byte {
+	return ca.KeyTypeId
+}
+
+func (am AuraModule) OnGenesisSession(validators []primitives.ValidatorKey) {
+	aura.OnGenesisSession(validators)
+}
+
+func (am AuraModule) OnNewSession(changed bool, validators []primitives.ValidatorKey, _ []primitives.ValidatorKey) {
+	aura.OnNewSession(changed, validators)
+}
+
+func (am AuraModule) OnBeforeSessionEnding() {}
+
+func (am AuraModule) OnDisabled(validatorIndex sc.U32) {
+	aura.OnDisabled(validatorIndex)
+}
+
 func (am AuraModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
-	return am.metadataTypes(), primitives.MetadataModule{
-		Name: "Aura",
-		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
-			Prefix: "Aura",
-			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
-				primitives.NewMetadataModuleStorageEntry(
-					"Authorities",
-					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesAuraStorageAuthorities)),
-					"The current authority set."),
-				primitives.NewMetadataModuleStorageEntry(
-					"CurrentSlot",
-					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesAuraSlot)),
-					"The current slot of this block.   This will be set in `on_initialize`."),
-			},
-		}),
-		Call:      sc.NewOption[sc.Compact](nil),
-		Event:     sc.NewOption[sc.Compact](nil),
-		Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
-		Error:     sc.NewOption[sc.Compact](nil),
-		Index:     aura.ModuleIndex,
+	declaredTypes, metadataModule := am.declaration().Metadata()
+
+	return append(am.metadataTypes(), declaredTypes...), metadataModule
+}
+
+// declaration declares the storage of the module, from which its metadata is derived.
+func (am AuraModule) declaration() support.ModuleDeclaration {
+	return support.ModuleDeclaration{
+		Name:    "Aura",
+		Index:   ca.ModuleIndex,
+		Path:    "pallet_aura",
+		Storage: aura.StorageDeclarations(),
 	}
 }
 
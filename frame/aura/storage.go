@@ -0,0 +1,48 @@
+package aura
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	storageAuthorities = support.NewStorageValue[sc.Sequence[types.PublicKey]](constants.KeyAura, constants.KeyAuthorities, decodeAuthorities)
+	storageCurrentSlot = support.NewStorageValue[Slot](constants.KeyAura, constants.KeyCurrentSlot, sc.DecodeU64)
+)
+
+// StorageDeclarations returns the declarations of the storage items of the module, in the order of the metadata.
+func StorageDeclarations() []support.StorageDeclaration {
+	return []support.StorageDeclaration{
+		{
+			Item:      storageAuthorities,
+			Modifier:  types.MetadataModuleStorageEntryModifierDefault,
+			ValueType: support.TypeId(metadata.TypesAuraStorageAuthorities),
+			Docs:      "The current authority set.",
+		},
+		{
+			Item:      storageCurrentSlot,
+			Modifier:  types.MetadataModuleStorageEntryModifierDefault,
+			ValueType: support.TypeId(metadata.TypesAuraSlot),
+			Docs:      "The current slot of this block.   This will be set in `on_initialize`.",
+		},
+	}
+}
+
+func storageGetAuthorities() sc.Sequence[types.PublicKey] {
+	return storageAuthorities.Get()
+}
+
+func storageSetAuthorities(authorities sc.Sequence[types.PublicKey]) {
+	storageAuthorities.Put(authorities)
+}
+
+func storageGetCurrentSlot() Slot {
+	return storageCurrentSlot.Get()
+}
+
+func storageSetCurrentSlot(slot Slot) {
+	storageCurrentSlot.Put(slot)
+}
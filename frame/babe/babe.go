@@ -0,0 +1,532 @@
+package babe
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/babe"
+	"github.com/LimeChain/gosemble/frame/babe/errors"
+	"github.com/LimeChain/gosemble/frame/session"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/utils"
+)
+
+// Consensus log types, deposited by BABE.
+const (
+	ConsensusLogNextEpochData sc.U8 = iota + 1
+	ConsensusLogOnDisabled
+	ConsensusLogNextConfigData
+)
+
+// randomnessVrfContext is the context of the VRF output, from which the randomness of the author is derived.
+var randomnessVrfContext = []byte("BabeVRFInOutContext")
+
+// Configuration returns the configuration of BABE for the client.
+// Returns a pointer-size of the SCALE-encoded configuration.
+func Configuration() int64 {
+	epochConfig := currentEpochConfig()
+
+	configuration := BabeConfiguration{
+		SlotDuration: babe.ExpectedBlockTime,
+		EpochLength:  babe.EpochDuration,
+		C:            epochConfig.C,
+		Authorities:  StorageGetAuthorities(),
+		Randomness:   StorageGetRandomness(),
+		AllowedSlots: epochConfig.AllowedSlots,
+	}
+
+	return utils.BytesToOffsetAndSize(configuration.Bytes())
+}
+
+// CurrentEpochStart returns the slot, in which the current epoch started.
+// Returns a pointer-size of the SCALE-encoded slot.
+func CurrentEpochStart() int64 {
+	return utils.BytesToOffsetAndSize(currentEpochStart().Bytes())
+}
+
+// CurrentEpoch returns the description of the current epoch.
+// Returns a pointer-size of the SCALE-encoded epoch.
+func CurrentEpoch() int64 {
+	epochIndex := StorageGetEpochIndex()
+
+	epoch := Epoch{
+		EpochIndex:  epochIndex,
+		StartSlot:   epochStart(epochIndex),
+		Duration:    babe.EpochDuration,
+		Authorities: StorageGetAuthorities(),
+		Randomness:  StorageGetRandomness(),
+		Config:      currentEpochConfig(),
+	}
+
+	return utils.BytesToOffsetAndSize(epoch.Bytes())
+}
+
+// NextEpoch returns the description of the next epoch. The information, contained in it,
+// may change, until the epoch starts.
+// Returns a pointer-size of the SCALE-encoded epoch.
+func NextEpoch() int64 {
+	nextEpochIndex := StorageGetEpochIndex() + 1
+
+	config := currentEpochConfig()
+	nextConfig := StorageGetNextEpochConfig()
+	if nextConfig.HasValue {
+		config = nextConfig.Value
+	}
+
+	epoch := Epoch{
+		EpochIndex:  nextEpochIndex,
+		StartSlot:   epochStart(nextEpochIndex),
+		Duration:    babe.EpochDuration,
+		Authorities: StorageGetNextAuthorities(),
+		Randomness:  StorageGetNextRandomness(),
+		Config:      config,
+	}
+
+	return utils.BytesToOffsetAndSize(epoch.Bytes())
+}
+
+// SubmitReportEquivocationUnsignedExtrinsic submits an unsigned extrinsic, which reports an equivocation.
+// It takes two arguments:
+// - dataPtr: Pointer to the data in the Wasm memory.
+// - dataLen: Length of the data.
+// which represent the SCALE-encoded equivocation proof and opaque key ownership proof.
+// Returns a pointer-size of the SCALE-encoded optional empty tuple, which is none, if the
+// extrinsic could not be submitted.
+func SubmitReportEquivocationUnsignedExtrinsic(dataPtr int32, dataLen int32) int64 {
+	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
+	buffer := bytes.NewBuffer(b)
+
+	equivocationProof := DecodeEquivocationProof(buffer)
+	opaqueKeyOwnerProof := sc.DecodeSequence[sc.U8](buffer)
+	keyOwnerProof := session.DecodeMembershipProof(bytes.NewBuffer(sc.SequenceU8ToBytes(opaqueKeyOwnerProof)))
+
+	if !submitUnsignedEquivocationReport(equivocationProof, keyOwnerProof) {
+		return utils.BytesToOffsetAndSize(sc.NewOption[sc.Empty](nil).Bytes())
+	}
+
+	return utils.BytesToOffsetAndSize(sc.NewOption[sc.Empty](sc.Empty{}).Bytes())
+}
+
+// GenerateKeyOwnershipProof generates a proof of the membership of the authority in the validator set.
+// It takes two arguments:
+// - dataPtr: Pointer to the data in the Wasm memory.
+// - dataLen: Length of the data.
+// which represent the SCALE-encoded slot and authority id.
+// Returns a pointer-size of the SCALE-encoded optional opaque key ownership proof, which is none,
+// if the authority is not a member of the current validator set.
+func GenerateKeyOwnershipProof(dataPtr int32, dataLen int32) int64 {
+	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
+	buffer := bytes.NewBuffer(b)
+
+	// The slot is not needed, since only proofs of the current session can be generated.
+	_ = sc.DecodeU64(buffer)
+	authorityId := types.DecodePublicKey(buffer)
+
+	proof := session.ProveKeyOwnership(babe.KeyTypeId, authorityId)
+	if !proof.HasValue {
+		return utils.BytesToOffsetAndSize(sc.NewOption[sc.Sequence[sc.U8]](nil).Bytes())
+	}
+
+	opaqueProof := sc.BytesToSequenceU8(proof.Value.Bytes())
+	return utils.BytesToOffsetAndSize(sc.NewOption[sc.Sequence[sc.U8]](opaqueProof).Bytes())
+}
+
+// OnInitialize records the slot of the block, claimed by the author in the pre-runtime digest.
+// The first block of the chain sets the genesis slot and signals the data of the first epoch.
+func OnInitialize(_ types.BlockNumber) types.Weight {
+	if storageInitialized.Exists() {
+		return constants.DbWeight.Reads(1)
+	}
+
+	preDigest := preDigestFromDigests()
+
+	if preDigest.HasValue {
+		currentSlot := preDigest.Value.Slot
+
+		if StorageGetGenesisSlot() == 0 {
+			storageSetGenesisSlot(currentSlot)
+
+			// The first block is in epoch 0, which uses the authorities and randomness of the genesis.
+			next := NextEpochDescriptor{
+				Authorities: StorageGetAuthorities(),
+				Randomness:  StorageGetRandomness(),
+			}
+			system.DepositLog(consensusLog(ConsensusLogNextEpochData, next.Bytes()))
+		}
+
+		lateness := sc.U64(0)
+		if previousSlot := StorageGetCurrentSlot(); currentSlot > previousSlot+1 {
+			lateness = currentSlot - (previousSlot + 1)
+		}
+		storageSetLateness(types.BlockNumber(lateness))
+		storageSetCurrentSlot(currentSlot)
+
+		authorityIndex := preDigest.Value.AuthorityIndex
+		if session.IsDisabled(authorityIndex) {
+			log.Critical(fmt.Sprintf("Validator with index [%d] is disabled and should not be attempting to author blocks.", authorityIndex))
+		}
+	}
+
+	storageClearAuthorVrfRandomness()
+	storageSetInitialized(preDigest)
+
+	return constants.DbWeight.ReadsWrites(4, 5)
+}
+
+// OnFinalize collects the randomness of the block author. The randomness of primary slot claims
+// is deposited into the randomness of the next epochs.
+func OnFinalize(_ types.BlockNumber) {
+	initialized := storageGetInitialized()
+	storageClearInitialized()
+
+	if initialized.HasValue && initialized.Value.HasValue {
+		preDigest := initialized.Value.Value
+
+		authorityIndex := preDigest.AuthorityIndex
+		if session.IsDisabled(authorityIndex) {
+			log.Critical(fmt.Sprintf("Validator with index [%d] is disabled and should not be attempting to author blocks.", authorityIndex))
+		}
+
+		randomness := authorVrfRandomness(preDigest)
+		if randomness.HasValue {
+			if preDigest.Kind == PreDigestPrimary {
+				depositRandomness(randomness.Value)
+			}
+
+			storageSetAuthorVrfRandomness(randomness.Value)
+		}
+	}
+
+	storageClearLateness()
+}
+
+// OnTimestampSet checks that the slot of the timestamp is the slot, claimed by the block author.
+func OnTimestampSet(now sc.U64) {
+	if babe.ExpectedBlockTime == 0 {
+		log.Critical("BABE slot duration cannot be zero.")
+	}
+
+	timestampSlot := now / babe.ExpectedBlockTime
+
+	if StorageGetCurrentSlot() != timestampSlot {
+		log.Critical("Timestamp slot must match `CurrentSlot`")
+	}
+}
+
+// ShouldEpochChange returns true, if the current epoch has ended by block `now`.
+// The genesis epoch starts with the slot of block 1, so the epoch never changes in block 1.
+func ShouldEpochChange(now types.BlockNumber) bool {
+	if now == 1 {
+		return false
+	}
+
+	currentSlot := StorageGetCurrentSlot()
+	start := currentEpochStart()
+
+	return currentSlot >= start && currentSlot-start >= babe.EpochDuration
+}
+
+// OnGenesisSession initializes the current and next authorities with the keys of the genesis validators.
+func OnGenesisSession(validators []types.ValidatorKey) {
+	if len(validators) == 0 {
+		return
+	}
+
+	if len(StorageGetAuthorities()) != 0 {
+		log.Critical("Authorities are already initialized!")
+	}
+
+	authorities := authoritiesFromValidators(validators)
+	storageSetAuthorities(authorities)
+	storageSetNextAuthorities(authorities)
+}
+
+// OnNewSession enacts the change of the epoch, which starts together with the new session.
+func OnNewSession(validators []types.ValidatorKey, queuedValidators []types.ValidatorKey) {
+	enactEpochChange(authoritiesFromValidators(validators), authoritiesFromValidators(queuedValidators), sc.NewOption[sc.U32](session.StorageGetCurrentIndex()))
+}
+
+// OnDisabled signals the client that the authority at `index` is disabled.
+func OnDisabled(index sc.U32) {
+	system.DepositLog(consensusLog(ConsensusLogOnDisabled, index.Bytes()))
+}
+
+// PlanConfigChange plans a change of the epoch configuration, which is signalled in the next
+// epoch change and enacted in the epoch after it. Multiple changes, planned in the same epoch,
+// override each other.
+func PlanConfigChange(config NextConfigDescriptor) types.DispatchError {
+	if !isValidConfiguration(config.EpochConfiguration) {
+		return newModuleError(errors.ErrorInvalidConfiguration)
+	}
+
+	storageSetPendingEpochConfigChange(config)
+
+	return nil
+}
+
+// isValidConfiguration returns true, if slots can be claimed with the configuration.
+// Without secondary slots, the probability of primary slots must not be zero.
+func isValidConfiguration(config EpochConfiguration) bool {
+	return (config.C[0] != 0 || config.AllowedSlots != babe.AllowedSlotsPrimary) && config.C[1] != 0
+}
+
+// enactEpochChange starts the epoch, in which the current slot is, with `authorities`, and signals
+// the next epoch with `nextAuthorities` to the client. The epoch index is derived from the slot, so
+// epochs, which passed without blocks, are skipped, and the skipped epochs are mapped to `sessionIndex`.
+func enactEpochChange(authorities sc.Sequence[types.Authority], nextAuthorities sc.Sequence[types.Authority], sessionIndex sc.Option[sc.U32]) {
+	if len(authorities) == 0 {
+		log.Warn("Ignoring empty epoch change.")
+		return
+	}
+
+	epochIndex := epochIndexForSlot(StorageGetCurrentSlot())
+
+	if StorageGetEpochIndex()+1 != epochIndex && sessionIndex.HasValue {
+		if epochIndex < sc.U64(sessionIndex.Value) {
+			log.Warn(fmt.Sprintf("Current epoch index [%d] is lower than session index [%d].", epochIndex, sessionIndex.Value))
+		} else {
+			skippedEpochs := StorageGetSkippedEpochs()
+			if len(skippedEpochs) >= babe.MaxSkippedEpochs {
+				skippedEpochs = skippedEpochs[1:]
+			}
+
+			skippedEpochs = append(skippedEpochs, SkippedEpoch{EpochIndex: epochIndex, SessionIndex: sessionIndex.Value})
+			storageSetSkippedEpochs(skippedEpochs)
+		}
+	}
+
+	storageSetEpochIndex(epochIndex)
+	storageSetAuthorities(authorities)
+	storageSetRandomness(randomnessChangeEpoch(epochIndex + 1))
+	storageSetNextAuthorities(nextAuthorities)
+
+	epochStart := StorageGetEpochStart()
+	storageSetEpochStart(EpochStart{
+		Previous: epochStart.Current,
+		Current:  system.StorageGetBlockNumber(),
+	})
+
+	// The next epoch is signalled as soon as the current one starts, so the client can track the changes.
+	next := NextEpochDescriptor{
+		Authorities: nextAuthorities,
+		Randomness:  StorageGetNextRandomness(),
+	}
+	system.DepositLog(consensusLog(ConsensusLogNextEpochData, next.Bytes()))
+
+	nextConfig := StorageGetNextEpochConfig()
+	if nextConfig.HasValue {
+		storageSetEpochConfig(nextConfig.Value)
+	}
+
+	pendingConfig := StorageGetPendingEpochConfigChange()
+	if pendingConfig.HasValue {
+		storageClearPendingEpochConfigChange()
+		storageSetNextEpochConfig(pendingConfig.Value.EpochConfiguration)
+
+		system.DepositLog(consensusLog(ConsensusLogNextConfigData, pendingConfig.Value.Bytes()))
+	}
+}
+
+// randomnessChangeEpoch returns the randomness of the starting epoch and computes the randomness
+// of the epoch after it from the randomness, collected during the previous epoch.
+func randomnessChangeEpoch(nextEpochIndex sc.U64) Randomness {
+	randomness := StorageGetNextRandomness()
+
+	segmentIndex := storageGetSegmentIndex()
+	storageSetSegmentIndex(0)
+
+	rho := sc.Sequence[Randomness]{}
+	for i := sc.U32(0); i <= segmentIndex; i++ {
+		rho = append(rho, storageTakeUnderConstruction(i)...)
+	}
+
+	storageSetNextRandomness(computeRandomness(randomness, nextEpochIndex, rho))
+
+	return randomness
+}
+
+// computeRandomness computes the randomness of an epoch from the randomness of the last epoch
+// and the VRF outputs, collected during it.
+func computeRandomness(lastEpochRandomness Randomness, epochIndex sc.U64, rho sc.Sequence[Randomness]) Randomness {
+	buffer := &bytes.Buffer{}
+	buffer.Write(sc.FixedSequenceU8ToBytes(lastEpochRandomness))
+	buffer.Write(epochIndex.Bytes())
+	for _, vrfOutput := range rho {
+		buffer.Write(sc.FixedSequenceU8ToBytes(vrfOutput))
+	}
+
+	return sc.BytesToFixedSequenceU8(hashing.Blake256(buffer.Bytes()))
+}
+
+// depositRandomness deposits the randomness of a primary slot claim into the segment, which is under construction.
+func depositRandomness(randomness Randomness) {
+	segmentIndex := storageGetSegmentIndex()
+
+	segment := storageGetUnderConstruction(segmentIndex)
+	if sc.U32(len(segment)) < babe.UnderConstructionSegmentLength {
+		storageSetUnderConstruction(segmentIndex, append(segment, randomness))
+		return
+	}
+
+	segmentIndex += 1
+	storageSetUnderConstruction(segmentIndex, sc.Sequence[Randomness]{randomness})
+	storageSetSegmentIndex(segmentIndex)
+}
+
+// authorVrfRandomness returns the randomness of the VRF output of the block author. The VRF signature
+// is verified by the client on block import, since the host does not expose schnorrkel VRFs.
+func authorVrfRandomness(preDigest PreDigest) sc.Option[Randomness] {
+	if !preDigest.HasVrfSignature() {
+		return sc.NewOption[Randomness](nil)
+	}
+
+	authorities := StorageGetAuthorities()
+	if preDigest.AuthorityIndex >= sc.U32(len(authorities)) {
+		return sc.NewOption[Randomness](nil)
+	}
+
+	transcript := vrfTranscript(StorageGetRandomness(), preDigest.Slot, StorageGetEpochIndex())
+
+	return sc.NewOption[Randomness](makeVrfBytes(randomnessVrfContext, transcript, authorities[preDigest.AuthorityIndex].Id, preDigest.VrfSignature.PreOutput))
+}
+
+// vrfTranscript returns the input of the VRF of a slot claim, which contains the randomness,
+// the slot and the index of the epoch, in which the slot is claimed.
+func vrfTranscript(randomness Randomness, slot sc.U64, epochIndex sc.U64) []byte {
+	buffer := &bytes.Buffer{}
+	buffer.Write([]byte("BABE"))
+	buffer.Write(slot.Bytes())
+	buffer.Write(epochIndex.Bytes())
+	buffer.Write(sc.FixedSequenceU8ToBytes(randomness))
+
+	return buffer.Bytes()
+}
+
+// makeVrfBytes returns the blake2-256 hash of the context, the hash of the public key and the
+// transcript, and the pre-output. It is not the schnorrkel `make_bytes` output of the VRF.
+func makeVrfBytes(context []byte, transcript []byte, publicKey sc.FixedSequence[sc.U8], preOutput sc.FixedSequence[sc.U8]) Randomness {
+	buffer := &bytes.Buffer{}
+	buffer.Write([]byte("VRFResult"))
+	buffer.Write(context)
+	buffer.Write(hashing.Blake256(append(sc.FixedSequenceU8ToBytes(publicKey), transcript...)))
+	buffer.Write(sc.FixedSequenceU8ToBytes(preOutput))
+
+	return sc.BytesToFixedSequenceU8(hashing.Blake256(buffer.Bytes()))
+}
+
+// sessionIndexForEpoch returns the session, in which the epoch started. Without skipped epochs,
+// each session has a single epoch.
+func sessionIndexForEpoch(epochIndex sc.U64) sc.U32 {
+	skippedEpochs := StorageGetSkippedEpochs()
+
+	index := sort.Search(len(skippedEpochs), func(i int) bool {
+		return skippedEpochs[i].EpochIndex >= epochIndex
+	})
+
+	if index < len(skippedEpochs) && skippedEpochs[index].EpochIndex == epochIndex {
+		return skippedEpochs[index].SessionIndex
+	}
+
+	if index == 0 {
+		return sc.U32(epochIndex)
+	}
+
+	closest := skippedEpochs[index-1]
+	skipped := closest.EpochIndex - sc.U64(closest.SessionIndex)
+	if epochIndex < skipped {
+		return 0
+	}
+
+	return sc.U32(epochIndex - skipped)
+}
+
+// epochIndexForSlot returns the index of the epoch, in which the slot is.
+func epochIndexForSlot(slot Slot) sc.U64 {
+	genesisSlot := StorageGetGenesisSlot()
+	if slot < genesisSlot {
+		return 0
+	}
+
+	return (slot - genesisSlot) / babe.EpochDuration
+}
+
+// epochStart returns the first slot of the epoch.
+func epochStart(epochIndex sc.U64) Slot {
+	return epochIndex*babe.EpochDuration + StorageGetGenesisSlot()
+}
+
+func currentEpochStart() Slot {
+	return epochStart(StorageGetEpochIndex())
+}
+
+// currentEpochConfig returns the configuration of the current epoch. Until a configuration is enacted,
+// the epochs use the genesis configuration.
+func currentEpochConfig() EpochConfiguration {
+	config := StorageGetEpochConfig()
+	if !config.HasValue {
+		return EpochConfiguration{
+			C:            babe.PrimaryProbability,
+			AllowedSlots: babe.GenesisAllowedSlots,
+		}
+	}
+
+	return config.Value
+}
+
+// preDigestFromDigests returns the slot claim of the block author from the pre-runtime digest.
+func preDigestFromDigests() sc.Option[PreDigest] {
+	return preDigestFromDigest(system.StorageGetDigest())
+}
+
+func preDigestFromDigest(digest types.Digest) sc.Option[PreDigest] {
+	for _, digestItem := range digest.OfType(types.DigestTypePreRuntime) {
+		if reflect.DeepEqual(sc.FixedSequenceU8ToBytes(digestItem.Engine), babe.EngineId[:]) {
+			buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(digestItem.Payload))
+
+			return sc.NewOption[PreDigest](DecodePreDigest(buffer))
+		}
+	}
+
+	return sc.NewOption[PreDigest](nil)
+}
+
+// authoritiesFromValidators returns the keys of the validators as equally weighted authorities,
+// bounded by the maximum number of authorities.
+func authoritiesFromValidators(validators []types.ValidatorKey) sc.Sequence[types.Authority] {
+	if len(validators) > int(babe.MaxAuthorities) {
+		log.Warn("next authorities list larger than the maximum number of authorities, truncating")
+		validators = validators[:babe.MaxAuthorities]
+	}
+
+	authorities := make(sc.Sequence[types.Authority], len(validators))
+	for i, validator := range validators {
+		authorities[i] = types.Authority{
+			Id:     validator.Key,
+			Weight: 1,
+		}
+	}
+
+	return authorities
+}
+
+func consensusLog(logType sc.U8, payload []byte) types.DigestItem {
+	return types.NewDigestItemConsensusMessage(
+		sc.BytesToFixedSequenceU8(babe.EngineId[:]),
+		sc.BytesToSequenceU8(append([]byte{byte(logType)}, payload...)),
+	)
+}
+
+func newModuleError(err sc.U8) types.DispatchError {
+	return types.NewDispatchErrorModule(types.CustomModuleError{
+		Index:   babe.ModuleIndex,
+		Error:   sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
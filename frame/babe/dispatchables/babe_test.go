@@ -0,0 +1,408 @@
+//go:build nonwasmenv
+
+package dispatchables
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	cb "github.com/LimeChain/gosemble/constants/babe"
+	"github.com/LimeChain/gosemble/frame/babe"
+	"github.com/LimeChain/gosemble/frame/babe/errors"
+	"github.com/LimeChain/gosemble/frame/session"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/frame/testutils"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	alice = testutils.NewAddress(1)
+	bob   = testutils.NewAddress(2)
+)
+
+// setup starts the genesis session with alice and bob as validators and returns their babe keys.
+func setup() (types.PublicKey, types.PublicKey) {
+	handler := testutils.NewSessionHandler(cb.KeyTypeId)
+	handler.Genesis = babe.OnGenesisSession
+	handler.NewSession = func(_ bool, validators []types.ValidatorKey, queuedValidators []types.ValidatorKey) {
+		babe.OnNewSession(validators, queuedValidators)
+	}
+	handler.Disabled = babe.OnDisabled
+
+	keys := testutils.SetupValidators(handler, host.SchemeSr25519, alice, bob)
+
+	return keys[0], keys[1]
+}
+
+func preRuntimeDigest(preDigest babe.PreDigest) types.Digest {
+	return types.Digest{
+		Logs: sc.Sequence[types.DigestItem]{
+			types.NewDigestItemPreRuntime(sc.BytesToFixedSequenceU8(cb.EngineId[:]), sc.BytesToSequenceU8(preDigest.Bytes())),
+		},
+	}
+}
+
+// sealedHeader returns a header with the given number, which claims the slot for the authority at index 0
+// and is sealed by `key`.
+func sealedHeader(key types.PublicKey, number types.BlockNumber, slot babe.Slot) types.Header {
+	return sealedHeaderOfAuthority(key, 0, number, slot)
+}
+
+// sealedHeaderOfAuthority returns a header with the given number, which claims the slot for the authority
+// at `authorityIndex` and is sealed by `key`.
+func sealedHeaderOfAuthority(key types.PublicKey, authorityIndex sc.U32, number types.BlockNumber, slot babe.Slot) types.Header {
+	header := types.Header{
+		ParentHash:     types.NewBlake2bHash(make([]sc.U8, 32)...),
+		Number:         number,
+		StateRoot:      types.NewH256(make([]sc.U8, 32)...),
+		ExtrinsicsRoot: types.NewH256(make([]sc.U8, 32)...),
+		Digest:         preRuntimeDigest(babe.PreDigest{Kind: babe.PreDigestSecondaryPlain, AuthorityIndex: authorityIndex, Slot: slot}),
+	}
+
+	preHash := hashing.Blake256(header.Bytes())
+	signature, _ := host.DefaultKeystore().Sign(host.SchemeSr25519, cb.KeyTypeId[:], sc.FixedSequenceU8ToBytes(key), preHash)
+
+	header.Digest.Push(types.NewDigestItemSeal(sc.BytesToFixedSequenceU8(cb.EngineId[:]), sc.BytesToSequenceU8(signature)))
+
+	return header
+}
+
+func newEquivocationProof(key types.PublicKey, slot babe.Slot, first, second types.Header) babe.EquivocationProof {
+	return babe.EquivocationProof{
+		Offender:     key,
+		Slot:         slot,
+		FirstHeader:  first,
+		SecondHeader: second,
+	}
+}
+
+func Test_Babe(t *testing.T) {
+	validConfig := babe.NextConfigDescriptor{EpochConfiguration: babe.EpochConfiguration{C: [2]sc.U64{1, 2}, AllowedSlots: cb.AllowedSlotsPrimary}}
+
+	var testExamples = []struct {
+		label       string
+		call        types.Call
+		origin      types.RuntimeOrigin
+		args        func(key types.PublicKey) sc.VaryingData
+		expectation types.DispatchError
+		disabled    sc.Sequence[sc.U32]
+		paysFee     sc.U8
+	}{
+		{
+			label:  "plan_config_change(BadOrigin)",
+			call:   NewPlanConfigChangeCall(nil),
+			origin: types.NewRawOriginSigned(alice),
+			args: func(_ types.PublicKey) sc.VaryingData {
+				return sc.NewVaryingData(validConfig)
+			},
+			expectation: types.NewDispatchErrorBadOrigin(),
+		},
+		{
+			label:  "plan_config_change(InvalidConfiguration)",
+			call:   NewPlanConfigChangeCall(nil),
+			origin: types.NewRawOriginRoot(),
+			args: func(_ types.PublicKey) sc.VaryingData {
+				config := babe.NextConfigDescriptor{EpochConfiguration: babe.EpochConfiguration{C: [2]sc.U64{0, 2}, AllowedSlots: cb.AllowedSlotsPrimary}}
+				return sc.NewVaryingData(config)
+			},
+			expectation: testutils.NewModuleError(cb.ModuleIndex, errors.ErrorInvalidConfiguration),
+		},
+		{
+			label:  "plan_config_change(Ok)",
+			call:   NewPlanConfigChangeCall(nil),
+			origin: types.NewRawOriginRoot(),
+			args: func(_ types.PublicKey) sc.VaryingData {
+				return sc.NewVaryingData(validConfig)
+			},
+			paysFee: types.PaysYes,
+		},
+		{
+			label:  "report_equivocation(BadOrigin)",
+			call:   NewReportEquivocationCall(nil),
+			origin: types.NewRawOriginNone(),
+			args: func(key types.PublicKey) sc.VaryingData {
+				proof := newEquivocationProof(key, 5, sealedHeader(key, 1, 5), sealedHeader(key, 2, 5))
+				return sc.NewVaryingData(proof, session.ProveKeyOwnership(cb.KeyTypeId, key).Value)
+			},
+			expectation: types.NewDispatchErrorBadOrigin(),
+		},
+		{
+			label:  "report_equivocation_unsigned(BadOrigin)",
+			call:   NewReportEquivocationUnsignedCall(nil),
+			origin: types.NewRawOriginSigned(bob),
+			args: func(key types.PublicKey) sc.VaryingData {
+				proof := newEquivocationProof(key, 5, sealedHeader(key, 1, 5), sealedHeader(key, 2, 5))
+				return sc.NewVaryingData(proof, session.ProveKeyOwnership(cb.KeyTypeId, key).Value)
+			},
+			expectation: types.NewDispatchErrorBadOrigin(),
+		},
+		{
+			label:  "report_equivocation(InvalidEquivocationProof) same headers",
+			call:   NewReportEquivocationCall(nil),
+			origin: types.NewRawOriginSigned(bob),
+			args: func(key types.PublicKey) sc.VaryingData {
+				header := sealedHeader(key, 1, 5)
+				proof := newEquivocationProof(key, 5, header, header)
+				return sc.NewVaryingData(proof, session.ProveKeyOwnership(cb.KeyTypeId, key).Value)
+			},
+			expectation: testutils.NewModuleError(cb.ModuleIndex, errors.ErrorInvalidEquivocationProof),
+		},
+		{
+			label:  "report_equivocation(InvalidEquivocationProof) different slots",
+			call:   NewReportEquivocationCall(nil),
+			origin: types.NewRawOriginSigned(bob),
+			args: func(key types.PublicKey) sc.VaryingData {
+				proof := newEquivocationProof(key, 5, sealedHeader(key, 1, 5), sealedHeader(key, 2, 6))
+				return sc.NewVaryingData(proof, session.ProveKeyOwnership(cb.KeyTypeId, key).Value)
+			},
+			expectation: testutils.NewModuleError(cb.ModuleIndex, errors.ErrorInvalidEquivocationProof),
+		},
+		{
+			label:  "report_equivocation(InvalidEquivocationProof) different authorities",
+			call:   NewReportEquivocationCall(nil),
+			origin: types.NewRawOriginSigned(bob),
+			args: func(key types.PublicKey) sc.VaryingData {
+				proof := newEquivocationProof(key, 5, sealedHeaderOfAuthority(key, 0, 1, 5), sealedHeaderOfAuthority(key, 1, 2, 5))
+				return sc.NewVaryingData(proof, session.ProveKeyOwnership(cb.KeyTypeId, key).Value)
+			},
+			expectation: testutils.NewModuleError(cb.ModuleIndex, errors.ErrorInvalidEquivocationProof),
+		},
+		{
+			label:  "report_equivocation(InvalidEquivocationProof) sealed by another authority",
+			call:   NewReportEquivocationCall(nil),
+			origin: types.NewRawOriginSigned(bob),
+			args: func(key types.PublicKey) sc.VaryingData {
+				// The headers are sealed by bob, but the proof accuses alice.
+				bobKey := babe.StorageGetAuthorities()[1].Id
+				proof := newEquivocationProof(key, 5, sealedHeader(bobKey, 1, 5), sealedHeader(bobKey, 2, 5))
+				return sc.NewVaryingData(proof, session.ProveKeyOwnership(cb.KeyTypeId, key).Value)
+			},
+			expectation: testutils.NewModuleError(cb.ModuleIndex, errors.ErrorInvalidEquivocationProof),
+		},
+		{
+			label:  "report_equivocation(InvalidKeyOwnershipProof)",
+			call:   NewReportEquivocationCall(nil),
+			origin: types.NewRawOriginSigned(bob),
+			args: func(key types.PublicKey) sc.VaryingData {
+				// The slot is in the second epoch, which is not in the session of the proof.
+				slot := cb.EpochDuration + 5
+				proof := newEquivocationProof(key, slot, sealedHeader(key, 1, slot), sealedHeader(key, 2, slot))
+				return sc.NewVaryingData(proof, session.ProveKeyOwnership(cb.KeyTypeId, key).Value)
+			},
+			expectation: testutils.NewModuleError(cb.ModuleIndex, errors.ErrorInvalidKeyOwnershipProof),
+		},
+		{
+			label:  "report_equivocation(Ok)",
+			call:   NewReportEquivocationCall(nil),
+			origin: types.NewRawOriginSigned(bob),
+			args: func(key types.PublicKey) sc.VaryingData {
+				proof := newEquivocationProof(key, 5, sealedHeader(key, 1, 5), sealedHeader(key, 2, 5))
+				return sc.NewVaryingData(proof, session.ProveKeyOwnership(cb.KeyTypeId, key).Value)
+			},
+			disabled: sc.Sequence[sc.U32]{0},
+			paysFee:  types.PaysNo,
+		},
+		{
+			label:  "report_equivocation_unsigned(Ok)",
+			call:   NewReportEquivocationUnsignedCall(nil),
+			origin: types.NewRawOriginNone(),
+			args: func(key types.PublicKey) sc.VaryingData {
+				proof := newEquivocationProof(key, 5, sealedHeader(key, 1, 5), sealedHeader(key, 2, 5))
+				return sc.NewVaryingData(proof, session.ProveKeyOwnership(cb.KeyTypeId, key).Value)
+			},
+			disabled: sc.Sequence[sc.U32]{0},
+			paysFee:  types.PaysNo,
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			aliceKey, _ := setup()
+
+			result := testExample.call.Dispatch(testExample.origin, testExample.args(aliceKey))
+
+			if testExample.expectation != nil {
+				assert.True(t, bool(result.HasError))
+				assert.Equal(t, testExample.expectation, result.Err.Error)
+			} else {
+				assert.False(t, bool(result.HasError))
+				assert.Equal(t, testExample.paysFee, result.Ok.PaysFee)
+			}
+			assert.Equal(t, testExample.disabled, session.StorageGetDisabledValidators())
+		})
+	}
+}
+
+func Test_Babe_ReportEquivocation_Duplicate(t *testing.T) {
+	aliceKey, _ := setup()
+	proof := newEquivocationProof(aliceKey, 5, sealedHeader(aliceKey, 1, 5), sealedHeader(aliceKey, 2, 5))
+	keyOwnerProof := session.ProveKeyOwnership(cb.KeyTypeId, aliceKey).Value
+
+	assert.Nil(t, babe.ReportEquivocation(proof, keyOwnerProof))
+
+	assert.Equal(t, testutils.NewModuleError(cb.ModuleIndex, errors.ErrorDuplicateOffenceReport), babe.ReportEquivocation(proof, keyOwnerProof))
+	assert.Equal(t,
+		types.NewTransactionValidityError(types.NewInvalidTransactionStale()),
+		babe.PreDispatchUnsignedEquivocationReport(proof, keyOwnerProof))
+}
+
+func Test_Babe_ValidateUnsignedEquivocationReport(t *testing.T) {
+	aliceKey, _ := setup()
+	proof := newEquivocationProof(aliceKey, 5, sealedHeader(aliceKey, 1, 5), sealedHeader(aliceKey, 2, 5))
+	keyOwnerProof := session.ProveKeyOwnership(cb.KeyTypeId, aliceKey).Value
+
+	_, err := babe.ValidateUnsignedEquivocationReport(types.NewTransactionSourceExternal(), proof, keyOwnerProof)
+	assert.Equal(t, types.NewTransactionValidityError(types.NewInvalidTransactionCall()), err)
+
+	validTransaction, err := babe.ValidateUnsignedEquivocationReport(types.NewTransactionSourceLocal(), proof, keyOwnerProof)
+	assert.Nil(t, err)
+	assert.Equal(t, cb.ReportLongevity, validTransaction.Longevity)
+	assert.Equal(t, sc.Bool(false), validTransaction.Propagate)
+	assert.Len(t, validTransaction.Provides, 1)
+}
+
+func Test_Babe_EpochChange(t *testing.T) {
+	aliceKey, bobKey := setup()
+	authorities := sc.Sequence[types.Authority]{{Id: aliceKey, Weight: 1}, {Id: bobKey, Weight: 1}}
+	assert.Equal(t, authorities, babe.StorageGetAuthorities())
+	assert.Equal(t, authorities, babe.StorageGetNextAuthorities())
+
+	genesisSlot := babe.Slot(100)
+
+	// The first block sets the genesis slot and signals the first epoch.
+	system.StorageSetBlockNumber(1)
+	system.StorageSetDigest(preRuntimeDigest(babe.PreDigest{Kind: babe.PreDigestSecondaryPlain, AuthorityIndex: 1, Slot: genesisSlot}))
+	babe.OnInitialize(1)
+
+	assert.Equal(t, genesisSlot, babe.StorageGetGenesisSlot())
+	assert.Equal(t, genesisSlot, babe.StorageGetCurrentSlot())
+	assert.False(t, babe.ShouldEpochChange(1))
+	assert.Len(t, system.StorageGetDigest().OfType(types.DigestTypeConsensusMessage), 1)
+
+	babe.OnFinalize(1)
+
+	assert.Nil(t, babe.PlanConfigChange(babe.NextConfigDescriptor{EpochConfiguration: babe.EpochConfiguration{C: [2]sc.U64{1, 2}, AllowedSlots: cb.AllowedSlotsPrimary}}))
+
+	// A block in the slot after the end of the first epoch ends the epoch and the session.
+	slot := genesisSlot + cb.EpochDuration + 3
+	system.StorageSetBlockNumber(2)
+	system.StorageSetDigest(preRuntimeDigest(babe.PreDigest{Kind: babe.PreDigestSecondaryPlain, AuthorityIndex: 0, Slot: slot}))
+	babe.OnInitialize(2)
+
+	assert.Equal(t, types.BlockNumber(cb.EpochDuration+2), babe.StorageGetLateness())
+	assert.True(t, babe.ShouldEpochChange(2))
+
+	session.RotateSession()
+
+	assert.Equal(t, sc.U64(1), babe.StorageGetEpochIndex())
+	assert.Equal(t, babe.EpochStart{Previous: 0, Current: 2}, babe.StorageGetEpochStart())
+	assert.Empty(t, babe.StorageGetSkippedEpochs())
+	assert.False(t, bool(babe.StorageGetPendingEpochConfigChange().HasValue))
+	assert.Equal(t, sc.NewOption[babe.EpochConfiguration](babe.EpochConfiguration{C: [2]sc.U64{1, 2}, AllowedSlots: cb.AllowedSlotsPrimary}), babe.StorageGetNextEpochConfig())
+
+	consensusLogs := system.StorageGetDigest().OfType(types.DigestTypeConsensusMessage)
+	assert.Len(t, consensusLogs, 2)
+	assert.Equal(t, babe.ConsensusLogNextEpochData, consensusLogs[0].Payload[0])
+	assert.Equal(t, babe.ConsensusLogNextConfigData, consensusLogs[1].Payload[0])
+
+	babe.OnFinalize(2)
+
+	assert.Equal(t, types.BlockNumber(0), babe.StorageGetLateness())
+	assert.False(t, babe.ShouldEpochChange(3))
+}
+
+func Test_Babe_PlanConfigChange(t *testing.T) {
+	setup()
+	first := babe.NextConfigDescriptor{EpochConfiguration: babe.EpochConfiguration{C: [2]sc.U64{1, 2}, AllowedSlots: cb.AllowedSlotsPrimary}}
+	second := babe.NextConfigDescriptor{EpochConfiguration: babe.EpochConfiguration{C: [2]sc.U64{1, 4}, AllowedSlots: cb.AllowedSlotsPrimaryAndSecondaryVRF}}
+
+	assert.False(t, bool(babe.StorageGetPendingEpochConfigChange().HasValue))
+
+	result := NewPlanConfigChangeCall(nil).Dispatch(types.NewRawOriginRoot(), sc.NewVaryingData(first))
+	assert.False(t, bool(result.HasError))
+	assert.Equal(t, sc.NewOption[babe.NextConfigDescriptor](first), babe.StorageGetPendingEpochConfigChange())
+
+	// A later plan in the same epoch replaces the pending change.
+	result = NewPlanConfigChangeCall(nil).Dispatch(types.NewRawOriginRoot(), sc.NewVaryingData(second))
+	assert.False(t, bool(result.HasError))
+	assert.Equal(t, sc.NewOption[babe.NextConfigDescriptor](second), babe.StorageGetPendingEpochConfigChange())
+
+	// An invalid plan keeps the pending change.
+	invalid := babe.NextConfigDescriptor{EpochConfiguration: babe.EpochConfiguration{C: [2]sc.U64{1, 0}, AllowedSlots: cb.AllowedSlotsPrimary}}
+	result = NewPlanConfigChangeCall(nil).Dispatch(types.NewRawOriginRoot(), sc.NewVaryingData(invalid))
+	assert.True(t, bool(result.HasError))
+	assert.Equal(t, sc.NewOption[babe.NextConfigDescriptor](second), babe.StorageGetPendingEpochConfigChange())
+}
+
+func Test_Babe_AuthorVrfRandomness(t *testing.T) {
+	vrfSignature := babe.VrfSignature{
+		PreOutput: sc.BytesToFixedSequenceU8(append(make([]byte, 31), 1)),
+		Proof:     sc.BytesToFixedSequenceU8(make([]byte, 64)),
+	}
+
+	var testExamples = []struct {
+		label       string
+		preDigest   babe.PreDigest
+		expectation bool
+	}{
+		{
+			label:       "Primary slot claim",
+			preDigest:   babe.PreDigest{Kind: babe.PreDigestPrimary, AuthorityIndex: 0, Slot: 100, VrfSignature: vrfSignature},
+			expectation: true,
+		},
+		{
+			label:       "Secondary VRF slot claim",
+			preDigest:   babe.PreDigest{Kind: babe.PreDigestSecondaryVRF, AuthorityIndex: 0, Slot: 100, VrfSignature: vrfSignature},
+			expectation: true,
+		},
+		{
+			label:     "Secondary plain slot claim",
+			preDigest: babe.PreDigest{Kind: babe.PreDigestSecondaryPlain, AuthorityIndex: 0, Slot: 100},
+		},
+		{
+			label:     "Unknown authority",
+			preDigest: babe.PreDigest{Kind: babe.PreDigestPrimary, AuthorityIndex: 2, Slot: 100, VrfSignature: vrfSignature},
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			setup()
+
+			system.StorageSetBlockNumber(1)
+			system.StorageSetDigest(preRuntimeDigest(testExample.preDigest))
+			babe.OnInitialize(1)
+			babe.OnFinalize(1)
+
+			randomness := babe.StorageGetAuthorVrfRandomness()
+			assert.Equal(t, testExample.expectation, bool(randomness.HasValue))
+			if randomness.HasValue {
+				assert.NotEqual(t, vrfSignature.PreOutput, randomness.Value)
+			}
+		})
+	}
+}
+
+func Test_Babe_AuthorVrfRandomness_BoundToAuthority(t *testing.T) {
+	vrfSignature := babe.VrfSignature{
+		PreOutput: sc.BytesToFixedSequenceU8(append(make([]byte, 31), 1)),
+		Proof:     sc.BytesToFixedSequenceU8(make([]byte, 64)),
+	}
+
+	randomnessOf := func(authorityIndex sc.U32) sc.Option[babe.Randomness] {
+		setup()
+
+		system.StorageSetBlockNumber(1)
+		system.StorageSetDigest(preRuntimeDigest(babe.PreDigest{Kind: babe.PreDigestPrimary, AuthorityIndex: authorityIndex, Slot: 100, VrfSignature: vrfSignature}))
+		babe.OnInitialize(1)
+		babe.OnFinalize(1)
+
+		return babe.StorageGetAuthorVrfRandomness()
+	}
+
+	// The same VRF output yields a different randomness for each authority.
+	assert.NotEqual(t, randomnessOf(0), randomnessOf(1))
+}
@@ -0,0 +1,115 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	cb "github.com/LimeChain/gosemble/constants/babe"
+	"github.com/LimeChain/gosemble/frame/babe"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type PlanConfigChangeCall struct {
+	primitives.Callable
+}
+
+func NewPlanConfigChangeCall(args sc.VaryingData) PlanConfigChangeCall {
+	call := PlanConfigChangeCall{
+		Callable: primitives.Callable{
+			ModuleId:   cb.ModuleIndex,
+			FunctionId: cb.FunctionPlanConfigChangeIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c PlanConfigChangeCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		babe.DecodeNextConfigDescriptor(buffer),
+	)
+	return c
+}
+
+func (c PlanConfigChangeCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c PlanConfigChangeCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c PlanConfigChangeCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c PlanConfigChangeCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c PlanConfigChangeCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ PlanConfigChangeCall) BaseWeight(b ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `0`
+	//  Estimated: `0`
+	// Minimum execution time: 3_724 nanoseconds.
+	r := constants.DbWeight.Reads(0)
+	w := constants.DbWeight.Writes(1)
+	e := types.WeightFromParts(0, 0)
+	return types.WeightFromParts(3_724_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ PlanConfigChangeCall) IsInherent() bool {
+	return false
+}
+
+func (_ PlanConfigChangeCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ PlanConfigChangeCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ PlanConfigChangeCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ PlanConfigChangeCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := planConfigChange(origin, args[0].(babe.NextConfigDescriptor))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// planConfigChange plans a change of the epoch configuration, which is enacted in the epoch after
+// the next one. The origin must be root.
+func planConfigChange(origin types.RuntimeOrigin, config babe.NextConfigDescriptor) types.DispatchError {
+	if !origin.IsRootOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return babe.PlanConfigChange(config)
+}
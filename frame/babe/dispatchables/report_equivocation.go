@@ -0,0 +1,119 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	cb "github.com/LimeChain/gosemble/constants/babe"
+	"github.com/LimeChain/gosemble/frame/babe"
+	"github.com/LimeChain/gosemble/frame/session"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ReportEquivocationCall struct {
+	primitives.Callable
+}
+
+func NewReportEquivocationCall(args sc.VaryingData) ReportEquivocationCall {
+	call := ReportEquivocationCall{
+		Callable: primitives.Callable{
+			ModuleId:   cb.ModuleIndex,
+			FunctionId: cb.FunctionReportEquivocationIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ReportEquivocationCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		babe.DecodeEquivocationProof(buffer),
+		session.DecodeMembershipProof(buffer),
+	)
+	return c
+}
+
+func (c ReportEquivocationCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ReportEquivocationCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ReportEquivocationCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ReportEquivocationCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c ReportEquivocationCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ReportEquivocationCall) BaseWeight(b ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `2121`
+	//  Estimated: `11157`
+	// Minimum execution time: 118_735 nanoseconds.
+	r := constants.DbWeight.Reads(6)
+	w := constants.DbWeight.Writes(1)
+	e := types.WeightFromParts(0, 11157)
+	return types.WeightFromParts(118_735_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ReportEquivocationCall) IsInherent() bool {
+	return false
+}
+
+func (_ ReportEquivocationCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ ReportEquivocationCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ReportEquivocationCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ReportEquivocationCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := reportEquivocation(origin, args[0].(babe.EquivocationProof), args[1].(session.MembershipProof))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok: types.PostDispatchInfo{
+			PaysFee: types.PaysNo,
+		},
+	}
+}
+
+// reportEquivocation reports an equivocation of an authority, signed by the reporter.
+// Valid reports do not pay fees.
+func reportEquivocation(origin types.RuntimeOrigin, equivocationProof babe.EquivocationProof, keyOwnerProof session.MembershipProof) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return babe.ReportEquivocation(equivocationProof, keyOwnerProof)
+}
@@ -0,0 +1,170 @@
+package babe
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/babe"
+	ext "github.com/LimeChain/gosemble/execution/types"
+	"github.com/LimeChain/gosemble/frame/babe/errors"
+	"github.com/LimeChain/gosemble/frame/session"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/offchain"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+const sr25519SignatureLength = 64
+
+// ReportEquivocation checks the equivocation proof and the key ownership proof of the offender,
+// and disables the offender for the rest of the session. Each offender is reported at most once per session.
+func ReportEquivocation(equivocationProof EquivocationProof, keyOwnerProof session.MembershipProof) types.DispatchError {
+	offenderIndex, err := checkEquivocationReport(equivocationProof, keyOwnerProof)
+	if err != nil {
+		return err
+	}
+
+	session.DisableIndex(offenderIndex)
+
+	return nil
+}
+
+// ValidateUnsignedEquivocationReport validates an unsigned equivocation report. Only reports, which are
+// submitted by the local node or included in a block, are accepted, and they are not propagated.
+func ValidateUnsignedEquivocationReport(source types.TransactionSource, equivocationProof EquivocationProof, keyOwnerProof session.MembershipProof) (types.ValidTransaction, types.TransactionValidityError) {
+	if source[0] != types.TransactionSourceLocal && source[0] != types.TransactionSourceInBlock {
+		log.Warn("rejecting unsigned report equivocation transaction because it is not local/in-block")
+		return types.ValidTransaction{}, types.NewTransactionValidityError(types.NewInvalidTransactionCall())
+	}
+
+	err := PreDispatchUnsignedEquivocationReport(equivocationProof, keyOwnerProof)
+	if err != nil {
+		return types.ValidTransaction{}, err
+	}
+
+	tag := sc.Sequence[sc.U8]{}
+	tag = append(tag, sc.BytesToSequenceU8(sc.Str("BabeEquivocation").Bytes())...)
+	tag = append(tag, equivocationProof.Offender...)
+	tag = append(tag, sc.BytesToSequenceU8(equivocationProof.Slot.Bytes())...)
+
+	return types.ValidTransaction{
+		Priority:  types.TransactionPriority(math.MaxUint64),
+		Requires:  sc.Sequence[types.TransactionTag]{},
+		Provides:  sc.Sequence[types.TransactionTag]{tag},
+		Longevity: babe.ReportLongevity,
+		Propagate: false,
+	}, nil
+}
+
+// PreDispatchUnsignedEquivocationReport checks an unsigned equivocation report before it is dispatched.
+func PreDispatchUnsignedEquivocationReport(equivocationProof EquivocationProof, keyOwnerProof session.MembershipProof) types.TransactionValidityError {
+	_, err := checkEquivocationReport(equivocationProof, keyOwnerProof)
+	if err == nil {
+		return nil
+	}
+
+	if reflect.DeepEqual(err, newModuleError(errors.ErrorDuplicateOffenceReport)) {
+		return types.NewTransactionValidityError(types.NewInvalidTransactionStale())
+	}
+
+	return types.NewTransactionValidityError(types.NewInvalidTransactionBadProof())
+}
+
+// checkEquivocationReport checks the equivocation report and returns the index of the offender
+// in the current validators.
+func checkEquivocationReport(equivocationProof EquivocationProof, keyOwnerProof session.MembershipProof) (sc.U32, types.DispatchError) {
+	if !checkEquivocationProof(equivocationProof) {
+		return 0, newModuleError(errors.ErrorInvalidEquivocationProof)
+	}
+
+	// The slot of the equivocation must be in an epoch of the session of the key ownership proof.
+	epochIndex := epochIndexForSlot(equivocationProof.Slot)
+	if sessionIndexForEpoch(epochIndex) != keyOwnerProof.Session {
+		return 0, newModuleError(errors.ErrorInvalidKeyOwnershipProof)
+	}
+
+	_, offenderIndex, ok := session.CheckKeyOwnershipProof(babe.KeyTypeId, equivocationProof.Offender, keyOwnerProof)
+	if !ok {
+		return 0, newModuleError(errors.ErrorInvalidKeyOwnershipProof)
+	}
+
+	if session.IsDisabled(offenderIndex) {
+		return 0, newModuleError(errors.ErrorDuplicateOffenceReport)
+	}
+
+	return offenderIndex, nil
+}
+
+// checkEquivocationProof checks that the headers are different, claim the slot of the proof
+// by the same authority, and are sealed by the offender.
+func checkEquivocationProof(equivocationProof EquivocationProof) bool {
+	firstHeader := equivocationProof.FirstHeader
+	secondHeader := equivocationProof.SecondHeader
+
+	if reflect.DeepEqual(hashing.Blake256(firstHeader.Bytes()), hashing.Blake256(secondHeader.Bytes())) {
+		return false
+	}
+
+	firstPreDigest := preDigestFromDigest(firstHeader.Digest)
+	secondPreDigest := preDigestFromDigest(secondHeader.Digest)
+	if !firstPreDigest.HasValue || !secondPreDigest.HasValue {
+		return false
+	}
+
+	if equivocationProof.Slot != firstPreDigest.Value.Slot || firstPreDigest.Value.Slot != secondPreDigest.Value.Slot {
+		return false
+	}
+
+	if firstPreDigest.Value.AuthorityIndex != secondPreDigest.Value.AuthorityIndex {
+		return false
+	}
+
+	return checkSealSignature(firstHeader, equivocationProof.Offender) &&
+		checkSealSignature(secondHeader, equivocationProof.Offender)
+}
+
+// checkSealSignature checks that the last log of the header is a BABE seal, which is a signature
+// of the offender over the hash of the header without the seal.
+func checkSealSignature(header types.Header, offender types.PublicKey) bool {
+	logs := header.Digest.Logs
+	if len(logs) == 0 {
+		return false
+	}
+
+	seal := logs[len(logs)-1]
+	if seal.Type != types.DigestTypeSeal || !reflect.DeepEqual(sc.FixedSequenceU8ToBytes(seal.Engine), babe.EngineId[:]) {
+		return false
+	}
+
+	if len(seal.Payload) != sr25519SignatureLength {
+		return false
+	}
+
+	header.Digest = types.Digest{Logs: logs[:len(logs)-1]}
+	preHash := hashing.Blake256(header.Bytes())
+
+	signature := types.DecodeSr25519(bytes.NewBuffer(sc.SequenceU8ToBytes(seal.Payload)))
+
+	return bool(signature.Verify(sc.BytesToSequenceU8(preHash), types.NewAddress32(offender...)))
+}
+
+// submitUnsignedEquivocationReport submits an unsigned `report_equivocation_unsigned` extrinsic
+// to the transaction pool of the node.
+func submitUnsignedEquivocationReport(equivocationProof EquivocationProof, keyOwnerProof session.MembershipProof) bool {
+	call := types.Callable{
+		ModuleId:   babe.ModuleIndex,
+		FunctionId: babe.FunctionReportEquivocationUnsignedIndex,
+		Arguments:  sc.NewVaryingData(equivocationProof, keyOwnerProof),
+	}
+
+	extrinsic := sc.BytesToSequenceU8(append([]byte{ext.ExtrinsicFormatVersion}, call.Bytes()...))
+
+	ok := offchain.SubmitTransaction(extrinsic.Bytes())
+	if !ok {
+		log.Warn("failed to submit the equivocation report")
+	}
+
+	return ok
+}
@@ -0,0 +1,11 @@
+package errors
+
+import sc "github.com/LimeChain/goscale"
+
+// Babe module errors.
+const (
+	ErrorInvalidEquivocationProof sc.U8 = iota
+	ErrorInvalidKeyOwnershipProof
+	ErrorDuplicateOffenceReport
+	ErrorInvalidConfiguration
+)
@@ -0,0 +1,364 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/babe"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	bb "github.com/LimeChain/gosemble/frame/babe"
+	"github.com/LimeChain/gosemble/frame/babe/dispatchables"
+	"github.com/LimeChain/gosemble/frame/babe/errors"
+	"github.com/LimeChain/gosemble/frame/session"
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type BabeModule struct {
+	primitives.DefaultHooks
+	functions map[sc.U8]primitives.Call
+}
+
+func NewBabeModule() BabeModule {
+	functions := make(map[sc.U8]primitives.Call)
+	functions[babe.FunctionReportEquivocationIndex] = dispatchables.NewReportEquivocationCall(nil)
+	functions[babe.FunctionReportEquivocationUnsignedIndex] = dispatchables.NewReportEquivocationUnsignedCall(nil)
+	functions[babe.FunctionPlanConfigChangeIndex] = dispatchables.NewPlanConfigChangeCall(nil)
+
+	return BabeModule{
+		functions: functions,
+	}
+}
+
+func (bm BabeModule) Functions() map[sc.U8]primitives.Call {
+	return bm.functions
+}
+
+func (bm BabeModule) PreDispatch(call primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	if call.FunctionIndex() != babe.FunctionReportEquivocationUnsignedIndex {
+		return sc.Empty{}, nil
+	}
+
+	args := call.Args()
+	return sc.Empty{}, bb.PreDispatchUnsignedEquivocationReport(args[0].(bb.EquivocationProof), args[1].(session.MembershipProof))
+}
+
+func (bm BabeModule) ValidateUnsigned(source primitives.TransactionSource, call primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	if call.FunctionIndex() != babe.FunctionReportEquivocationUnsignedIndex {
+		return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+	}
+
+	args := call.Args()
+	return bb.ValidateUnsignedEquivocationReport(source, args[0].(bb.EquivocationProof), args[1].(session.MembershipProof))
+}
+
+func (bm BabeModule) OnInitialize(n primitives.BlockNumber) primitives.Weight {
+	return bb.OnInitialize(n)
+}
+
+func (bm BabeModule) OnFinalize(n primitives.BlockNumber) {
+	bb.OnFinalize(n)
+}
+
+func (bm BabeModule) OnTimestampSet(now sc.U64) {
+	bb.OnTimestampSet(now)
+}
+
+func (bm BabeModule) KeyTypeId() [4]byte {
+	return babe.KeyTypeId
+}
+
+func (bm BabeModule) OnGenesisSession(validators []primitives.ValidatorKey) {
+	bb.OnGenesisSession(validators)
+}
+
+func (bm BabeModule) OnNewSession(_ bool, validators []primitives.ValidatorKey, queuedValidators []primitives.ValidatorKey) {
+	bb.OnNewSession(validators, queuedValidators)
+}
+
+func (bm BabeModule) OnBeforeSessionEnding() {}
+
+func (bm BabeModule) OnDisabled(validatorIndex sc.U32) {
+	bb.OnDisabled(validatorIndex)
+}
+
+func (bm BabeModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
+	declaredTypes, metadataModule := bm.declaration().Metadata()
+
+	return append(bm.metadataTypes(), declaredTypes...), metadataModule
+}
+
+// declaration declares the storage, calls, errors and constants of the module,
+// from which its metadata is derived.
+func (bm BabeModule) declaration() support.ModuleDeclaration {
+	return support.ModuleDeclaration{
+		Name:    "Babe",
+		Index:   babe.ModuleIndex,
+		Path:    "pallet_babe",
+		Storage: bb.StorageDeclarations(),
+		Calls: &support.EnumDeclaration{
+			TypeId:   metadata.BabeCalls,
+			Variants: callDeclarations,
+		},
+		Errors: &support.EnumDeclaration{
+			TypeId:   metadata.TypesBabeErrors,
+			Variants: errorDeclarations,
+		},
+		Constants: []support.ConstantDeclaration{
+			{
+				Name:  "EpochDuration",
+				Value: babe.EpochDuration,
+				Docs:  "The amount of time, in slots, that each epoch should last. NOTE: Currently it is not possible to change the epoch duration after the chain has started. Attempting to do so will brick block production.",
+			},
+			{
+				Name:  "ExpectedBlockTime",
+				Value: babe.ExpectedBlockTime,
+				Docs:  "The expected average block time at which BABE should be creating blocks. Since BABE is probabilistic it is not trivial to figure out what the expected average block time should be based on the slot duration and the security parameter `c` (where `1 - c` represents the probability of a slot being empty).",
+			},
+			{
+				Name:  "MaxAuthorities",
+				Value: babe.MaxAuthorities,
+				Docs:  "Max number of authorities allowed",
+			},
+		},
+	}
+}
+
+func (bm BabeModule) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithPath(metadata.TypesSr25519PubKey, "sp_core sr25519 Public", sc.Sequence[sc.Str]{"sp_core", "sr25519", "Public"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(metadata.TypesFixedSequence32U8),
+				})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesBabeAppPublic, "sp_consensus_babe app Public", sc.Sequence[sc.Str]{"sp_consensus_babe", "app", "Public"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesSr25519PubKey, "sr25519::Public"),
+				})),
+
+		primitives.NewMetadataType(metadata.TypesTupleBabeAppPublicU64, "(AuthorityId, BabeAuthorityWeight)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{
+				sc.ToCompact(metadata.TypesBabeAppPublic),
+				sc.ToCompact(metadata.PrimitiveTypesU64),
+			})),
+		primitives.NewMetadataType(metadata.TypesSequenceTupleBabeAppPublicU64, "[]((AuthorityId, BabeAuthorityWeight))",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesTupleBabeAppPublicU64))),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesBabeSlot, "sp_consensus_slots Slot", sc.Sequence[sc.Str]{"sp_consensus_slots", "Slot"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.PrimitiveTypesU64, "u64"),
+				})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesBabeAllowedSlots, "AllowedSlots", sc.Sequence[sc.Str]{"sp_consensus_babe", "AllowedSlots"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"PrimarySlots",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						babe.AllowedSlotsPrimary,
+						"AllowedSlots.PrimarySlots"),
+					primitives.NewMetadataDefinitionVariant(
+						"PrimaryAndSecondaryPlainSlots",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						babe.AllowedSlotsPrimaryAndSecondaryPlain,
+						"AllowedSlots.PrimaryAndSecondaryPlainSlots"),
+					primitives.NewMetadataDefinitionVariant(
+						"PrimaryAndSecondaryVRFSlots",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						babe.AllowedSlotsPrimaryAndSecondaryVRF,
+						"AllowedSlots.PrimaryAndSecondaryVRFSlots"),
+				})),
+
+		primitives.NewMetadataType(metadata.TypesTupleU64U64, "(u64, u64)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{
+				sc.ToCompact(metadata.PrimitiveTypesU64),
+				sc.ToCompact(metadata.PrimitiveTypesU64),
+			})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesBabeEpochConfiguration, "BabeEpochConfiguration", sc.Sequence[sc.Str]{"sp_consensus_babe", "BabeEpochConfiguration"},
+			primitives.NewMetadataTypeDefinitionComposite(epochConfigurationFields())),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesBabeNextConfigDescriptor, "NextConfigDescriptor", sc.Sequence[sc.Str]{"sp_consensus_babe", "digests", "NextConfigDescriptor"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"V1",
+						epochConfigurationFields(),
+						bb.NextConfigDescriptorV1,
+						"NextConfigDescriptor.V1"),
+				})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesBabeVrfSignature, "VrfSignature", sc.Sequence[sc.Str]{"sp_core", "sr25519", "vrf", "VrfSignature"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence32U8, "pre_output", "VrfPreOutput"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence64U8, "proof", "VrfProof"),
+				})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesBabePrimaryPreDigest, "PrimaryPreDigest", sc.Sequence[sc.Str]{"sp_consensus_babe", "digests", "PrimaryPreDigest"},
+			primitives.NewMetadataTypeDefinitionComposite(preDigestFields(true))),
+		primitives.NewMetadataTypeWithPath(metadata.TypesBabeSecondaryPlainPreDigest, "SecondaryPlainPreDigest", sc.Sequence[sc.Str]{"sp_consensus_babe", "digests", "SecondaryPlainPreDigest"},
+			primitives.NewMetadataTypeDefinitionComposite(preDigestFields(false))),
+		primitives.NewMetadataTypeWithPath(metadata.TypesBabeSecondaryVRFPreDigest, "SecondaryVRFPreDigest", sc.Sequence[sc.Str]{"sp_consensus_babe", "digests", "SecondaryVRFPreDigest"},
+			primitives.NewMetadataTypeDefinitionComposite(preDigestFields(true))),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesBabePreDigest, "PreDigest", sc.Sequence[sc.Str]{"sp_consensus_babe", "digests", "PreDigest"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"Primary",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesBabePrimaryPreDigest, "PrimaryPreDigest"),
+						},
+						bb.PreDigestPrimary,
+						"PreDigest.Primary"),
+					primitives.NewMetadataDefinitionVariant(
+						"SecondaryPlain",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesBabeSecondaryPlainPreDigest, "SecondaryPlainPreDigest"),
+						},
+						bb.PreDigestSecondaryPlain,
+						"PreDigest.SecondaryPlain"),
+					primitives.NewMetadataDefinitionVariant(
+						"SecondaryVRF",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesBabeSecondaryVRFPreDigest, "SecondaryVRFPreDigest"),
+						},
+						bb.PreDigestSecondaryVRF,
+						"PreDigest.SecondaryVRF"),
+				})),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesOptionBabePreDigest, "Option<PreDigest>", sc.Sequence[sc.Str]{"Option"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"None",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						0,
+						"Option<PreDigest>(nil)"),
+					primitives.NewMetadataDefinitionVariant(
+						"Some",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionField(metadata.TypesBabePreDigest),
+						},
+						1,
+						"Option<PreDigest>(value)"),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.TypesBabePreDigest, "T")),
+
+		primitives.NewMetadataType(metadata.TypesSequenceFixedSequence32U8, "[][32]byte",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesFixedSequence32U8))),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesOptionFixedSequence32U8, "Option<[32]byte>", sc.Sequence[sc.Str]{"Option"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"None",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						0,
+						"Option<[32]byte>(nil)"),
+					primitives.NewMetadataDefinitionVariant(
+						"Some",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionField(metadata.TypesFixedSequence32U8),
+						},
+						1,
+						"Option<[32]byte>(value)"),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.TypesFixedSequence32U8, "T")),
+
+		primitives.NewMetadataType(metadata.TypesTupleU64U32, "(u64, u32)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{
+				sc.ToCompact(metadata.PrimitiveTypesU64),
+				sc.ToCompact(metadata.PrimitiveTypesU32),
+			})),
+		primitives.NewMetadataType(metadata.TypesSequenceTupleU64U32, "[]((u64, u32))",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesTupleU64U32))),
+
+		primitives.NewMetadataTypeWithParams(metadata.TypesBabeEquivocationProof, "EquivocationProof", sc.Sequence[sc.Str]{"sp_consensus_slots", "EquivocationProof"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBabeAppPublic, "offender", "Id"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBabeSlot, "slot", "Slot"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesHeader, "first_header", "Header"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesHeader, "second_header", "Header"),
+				}),
+			sc.Sequence[primitives.MetadataTypeParameter]{
+				primitives.NewMetadataTypeParameter(metadata.TypesHeader, "Header"),
+				primitives.NewMetadataTypeParameter(metadata.TypesBabeAppPublic, "Id"),
+			}),
+	}
+}
+
+func epochConfigurationFields() sc.Sequence[primitives.MetadataTypeDefinitionField] {
+	return sc.Sequence[primitives.MetadataTypeDefinitionField]{
+		primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesTupleU64U64, "c", "(u64, u64)"),
+		primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBabeAllowedSlots, "allowed_slots", "AllowedSlots"),
+	}
+}
+
+func preDigestFields(withVrfSignature bool) sc.Sequence[primitives.MetadataTypeDefinitionField] {
+	fields := sc.Sequence[primitives.MetadataTypeDefinitionField]{
+		primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "authority_index", "super::AuthorityIndex"),
+		primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBabeSlot, "slot", "Slot"),
+	}
+
+	if withVrfSignature {
+		fields = append(fields, primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBabeVrfSignature, "vrf_signature", "VrfSignature"))
+	}
+
+	return fields
+}
+
+var callDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "report_equivocation",
+		Index: babe.FunctionReportEquivocationIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBabeEquivocationProof, "equivocation_proof", "Box<EquivocationProof<HeaderFor<T>>>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMembershipProof, "key_owner_proof", "T::KeyOwnerProof"),
+		},
+		Docs: "Report authority equivocation/misbehavior. This method will verify the equivocation proof and validate the given key ownership proof against the extracted offender. If both are valid, the offence will be reported.",
+	},
+	{
+		Name:  "report_equivocation_unsigned",
+		Index: babe.FunctionReportEquivocationUnsignedIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBabeEquivocationProof, "equivocation_proof", "Box<EquivocationProof<HeaderFor<T>>>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMembershipProof, "key_owner_proof", "T::KeyOwnerProof"),
+		},
+		Docs: "Report authority equivocation/misbehavior. This method will verify the equivocation proof and validate the given key ownership proof against the extracted offender. If both are valid, the offence will be reported. This extrinsic must be called unsigned and it is expected that only block authors will call it (validated in `ValidateUnsigned`), as such if the block author is defined it will be defined as the equivocation reporter.",
+	},
+	{
+		Name:  "plan_config_change",
+		Index: babe.FunctionPlanConfigChangeIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBabeNextConfigDescriptor, "config", "NextConfigDescriptor"),
+		},
+		Docs: "Plan an epoch config change. The epoch config change is recorded and will be enacted on the next call to `enact_epoch_change`. The config will be activated one epoch after. Multiple calls to this method will replace any existing planned config change that had not been enacted yet.",
+	},
+}
+
+var errorDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "InvalidEquivocationProof",
+		Index: errors.ErrorInvalidEquivocationProof,
+		Docs:  "An equivocation proof provided as part of an equivocation report is invalid.",
+	},
+	{
+		Name:  "InvalidKeyOwnershipProof",
+		Index: errors.ErrorInvalidKeyOwnershipProof,
+		Docs:  "A key ownership proof provided as part of an equivocation report is invalid.",
+	},
+	{
+		Name:  "DuplicateOffenceReport",
+		Index: errors.ErrorDuplicateOffenceReport,
+		Docs:  "A given equivocation report is valid but already previously reported.",
+	},
+	{
+		Name:  "InvalidConfiguration",
+		Index: errors.ErrorInvalidConfiguration,
+		Docs:  "Submitted configuration is invalid.",
+	},
+}
@@ -0,0 +1,337 @@
+package babe
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	storageEpochIndex               = support.NewStorageValue[sc.U64](constants.KeyBabe, constants.KeyEpochIndex, sc.DecodeU64)
+	storageAuthorities              = support.NewStorageValue[sc.Sequence[types.Authority]](constants.KeyBabe, constants.KeyAuthorities, decodeAuthorities)
+	storageGenesisSlot              = support.NewStorageValue[Slot](constants.KeyBabe, constants.KeyGenesisSlot, sc.DecodeU64)
+	storageCurrentSlot              = support.NewStorageValue[Slot](constants.KeyBabe, constants.KeyCurrentSlot, sc.DecodeU64)
+	storageRandomness               = support.NewStorageValue[Randomness](constants.KeyBabe, constants.KeyRandomness, DecodeRandomness)
+	storagePendingEpochConfigChange = support.NewStorageValue[NextConfigDescriptor](constants.KeyBabe, constants.KeyPendingEpochConfigChange, DecodeNextConfigDescriptor)
+	storageNextRandomness           = support.NewStorageValue[Randomness](constants.KeyBabe, constants.KeyNextRandomness, DecodeRandomness)
+	storageNextAuthorities          = support.NewStorageValue[sc.Sequence[types.Authority]](constants.KeyBabe, constants.KeyNextAuthorities, decodeAuthorities)
+	storageSegmentIndex             = support.NewStorageValue[sc.U32](constants.KeyBabe, constants.KeySegmentIndex, sc.DecodeU32)
+	storageUnderConstruction        = support.NewStorageMap[sc.U32, sc.Sequence[Randomness]](constants.KeyBabe, constants.KeyUnderConstruction, support.Twox64Concat{}, sc.DecodeU32, decodeRandomnessSegment)
+	storageInitialized              = support.NewStorageValue[sc.Option[PreDigest]](constants.KeyBabe, constants.KeyInitialized, decodeInitialized)
+	storageAuthorVrfRandomness      = support.NewStorageValue[sc.Option[Randomness]](constants.KeyBabe, constants.KeyAuthorVrfRandomness, decodeAuthorVrfRandomness)
+	storageEpochStart               = support.NewStorageValue[EpochStart](constants.KeyBabe, constants.KeyEpochStart, DecodeEpochStart)
+	storageLateness                 = support.NewStorageValue[types.BlockNumber](constants.KeyBabe, constants.KeyLateness, sc.DecodeU32)
+	storageEpochConfig              = support.NewStorageValue[EpochConfiguration](constants.KeyBabe, constants.KeyEpochConfig, DecodeEpochConfiguration)
+	storageNextEpochConfig          = support.NewStorageValue[EpochConfiguration](constants.KeyBabe, constants.KeyNextEpochConfig, DecodeEpochConfiguration)
+	storageSkippedEpochs            = support.NewStorageValue[sc.Sequence[SkippedEpoch]](constants.KeyBabe, constants.KeySkippedEpochs, decodeSkippedEpochs)
+)
+
+func init() {
+	support.RegisterTypeId(metadata.TypesSequenceTupleBabeAppPublicU64, support.TypeOf[sc.Sequence[types.Authority]]())
+	support.RegisterTypeId(metadata.TypesFixedSequence32U8, support.TypeOf[Randomness]())
+	support.RegisterTypeId(metadata.TypesSequenceFixedSequence32U8, support.TypeOf[sc.Sequence[Randomness]]())
+	support.RegisterTypeId(metadata.TypesOptionFixedSequence32U8, support.TypeOf[sc.Option[Randomness]]())
+	support.RegisterTypeId(metadata.TypesBabeNextConfigDescriptor, support.TypeOf[NextConfigDescriptor]())
+	support.RegisterTypeId(metadata.TypesOptionBabePreDigest, support.TypeOf[sc.Option[PreDigest]]())
+	support.RegisterTypeId(metadata.TypesTupleU32U32, support.TypeOf[EpochStart]())
+	support.RegisterTypeId(metadata.TypesBabeEpochConfiguration, support.TypeOf[EpochConfiguration]())
+	support.RegisterTypeId(metadata.TypesSequenceTupleU64U32, support.TypeOf[sc.Sequence[SkippedEpoch]]())
+}
+
+// StorageDeclarations returns the declarations of the storage items of the module, in the order of the metadata.
+func StorageDeclarations() []support.StorageDeclaration {
+	return []support.StorageDeclaration{
+		{
+			Item:     storageEpochIndex,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "Current epoch index.",
+		},
+		{
+			Item:     storageAuthorities,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "Current epoch authorities.",
+		},
+		{
+			Item:      storageGenesisSlot,
+			Modifier:  types.MetadataModuleStorageEntryModifierDefault,
+			ValueType: support.TypeId(metadata.TypesBabeSlot),
+			Docs:      "The slot at which the first epoch actually started. This is 0 until the first block of the chain.",
+		},
+		{
+			Item:      storageCurrentSlot,
+			Modifier:  types.MetadataModuleStorageEntryModifierDefault,
+			ValueType: support.TypeId(metadata.TypesBabeSlot),
+			Docs:      "Current slot number.",
+		},
+		{
+			Item:     storageRandomness,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "The epoch randomness for the *current* epoch.",
+		},
+		{
+			Item:     storagePendingEpochConfigChange,
+			Modifier: types.MetadataModuleStorageEntryModifierOptional,
+			Docs:     "Pending epoch configuration change that will be applied when the next epoch is enacted.",
+		},
+		{
+			Item:     storageNextRandomness,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "Next epoch randomness.",
+		},
+		{
+			Item:     storageNextAuthorities,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "Next epoch authorities.",
+		},
+		{
+			Item:     storageSegmentIndex,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "Randomness under construction. We make a trade-off between storage accesses and list length. We store the under-construction randomness in segments of up to `UNDER_CONSTRUCTION_SEGMENT_LENGTH`. Once a segment reaches this length, we begin the next one. We reset all segments and return to `0` at the beginning of every epoch.",
+		},
+		{
+			Item:     storageUnderConstruction,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "TWOX-NOTE: `SegmentIndex` is an increasing integer, so this is okay.",
+		},
+		{
+			Item:     storageInitialized,
+			Modifier: types.MetadataModuleStorageEntryModifierOptional,
+			Docs:     "Temporary value (cleared at block finalization) which is `Some` if per-block initialization has already been called for current block.",
+		},
+		{
+			Item:     storageAuthorVrfRandomness,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "This field should always be populated during block processing unless secondary plain slots are enabled (which don't contain a VRF output). It is set in `on_finalize`, before it will contain the value from the last block.",
+		},
+		{
+			Item:     storageEpochStart,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "The block numbers when the last and current epoch have started, respectively `N-1` and `N`. NOTE: We track this is in order to annotate the block number when a given pool of entropy was fixed (i.e. it was known to chain observers). Since epochs are defined in slots, which may be skipped, the block numbers may not line up with the slot numbers.",
+		},
+		{
+			Item:     storageLateness,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "How late the current block is compared to its parent. This entry is populated as part of block execution and is cleaned up on block finalization. Querying this storage entry outside of block execution context should always yield zero.",
+		},
+		{
+			Item:     storageEpochConfig,
+			Modifier: types.MetadataModuleStorageEntryModifierOptional,
+			Docs:     "The configuration for the current epoch. Should never be `None` as it is initialized in genesis.",
+		},
+		{
+			Item:     storageNextEpochConfig,
+			Modifier: types.MetadataModuleStorageEntryModifierOptional,
+			Docs:     "The configuration for the next epoch, `None` if the config will not change (you can fallback to `EpochConfig` instead in that case).",
+		},
+		{
+			Item:     storageSkippedEpochs,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "A list of the last 100 skipped epochs and the corresponding session index when the epoch was skipped. This is only used for validating equivocation proofs. An equivocation proof must contains a key-ownership proof for a given session, therefore we need a way to tie together sessions and epoch indices, i.e. we need to validate that a validator was the owner of a given key on a given session, and what the active epoch index was during that session.",
+		},
+	}
+}
+
+// StorageGetEpochIndex returns the index of the current epoch.
+func StorageGetEpochIndex() sc.U64 {
+	return storageEpochIndex.Get()
+}
+
+func storageSetEpochIndex(epochIndex sc.U64) {
+	storageEpochIndex.Put(epochIndex)
+}
+
+// StorageGetAuthorities returns the authorities of the current epoch.
+func StorageGetAuthorities() sc.Sequence[types.Authority] {
+	return storageAuthorities.Get()
+}
+
+func storageSetAuthorities(authorities sc.Sequence[types.Authority]) {
+	storageAuthorities.Put(authorities)
+}
+
+// StorageGetGenesisSlot returns the slot, in which the first epoch started. It is 0 until the first block.
+func StorageGetGenesisSlot() Slot {
+	return storageGenesisSlot.Get()
+}
+
+func storageSetGenesisSlot(slot Slot) {
+	storageGenesisSlot.Put(slot)
+}
+
+// StorageGetCurrentSlot returns the slot of the current block.
+func StorageGetCurrentSlot() Slot {
+	return storageCurrentSlot.Get()
+}
+
+func storageSetCurrentSlot(slot Slot) {
+	storageCurrentSlot.Put(slot)
+}
+
+// StorageGetRandomness returns the randomness of the current epoch.
+func StorageGetRandomness() Randomness {
+	randomness := storageRandomness.GetOption()
+	if !randomness.HasValue {
+		return NewRandomness()
+	}
+
+	return randomness.Value
+}
+
+func storageSetRandomness(randomness Randomness) {
+	storageRandomness.Put(randomness)
+}
+
+// StorageGetPendingEpochConfigChange returns the configuration change, planned for the next epoch, if there is one.
+func StorageGetPendingEpochConfigChange() sc.Option[NextConfigDescriptor] {
+	return storagePendingEpochConfigChange.GetOption()
+}
+
+func storageSetPendingEpochConfigChange(config NextConfigDescriptor) {
+	storagePendingEpochConfigChange.Put(config)
+}
+
+func storageClearPendingEpochConfigChange() {
+	storagePendingEpochConfigChange.Clear()
+}
+
+// StorageGetNextRandomness returns the randomness of the next epoch.
+func StorageGetNextRandomness() Randomness {
+	randomness := storageNextRandomness.GetOption()
+	if !randomness.HasValue {
+		return NewRandomness()
+	}
+
+	return randomness.Value
+}
+
+func storageSetNextRandomness(randomness Randomness) {
+	storageNextRandomness.Put(randomness)
+}
+
+// StorageGetNextAuthorities returns the authorities of the next epoch.
+func StorageGetNextAuthorities() sc.Sequence[types.Authority] {
+	return storageNextAuthorities.Get()
+}
+
+func storageSetNextAuthorities(authorities sc.Sequence[types.Authority]) {
+	storageNextAuthorities.Put(authorities)
+}
+
+func storageGetSegmentIndex() sc.U32 {
+	return storageSegmentIndex.Get()
+}
+
+func storageSetSegmentIndex(segmentIndex sc.U32) {
+	storageSegmentIndex.Put(segmentIndex)
+}
+
+func storageGetUnderConstruction(segmentIndex sc.U32) sc.Sequence[Randomness] {
+	return storageUnderConstruction.Get(segmentIndex)
+}
+
+func storageSetUnderConstruction(segmentIndex sc.U32, segment sc.Sequence[Randomness]) {
+	storageUnderConstruction.Put(segmentIndex, segment)
+}
+
+func storageTakeUnderConstruction(segmentIndex sc.U32) sc.Sequence[Randomness] {
+	return storageUnderConstruction.Take(segmentIndex)
+}
+
+// storageGetInitialized returns the pre-digest of the current block, if the block is initialized.
+func storageGetInitialized() sc.Option[sc.Option[PreDigest]] {
+	return storageInitialized.GetOption()
+}
+
+func storageSetInitialized(preDigest sc.Option[PreDigest]) {
+	storageInitialized.Put(preDigest)
+}
+
+func storageClearInitialized() {
+	storageInitialized.Clear()
+}
+
+// StorageGetAuthorVrfRandomness returns the randomness of the VRF output of the block author, if there is one.
+func StorageGetAuthorVrfRandomness() sc.Option[Randomness] {
+	return storageAuthorVrfRandomness.Get()
+}
+
+func storageSetAuthorVrfRandomness(randomness Randomness) {
+	storageAuthorVrfRandomness.Put(sc.NewOption[Randomness](randomness))
+}
+
+func storageClearAuthorVrfRandomness() {
+	storageAuthorVrfRandomness.Clear()
+}
+
+// StorageGetEpochStart returns the blocks, in which the previous and the current epoch started.
+func StorageGetEpochStart() EpochStart {
+	return storageEpochStart.Get()
+}
+
+func storageSetEpochStart(epochStart EpochStart) {
+	storageEpochStart.Put(epochStart)
+}
+
+// StorageGetLateness returns the number of slots, skipped between the parent and the current block.
+func StorageGetLateness() types.BlockNumber {
+	return storageLateness.Get()
+}
+
+func storageSetLateness(lateness types.BlockNumber) {
+	storageLateness.Put(lateness)
+}
+
+func storageClearLateness() {
+	storageLateness.Clear()
+}
+
+// StorageGetEpochConfig returns the configuration of the current epoch, if it is set.
+func StorageGetEpochConfig() sc.Option[EpochConfiguration] {
+	return storageEpochConfig.GetOption()
+}
+
+func storageSetEpochConfig(config EpochConfiguration) {
+	storageEpochConfig.Put(config)
+}
+
+// StorageGetNextEpochConfig returns the configuration of the next epoch, if it changes.
+func StorageGetNextEpochConfig() sc.Option[EpochConfiguration] {
+	return storageNextEpochConfig.GetOption()
+}
+
+func storageSetNextEpochConfig(config EpochConfiguration) {
+	storageNextEpochConfig.Put(config)
+}
+
+// StorageGetSkippedEpochs returns the last skipped epochs, mapped to the sessions in which they ended.
+func StorageGetSkippedEpochs() sc.Sequence[SkippedEpoch] {
+	return storageSkippedEpochs.Get()
+}
+
+func storageSetSkippedEpochs(skippedEpochs sc.Sequence[SkippedEpoch]) {
+	storageSkippedEpochs.Put(skippedEpochs)
+}
+
+func decodeAuthorities(buffer *bytes.Buffer) sc.Sequence[types.Authority] {
+	return sc.DecodeSequenceWith(buffer, types.DecodeAuthority)
+}
+
+func decodeRandomnessSegment(buffer *bytes.Buffer) sc.Sequence[Randomness] {
+	return sc.DecodeSequenceWith(buffer, DecodeRandomness)
+}
+
+func decodeInitialized(buffer *bytes.Buffer) sc.Option[PreDigest] {
+	return sc.DecodeOptionWith(buffer, DecodePreDigest)
+}
+
+func decodeAuthorVrfRandomness(buffer *bytes.Buffer) sc.Option[Randomness] {
+	return sc.DecodeOptionWith(buffer, DecodeRandomness)
+}
+
+func decodeSkippedEpochs(buffer *bytes.Buffer) sc.Sequence[SkippedEpoch] {
+	return sc.DecodeSequenceWith(buffer, DecodeSkippedEpoch)
+}
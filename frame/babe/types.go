@@ -0,0 +1,284 @@
+package babe
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+type Slot = sc.U64
+
+// Randomness is the 32 bytes of randomness of an epoch.
+type Randomness = sc.FixedSequence[sc.U8]
+
+const randomnessLength = 32
+
+func NewRandomness() Randomness {
+	return sc.NewFixedSequence[sc.U8](randomnessLength, make([]sc.U8, randomnessLength)...)
+}
+
+func DecodeRandomness(buffer *bytes.Buffer) Randomness {
+	return sc.DecodeFixedSequence[sc.U8](randomnessLength, buffer)
+}
+
+// EpochConfiguration is the configuration of the slots of an epoch.
+type EpochConfiguration struct {
+	// C is the constant, used in the threshold of primary slots, as a (numerator, denominator) pair.
+	// `1 - C` is the probability of a slot being empty.
+	C [2]sc.U64
+	// AllowedSlots are the types of slots, which can be claimed in the epoch.
+	AllowedSlots sc.U8
+}
+
+func (ec EpochConfiguration) Encode(buffer *bytes.Buffer) {
+	ec.C[0].Encode(buffer)
+	ec.C[1].Encode(buffer)
+	ec.AllowedSlots.Encode(buffer)
+}
+
+func (ec EpochConfiguration) Bytes() []byte {
+	return sc.EncodedBytes(ec)
+}
+
+func DecodeEpochConfiguration(buffer *bytes.Buffer) EpochConfiguration {
+	return EpochConfiguration{
+		C:            [2]sc.U64{sc.DecodeU64(buffer), sc.DecodeU64(buffer)},
+		AllowedSlots: sc.DecodeU8(buffer),
+	}
+}
+
+// NextConfigDescriptorV1 is the version of the only next epoch configuration descriptor.
+const NextConfigDescriptorV1 sc.U8 = 1
+
+// NextConfigDescriptor describes the configuration of a future epoch.
+type NextConfigDescriptor struct {
+	EpochConfiguration
+}
+
+func (ncd NextConfigDescriptor) Encode(buffer *bytes.Buffer) {
+	NextConfigDescriptorV1.Encode(buffer)
+	ncd.EpochConfiguration.Encode(buffer)
+}
+
+func (ncd NextConfigDescriptor) Bytes() []byte {
+	return sc.EncodedBytes(ncd)
+}
+
+func DecodeNextConfigDescriptor(buffer *bytes.Buffer) NextConfigDescriptor {
+	if sc.DecodeU8(buffer) != NextConfigDescriptorV1 {
+		log.Critical("invalid NextConfigDescriptor type")
+	}
+
+	return NextConfigDescriptor{DecodeEpochConfiguration(buffer)}
+}
+
+// NextEpochDescriptor describes the authorities and the randomness of the next epoch.
+type NextEpochDescriptor struct {
+	Authorities sc.Sequence[types.Authority]
+	Randomness  Randomness
+}
+
+func (ned NextEpochDescriptor) Encode(buffer *bytes.Buffer) {
+	ned.Authorities.Encode(buffer)
+	ned.Randomness.Encode(buffer)
+}
+
+func (ned NextEpochDescriptor) Bytes() []byte {
+	return sc.EncodedBytes(ned)
+}
+
+// VrfSignature is the VRF output and proof of a slot claim.
+type VrfSignature struct {
+	PreOutput sc.FixedSequence[sc.U8]
+	Proof     sc.FixedSequence[sc.U8]
+}
+
+func (vs VrfSignature) Encode(buffer *bytes.Buffer) {
+	vs.PreOutput.Encode(buffer)
+	vs.Proof.Encode(buffer)
+}
+
+func (vs VrfSignature) Bytes() []byte {
+	return sc.EncodedBytes(vs)
+}
+
+func DecodeVrfSignature(buffer *bytes.Buffer) VrfSignature {
+	return VrfSignature{
+		PreOutput: sc.DecodeFixedSequence[sc.U8](32, buffer),
+		Proof:     sc.DecodeFixedSequence[sc.U8](64, buffer),
+	}
+}
+
+// Pre-digest kinds, one for each type of slot claim.
+const (
+	PreDigestPrimary sc.U8 = iota + 1
+	PreDigestSecondaryPlain
+	PreDigestSecondaryVRF
+)
+
+// PreDigest is the slot claim of the block author, included in the pre-runtime digest of the block.
+type PreDigest struct {
+	// Kind is the type of the claimed slot.
+	Kind           sc.U8
+	AuthorityIndex sc.U32
+	Slot           Slot
+	// VrfSignature is the VRF signature of primary and secondary VRF claims.
+	VrfSignature VrfSignature
+}
+
+func (pd PreDigest) Encode(buffer *bytes.Buffer) {
+	pd.Kind.Encode(buffer)
+	pd.AuthorityIndex.Encode(buffer)
+	pd.Slot.Encode(buffer)
+	if pd.HasVrfSignature() {
+		pd.VrfSignature.Encode(buffer)
+	}
+}
+
+func (pd PreDigest) Bytes() []byte {
+	return sc.EncodedBytes(pd)
+}
+
+func DecodePreDigest(buffer *bytes.Buffer) PreDigest {
+	preDigest := PreDigest{
+		Kind:           sc.DecodeU8(buffer),
+		AuthorityIndex: sc.DecodeU32(buffer),
+		Slot:           sc.DecodeU64(buffer),
+	}
+
+	switch preDigest.Kind {
+	case PreDigestPrimary, PreDigestSecondaryVRF:
+		preDigest.VrfSignature = DecodeVrfSignature(buffer)
+	case PreDigestSecondaryPlain:
+	default:
+		log.Critical("invalid PreDigest type")
+	}
+
+	return preDigest
+}
+
+// HasVrfSignature returns true, if the slot is claimed with a VRF signature.
+func (pd PreDigest) HasVrfSignature() bool {
+	return pd.Kind != PreDigestSecondaryPlain
+}
+
+// EpochStart are the blocks, in which the previous and the current epoch started.
+type EpochStart struct {
+	Previous types.BlockNumber
+	Current  types.BlockNumber
+}
+
+func (es EpochStart) Encode(buffer *bytes.Buffer) {
+	es.Previous.Encode(buffer)
+	es.Current.Encode(buffer)
+}
+
+func (es EpochStart) Bytes() []byte {
+	return sc.EncodedBytes(es)
+}
+
+func DecodeEpochStart(buffer *bytes.Buffer) EpochStart {
+	return EpochStart{
+		Previous: sc.DecodeU32(buffer),
+		Current:  sc.DecodeU32(buffer),
+	}
+}
+
+// SkippedEpoch maps an epoch, which started after skipped epochs, to its session.
+type SkippedEpoch struct {
+	EpochIndex   sc.U64
+	SessionIndex sc.U32
+}
+
+func (se SkippedEpoch) Encode(buffer *bytes.Buffer) {
+	se.EpochIndex.Encode(buffer)
+	se.SessionIndex.Encode(buffer)
+}
+
+func (se SkippedEpoch) Bytes() []byte {
+	return sc.EncodedBytes(se)
+}
+
+func DecodeSkippedEpoch(buffer *bytes.Buffer) SkippedEpoch {
+	return SkippedEpoch{
+		EpochIndex:   sc.DecodeU64(buffer),
+		SessionIndex: sc.DecodeU32(buffer),
+	}
+}
+
+// Epoch describes an epoch for the client.
+type Epoch struct {
+	EpochIndex  sc.U64
+	StartSlot   Slot
+	Duration    sc.U64
+	Authorities sc.Sequence[types.Authority]
+	Randomness  Randomness
+	Config      EpochConfiguration
+}
+
+func (e Epoch) Encode(buffer *bytes.Buffer) {
+	e.EpochIndex.Encode(buffer)
+	e.StartSlot.Encode(buffer)
+	e.Duration.Encode(buffer)
+	e.Authorities.Encode(buffer)
+	e.Randomness.Encode(buffer)
+	e.Config.Encode(buffer)
+}
+
+func (e Epoch) Bytes() []byte {
+	return sc.EncodedBytes(e)
+}
+
+// BabeConfiguration is the configuration of BABE for the client.
+type BabeConfiguration struct {
+	SlotDuration sc.U64
+	EpochLength  sc.U64
+	C            [2]sc.U64
+	Authorities  sc.Sequence[types.Authority]
+	Randomness   Randomness
+	AllowedSlots sc.U8
+}
+
+func (c BabeConfiguration) Encode(buffer *bytes.Buffer) {
+	c.SlotDuration.Encode(buffer)
+	c.EpochLength.Encode(buffer)
+	c.C[0].Encode(buffer)
+	c.C[1].Encode(buffer)
+	c.Authorities.Encode(buffer)
+	c.Randomness.Encode(buffer)
+	c.AllowedSlots.Encode(buffer)
+}
+
+func (c BabeConfiguration) Bytes() []byte {
+	return sc.EncodedBytes(c)
+}
+
+// EquivocationProof proves that an authority authored two different blocks in the same slot.
+type EquivocationProof struct {
+	Offender     types.PublicKey
+	Slot         Slot
+	FirstHeader  types.Header
+	SecondHeader types.Header
+}
+
+func (ep EquivocationProof) Encode(buffer *bytes.Buffer) {
+	ep.Offender.Encode(buffer)
+	ep.Slot.Encode(buffer)
+	ep.FirstHeader.Encode(buffer)
+	ep.SecondHeader.Encode(buffer)
+}
+
+func (ep EquivocationProof) Bytes() []byte {
+	return sc.EncodedBytes(ep)
+}
+
+func DecodeEquivocationProof(buffer *bytes.Buffer) EquivocationProof {
+	return EquivocationProof{
+		Offender:     types.DecodePublicKey(buffer),
+		Slot:         sc.DecodeU64(buffer),
+		FirstHeader:  types.DecodeHeader(buffer),
+		SecondHeader: types.DecodeHeader(buffer),
+	}
+}
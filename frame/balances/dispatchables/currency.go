@@ -0,0 +1,56 @@
+package dispatchables
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Currency implements the reservable and lockable currency interfaces over the native balances,
+// which allows other modules to take deposits and lock balances.
+type Currency struct{}
+
+// ReservedBalance returns the reserved balance of `who`.
+func (c Currency) ReservedBalance(who types.Address32) types.Balance {
+	return system.StorageGetAccount(who.FixedSequence).Data.Reserved
+}
+
+// Reserve moves `value` from the free balance of `who` to its reserved balance.
+func (c Currency) Reserve(who types.Address32, value types.Balance) types.DispatchError {
+	return Reserve(who, value.ToBigInt())
+}
+
+// Unreserve moves up to `value` from the reserved balance of `who` to its free balance.
+func (c Currency) Unreserve(who types.Address32, value types.Balance) types.Balance {
+	return sc.NewU128FromBigInt(Unreserve(who, value.ToBigInt()))
+}
+
+// SlashReserved deducts up to `value` from the reserved balance of `who`, which is burned.
+func (c Currency) SlashReserved(who types.Address32, value types.Balance) types.Balance {
+	imbalance, remaining := SlashReserved(who, value.ToBigInt())
+	imbalance.Drop()
+
+	return sc.NewU128FromBigInt(remaining)
+}
+
+// RepatriateReserved moves up to `value` from the reserved balance of `slashed` to the balance of `beneficiary`.
+func (c Currency) RepatriateReserved(slashed types.Address32, beneficiary types.Address32, value types.Balance, status types.BalanceStatus) (types.Balance, types.DispatchError) {
+	remaining, err := RepatriateReserved(slashed, beneficiary, value.ToBigInt(), status)
+
+	return sc.NewU128FromBigInt(remaining), err
+}
+
+// SetLock creates a new lock of `amount` of the balance of `who`, or replaces the existing one with the same `id`.
+func (c Currency) SetLock(id types.LockIdentifier, who types.Address32, amount types.Balance, reasons sc.U8) {
+	SetLock(id, who, amount.ToBigInt(), reasons)
+}
+
+// ExtendLock changes the lock with the same `id`, so that it locks at least `amount` for at least the given `reasons`.
+func (c Currency) ExtendLock(id types.LockIdentifier, who types.Address32, amount types.Balance, reasons sc.U8) {
+	ExtendLock(id, who, amount.ToBigInt(), reasons)
+}
+
+// RemoveLock removes the lock with the given `id` from `who`.
+func (c Currency) RemoveLock(id types.LockIdentifier, who types.Address32) {
+	RemoveLock(id, who)
+}
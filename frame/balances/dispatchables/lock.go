@@ -0,0 +1,138 @@
+package dispatchables
+
+import (
+	"math/big"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/balances"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// SetLock creates a new balance lock, or replaces the existing one with the same `id`.
+// The lock freezes `amount` of the free balance of `who` for the given `reasons`.
+// Does not do anything if `amount` is zero or `reasons` are empty.
+func SetLock(id types.LockIdentifier, who types.Address32, amount *big.Int, reasons sc.U8) {
+	if amount.Cmp(constants.Zero) == 0 || reasons == 0 {
+		return
+	}
+
+	newLock := types.BalanceLock{
+		Id:      id,
+		Amount:  sc.NewU128FromBigInt(amount),
+		Reasons: types.NewReasonsFromWithdrawReasons(reasons),
+	}
+
+	locks := sc.Sequence[types.BalanceLock]{}
+	replaced := false
+	for _, lock := range StorageGetLocks(who) {
+		if reflect.DeepEqual(lock.Id, id) {
+			locks = append(locks, newLock)
+			replaced = true
+		} else {
+			locks = append(locks, lock)
+		}
+	}
+
+	if !replaced {
+		locks = append(locks, newLock)
+	}
+
+	updateLocks(who, locks)
+}
+
+// ExtendLock changes the balance lock with the same `id`, so that it freezes at least `amount`
+// for at least the given `reasons`. Creates a new lock, if there is none with the `id`.
+// Does not do anything if `amount` is zero or `reasons` are empty.
+func ExtendLock(id types.LockIdentifier, who types.Address32, amount *big.Int, reasons sc.U8) {
+	if amount.Cmp(constants.Zero) == 0 || reasons == 0 {
+		return
+	}
+
+	newLock := types.BalanceLock{
+		Id:      id,
+		Amount:  sc.NewU128FromBigInt(amount),
+		Reasons: types.NewReasonsFromWithdrawReasons(reasons),
+	}
+
+	locks := sc.Sequence[types.BalanceLock]{}
+	extended := false
+	for _, lock := range StorageGetLocks(who) {
+		if reflect.DeepEqual(lock.Id, id) {
+			if lock.Amount.ToBigInt().Cmp(amount) > 0 {
+				newLock.Amount = lock.Amount
+			}
+			newLock.Reasons = lock.Reasons.Or(newLock.Reasons)
+
+			locks = append(locks, newLock)
+			extended = true
+		} else {
+			locks = append(locks, lock)
+		}
+	}
+
+	if !extended {
+		locks = append(locks, newLock)
+	}
+
+	updateLocks(who, locks)
+}
+
+// RemoveLock removes the balance lock with the given `id` from `who`.
+func RemoveLock(id types.LockIdentifier, who types.Address32) {
+	locks := sc.Sequence[types.BalanceLock]{}
+	for _, lock := range StorageGetLocks(who) {
+		if !reflect.DeepEqual(lock.Id, id) {
+			locks = append(locks, lock)
+		}
+	}
+
+	updateLocks(who, locks)
+}
+
+// updateLocks stores the locks of `who` and recomputes its frozen balances from them.
+// An account with locks holds a consumer reference, so that it is not reaped while locked.
+func updateLocks(who types.Address32, locks sc.Sequence[types.BalanceLock]) {
+	if len(locks) > balances.MaxLocks {
+		log.Warn("Warning: A user has more currency locks than expected. A runtime configuration adjustment may be needed.")
+	}
+
+	miscFrozen := big.NewInt(0)
+	feeFrozen := big.NewInt(0)
+	for _, lock := range locks {
+		amount := lock.Amount.ToBigInt()
+		if lock.Reasons != types.ReasonsFee && amount.Cmp(miscFrozen) > 0 {
+			miscFrozen = amount
+		}
+		if lock.Reasons != types.ReasonsMisc && amount.Cmp(feeFrozen) > 0 {
+			feeFrozen = amount
+		}
+	}
+
+	mutateAccount(who, func(account *types.AccountData, _ bool) sc.Result[sc.Encodable] {
+		account.MiscFrozen = sc.NewU128FromBigInt(miscFrozen)
+		account.FeeFrozen = sc.NewU128FromBigInt(feeFrozen)
+
+		return sc.Result[sc.Encodable]{}
+	})
+
+	existed := storageContainsLocks(who)
+	if len(locks) == 0 {
+		storageRemoveLocks(who)
+		if existed {
+			system.DecConsumers(who)
+		}
+		return
+	}
+
+	storageSetLocks(who, locks)
+	if !existed {
+		err := system.IncConsumersWithoutLimit(who)
+		if err != nil {
+			log.Warn("Warning: Attempt to introduce lock consumer reference, yet no providers. This is unexpected but should be safe.")
+		}
+	}
+}
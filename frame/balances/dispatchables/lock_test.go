@@ -0,0 +1,180 @@
+//go:build nonwasmenv
+
+package dispatchables
+
+import (
+	"math/big"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/balances"
+	"github.com/LimeChain/gosemble/frame/balances/errors"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/frame/testutils"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	alice = testutils.NewAddress(1)
+	bob   = testutils.NewAddress(2)
+
+	lockId    = types.LockIdentifier(sc.BytesToFixedSequenceU8([]byte("staking ")))
+	reserveId = types.ReserveIdentifier(sc.BytesToFixedSequenceU8([]byte("deposits")))
+
+	liquidityRestrictions = testutils.NewModuleError(balances.ModuleIndex, errors.ErrorLiquidityRestrictions)
+)
+
+func dollars(amount uint64) *big.Int {
+	return new(big.Int).SetUint64(amount * constants.Dollar)
+}
+
+func assertBalance(t *testing.T, expected *big.Int, actual *big.Int) {
+	assert.Equal(t, sc.NewU128FromBigInt(expected), sc.NewU128FromBigInt(actual))
+}
+
+func setupAccount(who types.Address32, free *big.Int) {
+	testutils.SetupAccount(who, sc.NewU128FromBigInt(free))
+}
+
+func Test_Locks_Frozen(t *testing.T) {
+	var testExamples = []struct {
+		label      string
+		setup      func()
+		miscFrozen *big.Int
+		feeFrozen  *big.Int
+		locks      int
+	}{
+		{
+			label: "set_lock(transfer)",
+			setup: func() {
+				SetLock(lockId, alice, dollars(5), types.WithdrawReasonsTransfer)
+			},
+			miscFrozen: dollars(5),
+			feeFrozen:  big.NewInt(0),
+			locks:      1,
+		},
+		{
+			label: "set_lock(transaction payment)",
+			setup: func() {
+				SetLock(lockId, alice, dollars(5), types.WithdrawReasonsTransactionPayment)
+			},
+			miscFrozen: big.NewInt(0),
+			feeFrozen:  dollars(5),
+			locks:      1,
+		},
+		{
+			label: "set_lock replaces the lock with the same id",
+			setup: func() {
+				SetLock(lockId, alice, dollars(5), types.WithdrawReasonsTransfer)
+				SetLock(lockId, alice, dollars(3), types.WithdrawReasonsTransfer|types.WithdrawReasonsTransactionPayment)
+			},
+			miscFrozen: dollars(3),
+			feeFrozen:  dollars(3),
+			locks:      1,
+		},
+		{
+			label: "locks with different ids overlap",
+			setup: func() {
+				SetLock(lockId, alice, dollars(5), types.WithdrawReasonsTransfer)
+				SetLock(types.LockIdentifier(sc.BytesToFixedSequenceU8([]byte("vesting "))), alice, dollars(3), types.WithdrawReasonsTransfer)
+			},
+			miscFrozen: dollars(5),
+			feeFrozen:  big.NewInt(0),
+			locks:      2,
+		},
+		{
+			label: "extend_lock keeps the greater amount and combines the reasons",
+			setup: func() {
+				SetLock(lockId, alice, dollars(5), types.WithdrawReasonsTransfer)
+				ExtendLock(lockId, alice, dollars(3), types.WithdrawReasonsTransactionPayment)
+			},
+			miscFrozen: dollars(5),
+			feeFrozen:  dollars(5),
+			locks:      1,
+		},
+		{
+			label: "remove_lock",
+			setup: func() {
+				SetLock(lockId, alice, dollars(5), types.WithdrawReasonsTransfer)
+				RemoveLock(lockId, alice)
+			},
+			miscFrozen: big.NewInt(0),
+			feeFrozen:  big.NewInt(0),
+			locks:      0,
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			host.Reset()
+			setupAccount(alice, dollars(10))
+
+			testExample.setup()
+
+			account := system.StorageGetAccount(alice.FixedSequence)
+			assert.Equal(t, sc.NewU128FromBigInt(testExample.miscFrozen), account.Data.MiscFrozen)
+			assert.Equal(t, sc.NewU128FromBigInt(testExample.feeFrozen), account.Data.FeeFrozen)
+			assert.Len(t, StorageGetLocks(alice), testExample.locks)
+			assert.Equal(t, testExample.locks > 0, account.Consumers == 1)
+		})
+	}
+}
+
+func Test_Locks_Withdrawal(t *testing.T) {
+	host.Reset()
+	setupAccount(alice, dollars(10))
+	setupAccount(bob, dollars(10))
+
+	SetLock(lockId, alice, dollars(8), types.WithdrawReasonsTransfer)
+
+	err := trans(alice, bob, sc.NewU128FromBigInt(dollars(3)), types.ExistenceRequirementAllowDeath)
+	assert.Equal(t, liquidityRestrictions, err)
+
+	_, err = Withdraw(alice, sc.NewU128FromBigInt(dollars(3)), types.WithdrawReasonsTransactionPayment, types.ExistenceRequirementKeepAlive)
+	assert.Nil(t, err)
+
+	SetLock(lockId, alice, dollars(6), types.WithdrawReasonsTransactionPayment)
+
+	_, err = Withdraw(alice, sc.NewU128FromBigInt(dollars(2)), types.WithdrawReasonsTransactionPayment, types.ExistenceRequirementKeepAlive)
+	assert.Equal(t, liquidityRestrictions, err)
+}
+
+func Test_NamedReserves(t *testing.T) {
+	host.Reset()
+	setupAccount(alice, dollars(10))
+	setupAccount(bob, dollars(10))
+
+	assert.Nil(t, ReserveNamed(reserveId, alice, dollars(3)))
+	assert.Nil(t, ReserveNamed(reserveId, alice, dollars(2)))
+	assertBalance(t, dollars(5), ReservedBalanceNamed(reserveId, alice))
+	assert.Equal(t, sc.NewU128FromBigInt(dollars(5)), system.StorageGetAccount(alice.FixedSequence).Data.Reserved)
+
+	assertBalance(t, big.NewInt(0), UnreserveNamed(reserveId, alice, dollars(2)))
+	assertBalance(t, dollars(3), ReservedBalanceNamed(reserveId, alice))
+
+	remaining, err := RepatriateReservedNamed(reserveId, alice, bob, dollars(4), types.BalanceStatusReserved)
+	assert.Nil(t, err)
+	assertBalance(t, dollars(1), remaining)
+	assertBalance(t, big.NewInt(0), ReservedBalanceNamed(reserveId, alice))
+	assertBalance(t, dollars(3), ReservedBalanceNamed(reserveId, bob))
+
+	_, remaining = SlashReservedNamed(reserveId, bob, dollars(5))
+	assertBalance(t, dollars(2), remaining)
+	assert.Len(t, StorageGetReserves(bob), 0)
+}
+
+func Test_NamedReserves_TooManyReserves(t *testing.T) {
+	host.Reset()
+	setupAccount(alice, dollars(100))
+
+	for i := 0; i < balances.MaxReserves; i++ {
+		id := types.ReserveIdentifier(sc.BytesToFixedSequenceU8([]byte{0, 0, 0, 0, 0, 0, 0, byte(i)}))
+		assert.Nil(t, ReserveNamed(id, alice, big.NewInt(1)))
+	}
+
+	err := ReserveNamed(reserveId, alice, big.NewInt(1))
+	assert.Equal(t, testutils.NewModuleError(balances.ModuleIndex, errors.ErrorTooManyReserves), err)
+}
@@ -0,0 +1,217 @@
+package dispatchables
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/balances"
+	"github.com/LimeChain/gosemble/frame/balances/errors"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// ReservedBalanceNamed returns the amount of the balance of `who`, which is reserved under `id`.
+func ReservedBalanceNamed(id types.ReserveIdentifier, who types.Address32) *big.Int {
+	reserves := StorageGetReserves(who)
+
+	index, found := searchReserves(reserves, id)
+	if !found {
+		return big.NewInt(0)
+	}
+
+	return reserves[index].Amount.ToBigInt()
+}
+
+// ReserveNamed moves `value` from the free balance of `who` to its reserved balance under `id`.
+// Fails if the balance cannot be reserved or `who` has too many named reserves.
+func ReserveNamed(id types.ReserveIdentifier, who types.Address32, value *big.Int) types.DispatchError {
+	if value.Cmp(constants.Zero) == 0 {
+		return nil
+	}
+
+	reserves := StorageGetReserves(who)
+
+	index, found := searchReserves(reserves, id)
+	if found {
+		reserves[index].Amount = sc.NewU128FromBigInt(new(big.Int).Add(reserves[index].Amount.ToBigInt(), value))
+	} else {
+		if len(reserves) >= balances.MaxReserves {
+			return types.NewDispatchErrorModule(types.CustomModuleError{
+				Index:   balances.ModuleIndex,
+				Error:   sc.U32(errors.ErrorTooManyReserves),
+				Message: sc.NewOption[sc.Str](nil),
+			})
+		}
+
+		reserves = insertReserve(reserves, index, types.ReserveData{Id: id, Amount: sc.NewU128FromBigInt(value)})
+	}
+
+	err := Reserve(who, value)
+	if err != nil {
+		return err
+	}
+
+	storageSetReserves(who, reserves)
+
+	return nil
+}
+
+// UnreserveNamed moves up to `value` from the balance of `who`, reserved under `id`, to its free balance.
+// Returns the amount, which could not be unreserved.
+func UnreserveNamed(id types.ReserveIdentifier, who types.Address32, value *big.Int) *big.Int {
+	if value.Cmp(constants.Zero) == 0 {
+		return big.NewInt(0)
+	}
+
+	reserves := StorageGetReserves(who)
+
+	index, found := searchReserves(reserves, id)
+	if !found {
+		return value
+	}
+
+	toChange := minBalance(reserves[index].Amount.ToBigInt(), value)
+
+	// The reserved balance could be lower than the named reserve, if it was slashed directly.
+	remaining := Unreserve(who, toChange)
+	actual := new(big.Int).Sub(toChange, remaining)
+
+	storeReserveDecrease(who, reserves, index, actual)
+
+	return new(big.Int).Sub(value, actual)
+}
+
+// SlashReservedNamed deducts up to `value` from the balance of `who`, reserved under `id`.
+// Returns the negative imbalance of the slashed amount and the amount, which could not be slashed.
+func SlashReservedNamed(id types.ReserveIdentifier, who types.Address32, value *big.Int) (NegativeImbalance, *big.Int) {
+	if value.Cmp(constants.Zero) == 0 {
+		return NewNegativeImbalance(sc.NewU128FromUint64(0)), big.NewInt(0)
+	}
+
+	reserves := StorageGetReserves(who)
+
+	index, found := searchReserves(reserves, id)
+	if !found {
+		return NewNegativeImbalance(sc.NewU128FromUint64(0)), value
+	}
+
+	toChange := minBalance(reserves[index].Amount.ToBigInt(), value)
+
+	imbalance, remaining := SlashReserved(who, toChange)
+	actual := new(big.Int).Sub(toChange, remaining)
+
+	storeReserveDecrease(who, reserves, index, actual)
+
+	return imbalance, new(big.Int).Sub(value, actual)
+}
+
+// RepatriateReservedNamed moves up to `value` from the balance of `slashed`, reserved under `id`,
+// to the balance of `beneficiary`, either to its free balance or to its balance reserved under the same `id`,
+// depending on `status`. Returns the amount, which could not be moved.
+func RepatriateReservedNamed(id types.ReserveIdentifier, slashed types.Address32, beneficiary types.Address32, value *big.Int, status types.BalanceStatus) (*big.Int, types.DispatchError) {
+	if value.Cmp(constants.Zero) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	if reflect.DeepEqual(slashed, beneficiary) {
+		if status == types.BalanceStatusFree {
+			return UnreserveNamed(id, slashed, value), nil
+		}
+
+		return saturatingSub(value, ReservedBalanceNamed(id, slashed)), nil
+	}
+
+	reserves := StorageGetReserves(slashed)
+
+	index, found := searchReserves(reserves, id)
+	if !found {
+		return value, nil
+	}
+
+	toChange := minBalance(reserves[index].Amount.ToBigInt(), value)
+
+	var beneficiaryReserves sc.Sequence[types.ReserveData]
+	beneficiaryIndex, beneficiaryFound := 0, false
+	if status == types.BalanceStatusReserved {
+		beneficiaryReserves = StorageGetReserves(beneficiary)
+		beneficiaryIndex, beneficiaryFound = searchReserves(beneficiaryReserves, id)
+		if !beneficiaryFound && len(beneficiaryReserves) >= balances.MaxReserves {
+			return value, types.NewDispatchErrorModule(types.CustomModuleError{
+				Index:   balances.ModuleIndex,
+				Error:   sc.U32(errors.ErrorTooManyReserves),
+				Message: sc.NewOption[sc.Str](nil),
+			})
+		}
+	}
+
+	remaining, err := RepatriateReserved(slashed, beneficiary, toChange, status)
+	if err != nil {
+		return value, err
+	}
+	actual := new(big.Int).Sub(toChange, remaining)
+
+	if status == types.BalanceStatusReserved {
+		if beneficiaryFound {
+			amount := new(big.Int).Add(beneficiaryReserves[beneficiaryIndex].Amount.ToBigInt(), actual)
+			beneficiaryReserves[beneficiaryIndex].Amount = sc.NewU128FromBigInt(amount)
+		} else {
+			beneficiaryReserves = insertReserve(beneficiaryReserves, beneficiaryIndex, types.ReserveData{Id: id, Amount: sc.NewU128FromBigInt(actual)})
+		}
+		storageSetReserves(beneficiary, beneficiaryReserves)
+	}
+
+	reserves[index].Amount = sc.NewU128FromBigInt(new(big.Int).Sub(reserves[index].Amount.ToBigInt(), actual))
+	storageSetReserves(slashed, reserves)
+
+	return new(big.Int).Sub(value, actual), nil
+}
+
+// storeReserveDecrease decreases the named reserve at `index` by `amount` and stores the reserves of `who`.
+// The named reserve is removed once it is empty.
+func storeReserveDecrease(who types.Address32, reserves sc.Sequence[types.ReserveData], index int, amount *big.Int) {
+	reserves[index].Amount = sc.NewU128FromBigInt(new(big.Int).Sub(reserves[index].Amount.ToBigInt(), amount))
+
+	if reserves[index].Amount.ToBigInt().Cmp(constants.Zero) == 0 {
+		reserves = append(reserves[:index], reserves[index+1:]...)
+	}
+
+	if len(reserves) == 0 {
+		storageRemoveReserves(who)
+		return
+	}
+
+	storageSetReserves(who, reserves)
+}
+
+// searchReserves returns the index of the named reserve with `id`, if it is found,
+// or the index at which it should be inserted to keep the reserves ordered.
+func searchReserves(reserves sc.Sequence[types.ReserveData], id types.ReserveIdentifier) (int, bool) {
+	idBytes := sc.FixedSequenceU8ToBytes(id)
+
+	index := sort.Search(len(reserves), func(i int) bool {
+		return bytes.Compare(sc.FixedSequenceU8ToBytes(reserves[i].Id), idBytes) >= 0
+	})
+
+	found := index < len(reserves) && bytes.Equal(sc.FixedSequenceU8ToBytes(reserves[index].Id), idBytes)
+
+	return index, found
+}
+
+func insertReserve(reserves sc.Sequence[types.ReserveData], index int, reserve types.ReserveData) sc.Sequence[types.ReserveData] {
+	reserves = append(reserves, types.ReserveData{})
+	copy(reserves[index+1:], reserves[index:])
+	reserves[index] = reserve
+
+	return reserves
+}
+
+func minBalance(a, b *big.Int) *big.Int {
+	if a.Cmp(b) < 0 {
+		return a
+	}
+
+	return b
+}
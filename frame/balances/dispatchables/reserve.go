@@ -0,0 +1,160 @@
+package dispatchables
+
+import (
+	"math/big"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/balances"
+	"github.com/LimeChain/gosemble/frame/balances/errors"
+	"github.com/LimeChain/gosemble/frame/balances/events"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Reserve moves `value` from the free balance of `who` to its reserved balance.
+// Fails if the free balance is not sufficient or is restricted by locks.
+func Reserve(who types.Address32, value *big.Int) types.DispatchError {
+	if value.Cmp(constants.Zero) == 0 {
+		return nil
+	}
+
+	result := tryMutateAccount(who, func(account *types.AccountData, _ bool) sc.Result[sc.Encodable] {
+		newFree := new(big.Int).Sub(account.Free.ToBigInt(), value)
+		if newFree.Cmp(constants.Zero) < 0 {
+			return sc.Result[sc.Encodable]{
+				HasError: true,
+				Value: types.NewDispatchErrorModule(types.CustomModuleError{
+					Index:   balances.ModuleIndex,
+					Error:   sc.U32(errors.ErrorInsufficientBalance),
+					Message: sc.NewOption[sc.Str](nil),
+				}),
+			}
+		}
+
+		err := ensureCanWithdraw(who, value, types.NewReasonsFromWithdrawReasons(types.WithdrawReasonsReserve), newFree)
+		if err != nil {
+			return sc.Result[sc.Encodable]{
+				HasError: true,
+				Value:    err,
+			}
+		}
+
+		account.Free = sc.NewU128FromBigInt(newFree)
+		account.Reserved = sc.NewU128FromBigInt(new(big.Int).Add(account.Reserved.ToBigInt(), value))
+
+		return sc.Result[sc.Encodable]{}
+	})
+
+	if result.HasError {
+		return result.Value.(types.DispatchError)
+	}
+
+	system.DepositEvent(events.NewEventReserved(who.FixedSequence, sc.NewU128FromBigInt(value)))
+
+	return nil
+}
+
+// Unreserve moves up to `value` from the reserved balance of `who` to its free balance.
+// Returns the amount, which could not be unreserved.
+func Unreserve(who types.Address32, value *big.Int) *big.Int {
+	return force(who, value)
+}
+
+// RepatriateReserved moves up to `value` from the reserved balance of `slashed` to the balance of `beneficiary`,
+// either to its free or reserved balance, depending on `status`.
+// Returns the amount, which could not be moved. Fails if `beneficiary` does not exist.
+func RepatriateReserved(slashed types.Address32, beneficiary types.Address32, value *big.Int, status types.BalanceStatus) (*big.Int, types.DispatchError) {
+	if value.Cmp(constants.Zero) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	if reflect.DeepEqual(slashed, beneficiary) {
+		if status == types.BalanceStatusFree {
+			return Unreserve(slashed, value), nil
+		}
+
+		reserved := system.StorageGetAccount(slashed.FixedSequence).Data.Reserved.ToBigInt()
+		return saturatingSub(value, reserved), nil
+	}
+
+	actual := big.NewInt(0)
+	result := tryMutateAccount(beneficiary, func(to *types.AccountData, isNew bool) sc.Result[sc.Encodable] {
+		if isNew {
+			return sc.Result[sc.Encodable]{
+				HasError: true,
+				Value: types.NewDispatchErrorModule(types.CustomModuleError{
+					Index:   balances.ModuleIndex,
+					Error:   sc.U32(errors.ErrorDeadAccount),
+					Message: sc.NewOption[sc.Str](nil),
+				}),
+			}
+		}
+
+		return tryMutateAccount(slashed, func(from *types.AccountData, _ bool) sc.Result[sc.Encodable] {
+			actual = from.Reserved.ToBigInt()
+			if value.Cmp(actual) < 0 {
+				actual = value
+			}
+
+			if status == types.BalanceStatusFree {
+				to.Free = sc.NewU128FromBigInt(new(big.Int).Add(to.Free.ToBigInt(), actual))
+			} else {
+				to.Reserved = sc.NewU128FromBigInt(new(big.Int).Add(to.Reserved.ToBigInt(), actual))
+			}
+			from.Reserved = sc.NewU128FromBigInt(new(big.Int).Sub(from.Reserved.ToBigInt(), actual))
+
+			return sc.Result[sc.Encodable]{}
+		})
+	})
+
+	if result.HasError {
+		return value, result.Value.(types.DispatchError)
+	}
+
+	system.DepositEvent(events.NewEventReserveRepatriated(slashed.FixedSequence, beneficiary.FixedSequence, sc.NewU128FromBigInt(actual), status))
+
+	return new(big.Int).Sub(value, actual), nil
+}
+
+// SlashReserved deducts up to `value` from the reserved balance of `who`.
+// Returns the negative imbalance of the slashed amount and the amount, which could not be slashed.
+func SlashReserved(who types.Address32, value *big.Int) (NegativeImbalance, *big.Int) {
+	if value.Cmp(constants.Zero) == 0 {
+		return NewNegativeImbalance(sc.NewU128FromUint64(0)), big.NewInt(0)
+	}
+
+	if totalBalance(who).Cmp(constants.Zero) == 0 {
+		return NewNegativeImbalance(sc.NewU128FromUint64(0)), value
+	}
+
+	actual := big.NewInt(0)
+	result := mutateAccount(who, func(account *types.AccountData, _ bool) sc.Result[sc.Encodable] {
+		actual = account.Reserved.ToBigInt()
+		if value.Cmp(actual) < 0 {
+			actual = value
+		}
+
+		account.Reserved = sc.NewU128FromBigInt(new(big.Int).Sub(account.Reserved.ToBigInt(), actual))
+
+		return sc.Result[sc.Encodable]{}
+	})
+
+	if result.HasError {
+		return NewNegativeImbalance(sc.NewU128FromUint64(0)), value
+	}
+
+	system.DepositEvent(events.NewEventSlashed(who.FixedSequence, sc.NewU128FromBigInt(actual)))
+
+	return NewNegativeImbalance(sc.NewU128FromBigInt(actual)), new(big.Int).Sub(value, actual)
+}
+
+func saturatingSub(a, b *big.Int) *big.Int {
+	result := new(big.Int).Sub(a, b)
+	if result.Cmp(constants.Zero) < 0 {
+		return big.NewInt(0)
+	}
+
+	return result
+}
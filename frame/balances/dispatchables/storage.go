@@ -0,0 +1,98 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	storageTotalIssuance = support.NewStorageValue[sc.U128](constants.KeyBalances, constants.KeyTotalIssuance, sc.DecodeU128)
+	// storageInactiveIssuance is declared for the clients, but never written, since no balance is deactivated.
+	storageInactiveIssuance = support.NewStorageValue[sc.U128](constants.KeyBalances, constants.KeyInactiveIssuance, sc.DecodeU128)
+	// storageAccount is declared for the clients, but never written. The balances of the accounts
+	// are stored in the account data of the system module.
+	storageAccount  = support.NewStorageMap[types.Address32, types.AccountData](constants.KeyBalances, constants.KeyAccount, support.Blake2_128Concat{}, types.DecodeAddress32, types.DecodeAccountData)
+	storageLocks    = support.NewStorageMap[types.Address32, sc.Sequence[types.BalanceLock]](constants.KeyBalances, constants.KeyLocks, support.Blake2_128Concat{}, types.DecodeAddress32, decodeLocks)
+	storageReserves = support.NewStorageMap[types.Address32, sc.Sequence[types.ReserveData]](constants.KeyBalances, constants.KeyReserves, support.Blake2_128Concat{}, types.DecodeAddress32, decodeReserves)
+)
+
+// StorageDeclarations returns the declarations of the storage items of the module, in the order of the metadata.
+func StorageDeclarations() []support.StorageDeclaration {
+	return []support.StorageDeclaration{
+		{
+			Item:     storageTotalIssuance,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "The total units issued in the system.",
+		},
+		{
+			Item:     storageInactiveIssuance,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "The total units of outstanding deactivated balance in the system.",
+		},
+		{
+			Item:     storageAccount,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "The Balances pallet example of storing the balance of an account.",
+		},
+		{
+			Item:     storageLocks,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "Any liquidity locks on some account balances. NOTE: Should only be accessed when setting, changing and freeing a lock.",
+		},
+		{
+			Item:     storageReserves,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "Named reserves on some account balances.",
+		},
+	}
+}
+
+func storageGetTotalIssuance() sc.U128 {
+	return storageTotalIssuance.Get()
+}
+
+func storageSetTotalIssuance(issuance sc.U128) {
+	storageTotalIssuance.Put(issuance)
+}
+
+// StorageGetLocks returns the balance locks of `who`.
+func StorageGetLocks(who types.Address32) sc.Sequence[types.BalanceLock] {
+	return storageLocks.Get(who)
+}
+
+func storageSetLocks(who types.Address32, locks sc.Sequence[types.BalanceLock]) {
+	storageLocks.Put(who, locks)
+}
+
+func storageRemoveLocks(who types.Address32) {
+	storageLocks.Remove(who)
+}
+
+func storageContainsLocks(who types.Address32) bool {
+	return storageLocks.Contains(who)
+}
+
+// StorageGetReserves returns the named reserves of `who`, ordered by their identifiers.
+func StorageGetReserves(who types.Address32) sc.Sequence[types.ReserveData] {
+	return storageReserves.Get(who)
+}
+
+func storageSetReserves(who types.Address32, reserves sc.Sequence[types.ReserveData]) {
+	storageReserves.Put(who, reserves)
+}
+
+func storageRemoveReserves(who types.Address32) {
+	storageReserves.Remove(who)
+}
+
+func decodeLocks(buffer *bytes.Buffer) sc.Sequence[types.BalanceLock] {
+	return sc.DecodeSequenceWith(buffer, types.DecodeBalanceLock)
+}
+
+func decodeReserves(buffer *bytes.Buffer) sc.Sequence[types.ReserveData] {
+	return sc.DecodeSequenceWith(buffer, types.DecodeReserveData)
+}
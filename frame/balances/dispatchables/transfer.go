@@ -168,7 +168,7 @@ func trans(from types.Address32, to types.Address32, value sc.U128, existenceReq
 				}
 			}
 
-			err := ensureCanWithdraw(from, value.ToBigInt(), types.ReasonsAll, fromAccount.Free.ToBigInt())
+			err := ensureCanWithdraw(from, value.ToBigInt(), types.NewReasonsFromWithdrawReasons(types.WithdrawReasonsTransfer), fromAccount.Free.ToBigInt())
 			if err != nil {
 				return sc.Result[sc.Encodable]{
 					HasError: true,
@@ -0,0 +1,28 @@
+package dispatchables
+
+import (
+	"fmt"
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// TryState checks that the total issuance equals the sum of the free and reserved balances of all accounts.
+func TryState() types.DispatchError {
+	sum := big.NewInt(0)
+	system.StorageIterateAccounts(func(_ types.PublicKey, account types.AccountInfo) bool {
+		sum.Add(sum, account.Data.Free.ToBigInt())
+		sum.Add(sum, account.Data.Reserved.ToBigInt())
+		return true
+	})
+
+	issuance := storageGetTotalIssuance()
+
+	if issuance.ToBigInt().Cmp(sum) != 0 {
+		return types.NewDispatchErrorOther(sc.Str(fmt.Sprintf("total issuance %s is not equal to the sum of all balances %s", issuance.ToBigInt(), sum)))
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+//go:build nonwasmenv
+
+package dispatchables
+
+import (
+	"math/big"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func setTotalIssuance(issuance *big.Int) {
+	key := append(hashing.Twox128(constants.KeyBalances), hashing.Twox128(constants.KeyTotalIssuance)...)
+	storage.Set(key, sc.NewU128FromBigInt(issuance).Bytes())
+}
+
+func Test_TryState(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		issuance    *big.Int
+		expectError bool
+	}{
+		{
+			label:       "Total issuance equal to the sum of balances",
+			issuance:    dollars(25),
+			expectError: false,
+		},
+		{
+			label:       "Total issuance different from the sum of balances",
+			issuance:    dollars(26),
+			expectError: true,
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			host.Reset()
+			setupAccount(alice, dollars(10))
+			setupAccount(bob, dollars(15))
+			assert.Nil(t, Reserve(bob, dollars(5)))
+			setTotalIssuance(testExample.issuance)
+
+			err := TryState()
+
+			if testExample.expectError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
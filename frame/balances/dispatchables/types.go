@@ -5,11 +5,8 @@ import (
 	"math/big"
 
 	sc "github.com/LimeChain/goscale"
-	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/frame/balances/events"
 	"github.com/LimeChain/gosemble/frame/system"
-	"github.com/LimeChain/gosemble/primitives/hashing"
-	"github.com/LimeChain/gosemble/primitives/storage"
 	"github.com/LimeChain/gosemble/primitives/types"
 )
 
@@ -22,9 +19,7 @@ func NewNegativeImbalance(balance types.Balance) NegativeImbalance {
 }
 
 func (ni NegativeImbalance) Drop() {
-	key := append(hashing.Twox128(constants.KeyBalances), hashing.Twox128(constants.KeyTotalIssuance)...)
-
-	issuance := storage.GetDecode(key, sc.DecodeU128)
+	issuance := storageGetTotalIssuance()
 
 	issuanceBn := issuance.ToBigInt()
 	sub := new(big.Int).Sub(issuanceBn, ni.ToBigInt())
@@ -33,7 +28,7 @@ func (ni NegativeImbalance) Drop() {
 		sub = issuanceBn
 	}
 
-	storage.Set(key, sc.NewU128FromBigInt(sub).Bytes())
+	storageSetTotalIssuance(sc.NewU128FromBigInt(sub))
 }
 
 type PositiveImbalance struct {
@@ -45,9 +40,7 @@ func NewPositiveImbalance(balance types.Balance) PositiveImbalance {
 }
 
 func (pi PositiveImbalance) Drop() {
-	key := append(hashing.Twox128(constants.KeyBalances), hashing.Twox128(constants.KeyTotalIssuance)...)
-
-	issuance := storage.GetDecode(key, sc.DecodeU128)
+	issuance := storageGetTotalIssuance()
 
 	issuanceBn := issuance.ToBigInt()
 	add := new(big.Int).Add(issuanceBn, pi.ToBigInt())
@@ -56,7 +49,7 @@ func (pi PositiveImbalance) Drop() {
 		add = issuanceBn
 	}
 
-	storage.Set(key, sc.NewU128FromBigInt(add).Bytes())
+	storageSetTotalIssuance(sc.NewU128FromBigInt(add))
 }
 
 type DustCleanerValue struct {
@@ -51,7 +51,7 @@ func Withdraw(who types.Address32, value sc.U128, reasons sc.U8, liveness types.
 			}
 		}
 
-		err := ensureCanWithdraw(who, value.ToBigInt(), types.Reasons(reasons), newFromAccountFree)
+		err := ensureCanWithdraw(who, value.ToBigInt(), types.NewReasonsFromWithdrawReasons(reasons), newFromAccountFree)
 		if err != nil {
 			return sc.Result[sc.Encodable]{
 				HasError: true,
@@ -7,10 +7,12 @@ import (
 	"github.com/LimeChain/gosemble/frame/balances/dispatchables"
 	"github.com/LimeChain/gosemble/frame/balances/errors"
 	"github.com/LimeChain/gosemble/frame/balances/events"
+	"github.com/LimeChain/gosemble/frame/support"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
 type BalancesModule struct {
+	primitives.DefaultHooks
 	functions map[sc.U8]primitives.Call
 }
 
@@ -40,150 +42,67 @@ func (bm BalancesModule) ValidateUnsigned(_ primitives.TransactionSource, _ prim
 	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
 }
 
+func (bm BalancesModule) TryState(_ primitives.BlockNumber) primitives.DispatchError {
+	return dispatchables.TryState()
+}
+
 func (bm BalancesModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
-	return bm.metadataTypes(), primitives.MetadataModule{
-		Name: "Balances",
-		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
-			Prefix: "Balances",
-			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
-				primitives.NewMetadataModuleStorageEntry(
-					"TotalIssuance",
-					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU128)),
-					"The total units issued in the system."),
-				primitives.NewMetadataModuleStorageEntry(
-					"InactiveIssuance",
-					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU128)),
-					"The total units of outstanding deactivated balance in the system."),
-				primitives.NewMetadataModuleStorageEntry(
-					"Account",
-					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionMap(
-						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
-						sc.ToCompact(metadata.TypesAddress32),
-						sc.ToCompact(metadata.TypesAccountData)),
-					"The Balances pallet example of storing the balance of an account."),
-				// TODO: Locks, Reserves, currently not used
+	declaredTypes, metadataModule := bm.declaration().Metadata()
+
+	return append(bm.metadataTypes(), declaredTypes...), metadataModule
+}
+
+// declaration declares the storage, calls, events, errors and constants of the module,
+// from which its metadata is derived.
+func (bm BalancesModule) declaration() support.ModuleDeclaration {
+	return support.ModuleDeclaration{
+		Name:    "Balances",
+		Index:   balances.ModuleIndex,
+		Path:    "pallet_balances",
+		Storage: dispatchables.StorageDeclarations(),
+		Calls: &support.EnumDeclaration{
+			TypeId:   metadata.BalancesCalls,
+			Variants: callDeclarations,
+		},
+		Events: &support.EnumDeclaration{
+			TypeId:   metadata.TypesBalancesEvent,
+			Variants: eventDeclarations,
+		},
+		Errors: &support.EnumDeclaration{
+			TypeId:   metadata.TypesBalancesErrors,
+			Variants: errorDeclarations,
+		},
+		Constants: []support.ConstantDeclaration{
+			{
+				Name:  "ExistentialDeposit",
+				Value: sc.NewU128FromBigInt(balances.ExistentialDeposit),
+				Docs:  "The minimum amount required to keep an account open. MUST BE GREATER THAN ZERO!",
+			},
+			{
+				Name:  "MaxLocks",
+				Value: sc.U32(balances.MaxLocks),
+				Docs:  "The maximum number of locks that should exist on an account.  Not strictly enforced, but used for weight estimation.",
 			},
-		}),
-		Call:  sc.NewOption[sc.Compact](sc.ToCompact(metadata.BalancesCalls)),
-		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesBalancesEvent)),
-		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
-			primitives.NewMetadataModuleConstant(
-				"ExistentialDeposit",
-				sc.ToCompact(metadata.PrimitiveTypesU128),
-				sc.BytesToSequenceU8(sc.NewU128FromBigInt(balances.ExistentialDeposit).Bytes()),
-				"The minimum amount required to keep an account open. MUST BE GREATER THAN ZERO!",
-			),
-			primitives.NewMetadataModuleConstant(
-				"MaxLocks",
-				sc.ToCompact(metadata.PrimitiveTypesU32),
-				sc.BytesToSequenceU8(sc.U32(balances.MaxLocks).Bytes()),
-				"The maximum number of locks that should exist on an account.  Not strictly enforced, but used for weight estimation.",
-			),
-			primitives.NewMetadataModuleConstant(
-				"MaxReserves",
-				sc.ToCompact(metadata.PrimitiveTypesU32),
-				sc.BytesToSequenceU8(sc.U32(balances.MaxReserves).Bytes()),
-				"The maximum number of named reserves that can exist on an account.",
-			),
-		}, // TODO:
-		Error: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesBalancesErrors)),
-		Index: balances.ModuleIndex,
+			{
+				Name:  "MaxReserves",
+				Value: sc.U32(balances.MaxReserves),
+				Docs:  "The maximum number of named reserves that can exist on an account.",
+			},
+		},
 	}
 }
 
 func (bm BalancesModule) metadataTypes() sc.Sequence[primitives.MetadataType] {
 	return sc.Sequence[primitives.MetadataType]{
-		primitives.NewMetadataTypeWithPath(metadata.TypesBalancesEvent, "pallet_balances pallet Event", sc.Sequence[sc.Str]{"pallet_balances", "pallet", "Event"}, primitives.NewMetadataTypeDefinitionVariant(
-			sc.Sequence[primitives.MetadataDefinitionVariant]{
-				primitives.NewMetadataDefinitionVariant(
-					"Endowed",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "account", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "free_balance", "T::Balance"),
-					},
-					events.EventEndowed,
-					"Event.Endowed"),
-				primitives.NewMetadataDefinitionVariant(
-					"DustLost",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "account", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
-					},
-					events.EventDustLost,
-					"Events.DustLost"),
-				primitives.NewMetadataDefinitionVariant(
-					"Transfer",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "from", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "to", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
-					},
-					events.EventTransfer,
-					"Events.Transfer"),
-				primitives.NewMetadataDefinitionVariant(
-					"BalanceSet",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "free", "T::Balance"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "reserved", "T::Balance"),
-					},
-					events.EventBalanceSet,
-					"Events.BalanceSet"),
-				primitives.NewMetadataDefinitionVariant(
-					"Reserved",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
-					},
-					events.EventReserved,
-					"Events.Reserved"),
-				primitives.NewMetadataDefinitionVariant(
-					"Unreserved",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
-					},
-					events.EventUnreserved,
-					"Events.Unreserved"),
-				primitives.NewMetadataDefinitionVariant(
-					"ReserveRepatriated",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "from", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "to", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBalanceStatus, "destination_status", "Status"),
-					},
-					events.EventReserveRepatriated,
-					"Events.ReserveRepatriated"),
-				primitives.NewMetadataDefinitionVariant(
-					"Deposit",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
-					},
-					events.EventDeposit,
-					"Event.Deposit"),
-				primitives.NewMetadataDefinitionVariant(
-					"Withdraw",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
-					},
-					events.EventWithdraw,
-					"Event.Withdraw"),
-				primitives.NewMetadataDefinitionVariant(
-					"Slashed",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
-					},
-					events.EventSlashed,
-					"Event.Slashed"),
+		primitives.NewMetadataTypeWithPath(metadata.TypesAccountData, "AccountData", sc.Sequence[sc.Str]{"pallet_balances", "AccountData"}, primitives.NewMetadataTypeDefinitionComposite(
+			sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "free", "Balance"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "reserved", "Balance"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "misc_frozen", "Balance"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "fee_frozen", "Balance"),
 			},
 		)),
+
 		primitives.NewMetadataTypeWithPath(metadata.TypesBalanceStatus,
 			"BalanceStatus",
 			sc.Sequence[sc.Str]{"frame_support", "traits", "tokens", "misc", "BalanceStatus"}, primitives.NewMetadataTypeDefinitionVariant(
@@ -200,113 +119,241 @@ func (bm BalancesModule) metadataTypes() sc.Sequence[primitives.MetadataType] {
 						"BalanceStatus.Reserved"),
 				})),
 
-		primitives.NewMetadataTypeWithParams(metadata.TypesBalancesErrors,
-			"pallet_balances pallet Error",
-			sc.Sequence[sc.Str]{"pallet_balances", "pallet", "Error"},
-			primitives.NewMetadataTypeDefinitionVariant(
+		primitives.NewMetadataTypeWithPath(metadata.TypesReasons,
+			"Reasons",
+			sc.Sequence[sc.Str]{"pallet_balances", "Reasons"}, primitives.NewMetadataTypeDefinitionVariant(
 				sc.Sequence[primitives.MetadataDefinitionVariant]{
 					primitives.NewMetadataDefinitionVariant(
-						"VestingBalance",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						errors.ErrorVestingBalance,
-						"Vesting balance too high to send value"),
-					primitives.NewMetadataDefinitionVariant(
-						"LiquidityRestrictions",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						errors.ErrorLiquidityRestrictions,
-						"Account liquidity restrictions prevent withdrawal"),
-					primitives.NewMetadataDefinitionVariant(
-						"InsufficientBalance",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						errors.ErrorInsufficientBalance,
-						"Balance too low to send value."),
-					primitives.NewMetadataDefinitionVariant(
-						"ExistentialDeposit",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						errors.ErrorExistentialDeposit,
-						"Value too low to create account due to existential deposit"),
-					primitives.NewMetadataDefinitionVariant(
-						"KeepAlive",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						errors.ErrorKeepAlive,
-						"Transfer/payment would kill account"),
-					primitives.NewMetadataDefinitionVariant(
-						"ExistingVestingSchedule",
+						"Fee",
 						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						errors.ErrorExistingVestingSchedule,
-						"A vesting schedule already exists for this account"),
+						sc.U8(primitives.ReasonsFee),
+						"Reasons.Fee"),
 					primitives.NewMetadataDefinitionVariant(
-						"DeadAccount",
+						"Misc",
 						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						errors.ErrorDeadAccount,
-						"Beneficiary account must pre-exist"),
+						sc.U8(primitives.ReasonsMisc),
+						"Reasons.Misc"),
 					primitives.NewMetadataDefinitionVariant(
-						"TooManyReserves",
+						"All",
 						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						errors.ErrorTooManyReserves,
-						"Number of named reserves exceed MaxReserves"),
-				}),
-			sc.Sequence[primitives.MetadataTypeParameter]{
-				primitives.NewMetadataEmptyTypeParameter("T"),
-				primitives.NewMetadataEmptyTypeParameter("I"),
-			}),
-
-		primitives.NewMetadataTypeWithParams(metadata.BalancesCalls, "Balances calls", sc.Sequence[sc.Str]{"pallet_balances", "pallet", "Call"}, primitives.NewMetadataTypeDefinitionVariant(
-			sc.Sequence[primitives.MetadataDefinitionVariant]{
-				primitives.NewMetadataDefinitionVariant(
-					"transfer",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "dest", "AccountIdLookupOf<T>"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "value", "T::Balance"),
-					},
-					balances.FunctionTransferIndex,
-					"Transfer some liquid free balance to another account."),
-				primitives.NewMetadataDefinitionVariant(
-					"set_balance",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "who", "AccountIdLookupOf<T>"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "new_free", "T::Balance"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "new_reserved", "T::Balance"),
-					},
-					balances.FunctionSetBalanceIndex,
-					"Set the balances of a given account."),
-				primitives.NewMetadataDefinitionVariant(
-					"force_transfer",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "source", "AccountIdLookupOf<T>"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "dest", "AccountIdLookupOf<T>"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "value", "T::Balance"),
-					},
-					balances.FunctionForceTransferIndex,
-					"Exactly as `transfer`, except the origin must be root and the source account may be specified."),
-				primitives.NewMetadataDefinitionVariant(
-					"transfer_keep_alive",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "dest", "AccountIdLookupOf<T>"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "value", "T::Balance"),
-					},
-					balances.FunctionTransferKeepAliveIndex,
-					"Same as the [`transfer`] call, but with a check that the transfer will not kill the origin account."),
-				primitives.NewMetadataDefinitionVariant(
-					"transfer_all",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "dest", "AccountIdLookupOf<T>"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "keep_alive", "bool"),
-					},
-					balances.FunctionTransferAllIndex,
-					"Transfer the entire transferable balance from the caller account."),
-				primitives.NewMetadataDefinitionVariant(
-					"force_unreserve",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "who", "AccountIdLookupOf<T>"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
-					},
-					balances.FunctionForceFreeIndex,
-					"Unreserve some balance from a user by force."),
-			}),
-			sc.Sequence[primitives.MetadataTypeParameter]{
-				primitives.NewMetadataEmptyTypeParameter("T"),
-				primitives.NewMetadataEmptyTypeParameter("I"),
-			}),
+						sc.U8(primitives.ReasonsAll),
+						"Reasons.All"),
+				})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesBalanceLock, "BalanceLock", sc.Sequence[sc.Str]{"pallet_balances", "BalanceLock"}, primitives.NewMetadataTypeDefinitionComposite(
+			sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence8U8, "id", "LockIdentifier"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "Balance"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesReasons, "reasons", "Reasons"),
+			},
+		)),
+		primitives.NewMetadataType(metadata.TypesSequenceBalanceLock, "Vec<BalanceLock>",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesBalanceLock))),
+		primitives.NewMetadataTypeWithPath(metadata.TypesReserveData, "ReserveData", sc.Sequence[sc.Str]{"pallet_balances", "ReserveData"}, primitives.NewMetadataTypeDefinitionComposite(
+			sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence8U8, "id", "ReserveIdentifier"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "Balance"),
+			},
+		)),
+		primitives.NewMetadataType(metadata.TypesSequenceReserveData, "Vec<ReserveData>",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesReserveData))),
 	}
 }
+
+var callDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "transfer",
+		Index: balances.FunctionTransferIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "dest", "AccountIdLookupOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "value", "T::Balance"),
+		},
+		Docs: "Transfer some liquid free balance to another account.",
+	},
+	{
+		Name:  "set_balance",
+		Index: balances.FunctionSetBalanceIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "who", "AccountIdLookupOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "new_free", "T::Balance"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "new_reserved", "T::Balance"),
+		},
+		Docs: "Set the balances of a given account.",
+	},
+	{
+		Name:  "force_transfer",
+		Index: balances.FunctionForceTransferIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "source", "AccountIdLookupOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "dest", "AccountIdLookupOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "value", "T::Balance"),
+		},
+		Docs: "Exactly as `transfer`, except the origin must be root and the source account may be specified.",
+	},
+	{
+		Name:  "transfer_keep_alive",
+		Index: balances.FunctionTransferKeepAliveIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "dest", "AccountIdLookupOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "value", "T::Balance"),
+		},
+		Docs: "Same as the [`transfer`] call, but with a check that the transfer will not kill the origin account.",
+	},
+	{
+		Name:  "transfer_all",
+		Index: balances.FunctionTransferAllIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "dest", "AccountIdLookupOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "keep_alive", "bool"),
+		},
+		Docs: "Transfer the entire transferable balance from the caller account.",
+	},
+	{
+		Name:  "force_unreserve",
+		Index: balances.FunctionForceFreeIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "who", "AccountIdLookupOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
+		},
+		Docs: "Unreserve some balance from a user by force.",
+	},
+}
+
+var eventDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "Endowed",
+		Index: events.EventEndowed,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "account", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "free_balance", "T::Balance"),
+		},
+		Docs: "Event.Endowed",
+	},
+	{
+		Name:  "DustLost",
+		Index: events.EventDustLost,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "account", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
+		},
+		Docs: "Events.DustLost",
+	},
+	{
+		Name:  "Transfer",
+		Index: events.EventTransfer,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "from", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "to", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
+		},
+		Docs: "Events.Transfer",
+	},
+	{
+		Name:  "BalanceSet",
+		Index: events.EventBalanceSet,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "free", "T::Balance"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "reserved", "T::Balance"),
+		},
+		Docs: "Events.BalanceSet",
+	},
+	{
+		Name:  "Reserved",
+		Index: events.EventReserved,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
+		},
+		Docs: "Events.Reserved",
+	},
+	{
+		Name:  "Unreserved",
+		Index: events.EventUnreserved,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
+		},
+		Docs: "Events.Unreserved",
+	},
+	{
+		Name:  "ReserveRepatriated",
+		Index: events.EventReserveRepatriated,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "from", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "to", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBalanceStatus, "destination_status", "Status"),
+		},
+		Docs: "Events.ReserveRepatriated",
+	},
+	{
+		Name:  "Deposit",
+		Index: events.EventDeposit,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
+		},
+		Docs: "Event.Deposit",
+	},
+	{
+		Name:  "Withdraw",
+		Index: events.EventWithdraw,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
+		},
+		Docs: "Event.Withdraw",
+	},
+	{
+		Name:  "Slashed",
+		Index: events.EventSlashed,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "amount", "T::Balance"),
+		},
+		Docs: "Event.Slashed",
+	},
+}
+
+var errorDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "VestingBalance",
+		Index: errors.ErrorVestingBalance,
+		Docs:  "Vesting balance too high to send value",
+	},
+	{
+		Name:  "LiquidityRestrictions",
+		Index: errors.ErrorLiquidityRestrictions,
+		Docs:  "Account liquidity restrictions prevent withdrawal",
+	},
+	{
+		Name:  "InsufficientBalance",
+		Index: errors.ErrorInsufficientBalance,
+		Docs:  "Balance too low to send value.",
+	},
+	{
+		Name:  "ExistentialDeposit",
+		Index: errors.ErrorExistentialDeposit,
+		Docs:  "Value too low to create account due to existential deposit",
+	},
+	{
+		Name:  "KeepAlive",
+		Index: errors.ErrorKeepAlive,
+		Docs:  "Transfer/payment would kill account",
+	},
+	{
+		Name:  "ExistingVestingSchedule",
+		Index: errors.ErrorExistingVestingSchedule,
+		Docs:  "A vesting schedule already exists for this account",
+	},
+	{
+		Name:  "DeadAccount",
+		Index: errors.ErrorDeadAccount,
+		Docs:  "Beneficiary account must pre-exist",
+	},
+	{
+		Name:  "TooManyReserves",
+		Index: errors.ErrorTooManyReserves,
+		Docs:  "Number of named reserves exceed MaxReserves",
+	},
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/config"
 	"github.com/LimeChain/gosemble/execution/inherent"
 	"github.com/LimeChain/gosemble/execution/types"
 	"github.com/LimeChain/gosemble/frame/executive"
@@ -33,7 +34,7 @@ func ApplyExtrinsic(dataPtr int32, dataLen int32) int64 {
 	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
 	buffer := bytes.NewBuffer(b)
 
-	uxt := types.DecodeUncheckedExtrinsic(buffer)
+	uxt := types.DecodeUncheckedExtrinsic(config.SignedExtra, buffer)
 
 	ok, err := executive.ApplyExtrinsic(uxt)
 	var applyExtrinsicResult primitives.ApplyExtrinsicResult
@@ -98,7 +99,7 @@ func CheckInherents(dataPtr int32, dataLen int32) int64 {
 	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
 	buffer := bytes.NewBuffer(b)
 
-	block := types.DecodeBlock(buffer)
+	block := types.DecodeBlock(config.SignedExtra, buffer)
 
 	inherentData, err := primitives.DecodeInherentData(buffer)
 	if err != nil {
@@ -3,6 +3,7 @@ package core
 import (
 	"bytes"
 
+	"github.com/LimeChain/gosemble/config"
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/execution/types"
 	"github.com/LimeChain/gosemble/frame/executive"
@@ -49,6 +50,6 @@ func ExecuteBlock(dataPtr int32, dataLen int32) {
 	data := utils.ToWasmMemorySlice(dataPtr, dataLen)
 	buffer := bytes.NewBuffer(data)
 
-	block := types.DecodeBlock(buffer)
+	block := types.DecodeBlock(config.SignedExtra, buffer)
 	executive.ExecuteBlock(block)
 }
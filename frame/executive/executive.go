@@ -5,16 +5,15 @@ import (
 	"reflect"
 
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/config"
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/execution/extrinsic"
 	"github.com/LimeChain/gosemble/execution/inherent"
 	"github.com/LimeChain/gosemble/execution/types"
-	"github.com/LimeChain/gosemble/frame/aura"
+	"github.com/LimeChain/gosemble/frame/migrations"
 	"github.com/LimeChain/gosemble/frame/system"
 	"github.com/LimeChain/gosemble/primitives/crypto"
-	"github.com/LimeChain/gosemble/primitives/hashing"
 	"github.com/LimeChain/gosemble/primitives/log"
-	"github.com/LimeChain/gosemble/primitives/storage"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
@@ -26,13 +25,14 @@ func InitializeBlock(header primitives.Header) {
 
 	weight := primitives.WeightZero()
 	if runtimeUpgrade() {
-		weight = weight.SaturatingAdd(executeOnRuntimeUpgrade())
+		weight = weight.SaturatingAdd(onRuntimeUpgrade())
+		weight = weight.SaturatingAdd(migrations.OnRuntimeUpgrade(header.Number))
 	}
 
 	system.Initialize(header.Number, header.ParentHash, extractPreRuntimeDigest(header.Digest))
 
-	// TODO: accumulate the weight from all pallets that have on_initialize
-	weight = weight.SaturatingAdd(aura.OnInitialize())
+	weight = weight.SaturatingAdd(onInitialize(header.Number))
+	weight = weight.SaturatingAdd(migrations.Step())
 	weight = weight.SaturatingAdd(system.DefaultBlockWeights().BaseBlock)
 	// use in case of dynamic weight calculation
 	system.RegisterExtraWeightUnchecked(weight, primitives.NewDispatchClassMandatory())
@@ -48,12 +48,12 @@ func ExecuteBlock(block types.Block) {
 	initialChecks(block)
 
 	crypto.ExtCryptoStartBatchVerify()
-	executeExtrinsicsWithBookKeeping(block)
+	executeExtrinsicsWithBookKeeping(block, true)
 	if crypto.ExtCryptoFinishBatchVerify() != 1 {
 		log.Critical("Signature verification failed")
 	}
 
-	finalChecks(&block.Header)
+	finalChecks(&block.Header, true)
 }
 
 // ApplyExtrinsic applies extrinsic outside the block execution function.
@@ -61,17 +61,36 @@ func ExecuteBlock(block types.Block) {
 // This doesn't attempt to validate anything regarding the block, but it builds a list of uxt
 // hashes.
 func ApplyExtrinsic(uxt types.UncheckedExtrinsic) (primitives.DispatchOutcome, primitives.TransactionValidityError) {
+	return applyExtrinsic(uxt, true)
+}
+
+func applyExtrinsic(uxt types.UncheckedExtrinsic, signatureCheck bool) (primitives.DispatchOutcome, primitives.TransactionValidityError) {
 	encoded := uxt.Bytes()
 	encodedLen := sc.ToCompact(len(encoded))
 
 	log.Trace("apply_extrinsic")
 
 	// Verify that the signature is good.
-	xt, err := extrinsic.Unchecked(uxt).Check(primitives.DefaultAccountIdLookup())
+	check := extrinsic.Unchecked(uxt).Check
+	if !signatureCheck {
+		check = extrinsic.Unchecked(uxt).CheckWithoutSignature
+	}
+
+	xt, err := check(primitives.DefaultAccountIdLookup())
 	if err != nil {
 		return primitives.DispatchOutcome{}, err
 	}
 
+	// Decode parameters and dispatch
+	dispatchInfo := primitives.GetDispatchInfo(xt.Function)
+	log.Trace("get_dispatch_info: weight ref time " + dispatchInfo.Weight.RefTime.String())
+
+	// Only mandatory extrinsics are applied while migrations are ongoing.
+	// The others are left for the blocks after the migrations complete.
+	if migrations.Ongoing() && !bool(dispatchInfo.Class.Is(primitives.DispatchClassMandatory)) {
+		return primitives.DispatchOutcome{}, primitives.NewTransactionValidityError(primitives.NewInvalidTransactionExhaustsResources())
+	}
+
 	// We don't need to make sure to `note_extrinsic` only after we know it's going to be
 	// executed to prevent it from leaking in storage since at this point, it will either
 	// execute or panic (and revert storage changes).
@@ -79,12 +98,8 @@ func ApplyExtrinsic(uxt types.UncheckedExtrinsic) (primitives.DispatchOutcome, p
 
 	// AUDIT: Under no circumstances may this function panic from here onwards.
 
-	// Decode parameters and dispatch
-	dispatchInfo := primitives.GetDispatchInfo(xt.Function)
-	log.Trace("get_dispatch_info: weight ref time " + dispatchInfo.Weight.RefTime.String())
-
 	unsignedValidator := extrinsic.UnsignedValidatorForChecked{}
-	res, err := extrinsic.Checked(xt).Apply(unsignedValidator, &dispatchInfo, encodedLen)
+	res, err := extrinsic.Checked(xt).Apply(unsignedValidator, config.SignedExtra, &dispatchInfo, encodedLen)
 	if err != nil {
 		return primitives.DispatchOutcome{}, err
 	}
@@ -135,12 +150,12 @@ func ValidateTransaction(source primitives.TransactionSource, uxt types.Unchecke
 
 	log.Trace("validate")
 	unsignedValidator := extrinsic.UnsignedValidatorForChecked{}
-	return extrinsic.Checked(xt).Validate(unsignedValidator, source, &dispatchInfo, encodedLen)
+	return extrinsic.Checked(xt).Validate(unsignedValidator, config.SignedExtra, source, &dispatchInfo, encodedLen)
 }
 
-func executeExtrinsicsWithBookKeeping(block types.Block) {
+func executeExtrinsicsWithBookKeeping(block types.Block, signatureCheck bool) {
 	for _, ext := range block.Extrinsics {
-		_, err := ApplyExtrinsic(ext)
+		_, err := applyExtrinsic(ext, signatureCheck)
 		if err != nil {
 			log.Critical(string(err[0].Bytes()))
 		}
@@ -173,19 +188,15 @@ func initialChecks(block types.Block) {
 }
 
 func runtimeUpgrade() sc.Bool {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	lastRuntimeUpgradeHash := hashing.Twox128(constants.KeyLastRuntimeUpgrade)
-
-	keyLru := append(systemHash, lastRuntimeUpgradeHash...)
-	lrupi := storage.GetDecode(keyLru, primitives.DecodeLastRuntimeUpgradeInfo)
+	lrupi := system.StorageGetLastRuntimeUpgrade()
 
 	if constants.RuntimeVersion.SpecVersion > sc.U32(lrupi.SpecVersion.ToBigInt().Int64()) ||
 		lrupi.SpecName != constants.RuntimeVersion.SpecName {
 
-		valueLru := append(
-			sc.ToCompact(constants.RuntimeVersion.SpecVersion).Bytes(),
-			constants.RuntimeVersion.SpecName.Bytes()...)
-		storage.Set(keyLru, valueLru)
+		system.StorageSetLastRuntimeUpgrade(primitives.LastRuntimeUpgradeInfo{
+			SpecVersion: sc.ToCompact(constants.RuntimeVersion.SpecVersion),
+			SpecName:    constants.RuntimeVersion.SpecName,
+		})
 
 		return true
 	}
@@ -195,30 +206,27 @@ func runtimeUpgrade() sc.Bool {
 
 func extractPreRuntimeDigest(digest primitives.Digest) primitives.Digest {
 	result := primitives.Digest{}
-	for k, v := range digest {
-		if k == primitives.DigestTypePreRuntime {
-			result[k] = v
-		}
+	for _, item := range digest.OfType(primitives.DigestTypePreRuntime) {
+		result.Push(item)
 	}
 
 	return result
 }
 
-func finalChecks(header *primitives.Header) {
+func finalChecks(header *primitives.Header, stateRootCheck bool) {
 	newHeader := system.Finalize()
 
-	if len(header.Digest) != len(newHeader.Digest) {
+	if len(header.Digest.Logs) != len(newHeader.Digest.Logs) {
 		log.Critical("Number of digest must match the calculated")
 	}
 
-	for key, digest := range header.Digest {
-		otherDigest := newHeader.Digest[key]
-		if !reflect.DeepEqual(digest, otherDigest) {
+	for i, item := range header.Digest.Logs {
+		if !reflect.DeepEqual(item, newHeader.Digest.Logs[i]) {
 			log.Critical("digest item must match that calculated")
 		}
 	}
 
-	if !reflect.DeepEqual(header.StateRoot, newHeader.StateRoot) {
+	if stateRootCheck && !reflect.DeepEqual(header.StateRoot, newHeader.StateRoot) {
 		log.Critical("Storage root must match that calculated")
 	}
 
@@ -226,10 +234,3 @@ func finalChecks(header *primitives.Header) {
 		log.Critical("Transaction trie must be valid")
 	}
 }
-
-// Execute all `OnRuntimeUpgrade` of this runtime, and return the aggregate weight.
-func executeOnRuntimeUpgrade() primitives.Weight {
-	// TODO: ex: balances
-	// call on_runtime_upgrade hook for all modules that implement it
-	return onRuntimeUpgrade()
-}
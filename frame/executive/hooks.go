@@ -3,12 +3,16 @@ package executive
 import (
 	"fmt"
 
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/config"
 	"github.com/LimeChain/gosemble/frame/system"
-	"github.com/LimeChain/gosemble/frame/timestamp"
+	"github.com/LimeChain/gosemble/primitives/hashing"
 	"github.com/LimeChain/gosemble/primitives/log"
 	"github.com/LimeChain/gosemble/primitives/types"
 )
 
+// IdleAndFinalizeHook calls the OnIdle hooks of all modules with the weight left in the block,
+// followed by their OnFinalize hooks.
 func IdleAndFinalizeHook(blockNumber types.BlockNumber) {
 	weight := system.StorageGetBlockWeight()
 
@@ -16,20 +20,70 @@ func IdleAndFinalizeHook(blockNumber types.BlockNumber) {
 	remainingWeight := maxWeight.SaturatingSub(weight.Total())
 
 	if remainingWeight.AllGt(types.WeightZero()) {
-		// TODO: call on_idle hook for each pallet
 		usedWeight := onIdle(blockNumber, remainingWeight)
 		system.RegisterExtraWeightUnchecked(usedWeight, types.NewDispatchClassMandatory())
 	}
 
-	// Each pallet (babe, grandpa) has its own on_finalize that has to be implemented once it is supported
-	timestamp.OnFinalize()
+	onFinalize(blockNumber)
 }
 
+// OffchainWorker starts the off-chain task of all modules for the given block header.
+func OffchainWorker(header types.Header) {
+	system.Initialize(header.Number, header.ParentHash, header.Digest)
+
+	hash := hashing.Blake256(header.Bytes())
+	system.StorageSetBlockHash(header.Number, types.NewBlake2bHash(sc.BytesToSequenceU8(hash)...))
+
+	for _, index := range config.ModuleIndices() {
+		config.Modules[index].OffchainWorker(header.Number)
+	}
+}
+
+// IntegrityTest checks the configuration of all modules.
+// It is not part of the block execution and is meant to be run by tests.
+func IntegrityTest() {
+	for _, index := range config.ModuleIndices() {
+		config.Modules[index].IntegrityTest()
+	}
+}
+
+// onRuntimeUpgrade calls the OnRuntimeUpgrade hooks of all modules in index order and returns the aggregated weight.
 func onRuntimeUpgrade() types.Weight {
-	return types.WeightFromParts(200, 0)
+	weight := types.WeightZero()
+	for _, index := range config.ModuleIndices() {
+		weight = weight.SaturatingAdd(config.Modules[index].OnRuntimeUpgrade())
+	}
+
+	return weight
 }
 
+// onInitialize calls the OnInitialize hooks of all modules in index order and returns the aggregated weight.
+func onInitialize(n types.BlockNumber) types.Weight {
+	weight := types.WeightZero()
+	for _, index := range config.ModuleIndices() {
+		weight = weight.SaturatingAdd(config.Modules[index].OnInitialize(n))
+	}
+
+	return weight
+}
+
+// onIdle calls the OnIdle hooks of all modules in index order. Each module receives the weight,
+// which is left after the modules before it, and the aggregated used weight is returned.
 func onIdle(n types.BlockNumber, remainingWeight types.Weight) types.Weight {
 	log.Trace(fmt.Sprintf("on_idle %v, %v)", n, remainingWeight))
-	return types.WeightFromParts(175, 0)
+
+	usedWeight := types.WeightZero()
+	for _, index := range config.ModuleIndices() {
+		adjustedRemainingWeight := remainingWeight.SaturatingSub(usedWeight)
+		usedWeight = usedWeight.SaturatingAdd(config.Modules[index].OnIdle(n, adjustedRemainingWeight))
+	}
+
+	return usedWeight
+}
+
+// onFinalize calls the OnFinalize hooks of all modules in index order.
+func onFinalize(n types.BlockNumber) {
+	for _, index := range config.ModuleIndices() {
+		config.Modules[index].OnFinalize(n)
+	}
 }
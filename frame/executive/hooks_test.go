@@ -0,0 +1,175 @@
+//go:build nonwasmenv
+
+package executive
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/config"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockHooksModule struct {
+	types.DefaultHooks
+	index  sc.U8
+	weight types.Weight
+	calls  *[]string
+	idle   *[]types.Weight
+}
+
+func (m mockHooksModule) Functions() map[sc.U8]types.Call {
+	return map[sc.U8]types.Call{}
+}
+
+func (m mockHooksModule) PreDispatch(_ types.Call) (sc.Empty, types.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (m mockHooksModule) ValidateUnsigned(_ types.TransactionSource, _ types.Call) (types.ValidTransaction, types.TransactionValidityError) {
+	return types.ValidTransaction{}, types.NewTransactionValidityError(types.NewUnknownTransactionNoUnsignedValidator())
+}
+
+func (m mockHooksModule) Metadata() (sc.Sequence[types.MetadataType], types.MetadataModule) {
+	return sc.Sequence[types.MetadataType]{}, types.MetadataModule{Name: sc.Str("mock" + string(rune('0'+m.index)))}
+}
+
+func (m mockHooksModule) OnInitialize(_ types.BlockNumber) types.Weight {
+	*m.calls = append(*m.calls, "initialize"+string(rune('0'+m.index)))
+	return m.weight
+}
+
+func (m mockHooksModule) OnIdle(_ types.BlockNumber, remainingWeight types.Weight) types.Weight {
+	*m.idle = append(*m.idle, remainingWeight)
+	return m.weight
+}
+
+func (m mockHooksModule) OnFinalize(_ types.BlockNumber) {
+	*m.calls = append(*m.calls, "finalize"+string(rune('0'+m.index)))
+}
+
+func (m mockHooksModule) OnRuntimeUpgrade() types.Weight {
+	*m.calls = append(*m.calls, "upgrade"+string(rune('0'+m.index)))
+	return m.weight
+}
+
+func (m mockHooksModule) TryState(_ types.BlockNumber) types.DispatchError {
+	*m.calls = append(*m.calls, "tryState"+string(rune('0'+m.index)))
+	return nil
+}
+
+func setupMockModules() (*[]string, *[]types.Weight, func()) {
+	calls := &[]string{}
+	idle := &[]types.Weight{}
+
+	modules := config.Modules
+	config.Modules = map[sc.U8]types.Module{}
+	for _, index := range []sc.U8{3, 1, 2} {
+		config.Modules[index] = mockHooksModule{
+			index:  index,
+			weight: types.WeightFromParts(sc.U64(index)*10, sc.U64(index)),
+			calls:  calls,
+			idle:   idle,
+		}
+	}
+
+	return calls, idle, func() { config.Modules = modules }
+}
+
+func Test_Hooks(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		expectation func(t *testing.T, calls *[]string, idle *[]types.Weight)
+	}{
+		{
+			label: "onInitialize",
+			expectation: func(t *testing.T, calls *[]string, _ *[]types.Weight) {
+				weight := onInitialize(1)
+
+				assert.Equal(t, []string{"initialize1", "initialize2", "initialize3"}, *calls)
+				assert.Equal(t, types.WeightFromParts(60, 6), weight)
+			},
+		},
+		{
+			label: "onRuntimeUpgrade",
+			expectation: func(t *testing.T, calls *[]string, _ *[]types.Weight) {
+				weight := onRuntimeUpgrade()
+
+				assert.Equal(t, []string{"upgrade1", "upgrade2", "upgrade3"}, *calls)
+				assert.Equal(t, types.WeightFromParts(60, 6), weight)
+			},
+		},
+		{
+			label: "onIdle",
+			expectation: func(t *testing.T, _ *[]string, idle *[]types.Weight) {
+				weight := onIdle(1, types.WeightFromParts(100, 100))
+
+				assert.Equal(t, []types.Weight{
+					types.WeightFromParts(100, 100),
+					types.WeightFromParts(90, 99),
+					types.WeightFromParts(70, 97),
+				}, *idle)
+				assert.Equal(t, types.WeightFromParts(60, 6), weight)
+			},
+		},
+		{
+			label: "onFinalize",
+			expectation: func(t *testing.T, calls *[]string, _ *[]types.Weight) {
+				onFinalize(1)
+
+				assert.Equal(t, []string{"finalize1", "finalize2", "finalize3"}, *calls)
+			},
+		},
+		{
+			label: "tryState all",
+			expectation: func(t *testing.T, calls *[]string, _ *[]types.Weight) {
+				err := tryState(1, types.NewTryStateSelectAll())
+
+				assert.Nil(t, err)
+				assert.Equal(t, []string{"tryState1", "tryState2", "tryState3"}, *calls)
+			},
+		},
+		{
+			label: "tryState none",
+			expectation: func(t *testing.T, calls *[]string, _ *[]types.Weight) {
+				err := tryState(1, types.NewTryStateSelectNone())
+
+				assert.Nil(t, err)
+				assert.Empty(t, *calls)
+			},
+		},
+		{
+			label: "tryState round robin",
+			expectation: func(t *testing.T, calls *[]string, _ *[]types.Weight) {
+				err := tryState(2, types.NewTryStateSelectRoundRobin(2))
+
+				assert.Nil(t, err)
+				assert.Equal(t, []string{"tryState3", "tryState1"}, *calls)
+			},
+		},
+		{
+			label: "tryState only",
+			expectation: func(t *testing.T, calls *[]string, _ *[]types.Weight) {
+				names := sc.Sequence[sc.Sequence[sc.U8]]{sc.BytesToSequenceU8([]byte("mock2"))}
+				err := tryState(1, types.NewTryStateSelectOnly(names))
+
+				assert.Nil(t, err)
+				assert.Equal(t, []string{"tryState2"}, *calls)
+			},
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			calls, idle, teardown := setupMockModules()
+			defer teardown()
+
+			testExample.expectation(t, calls, idle)
+		})
+	}
+}
+
+func Test_IntegrityTest(t *testing.T) {
+	assert.NotPanics(t, IntegrityTest)
+}
@@ -0,0 +1,109 @@
+package executive
+
+import (
+	"fmt"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/config"
+	"github.com/LimeChain/gosemble/execution/types"
+	"github.com/LimeChain/gosemble/frame/migrations"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// TryRuntimeUpgrade executes the OnRuntimeUpgrade hooks of all modules and all pending migrations
+// to completion, followed by the selected checks, and returns the consumed weight.
+// It panics if any of the migrations or checks fails. It is not part of the block execution
+// and is meant to be run by the try-runtime checks against a snapshot of the state.
+func TryRuntimeUpgrade(checks primitives.UpgradeCheckSelect) primitives.Weight {
+	weight := onRuntimeUpgrade()
+
+	migrationsWeight, err := migrations.TryExecuteAll(checks.PreAndPost())
+	if err != nil {
+		log.Critical(fmt.Sprintf("migrations failed with error [%v]", err))
+	}
+	weight = weight.SaturatingAdd(migrationsWeight)
+
+	if checks.TryState() {
+		err := tryState(system.StorageGetBlockNumber(), primitives.NewTryStateSelectAll())
+		if err != nil {
+			log.Critical(fmt.Sprintf("try_state checks failed with error [%v]", err))
+		}
+	}
+
+	return weight
+}
+
+// TryExecuteBlock executes the block like ExecuteBlock, without checking the state root
+// and the signatures of the extrinsics if their checks are disabled, followed by the TryState
+// checks of the selected modules. It returns the weight of the block and panics if any of the checks fails.
+// It is not part of the block execution and is meant to be run by the try-runtime checks.
+func TryExecuteBlock(block types.Block, stateRootCheck bool, signatureCheck bool, selectTryState primitives.TryStateSelect) primitives.Weight {
+	log.Trace(fmt.Sprintf("try_execute_block %v", block.Header.Number))
+
+	InitializeBlock(block.Header)
+
+	initialChecks(block)
+
+	executeExtrinsicsWithBookKeeping(block, signatureCheck)
+
+	err := tryState(block.Header.Number, selectTryState)
+	if err != nil {
+		log.Critical(fmt.Sprintf("try_state checks failed with error [%v]", err))
+	}
+
+	weight := system.StorageGetBlockWeight().Total()
+
+	finalChecks(&block.Header, stateRootCheck)
+
+	return weight
+}
+
+// tryState executes the TryState checks of the selected modules in index order
+// and returns the first error.
+func tryState(n primitives.BlockNumber, selectTryState primitives.TryStateSelect) primitives.DispatchError {
+	indices := config.ModuleIndices()
+
+	switch selectTryState.VaryingData[0] {
+	case primitives.TryStateSelectNone:
+		return nil
+	case primitives.TryStateSelectAll:
+	case primitives.TryStateSelectRoundRobin:
+		count := int(selectTryState.VaryingData[1].(sc.U32))
+		if count > len(indices) {
+			count = len(indices)
+		}
+
+		start := int(n) % len(indices)
+		selected := make([]sc.U8, 0, count)
+		for i := 0; i < count; i++ {
+			selected = append(selected, indices[(start+i)%len(indices)])
+		}
+		indices = selected
+	case primitives.TryStateSelectOnly:
+		names := selectTryState.VaryingData[1].(sc.Sequence[sc.Sequence[sc.U8]])
+
+		selected := make([]sc.U8, 0, len(names))
+		for _, index := range indices {
+			_, module := config.Modules[index].Metadata()
+			for _, name := range names {
+				if reflect.DeepEqual(sc.SequenceU8ToBytes(name), []byte(module.Name)) {
+					selected = append(selected, index)
+					break
+				}
+			}
+		}
+		indices = selected
+	}
+
+	for _, index := range indices {
+		err := config.Modules[index].TryState(n)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
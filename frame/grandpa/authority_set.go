@@ -0,0 +1,137 @@
+package grandpa
+
+import (
+	"fmt"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/grandpa"
+	"github.com/LimeChain/gosemble/frame/grandpa/errors"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// ScheduleChange schedules a change of the authority set to `nextAuthorities`, which is signalled in the current
+// block and enacted `inBlocks` blocks later. A forced change carries the median last finalized block `forced`
+// and is enacted by the client regardless of finality. Only one change can be pending at a time, and a forced
+// change can only be scheduled after twice the delay of the previous forced change has passed.
+func ScheduleChange(nextAuthorities sc.Sequence[types.Authority], inBlocks types.BlockNumber, forced sc.Option[types.BlockNumber]) types.DispatchError {
+	if StorageGetPendingChange().HasValue {
+		return newModuleError(errors.ErrorChangePending)
+	}
+
+	scheduledAt := system.StorageGetBlockNumber()
+
+	if forced.HasValue {
+		nextForced := StorageGetNextForced()
+		if nextForced.HasValue && nextForced.Value > scheduledAt {
+			return newModuleError(errors.ErrorTooSoon)
+		}
+
+		// Only allow the next forced change when twice the window has passed since this one.
+		storageSetNextForced(scheduledAt + inBlocks*2)
+	}
+
+	if sc.U32(len(nextAuthorities)) > grandpa.MaxAuthorities {
+		log.Warn(fmt.Sprintf("next authorities list larger than [%d], truncating", grandpa.MaxAuthorities))
+		nextAuthorities = nextAuthorities[:grandpa.MaxAuthorities]
+	}
+
+	storageSetPendingChange(StoredPendingChange{
+		ScheduledAt:     scheduledAt,
+		Delay:           inBlocks,
+		NextAuthorities: nextAuthorities,
+		Forced:          forced,
+	})
+
+	return nil
+}
+
+// SchedulePause schedules a pause of finality, which is signalled in the current block and enacted `inBlocks`
+// blocks later. Fails if the authority set is not live.
+func SchedulePause(inBlocks types.BlockNumber) types.DispatchError {
+	if StorageGetState().Kind() != StoredStateLive {
+		return newModuleError(errors.ErrorPauseFailed)
+	}
+
+	storageSetState(NewStoredStatePendingPause(system.StorageGetBlockNumber(), inBlocks))
+
+	return nil
+}
+
+// ScheduleResume schedules a resume of finality, which is signalled in the current block and enacted `inBlocks`
+// blocks later. Fails if the authority set is not paused.
+func ScheduleResume(inBlocks types.BlockNumber) types.DispatchError {
+	if StorageGetState().Kind() != StoredStatePaused {
+		return newModuleError(errors.ErrorResumeFailed)
+	}
+
+	storageSetState(NewStoredStatePendingResume(system.StorageGetBlockNumber(), inBlocks))
+
+	return nil
+}
+
+// OnStalled notes that finality has stalled. A forced change of the authority set, enacted after `furtherWait`
+// blocks with the median last finalized block `median`, is scheduled at the start of the next session.
+func OnStalled(furtherWait types.BlockNumber, median types.BlockNumber) {
+	storageSetStalled(Stalled{FurtherWait: furtherWait, Median: median})
+}
+
+// OnFinalize signals the pending change of the authority set, and pending pauses and resumes of finality,
+// in the block in which they are scheduled, and enacts them once their delay has passed.
+func OnFinalize(n types.BlockNumber) {
+	pendingChange := StorageGetPendingChange()
+	if pendingChange.HasValue {
+		change := pendingChange.Value
+
+		if n == change.ScheduledAt {
+			if change.Forced.HasValue {
+				payload := append(change.Forced.Value.Bytes(), scheduledChangeBytes(change)...)
+				system.DepositLog(consensusLog(ConsensusLogForcedChange, payload))
+			} else {
+				system.DepositLog(consensusLog(ConsensusLogScheduledChange, scheduledChangeBytes(change)))
+			}
+		}
+
+		if n == change.ScheduledAt+change.Delay {
+			storageSetAuthorities(change.NextAuthorities)
+			system.DepositEvent(NewEventNewAuthorities(change.NextAuthorities))
+			storageClearPendingChange()
+		}
+	}
+
+	state := StorageGetState()
+	switch state.Kind() {
+	case StoredStatePendingPause:
+		if n == state.ScheduledAt() {
+			system.DepositLog(consensusLog(ConsensusLogPause, state.Delay().Bytes()))
+		}
+
+		if n == state.ScheduledAt()+state.Delay() {
+			storageSetState(NewStoredStatePaused())
+			system.DepositEvent(NewEventPaused())
+		}
+	case StoredStatePendingResume:
+		if n == state.ScheduledAt() {
+			system.DepositLog(consensusLog(ConsensusLogResume, state.Delay().Bytes()))
+		}
+
+		if n == state.ScheduledAt()+state.Delay() {
+			storageSetState(NewStoredStateLive())
+			system.DepositEvent(NewEventResumed())
+		}
+	}
+}
+
+// scheduledChangeBytes encodes the change as the `ScheduledChange` of the consensus log.
+func scheduledChangeBytes(change StoredPendingChange) []byte {
+	return append(change.NextAuthorities.Bytes(), change.Delay.Bytes()...)
+}
+
+func newModuleError(err sc.U8) types.DispatchError {
+	return types.NewDispatchErrorModule(types.CustomModuleError{
+		Index:   grandpa.ModuleIndex,
+		Error:   sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
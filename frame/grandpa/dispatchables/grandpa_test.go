@@ -0,0 +1,226 @@
+//go:build nonwasmenv
+
+package dispatchables
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	cg "github.com/LimeChain/gosemble/constants/grandpa"
+	"github.com/LimeChain/gosemble/frame/grandpa"
+	"github.com/LimeChain/gosemble/frame/grandpa/errors"
+	"github.com/LimeChain/gosemble/frame/session"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/frame/testutils"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	alice = testutils.NewAddress(1)
+	bob   = testutils.NewAddress(2)
+)
+
+// setup starts the genesis session with alice and bob as validators and returns their grandpa keys.
+func setup() (types.PublicKey, types.PublicKey) {
+	handler := testutils.NewSessionHandler(cg.KeyTypeId)
+	handler.Genesis = grandpa.OnGenesisSession
+	handler.NewSession = func(changed bool, validators []types.ValidatorKey, _ []types.ValidatorKey) {
+		grandpa.OnNewSession(changed, validators)
+	}
+	handler.Disabled = grandpa.OnDisabled
+
+	keys := testutils.SetupValidators(handler, host.SchemeEd25519, alice, bob)
+
+	return keys[0], keys[1]
+}
+
+// signedPrevote signs a prevote for the block with the given number in round 1 of the current set.
+func signedPrevote(key types.PublicKey, targetNumber types.BlockNumber) grandpa.SignedVote {
+	vote := grandpa.Vote{TargetHash: types.NewH256(make([]sc.U8, 32)...), TargetNumber: targetNumber}
+
+	buffer := &bytes.Buffer{}
+	grandpa.MessagePrevote.Encode(buffer)
+	vote.Encode(buffer)
+	grandpa.RoundNumber(1).Encode(buffer)
+	grandpa.StorageGetCurrentSetId().Encode(buffer)
+
+	signature, _ := host.DefaultKeystore().Sign(host.SchemeEd25519, cg.KeyTypeId[:], sc.FixedSequenceU8ToBytes(key), buffer.Bytes())
+
+	return grandpa.SignedVote{Vote: vote, Signature: types.NewEd25519(sc.BytesToSequenceU8(signature)...)}
+}
+
+func newEquivocationProof(key types.PublicKey, first, second grandpa.SignedVote) grandpa.EquivocationProof {
+	return grandpa.EquivocationProof{
+		SetId: grandpa.StorageGetCurrentSetId(),
+		Equivocation: grandpa.Equivocation{
+			Kind:        grandpa.MessagePrevote,
+			RoundNumber: 1,
+			Identity:    key,
+			First:       first,
+			Second:      second,
+		},
+	}
+}
+
+func Test_Grandpa(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		call        types.Call
+		origin      types.RuntimeOrigin
+		args        func(key types.PublicKey) sc.VaryingData
+		expectation types.DispatchError
+		disabled    sc.Sequence[sc.U32]
+	}{
+		{
+			label:  "note_stalled(BadOrigin)",
+			call:   NewNoteStalledCall(nil),
+			origin: types.NewRawOriginSigned(alice),
+			args: func(_ types.PublicKey) sc.VaryingData {
+				return sc.NewVaryingData(types.BlockNumber(10), types.BlockNumber(5))
+			},
+			expectation: types.NewDispatchErrorBadOrigin(),
+		},
+		{
+			label:  "report_equivocation(BadOrigin)",
+			call:   NewReportEquivocationCall(nil),
+			origin: types.NewRawOriginNone(),
+			args: func(key types.PublicKey) sc.VaryingData {
+				proof := newEquivocationProof(key, signedPrevote(key, 1), signedPrevote(key, 2))
+				return sc.NewVaryingData(proof, session.ProveKeyOwnership(cg.KeyTypeId, key).Value)
+			},
+			expectation: types.NewDispatchErrorBadOrigin(),
+		},
+		{
+			label:  "report_equivocation_unsigned(BadOrigin)",
+			call:   NewReportEquivocationUnsignedCall(nil),
+			origin: types.NewRawOriginSigned(bob),
+			args: func(key types.PublicKey) sc.VaryingData {
+				proof := newEquivocationProof(key, signedPrevote(key, 1), signedPrevote(key, 2))
+				return sc.NewVaryingData(proof, session.ProveKeyOwnership(cg.KeyTypeId, key).Value)
+			},
+			expectation: types.NewDispatchErrorBadOrigin(),
+		},
+		{
+			label:  "report_equivocation(InvalidKeyOwnershipProof)",
+			call:   NewReportEquivocationCall(nil),
+			origin: types.NewRawOriginSigned(bob),
+			args: func(key types.PublicKey) sc.VaryingData {
+				proof := newEquivocationProof(key, signedPrevote(key, 1), signedPrevote(key, 2))
+				return sc.NewVaryingData(proof, session.MembershipProof{Session: 1, ValidatorCount: 2})
+			},
+			expectation: testutils.NewModuleError(cg.ModuleIndex, errors.ErrorInvalidKeyOwnershipProof),
+		},
+		{
+			label:  "report_equivocation(InvalidEquivocationProof)",
+			call:   NewReportEquivocationCall(nil),
+			origin: types.NewRawOriginSigned(bob),
+			args: func(key types.PublicKey) sc.VaryingData {
+				proof := newEquivocationProof(key, signedPrevote(key, 1), signedPrevote(key, 1))
+				return sc.NewVaryingData(proof, session.ProveKeyOwnership(cg.KeyTypeId, key).Value)
+			},
+			expectation: testutils.NewModuleError(cg.ModuleIndex, errors.ErrorInvalidEquivocationProof),
+		},
+		{
+			label:  "report_equivocation(Ok)",
+			call:   NewReportEquivocationCall(nil),
+			origin: types.NewRawOriginSigned(bob),
+			args: func(key types.PublicKey) sc.VaryingData {
+				proof := newEquivocationProof(key, signedPrevote(key, 1), signedPrevote(key, 2))
+				return sc.NewVaryingData(proof, session.ProveKeyOwnership(cg.KeyTypeId, key).Value)
+			},
+			disabled: sc.Sequence[sc.U32]{0},
+		},
+		{
+			label:  "report_equivocation_unsigned(Ok)",
+			call:   NewReportEquivocationUnsignedCall(nil),
+			origin: types.NewRawOriginNone(),
+			args: func(key types.PublicKey) sc.VaryingData {
+				proof := newEquivocationProof(key, signedPrevote(key, 1), signedPrevote(key, 2))
+				return sc.NewVaryingData(proof, session.ProveKeyOwnership(cg.KeyTypeId, key).Value)
+			},
+			disabled: sc.Sequence[sc.U32]{0},
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			aliceKey, _ := setup()
+
+			result := testExample.call.Dispatch(testExample.origin, testExample.args(aliceKey))
+
+			if testExample.expectation != nil {
+				assert.True(t, bool(result.HasError))
+				assert.Equal(t, testExample.expectation, result.Err.Error)
+			} else {
+				assert.False(t, bool(result.HasError))
+				assert.Equal(t, types.PaysNo, result.Ok.PaysFee)
+			}
+			assert.Equal(t, testExample.disabled, session.StorageGetDisabledValidators())
+		})
+	}
+}
+
+func Test_Grandpa_ReportEquivocation_Duplicate(t *testing.T) {
+	aliceKey, _ := setup()
+	proof := newEquivocationProof(aliceKey, signedPrevote(aliceKey, 1), signedPrevote(aliceKey, 2))
+	keyOwnerProof := session.ProveKeyOwnership(cg.KeyTypeId, aliceKey).Value
+
+	assert.Nil(t, grandpa.ReportEquivocation(proof, keyOwnerProof))
+
+	assert.Equal(t, testutils.NewModuleError(cg.ModuleIndex, errors.ErrorDuplicateOffenceReport), grandpa.ReportEquivocation(proof, keyOwnerProof))
+	assert.Equal(t,
+		types.NewTransactionValidityError(types.NewInvalidTransactionStale()),
+		grandpa.PreDispatchUnsignedEquivocationReport(proof, keyOwnerProof))
+}
+
+func Test_Grandpa_ValidateUnsignedEquivocationReport(t *testing.T) {
+	aliceKey, _ := setup()
+	proof := newEquivocationProof(aliceKey, signedPrevote(aliceKey, 1), signedPrevote(aliceKey, 2))
+	keyOwnerProof := session.ProveKeyOwnership(cg.KeyTypeId, aliceKey).Value
+
+	_, err := grandpa.ValidateUnsignedEquivocationReport(types.NewTransactionSourceExternal(), proof, keyOwnerProof)
+	assert.Equal(t, types.NewTransactionValidityError(types.NewInvalidTransactionCall()), err)
+
+	validTransaction, err := grandpa.ValidateUnsignedEquivocationReport(types.NewTransactionSourceLocal(), proof, keyOwnerProof)
+	assert.Nil(t, err)
+	assert.Equal(t, cg.ReportLongevity, validTransaction.Longevity)
+	assert.Equal(t, sc.Bool(false), validTransaction.Propagate)
+	assert.Len(t, validTransaction.Provides, 1)
+}
+
+func Test_Grandpa_NoteStalled(t *testing.T) {
+	aliceKey, bobKey := setup()
+	system.StorageSetBlockNumber(3)
+
+	result := NewNoteStalledCall(nil).Dispatch(types.NewRawOriginRoot(), sc.NewVaryingData(types.BlockNumber(10), types.BlockNumber(2)))
+	assert.False(t, bool(result.HasError))
+	assert.Equal(t, sc.NewOption[grandpa.Stalled](grandpa.Stalled{FurtherWait: 10, Median: 2}), grandpa.StorageGetStalled())
+
+	// The stall forces a change of the authority set in the next session, even though the validators do not change.
+	session.RotateSession()
+
+	pendingChange := grandpa.StorageGetPendingChange()
+	assert.True(t, bool(pendingChange.HasValue))
+	assert.Equal(t, types.BlockNumber(3), pendingChange.Value.ScheduledAt)
+	assert.Equal(t, types.BlockNumber(10), pendingChange.Value.Delay)
+	assert.Equal(t, sc.NewOption[types.BlockNumber](types.BlockNumber(2)), pendingChange.Value.Forced)
+	assert.Equal(t, sc.NewOption[types.BlockNumber](types.BlockNumber(23)), grandpa.StorageGetNextForced())
+	assert.Equal(t, sc.NewOption[grandpa.Stalled](nil), grandpa.StorageGetStalled())
+	assert.Equal(t, grandpa.SetId(1), grandpa.StorageGetCurrentSetId())
+
+	grandpa.OnFinalize(3)
+
+	consensusLogs := system.StorageGetDigest().OfType(types.DigestTypeConsensusMessage)
+	assert.Len(t, consensusLogs, 1)
+	assert.Equal(t, grandpa.ConsensusLogForcedChange, consensusLogs[0].Payload[0])
+
+	grandpa.OnFinalize(13)
+
+	assert.Equal(t, sc.NewOption[grandpa.StoredPendingChange](nil), grandpa.StorageGetPendingChange())
+	assert.Equal(t,
+		sc.Sequence[types.Authority]{{Id: aliceKey, Weight: 1}, {Id: bobKey, Weight: 1}},
+		pendingChange.Value.NextAuthorities)
+}
@@ -0,0 +1,119 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	cg "github.com/LimeChain/gosemble/constants/grandpa"
+	"github.com/LimeChain/gosemble/frame/grandpa"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type NoteStalledCall struct {
+	primitives.Callable
+}
+
+func NewNoteStalledCall(args sc.VaryingData) NoteStalledCall {
+	call := NoteStalledCall{
+		Callable: primitives.Callable{
+			ModuleId:   cg.ModuleIndex,
+			FunctionId: cg.FunctionNoteStalledIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c NoteStalledCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU32(buffer),
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c NoteStalledCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c NoteStalledCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c NoteStalledCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c NoteStalledCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c NoteStalledCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ NoteStalledCall) BaseWeight(b ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `0`
+	//  Estimated: `0`
+	// Minimum execution time: 4_065 nanoseconds.
+	r := constants.DbWeight.Reads(0)
+	w := constants.DbWeight.Writes(1)
+	e := types.WeightFromParts(0, 0)
+	return types.WeightFromParts(4_065_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ NoteStalledCall) IsInherent() bool {
+	return false
+}
+
+func (_ NoteStalledCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ NoteStalledCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassOperational()
+}
+
+func (_ NoteStalledCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ NoteStalledCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := noteStalled(origin, args[0].(types.BlockNumber), args[1].(types.BlockNumber))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// noteStalled notes that finality has stalled. A forced change of the authority set is scheduled
+// at the start of the next session, which is enacted after `delay` blocks and with the best finalized
+// block `bestFinalizedBlockNumber`. The origin must be root.
+func noteStalled(origin types.RuntimeOrigin, delay types.BlockNumber, bestFinalizedBlockNumber types.BlockNumber) types.DispatchError {
+	if !origin.IsRootOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	grandpa.OnStalled(delay, bestFinalizedBlockNumber)
+
+	return nil
+}
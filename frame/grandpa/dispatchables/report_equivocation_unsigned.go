@@ -0,0 +1,119 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	cg "github.com/LimeChain/gosemble/constants/grandpa"
+	"github.com/LimeChain/gosemble/frame/grandpa"
+	"github.com/LimeChain/gosemble/frame/session"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ReportEquivocationUnsignedCall struct {
+	primitives.Callable
+}
+
+func NewReportEquivocationUnsignedCall(args sc.VaryingData) ReportEquivocationUnsignedCall {
+	call := ReportEquivocationUnsignedCall{
+		Callable: primitives.Callable{
+			ModuleId:   cg.ModuleIndex,
+			FunctionId: cg.FunctionReportEquivocationUnsignedIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ReportEquivocationUnsignedCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		grandpa.DecodeEquivocationProof(buffer),
+		session.DecodeMembershipProof(buffer),
+	)
+	return c
+}
+
+func (c ReportEquivocationUnsignedCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ReportEquivocationUnsignedCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ReportEquivocationUnsignedCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ReportEquivocationUnsignedCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c ReportEquivocationUnsignedCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ReportEquivocationUnsignedCall) BaseWeight(b ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `1021`
+	//  Estimated: `9286`
+	// Minimum execution time: 176_458 nanoseconds.
+	r := constants.DbWeight.Reads(6)
+	w := constants.DbWeight.Writes(1)
+	e := types.WeightFromParts(0, 9286)
+	return types.WeightFromParts(176_458_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ReportEquivocationUnsignedCall) IsInherent() bool {
+	return false
+}
+
+func (_ ReportEquivocationUnsignedCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ ReportEquivocationUnsignedCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ReportEquivocationUnsignedCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ReportEquivocationUnsignedCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := reportEquivocationUnsigned(origin, args[0].(grandpa.EquivocationProof), args[1].(session.MembershipProof))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok: types.PostDispatchInfo{
+			PaysFee: types.PaysNo,
+		},
+	}
+}
+
+// reportEquivocationUnsigned reports an equivocation of an authority in an unsigned extrinsic,
+// which is submitted by the block author. The report is validated by the module before it is dispatched.
+func reportEquivocationUnsigned(origin types.RuntimeOrigin, equivocationProof grandpa.EquivocationProof, keyOwnerProof session.MembershipProof) types.DispatchError {
+	if !origin.IsNoneOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return grandpa.ReportEquivocation(equivocationProof, keyOwnerProof)
+}
@@ -0,0 +1,159 @@
+package grandpa
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/grandpa"
+	ext "github.com/LimeChain/gosemble/execution/types"
+	"github.com/LimeChain/gosemble/frame/grandpa/errors"
+	"github.com/LimeChain/gosemble/frame/session"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/offchain"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// ReportEquivocation checks the equivocation proof and the key ownership proof of the offender,
+// and disables the offender for the rest of the session. Each offender is reported at most once per session.
+func ReportEquivocation(equivocationProof EquivocationProof, keyOwnerProof session.MembershipProof) types.DispatchError {
+	offenderIndex, err := checkEquivocationReport(equivocationProof, keyOwnerProof)
+	if err != nil {
+		return err
+	}
+
+	session.DisableIndex(offenderIndex)
+
+	return nil
+}
+
+// ValidateUnsignedEquivocationReport validates an unsigned equivocation report. Only reports, which are
+// submitted by the local node or included in a block, are accepted, and they are not propagated.
+func ValidateUnsignedEquivocationReport(source types.TransactionSource, equivocationProof EquivocationProof, keyOwnerProof session.MembershipProof) (types.ValidTransaction, types.TransactionValidityError) {
+	if source[0] != types.TransactionSourceLocal && source[0] != types.TransactionSourceInBlock {
+		log.Warn("rejecting unsigned report equivocation transaction because it is not local/in-block")
+		return types.ValidTransaction{}, types.NewTransactionValidityError(types.NewInvalidTransactionCall())
+	}
+
+	err := PreDispatchUnsignedEquivocationReport(equivocationProof, keyOwnerProof)
+	if err != nil {
+		return types.ValidTransaction{}, err
+	}
+
+	equivocation := equivocationProof.Equivocation
+	tag := sc.Sequence[sc.U8]{}
+	tag = append(tag, sc.BytesToSequenceU8(sc.Str("GrandpaEquivocation").Bytes())...)
+	tag = append(tag, equivocation.Identity...)
+	tag = append(tag, sc.BytesToSequenceU8(equivocationProof.SetId.Bytes())...)
+	tag = append(tag, sc.BytesToSequenceU8(equivocation.RoundNumber.Bytes())...)
+
+	return types.ValidTransaction{
+		Priority:  types.TransactionPriority(math.MaxUint64),
+		Requires:  sc.Sequence[types.TransactionTag]{},
+		Provides:  sc.Sequence[types.TransactionTag]{tag},
+		Longevity: grandpa.ReportLongevity,
+		Propagate: false,
+	}, nil
+}
+
+// PreDispatchUnsignedEquivocationReport checks an unsigned equivocation report before it is dispatched.
+func PreDispatchUnsignedEquivocationReport(equivocationProof EquivocationProof, keyOwnerProof session.MembershipProof) types.TransactionValidityError {
+	_, err := checkEquivocationReport(equivocationProof, keyOwnerProof)
+	if err == nil {
+		return nil
+	}
+
+	if reflect.DeepEqual(err, newModuleError(errors.ErrorDuplicateOffenceReport)) {
+		return types.NewTransactionValidityError(types.NewInvalidTransactionStale())
+	}
+
+	return types.NewTransactionValidityError(types.NewInvalidTransactionBadProof())
+}
+
+// checkEquivocationReport checks the equivocation report and returns the index of the offender
+// in the current validators.
+func checkEquivocationReport(equivocationProof EquivocationProof, keyOwnerProof session.MembershipProof) (sc.U32, types.DispatchError) {
+	equivocation := equivocationProof.Equivocation
+
+	_, offenderIndex, ok := session.CheckKeyOwnershipProof(grandpa.KeyTypeId, equivocation.Identity, keyOwnerProof)
+	if !ok {
+		return 0, newModuleError(errors.ErrorInvalidKeyOwnershipProof)
+	}
+
+	if !checkEquivocationProof(equivocationProof) {
+		return 0, newModuleError(errors.ErrorInvalidEquivocationProof)
+	}
+
+	setId := equivocationProof.SetId
+
+	// The session of the key ownership proof must be within the sessions, for which the set was responsible.
+	previousSetIdSession := sc.NewOption[sc.U32](nil)
+	if setId != 0 {
+		previousSetIdSession = StorageGetSetIdSession(setId - 1)
+		if !previousSetIdSession.HasValue {
+			return 0, newModuleError(errors.ErrorInvalidEquivocationProof)
+		}
+	}
+
+	setIdSession := StorageGetSetIdSession(setId)
+	if !setIdSession.HasValue {
+		return 0, newModuleError(errors.ErrorInvalidEquivocationProof)
+	}
+
+	if keyOwnerProof.Session > setIdSession.Value ||
+		(previousSetIdSession.HasValue && keyOwnerProof.Session <= previousSetIdSession.Value) {
+		return 0, newModuleError(errors.ErrorInvalidEquivocationProof)
+	}
+
+	if session.IsDisabled(offenderIndex) {
+		return 0, newModuleError(errors.ErrorDuplicateOffenceReport)
+	}
+
+	return offenderIndex, nil
+}
+
+// checkEquivocationProof checks that the votes of the equivocation are different and signed by the offender.
+func checkEquivocationProof(equivocationProof EquivocationProof) bool {
+	equivocation := equivocationProof.Equivocation
+
+	// If both votes have the same target, the equivocation is invalid.
+	if reflect.DeepEqual(equivocation.First.Vote, equivocation.Second.Vote) {
+		return false
+	}
+
+	validFirst := checkMessageSignature(equivocation.Kind, equivocation.First, equivocation.Identity, equivocation.RoundNumber, equivocationProof.SetId)
+	validSecond := checkMessageSignature(equivocation.Kind, equivocation.Second, equivocation.Identity, equivocation.RoundNumber, equivocationProof.SetId)
+
+	return validFirst && validSecond
+}
+
+// checkMessageSignature checks the signature of the vote, which signs the message together with its round and set id.
+func checkMessageSignature(kind sc.U8, signedVote SignedVote, id types.PublicKey, round RoundNumber, setId SetId) bool {
+	buffer := &bytes.Buffer{}
+	kind.Encode(buffer)
+	signedVote.Vote.Encode(buffer)
+	round.Encode(buffer)
+	setId.Encode(buffer)
+
+	return bool(signedVote.Signature.Verify(sc.BytesToSequenceU8(buffer.Bytes()), types.NewAddress32(id...)))
+}
+
+// submitUnsignedEquivocationReport submits an unsigned `report_equivocation_unsigned` extrinsic
+// to the transaction pool of the node.
+func submitUnsignedEquivocationReport(equivocationProof EquivocationProof, keyOwnerProof session.MembershipProof) bool {
+	call := types.Callable{
+		ModuleId:   grandpa.ModuleIndex,
+		FunctionId: grandpa.FunctionReportEquivocationUnsignedIndex,
+		Arguments:  sc.NewVaryingData(equivocationProof, keyOwnerProof),
+	}
+
+	extrinsic := sc.BytesToSequenceU8(append([]byte{ext.ExtrinsicFormatVersion}, call.Bytes()...))
+
+	ok := offchain.SubmitTransaction(extrinsic.Bytes())
+	if !ok {
+		log.Warn("failed to submit the equivocation report")
+	}
+
+	return ok
+}
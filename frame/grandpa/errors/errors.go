@@ -0,0 +1,14 @@
+package errors
+
+import sc "github.com/LimeChain/goscale"
+
+// Grandpa module errors.
+const (
+	ErrorPauseFailed sc.U8 = iota
+	ErrorResumeFailed
+	ErrorChangePending
+	ErrorTooSoon
+	ErrorInvalidKeyOwnershipProof
+	ErrorInvalidEquivocationProof
+	ErrorDuplicateOffenceReport
+)
@@ -0,0 +1,52 @@
+package grandpa
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/grandpa"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Grandpa module events.
+const (
+	EventNewAuthorities sc.U8 = iota
+	EventPaused
+	EventResumed
+)
+
+func NewEventNewAuthorities(authoritySet sc.Sequence[types.Authority]) types.Event {
+	return types.NewEvent(grandpa.ModuleIndex, EventNewAuthorities, authoritySet)
+}
+
+func NewEventPaused() types.Event {
+	return types.NewEvent(grandpa.ModuleIndex, EventPaused)
+}
+
+func NewEventResumed() types.Event {
+	return types.NewEvent(grandpa.ModuleIndex, EventResumed)
+}
+
+func DecodeEvent(buffer *bytes.Buffer) types.Event {
+	moduleIndex := sc.DecodeU8(buffer)
+	if moduleIndex != grandpa.ModuleIndex {
+		log.Critical("invalid grandpa.Event")
+	}
+
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case EventNewAuthorities:
+		authoritySet := sc.DecodeSequenceWith(buffer, types.DecodeAuthority)
+		return NewEventNewAuthorities(authoritySet)
+	case EventPaused:
+		return NewEventPaused()
+	case EventResumed:
+		return NewEventResumed()
+	default:
+		log.Critical("invalid grandpa.Event type")
+	}
+
+	panic("unreachable")
+}
@@ -1,17 +1,29 @@
 package grandpa
 
 import (
+	"bytes"
 	"fmt"
 
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/grandpa"
+	"github.com/LimeChain/gosemble/frame/session"
+	"github.com/LimeChain/gosemble/frame/system"
 	"github.com/LimeChain/gosemble/primitives/log"
 	"github.com/LimeChain/gosemble/primitives/storage"
 	"github.com/LimeChain/gosemble/primitives/types"
 	"github.com/LimeChain/gosemble/utils"
 )
 
+// Consensus log types, deposited by GRANDPA.
+const (
+	ConsensusLogScheduledChange sc.U8 = iota + 1
+	ConsensusLogForcedChange
+	ConsensusLogOnDisabled
+	ConsensusLogPause
+	ConsensusLogResume
+)
+
 // Authorities returns the current set of authorities, including their respective weights.
 // Returns a pointer-size of the SCALE-encoded set of authorities with their weights.
 // [Specification](https://spec.polkadot.network/chap-runtime-api#sect-rte-grandpa-auth)
@@ -26,3 +38,141 @@ func Authorities() int64 {
 
 	return utils.BytesToOffsetAndSize(authorities.Bytes())
 }
+
+// CurrentSetId returns the id of the current authority set.
+// Returns a pointer-size of the SCALE-encoded set id.
+func CurrentSetId() int64 {
+	return utils.BytesToOffsetAndSize(StorageGetCurrentSetId().Bytes())
+}
+
+// SubmitReportEquivocationUnsignedExtrinsic submits an unsigned extrinsic, which reports an equivocation.
+// It takes two arguments:
+// - dataPtr: Pointer to the data in the Wasm memory.
+// - dataLen: Length of the data.
+// which represent the SCALE-encoded equivocation proof and opaque key ownership proof.
+// Returns a pointer-size of the SCALE-encoded optional empty tuple, which is none, if the
+// extrinsic could not be submitted.
+func SubmitReportEquivocationUnsignedExtrinsic(dataPtr int32, dataLen int32) int64 {
+	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
+	buffer := bytes.NewBuffer(b)
+
+	equivocationProof := DecodeEquivocationProof(buffer)
+	opaqueKeyOwnerProof := sc.DecodeSequence[sc.U8](buffer)
+	keyOwnerProof := session.DecodeMembershipProof(bytes.NewBuffer(sc.SequenceU8ToBytes(opaqueKeyOwnerProof)))
+
+	if !submitUnsignedEquivocationReport(equivocationProof, keyOwnerProof) {
+		return utils.BytesToOffsetAndSize(sc.NewOption[sc.Empty](nil).Bytes())
+	}
+
+	return utils.BytesToOffsetAndSize(sc.NewOption[sc.Empty](sc.Empty{}).Bytes())
+}
+
+// GenerateKeyOwnershipProof generates a proof of the membership of the authority in the given set.
+// It takes two arguments:
+// - dataPtr: Pointer to the data in the Wasm memory.
+// - dataLen: Length of the data.
+// which represent the SCALE-encoded set id and authority id.
+// Returns a pointer-size of the SCALE-encoded optional opaque key ownership proof, which is none,
+// if the authority is not a member of the current validator set.
+func GenerateKeyOwnershipProof(dataPtr int32, dataLen int32) int64 {
+	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
+	buffer := bytes.NewBuffer(b)
+
+	// The set id is not needed, since only proofs of the current session can be generated.
+	_ = sc.DecodeU64(buffer)
+	authorityId := types.DecodePublicKey(buffer)
+
+	proof := session.ProveKeyOwnership(grandpa.KeyTypeId, authorityId)
+	if !proof.HasValue {
+		return utils.BytesToOffsetAndSize(sc.NewOption[sc.Sequence[sc.U8]](nil).Bytes())
+	}
+
+	opaqueProof := sc.BytesToSequenceU8(proof.Value.Bytes())
+	return utils.BytesToOffsetAndSize(sc.NewOption[sc.Sequence[sc.U8]](opaqueProof).Bytes())
+}
+
+// OnGenesisSession initializes the authorities with the keys of the genesis validators.
+func OnGenesisSession(validators []types.ValidatorKey) {
+	if len(validators) == 0 {
+		return
+	}
+
+	if len(storageGetAuthorities()) != 0 {
+		log.Critical("Authorities are already initialized!")
+	}
+
+	storageSetAuthorities(authoritiesFromValidators(validators))
+	storageSetSetIdSession(0, 0)
+}
+
+// OnNewSession schedules a change of the authorities to the keys of the new session validators,
+// if the validator set or its keys have changed, or a stall of finality was noted. The change is
+// forced, if finality is stalled. The id of the authority set increases with each scheduled change.
+func OnNewSession(changed bool, validators []types.ValidatorKey) {
+	currentSetId := StorageGetCurrentSetId()
+
+	stalled := StorageGetStalled()
+	if changed || bool(stalled.HasValue) {
+		next := authoritiesFromValidators(validators)
+
+		var err types.DispatchError
+		if stalled.HasValue {
+			storageClearStalled()
+			err = ScheduleChange(next, stalled.Value.FurtherWait, sc.NewOption[types.BlockNumber](stalled.Value.Median))
+		} else {
+			err = ScheduleChange(next, 0, sc.NewOption[types.BlockNumber](nil))
+		}
+
+		// The set id increases only if the change was scheduled.
+		if err == nil {
+			currentSetId += 1
+			storageSetCurrentSetId(currentSetId)
+
+			if currentSetId >= grandpa.MaxSetIdSessionEntries {
+				storageRemoveSetIdSession(currentSetId - grandpa.MaxSetIdSessionEntries)
+			}
+		}
+	}
+
+	storageSetSetIdSession(currentSetId, session.StorageGetCurrentIndex())
+}
+
+// OnDisabled signals the client that the authority at `index` is disabled.
+func OnDisabled(index sc.U32) {
+	system.DepositLog(consensusLog(ConsensusLogOnDisabled, sc.U64(index).Bytes()))
+}
+
+func storageGetAuthorities() sc.Sequence[types.Authority] {
+	versionedAuthorityList := storage.GetDecode(constants.KeyGrandpaAuthorities, types.DecodeVersionedAuthorityList)
+
+	return versionedAuthorityList.AuthorityList
+}
+
+func storageSetAuthorities(authorities sc.Sequence[types.Authority]) {
+	versionedAuthorityList := types.VersionedAuthorityList{
+		Version:       grandpa.AuthorityVersion,
+		AuthorityList: authorities,
+	}
+
+	storage.Set(constants.KeyGrandpaAuthorities, versionedAuthorityList.Bytes())
+}
+
+// authoritiesFromValidators returns the keys of the validators as equally weighted authorities.
+func authoritiesFromValidators(validators []types.ValidatorKey) sc.Sequence[types.Authority] {
+	authorities := make(sc.Sequence[types.Authority], len(validators))
+	for i, validator := range validators {
+		authorities[i] = types.Authority{
+			Id:     validator.Key,
+			Weight: 1,
+		}
+	}
+
+	return authorities
+}
+
+func consensusLog(logType sc.U8, payload []byte) types.DigestItem {
+	return types.NewDigestItemConsensusMessage(
+		sc.BytesToFixedSequenceU8(grandpa.EngineId[:]),
+		sc.BytesToSequenceU8(append([]byte{byte(logType)}, payload...)),
+	)
+}
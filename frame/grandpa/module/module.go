@@ -4,48 +4,350 @@ import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants/grandpa"
 	"github.com/LimeChain/gosemble/constants/metadata"
+	gp "github.com/LimeChain/gosemble/frame/grandpa"
+	"github.com/LimeChain/gosemble/frame/grandpa/dispatchables"
+	"github.com/LimeChain/gosemble/frame/grandpa/errors"
+	"github.com/LimeChain/gosemble/frame/session"
+	"github.com/LimeChain/gosemble/frame/support"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
 type GrandpaModule struct {
+	primitives.DefaultHooks
+	functions map[sc.U8]primitives.Call
 }
 
 func NewGrandpaModule() GrandpaModule {
-	return GrandpaModule{}
+	functions := make(map[sc.U8]primitives.Call)
+	functions[grandpa.FunctionReportEquivocationIndex] = dispatchables.NewReportEquivocationCall(nil)
+	functions[grandpa.FunctionReportEquivocationUnsignedIndex] = dispatchables.NewReportEquivocationUnsignedCall(nil)
+	functions[grandpa.FunctionNoteStalledIndex] = dispatchables.NewNoteStalledCall(nil)
+
+	return GrandpaModule{
+		functions: functions,
+	}
 }
 
 func (gm GrandpaModule) Functions() map[sc.U8]primitives.Call {
-	return map[sc.U8]primitives.Call{}
+	return gm.functions
 }
 
-func (gm GrandpaModule) PreDispatch(_ primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
-	return sc.Empty{}, nil
+func (gm GrandpaModule) PreDispatch(call primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	if call.FunctionIndex() != grandpa.FunctionReportEquivocationUnsignedIndex {
+		return sc.Empty{}, nil
+	}
+
+	args := call.Args()
+	return sc.Empty{}, gp.PreDispatchUnsignedEquivocationReport(args[0].(gp.EquivocationProof), args[1].(session.MembershipProof))
 }
 
-func (gm GrandpaModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
-	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+func (gm GrandpaModule) ValidateUnsigned(source primitives.TransactionSource, call primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	if call.FunctionIndex() != grandpa.FunctionReportEquivocationUnsignedIndex {
+		return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+	}
+
+	args := call.Args()
+	return gp.ValidateUnsignedEquivocationReport(source, args[0].(gp.EquivocationProof), args[1].(session.MembershipProof))
+}
+
+func (gm GrandpaModule) OnFinalize(n primitives.BlockNumber) {
+	gp.OnFinalize(n)
+}
+
+func (gm GrandpaModule) KeyTypeId() [4]byte {
+	return grandpa.KeyTypeId
+}
+
+func (gm GrandpaModule) OnGenesisSession(validators []primitives.ValidatorKey) {
+	gp.OnGenesisSession(validators)
+}
+
+func (gm GrandpaModule) OnNewSession(changed bool, validators []primitives.ValidatorKey, _ []primitives.ValidatorKey) {
+	gp.OnNewSession(changed, validators)
+}
+
+func (gm GrandpaModule) OnBeforeSessionEnding() {}
+
+func (gm GrandpaModule) OnDisabled(validatorIndex sc.U32) {
+	gp.OnDisabled(validatorIndex)
 }
 
 func (gm GrandpaModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
-	return gm.metadataTypes(), primitives.MetadataModule{
-		Name:      "Grandpa",
-		Storage:   sc.Option[primitives.MetadataModuleStorage]{},
-		Call:      sc.NewOption[sc.Compact](nil),
-		Event:     sc.NewOption[sc.Compact](nil),
-		Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
-		Error:     sc.NewOption[sc.Compact](nil),
-		Index:     grandpa.ModuleIndex,
+	declaredTypes, metadataModule := gm.declaration().Metadata()
+
+	return append(gm.metadataTypes(), declaredTypes...), metadataModule
+}
+
+// declaration declares the storage, calls, events, errors and constants of the module,
+// from which its metadata is derived.
+func (gm GrandpaModule) declaration() support.ModuleDeclaration {
+	return support.ModuleDeclaration{
+		Name:    "Grandpa",
+		Index:   grandpa.ModuleIndex,
+		Path:    "pallet_grandpa",
+		Storage: gp.StorageDeclarations(),
+		Calls: &support.EnumDeclaration{
+			TypeId:   metadata.GrandpaCalls,
+			Variants: callDeclarations,
+		},
+		Events: &support.EnumDeclaration{
+			TypeId:   metadata.TypesGrandpaEvent,
+			Variants: eventDeclarations,
+		},
+		Errors: &support.EnumDeclaration{
+			TypeId:   metadata.TypesGrandpaErrors,
+			Variants: errorDeclarations,
+		},
+		Constants: []support.ConstantDeclaration{
+			{
+				Name:  "MaxAuthorities",
+				Value: grandpa.MaxAuthorities,
+				Docs:  "Max Authorities in use",
+			},
+			{
+				Name:  "MaxSetIdSessionEntries",
+				Value: grandpa.MaxSetIdSessionEntries,
+				Docs:  "The maximum number of entries to keep in the set id to session index mapping.",
+			},
+		},
 	}
 }
 
 func (gm GrandpaModule) metadataTypes() sc.Sequence[primitives.MetadataType] {
 	return sc.Sequence[primitives.MetadataType]{
-		primitives.NewMetadataTypeWithParams(metadata.GrandpaCalls, "Grandpa calls", sc.Sequence[sc.Str]{"pallet_grandpa", "pallet", "Call"}, primitives.NewMetadataTypeDefinitionVariant(
-			// TODO: types
-			sc.Sequence[primitives.MetadataDefinitionVariant]{}),
-			sc.Sequence[primitives.MetadataTypeParameter]{
-				primitives.NewMetadataEmptyTypeParameter("T"),
-				primitives.NewMetadataEmptyTypeParameter("I"),
-			}),
+		primitives.NewMetadataTypeWithPath(metadata.TypesGrandpaAppPublic, "sp_consensus_grandpa app Public", sc.Sequence[sc.Str]{"sp_consensus_grandpa", "app", "Public"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesFixedSequence32U8, "ed25519::Public"),
+				})),
+		primitives.NewMetadataType(metadata.TypesTupleGrandpaAppPublicU64, "(AuthorityId, AuthorityWeight)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.TypesGrandpaAppPublic), sc.ToCompact(metadata.PrimitiveTypesU64)})),
+		primitives.NewMetadataType(metadata.TypesSequenceTupleGrandpaAppPublicU64, "AuthorityList",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesTupleGrandpaAppPublicU64))),
+		primitives.NewMetadataTypeWithParam(metadata.TypesGrandpaStoredState, "StoredState", sc.Sequence[sc.Str]{"pallet_grandpa", "StoredState"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"Live",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						gp.StoredStateLive,
+						"StoredState.Live"),
+					primitives.NewMetadataDefinitionVariant(
+						"PendingPause",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "scheduled_at", "N"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "delay", "N"),
+						},
+						gp.StoredStatePendingPause,
+						"StoredState.PendingPause"),
+					primitives.NewMetadataDefinitionVariant(
+						"Paused",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						gp.StoredStatePaused,
+						"StoredState.Paused"),
+					primitives.NewMetadataDefinitionVariant(
+						"PendingResume",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "scheduled_at", "N"),
+							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "delay", "N"),
+						},
+						gp.StoredStatePendingResume,
+						"StoredState.PendingResume"),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU32, "N")),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesOptionU32, "Option<u32>", sc.Sequence[sc.Str]{"Option"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"None",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						0,
+						"Option<u32>(nil)"),
+					primitives.NewMetadataDefinitionVariant(
+						"Some",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU32),
+						},
+						1,
+						"Option<u32>(value)"),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU32, "T")),
+
+		primitives.NewMetadataTypeWithParam(metadata.TypesGrandpaStoredPendingChange, "StoredPendingChange", sc.Sequence[sc.Str]{"pallet_grandpa", "StoredPendingChange"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "scheduled_at", "N"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "delay", "N"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceTupleGrandpaAppPublicU64, "next_authorities", "BoundedAuthorityList<Limit>"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionU32, "forced", "Option<N>"),
+				}),
+			primitives.NewMetadataTypeParameter(metadata.PrimitiveTypesU32, "N")),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesGrandpaAppSignature, "sp_consensus_grandpa app Signature", sc.Sequence[sc.Str]{"sp_consensus_grandpa", "app", "Signature"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesSignatureEd25519, "ed25519::Signature"),
+				})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesGrandpaPrevote, "Prevote", sc.Sequence[sc.Str]{"finality_grandpa", "Prevote"},
+			primitives.NewMetadataTypeDefinitionComposite(voteFields())),
+		primitives.NewMetadataTypeWithPath(metadata.TypesGrandpaPrecommit, "Precommit", sc.Sequence[sc.Str]{"finality_grandpa", "Precommit"},
+			primitives.NewMetadataTypeDefinitionComposite(voteFields())),
+
+		primitives.NewMetadataType(metadata.TypesTupleGrandpaPrevoteSignature, "(Prevote, AuthoritySignature)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{
+				sc.ToCompact(metadata.TypesGrandpaPrevote),
+				sc.ToCompact(metadata.TypesGrandpaAppSignature),
+			})),
+		primitives.NewMetadataType(metadata.TypesTupleGrandpaPrecommitSignature, "(Precommit, AuthoritySignature)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{
+				sc.ToCompact(metadata.TypesGrandpaPrecommit),
+				sc.ToCompact(metadata.TypesGrandpaAppSignature),
+			})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesGrandpaEquivocationPrevote, "Equivocation<AuthorityId, Prevote, AuthoritySignature>", sc.Sequence[sc.Str]{"finality_grandpa", "Equivocation"},
+			primitives.NewMetadataTypeDefinitionComposite(equivocationFields(metadata.TypesTupleGrandpaPrevoteSignature))),
+		primitives.NewMetadataTypeWithPath(metadata.TypesGrandpaEquivocationPrecommit, "Equivocation<AuthorityId, Precommit, AuthoritySignature>", sc.Sequence[sc.Str]{"finality_grandpa", "Equivocation"},
+			primitives.NewMetadataTypeDefinitionComposite(equivocationFields(metadata.TypesTupleGrandpaPrecommitSignature))),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesGrandpaEquivocation, "Equivocation", sc.Sequence[sc.Str]{"sp_consensus_grandpa", "Equivocation"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"Prevote",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesGrandpaEquivocationPrevote, "grandpa::Equivocation<AuthorityId, grandpa::Prevote<H, N>, AuthoritySignature>"),
+						},
+						gp.MessagePrevote,
+						"Equivocation.Prevote"),
+					primitives.NewMetadataDefinitionVariant(
+						"Precommit",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesGrandpaEquivocationPrecommit, "grandpa::Equivocation<AuthorityId, grandpa::Precommit<H, N>, AuthoritySignature>"),
+						},
+						gp.MessagePrecommit,
+						"Equivocation.Precommit"),
+				})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesGrandpaEquivocationProof, "EquivocationProof", sc.Sequence[sc.Str]{"sp_consensus_grandpa", "EquivocationProof"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "set_id", "SetId"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesGrandpaEquivocation, "equivocation", "Equivocation<H, N>"),
+				})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesMembershipProof, "MembershipProof", sc.Sequence[sc.Str]{"sp_session", "MembershipProof"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "session", "SessionIndex"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceSequenceU8, "trie_nodes", "Vec<Vec<u8>>"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "validator_count", "ValidatorCount"),
+				})),
+	}
+}
+
+func voteFields() sc.Sequence[primitives.MetadataTypeDefinitionField] {
+	return sc.Sequence[primitives.MetadataTypeDefinitionField]{
+		primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "target_hash", "H"),
+		primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "target_number", "N"),
 	}
 }
+
+func equivocationFields(signedVote int) sc.Sequence[primitives.MetadataTypeDefinitionField] {
+	return sc.Sequence[primitives.MetadataTypeDefinitionField]{
+		primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "round_number", "u64"),
+		primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesGrandpaAppPublic, "identity", "Id"),
+		primitives.NewMetadataTypeDefinitionFieldWithNames(signedVote, "first", "(V, S)"),
+		primitives.NewMetadataTypeDefinitionFieldWithNames(signedVote, "second", "(V, S)"),
+	}
+}
+
+var callDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "report_equivocation",
+		Index: grandpa.FunctionReportEquivocationIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesGrandpaEquivocationProof, "equivocation_proof", "Box<EquivocationProof<T::Hash, BlockNumberFor<T>>>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMembershipProof, "key_owner_proof", "T::KeyOwnerProof"),
+		},
+		Docs: "Report voter equivocation/misbehavior. This method will verify the equivocation proof and validate the given key ownership proof against the extracted offender. If both are valid, the offence will be reported.",
+	},
+	{
+		Name:  "report_equivocation_unsigned",
+		Index: grandpa.FunctionReportEquivocationUnsignedIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesGrandpaEquivocationProof, "equivocation_proof", "Box<EquivocationProof<T::Hash, BlockNumberFor<T>>>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMembershipProof, "key_owner_proof", "T::KeyOwnerProof"),
+		},
+		Docs: "Report voter equivocation/misbehavior. This method will verify the equivocation proof and validate the given key ownership proof against the extracted offender. If both are valid, the offence will be reported. This extrinsic must be called unsigned and it is expected that only block authors will call it (validated in `ValidateUnsigned`), as such if the block author is defined it will be defined as the equivocation reporter.",
+	},
+	{
+		Name:  "note_stalled",
+		Index: grandpa.FunctionNoteStalledIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "delay", "BlockNumberFor<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "best_finalized_block_number", "BlockNumberFor<T>"),
+		},
+		Docs: "Note that the current authority set of the GRANDPA finality gadget has stalled. This will trigger a forced authority set change at the beginning of the next session, to be enacted `delay` blocks after that. The `delay` should be high enough to safely assume that the block signalling the forced change will not be re-orged e.g. 1000 blocks. The block production rate (which may be slowed down because of finality lagging) should be taken into account when choosing the `delay`. The GRANDPA voters based on the new authority will start voting on top of `best_finalized_block_number` for new finalized blocks. `best_finalized_block_number` should be the highest of the latest finalized block of all validators of the new authority set. Only callable by root.",
+	},
+}
+
+var eventDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "NewAuthorities",
+		Index: gp.EventNewAuthorities,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceTupleGrandpaAppPublicU64, "authority_set", "AuthorityList"),
+		},
+		Docs: "New authority set has been applied.",
+	},
+	{
+		Name:  "Paused",
+		Index: gp.EventPaused,
+		Docs:  "Current authority set has been paused.",
+	},
+	{
+		Name:  "Resumed",
+		Index: gp.EventResumed,
+		Docs:  "Current authority set has been resumed.",
+	},
+}
+
+var errorDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "PauseFailed",
+		Index: errors.ErrorPauseFailed,
+		Docs:  "Attempt to signal GRANDPA pause when the authority set isn't live (either paused or already pending pause).",
+	},
+	{
+		Name:  "ResumeFailed",
+		Index: errors.ErrorResumeFailed,
+		Docs:  "Attempt to signal GRANDPA resume when the authority set isn't paused (either live or already pending resume).",
+	},
+	{
+		Name:  "ChangePending",
+		Index: errors.ErrorChangePending,
+		Docs:  "Attempt to signal GRANDPA change with one already pending.",
+	},
+	{
+		Name:  "TooSoon",
+		Index: errors.ErrorTooSoon,
+		Docs:  "Cannot signal forced change so soon after last.",
+	},
+	{
+		Name:  "InvalidKeyOwnershipProof",
+		Index: errors.ErrorInvalidKeyOwnershipProof,
+		Docs:  "A key ownership proof provided as part of an equivocation report is invalid.",
+	},
+	{
+		Name:  "InvalidEquivocationProof",
+		Index: errors.ErrorInvalidEquivocationProof,
+		Docs:  "An equivocation proof provided as part of an equivocation report is invalid.",
+	},
+	{
+		Name:  "DuplicateOffenceReport",
+		Index: errors.ErrorDuplicateOffenceReport,
+		Docs:  "A given equivocation report is valid but already previously reported.",
+	},
+}
@@ -0,0 +1,131 @@
+package grandpa
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	storageState         = support.NewStorageValue[StoredState](constants.KeyGrandpa, constants.KeyState, DecodeStoredState)
+	storagePendingChange = support.NewStorageValue[StoredPendingChange](constants.KeyGrandpa, constants.KeyPendingChange, DecodeStoredPendingChange)
+	storageNextForced    = support.NewStorageValue[types.BlockNumber](constants.KeyGrandpa, constants.KeyNextForced, sc.DecodeU32)
+	storageStalled       = support.NewStorageValue[Stalled](constants.KeyGrandpa, constants.KeyStalled, DecodeStalled)
+	storageCurrentSetId  = support.NewStorageValue[SetId](constants.KeyGrandpa, constants.KeyCurrentSetId, sc.DecodeU64)
+	storageSetIdSession  = support.NewStorageMap[SetId, sc.U32](constants.KeyGrandpa, constants.KeySetIdSession, support.Twox64Concat{}, sc.DecodeU64, sc.DecodeU32)
+)
+
+func init() {
+	support.RegisterTypeId(metadata.TypesGrandpaStoredState, support.TypeOf[StoredState]())
+	support.RegisterTypeId(metadata.TypesGrandpaStoredPendingChange, support.TypeOf[StoredPendingChange]())
+	support.RegisterTypeId(metadata.TypesTupleU32U32, support.TypeOf[Stalled]())
+}
+
+// StorageDeclarations returns the declarations of the storage items of the module, in the order of the metadata.
+func StorageDeclarations() []support.StorageDeclaration {
+	return []support.StorageDeclaration{
+		{
+			Item:     storageState,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "State of the current authority set.",
+		},
+		{
+			Item:     storagePendingChange,
+			Modifier: types.MetadataModuleStorageEntryModifierOptional,
+			Docs:     "Pending change: (signaled at, scheduled change).",
+		},
+		{
+			Item:     storageNextForced,
+			Modifier: types.MetadataModuleStorageEntryModifierOptional,
+			Docs:     "next block number where we can force a change.",
+		},
+		{
+			Item:     storageStalled,
+			Modifier: types.MetadataModuleStorageEntryModifierOptional,
+			Docs:     "`true` if we are currently stalled.",
+		},
+		{
+			Item:     storageCurrentSetId,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "The number of changes (both in terms of keys and underlying economic responsibilities) in the \"set\" of Grandpa validators from genesis.",
+		},
+		{
+			Item:     storageSetIdSession,
+			Modifier: types.MetadataModuleStorageEntryModifierOptional,
+			Docs:     "A mapping from grandpa set ID to the index of the *most recent* session for which its members were responsible.",
+		},
+	}
+}
+
+// StorageGetState returns the state of the current authority set. The authority set is live by default.
+func StorageGetState() StoredState {
+	state := storageState.GetOption()
+	if !state.HasValue {
+		return NewStoredStateLive()
+	}
+
+	return state.Value
+}
+
+func storageSetState(state StoredState) {
+	storageState.Put(state)
+}
+
+// StorageGetPendingChange returns the pending change of the authority set, if there is one.
+func StorageGetPendingChange() sc.Option[StoredPendingChange] {
+	return storagePendingChange.GetOption()
+}
+
+func storageSetPendingChange(change StoredPendingChange) {
+	storagePendingChange.Put(change)
+}
+
+func storageClearPendingChange() {
+	storagePendingChange.Clear()
+}
+
+// StorageGetNextForced returns the next block, at which a forced change can be scheduled, if there is one.
+func StorageGetNextForced() sc.Option[types.BlockNumber] {
+	return storageNextForced.GetOption()
+}
+
+func storageSetNextForced(n types.BlockNumber) {
+	storageNextForced.Put(n)
+}
+
+// StorageGetStalled returns the noted stall of finality, if there is one.
+func StorageGetStalled() sc.Option[Stalled] {
+	return storageStalled.GetOption()
+}
+
+func storageSetStalled(stalled Stalled) {
+	storageStalled.Put(stalled)
+}
+
+func storageClearStalled() {
+	storageStalled.Clear()
+}
+
+// StorageGetCurrentSetId returns the id of the current authority set.
+func StorageGetCurrentSetId() SetId {
+	return storageCurrentSetId.Get()
+}
+
+func storageSetCurrentSetId(setId SetId) {
+	storageCurrentSetId.Put(setId)
+}
+
+// StorageGetSetIdSession returns the most recent session, for which the authority set was responsible.
+func StorageGetSetIdSession(setId SetId) sc.Option[sc.U32] {
+	return storageSetIdSession.GetOption(setId)
+}
+
+func storageSetSetIdSession(setId SetId, sessionIndex sc.U32) {
+	storageSetIdSession.Put(setId, sessionIndex)
+}
+
+func storageRemoveSetIdSession(setId SetId) {
+	storageSetIdSession.Remove(setId)
+}
@@ -0,0 +1,239 @@
+package grandpa
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetId = sc.U64
+type RoundNumber = sc.U64
+
+const (
+	StoredStateLive sc.U8 = iota
+	StoredStatePendingPause
+	StoredStatePaused
+	StoredStatePendingResume
+)
+
+// StoredState is the state of the current authority set. Pending pauses and resumes
+// are signalled at `ScheduledAt` and enacted `Delay` blocks later.
+type StoredState struct {
+	sc.VaryingData
+}
+
+func NewStoredStateLive() StoredState {
+	return StoredState{sc.NewVaryingData(StoredStateLive)}
+}
+
+func NewStoredStatePendingPause(scheduledAt types.BlockNumber, delay types.BlockNumber) StoredState {
+	return StoredState{sc.NewVaryingData(StoredStatePendingPause, scheduledAt, delay)}
+}
+
+func NewStoredStatePaused() StoredState {
+	return StoredState{sc.NewVaryingData(StoredStatePaused)}
+}
+
+func NewStoredStatePendingResume(scheduledAt types.BlockNumber, delay types.BlockNumber) StoredState {
+	return StoredState{sc.NewVaryingData(StoredStatePendingResume, scheduledAt, delay)}
+}
+
+func DecodeStoredState(buffer *bytes.Buffer) StoredState {
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case StoredStateLive:
+		return NewStoredStateLive()
+	case StoredStatePendingPause:
+		return NewStoredStatePendingPause(sc.DecodeU32(buffer), sc.DecodeU32(buffer))
+	case StoredStatePaused:
+		return NewStoredStatePaused()
+	case StoredStatePendingResume:
+		return NewStoredStatePendingResume(sc.DecodeU32(buffer), sc.DecodeU32(buffer))
+	default:
+		log.Critical("invalid StoredState type")
+	}
+
+	panic("unreachable")
+}
+
+func (ss StoredState) Kind() sc.U8 {
+	return ss.VaryingData[0].(sc.U8)
+}
+
+// ScheduledAt returns the block, at which a pending pause or resume was signalled.
+func (ss StoredState) ScheduledAt() types.BlockNumber {
+	return ss.VaryingData[1].(types.BlockNumber)
+}
+
+// Delay returns the number of blocks after which a pending pause or resume is enacted.
+func (ss StoredState) Delay() types.BlockNumber {
+	return ss.VaryingData[2].(types.BlockNumber)
+}
+
+// StoredPendingChange is a pending change of the authority set.
+type StoredPendingChange struct {
+	// ScheduledAt is the block, at which the change was signalled.
+	ScheduledAt types.BlockNumber
+	// Delay is the number of blocks after which the change is enacted.
+	Delay types.BlockNumber
+	// NextAuthorities is the authority set after the change.
+	NextAuthorities sc.Sequence[types.Authority]
+	// Forced is the median last finalized block, if the change is forced.
+	Forced sc.Option[types.BlockNumber]
+}
+
+func (spc StoredPendingChange) Encode(buffer *bytes.Buffer) {
+	spc.ScheduledAt.Encode(buffer)
+	spc.Delay.Encode(buffer)
+	spc.NextAuthorities.Encode(buffer)
+	spc.Forced.Encode(buffer)
+}
+
+func (spc StoredPendingChange) Bytes() []byte {
+	return sc.EncodedBytes(spc)
+}
+
+func DecodeStoredPendingChange(buffer *bytes.Buffer) StoredPendingChange {
+	return StoredPendingChange{
+		ScheduledAt:     sc.DecodeU32(buffer),
+		Delay:           sc.DecodeU32(buffer),
+		NextAuthorities: sc.DecodeSequenceWith(buffer, types.DecodeAuthority),
+		Forced:          sc.DecodeOptionWith(buffer, sc.DecodeU32),
+	}
+}
+
+// Stalled is a stall of finality, noted to be resolved by a forced change in the next session.
+type Stalled struct {
+	// FurtherWait is the delay of the forced change.
+	FurtherWait types.BlockNumber
+	// Median is the median last finalized block number.
+	Median types.BlockNumber
+}
+
+func (s Stalled) Encode(buffer *bytes.Buffer) {
+	s.FurtherWait.Encode(buffer)
+	s.Median.Encode(buffer)
+}
+
+func (s Stalled) Bytes() []byte {
+	return sc.EncodedBytes(s)
+}
+
+func DecodeStalled(buffer *bytes.Buffer) Stalled {
+	return Stalled{
+		FurtherWait: sc.DecodeU32(buffer),
+		Median:      sc.DecodeU32(buffer),
+	}
+}
+
+// Vote is a prevote or a precommit for a block.
+type Vote struct {
+	TargetHash   types.H256
+	TargetNumber types.BlockNumber
+}
+
+func (v Vote) Encode(buffer *bytes.Buffer) {
+	v.TargetHash.Encode(buffer)
+	v.TargetNumber.Encode(buffer)
+}
+
+func (v Vote) Bytes() []byte {
+	return sc.EncodedBytes(v)
+}
+
+func DecodeVote(buffer *bytes.Buffer) Vote {
+	return Vote{
+		TargetHash:   types.DecodeH256(buffer),
+		TargetNumber: sc.DecodeU32(buffer),
+	}
+}
+
+// SignedVote is a vote together with the signature of its voter.
+type SignedVote struct {
+	Vote      Vote
+	Signature types.Ed25519
+}
+
+func (sv SignedVote) Encode(buffer *bytes.Buffer) {
+	sv.Vote.Encode(buffer)
+	sv.Signature.Encode(buffer)
+}
+
+func (sv SignedVote) Bytes() []byte {
+	return sc.EncodedBytes(sv)
+}
+
+func DecodeSignedVote(buffer *bytes.Buffer) SignedVote {
+	return SignedVote{
+		Vote:      DecodeVote(buffer),
+		Signature: types.DecodeEd25519(buffer),
+	}
+}
+
+// Message kinds, signed by the voters.
+const (
+	MessagePrevote sc.U8 = iota
+	MessagePrecommit
+)
+
+// Equivocation is a pair of different votes, cast by the same voter in the same round.
+type Equivocation struct {
+	// Kind is the kind of the votes, either MessagePrevote or MessagePrecommit.
+	Kind        sc.U8
+	RoundNumber RoundNumber
+	Identity    types.PublicKey
+	First       SignedVote
+	Second      SignedVote
+}
+
+func (e Equivocation) Encode(buffer *bytes.Buffer) {
+	e.Kind.Encode(buffer)
+	e.RoundNumber.Encode(buffer)
+	e.Identity.Encode(buffer)
+	e.First.Encode(buffer)
+	e.Second.Encode(buffer)
+}
+
+func (e Equivocation) Bytes() []byte {
+	return sc.EncodedBytes(e)
+}
+
+func DecodeEquivocation(buffer *bytes.Buffer) Equivocation {
+	kind := sc.DecodeU8(buffer)
+	if kind != MessagePrevote && kind != MessagePrecommit {
+		log.Critical("invalid Equivocation type")
+	}
+
+	return Equivocation{
+		Kind:        kind,
+		RoundNumber: sc.DecodeU64(buffer),
+		Identity:    types.DecodePublicKey(buffer),
+		First:       DecodeSignedVote(buffer),
+		Second:      DecodeSignedVote(buffer),
+	}
+}
+
+// EquivocationProof proves that an authority cast two different votes in the same round of the given set.
+type EquivocationProof struct {
+	SetId        SetId
+	Equivocation Equivocation
+}
+
+func (ep EquivocationProof) Encode(buffer *bytes.Buffer) {
+	ep.SetId.Encode(buffer)
+	ep.Equivocation.Encode(buffer)
+}
+
+func (ep EquivocationProof) Bytes() []byte {
+	return sc.EncodedBytes(ep)
+}
+
+func DecodeEquivocationProof(buffer *bytes.Buffer) EquivocationProof {
+	return EquivocationProof{
+		SetId:        sc.DecodeU64(buffer),
+		Equivocation: DecodeEquivocation(buffer),
+	}
+}
@@ -0,0 +1,131 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	ci "github.com/LimeChain/gosemble/constants/indices"
+	"github.com/LimeChain/gosemble/frame/indices"
+	"github.com/LimeChain/gosemble/frame/indices/errors"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ClaimCall struct {
+	primitives.Callable
+}
+
+func NewClaimCall(args sc.VaryingData) ClaimCall {
+	call := ClaimCall{
+		Callable: primitives.Callable{
+			ModuleId:   ci.ModuleIndex,
+			FunctionId: ci.FunctionClaimIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ClaimCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(sc.DecodeU32(buffer))
+	return c
+}
+
+func (c ClaimCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ClaimCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ClaimCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ClaimCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c ClaimCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ClaimCall) BaseWeight(b ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `76`
+	//  Estimated: `3534`
+	// Minimum execution time: 25_491 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.WeightFromParts(0, 3534)
+	return types.WeightFromParts(25_491_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ClaimCall) IsInherent() bool {
+	return false
+}
+
+func (_ ClaimCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ ClaimCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ClaimCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ClaimCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := claim(origin, args[0].(types.AccountIndex))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// claim assigns a previously unassigned index to the caller and reserves a deposit for it.
+func claim(origin types.RawOrigin, index types.AccountIndex) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+	who := origin.AsSigned()
+
+	if indices.StorageGetAccount(index).HasValue {
+		return newModuleError(errors.ErrorInUse)
+	}
+
+	err := currency.Reserve(who, ci.Deposit)
+	if err != nil {
+		return err
+	}
+
+	indices.StorageSetAccount(index, indices.AccountIndexInfo{
+		Who:     who,
+		Deposit: ci.Deposit,
+		Frozen:  false,
+	})
+	system.DepositEvent(indices.NewEventIndexAssigned(who, index))
+
+	return nil
+}
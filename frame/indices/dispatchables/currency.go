@@ -0,0 +1,11 @@
+package dispatchables
+
+import "github.com/LimeChain/gosemble/primitives/types"
+
+// currency reserves the deposits of the claimed indices.
+var currency types.ReservableCurrency
+
+// RegisterCurrency sets the currency, in which the deposits of the claimed indices are reserved.
+func RegisterCurrency(reservableCurrency types.ReservableCurrency) {
+	currency = reservableCurrency
+}
@@ -0,0 +1,132 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	ci "github.com/LimeChain/gosemble/constants/indices"
+	"github.com/LimeChain/gosemble/frame/indices"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ForceTransferCall struct {
+	primitives.Callable
+}
+
+func NewForceTransferCall(args sc.VaryingData) ForceTransferCall {
+	call := ForceTransferCall{
+		Callable: primitives.Callable{
+			ModuleId:   ci.ModuleIndex,
+			FunctionId: ci.FunctionForceTransferIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ForceTransferCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(types.DecodeMultiAddress(buffer), sc.DecodeU32(buffer), sc.DecodeBool(buffer))
+	return c
+}
+
+func (c ForceTransferCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ForceTransferCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ForceTransferCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ForceTransferCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c ForceTransferCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ ForceTransferCall) BaseWeight(b ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `275`
+	//  Estimated: `3593`
+	// Minimum execution time: 27_110 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(2)
+	e := types.WeightFromParts(0, 3593)
+	return types.WeightFromParts(27_110_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ ForceTransferCall) IsInherent() bool {
+	return false
+}
+
+func (_ ForceTransferCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ ForceTransferCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ ForceTransferCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ForceTransferCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := forceTransfer(origin, args[0].(types.MultiAddress), args[1].(types.AccountIndex), args[2].(sc.Bool))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// forceTransfer assigns an index to an account, regardless of whether it is already assigned.
+// The deposit of the previous owner, if any, is unreserved and the new assignment holds no deposit.
+// Can only be called by ROOT.
+func forceTransfer(origin types.RawOrigin, dest types.MultiAddress, index types.AccountIndex, freeze sc.Bool) types.DispatchError {
+	if !origin.IsRootOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	newAccount, lookupErr := types.DefaultAccountIdLookup().Lookup(dest)
+	if lookupErr != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	previous := indices.StorageGetAccount(index)
+	if previous.HasValue {
+		currency.Unreserve(previous.Value.Who, previous.Value.Deposit)
+	}
+
+	indices.StorageSetAccount(index, indices.AccountIndexInfo{
+		Who:     newAccount,
+		Deposit: sc.NewU128FromUint64(0),
+		Frozen:  freeze,
+	})
+	system.DepositEvent(indices.NewEventIndexAssigned(newAccount, index))
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	ci "github.com/LimeChain/gosemble/constants/indices"
+	"github.com/LimeChain/gosemble/frame/indices"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type FreeCall struct {
+	primitives.Callable
+}
+
+func NewFreeCall(args sc.VaryingData) FreeCall {
+	call := FreeCall{
+		Callable: primitives.Callable{
+			ModuleId:   ci.ModuleIndex,
+			FunctionId: ci.FunctionFreeIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c FreeCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(sc.DecodeU32(buffer))
+	return c
+}
+
+func (c FreeCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c FreeCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c FreeCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c FreeCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c FreeCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ FreeCall) BaseWeight(b ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `172`
+	//  Estimated: `3534`
+	// Minimum execution time: 26_863 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.WeightFromParts(0, 3534)
+	return types.WeightFromParts(26_863_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ FreeCall) IsInherent() bool {
+	return false
+}
+
+func (_ FreeCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ FreeCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ FreeCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ FreeCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := free(origin, args[0].(types.AccountIndex))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// free frees up an index owned by the caller and unreserves its deposit.
+func free(origin types.RawOrigin, index types.AccountIndex) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+	who := origin.AsSigned()
+
+	info, err := ensureOwner(index, who)
+	if err != nil {
+		return err
+	}
+
+	currency.Unreserve(who, info.Deposit)
+
+	indices.StorageRemoveAccount(index)
+	system.DepositEvent(indices.NewEventIndexFreed(index))
+
+	return nil
+}
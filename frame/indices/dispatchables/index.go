@@ -0,0 +1,37 @@
+package dispatchables
+
+import (
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	ci "github.com/LimeChain/gosemble/constants/indices"
+	"github.com/LimeChain/gosemble/frame/indices"
+	"github.com/LimeChain/gosemble/frame/indices/errors"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// ensureOwner returns the assignment of the index, if it is assigned to `who` and is not permanent.
+func ensureOwner(index types.AccountIndex, who types.Address32) (indices.AccountIndexInfo, types.DispatchError) {
+	info := indices.StorageGetAccount(index)
+	if !info.HasValue {
+		return indices.AccountIndexInfo{}, newModuleError(errors.ErrorNotAssigned)
+	}
+
+	if info.Value.Frozen {
+		return indices.AccountIndexInfo{}, newModuleError(errors.ErrorPermanent)
+	}
+
+	if !reflect.DeepEqual(info.Value.Who, who) {
+		return indices.AccountIndexInfo{}, newModuleError(errors.ErrorNotOwner)
+	}
+
+	return info.Value, nil
+}
+
+func newModuleError(err sc.U8) types.DispatchError {
+	return types.NewDispatchErrorModule(types.CustomModuleError{
+		Index:   ci.ModuleIndex,
+		Error:   sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
@@ -0,0 +1,233 @@
+//go:build nonwasmenv
+
+package dispatchables
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	ci "github.com/LimeChain/gosemble/constants/indices"
+	balances "github.com/LimeChain/gosemble/frame/balances/dispatchables"
+	"github.com/LimeChain/gosemble/frame/indices"
+	"github.com/LimeChain/gosemble/frame/indices/errors"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/frame/testutils"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	alice = testutils.NewAddress(1)
+	bob   = testutils.NewAddress(2)
+
+	index = sc.U32(7)
+)
+
+const initialBalance = 10 * constants.Dollar
+
+func setupAccount(who types.Address32) {
+	testutils.SetupAccount(who, sc.NewU128FromUint64(initialBalance))
+}
+
+func Test_Indices(t *testing.T) {
+	assigned := func(who types.Address32, deposit types.Balance, frozen sc.Bool) sc.Option[indices.AccountIndexInfo] {
+		return sc.NewOption[indices.AccountIndexInfo](indices.AccountIndexInfo{Who: who, Deposit: deposit, Frozen: frozen})
+	}
+	unassigned := sc.NewOption[indices.AccountIndexInfo](nil)
+	zero := sc.NewU128FromUint64(0)
+
+	balance := func(free, reserved uint64) types.AccountData {
+		return types.AccountData{Free: sc.NewU128FromUint64(free), Reserved: sc.NewU128FromUint64(reserved)}
+	}
+	deposit := ci.Deposit.ToBigInt().Uint64()
+	untouched := balance(initialBalance, 0)
+	reserved := balance(initialBalance-deposit, deposit)
+	withoutDeposit := balance(initialBalance-deposit, 0)
+
+	var testExamples = []struct {
+		label       string
+		call        types.Call
+		origin      types.RuntimeOrigin
+		args        sc.VaryingData
+		assigned    sc.Option[indices.AccountIndexInfo]
+		expectation types.DispatchError
+		account     sc.Option[indices.AccountIndexInfo]
+		alice       types.AccountData
+		bob         types.AccountData
+	}{
+		{
+			label:       "claim(BadOrigin)",
+			call:        NewClaimCall(nil),
+			origin:      types.NewRawOriginNone(),
+			args:        sc.NewVaryingData(index),
+			assigned:    unassigned,
+			expectation: types.NewDispatchErrorBadOrigin(),
+			account:     unassigned,
+			alice:       untouched,
+			bob:         untouched,
+		},
+		{
+			label:       "claim(InUse)",
+			call:        NewClaimCall(nil),
+			origin:      types.NewRawOriginSigned(bob),
+			args:        sc.NewVaryingData(index),
+			assigned:    assigned(alice, ci.Deposit, false),
+			expectation: newModuleError(errors.ErrorInUse),
+			account:     assigned(alice, ci.Deposit, false),
+			alice:       reserved,
+			bob:         untouched,
+		},
+		{
+			label:    "claim(Ok)",
+			call:     NewClaimCall(nil),
+			origin:   types.NewRawOriginSigned(alice),
+			args:     sc.NewVaryingData(index),
+			assigned: unassigned,
+			account:  assigned(alice, ci.Deposit, false),
+			alice:    reserved,
+			bob:      untouched,
+		},
+		{
+			label:       "transfer(NotTransfer)",
+			call:        NewTransferCall(nil),
+			origin:      types.NewRawOriginSigned(alice),
+			args:        sc.NewVaryingData(types.NewMultiAddress32(alice), index),
+			assigned:    assigned(alice, ci.Deposit, false),
+			expectation: newModuleError(errors.ErrorNotTransfer),
+			account:     assigned(alice, ci.Deposit, false),
+			alice:       reserved,
+			bob:         untouched,
+		},
+		{
+			label:       "transfer(NotOwner)",
+			call:        NewTransferCall(nil),
+			origin:      types.NewRawOriginSigned(bob),
+			args:        sc.NewVaryingData(types.NewMultiAddress32(alice), index),
+			assigned:    assigned(alice, ci.Deposit, false),
+			expectation: newModuleError(errors.ErrorNotOwner),
+			account:     assigned(alice, ci.Deposit, false),
+			alice:       reserved,
+			bob:         untouched,
+		},
+		{
+			label:    "transfer(Ok)",
+			call:     NewTransferCall(nil),
+			origin:   types.NewRawOriginSigned(alice),
+			args:     sc.NewVaryingData(types.NewMultiAddress32(bob), index),
+			assigned: assigned(alice, ci.Deposit, false),
+			account:  assigned(bob, ci.Deposit, false),
+			alice:    withoutDeposit,
+			bob:      balance(initialBalance, deposit),
+		},
+		{
+			label:       "free(NotAssigned)",
+			call:        NewFreeCall(nil),
+			origin:      types.NewRawOriginSigned(alice),
+			args:        sc.NewVaryingData(index),
+			assigned:    unassigned,
+			expectation: newModuleError(errors.ErrorNotAssigned),
+			account:     unassigned,
+			alice:       untouched,
+			bob:         untouched,
+		},
+		{
+			label:       "free(Permanent)",
+			call:        NewFreeCall(nil),
+			origin:      types.NewRawOriginSigned(alice),
+			args:        sc.NewVaryingData(index),
+			assigned:    assigned(alice, zero, true),
+			expectation: newModuleError(errors.ErrorPermanent),
+			account:     assigned(alice, zero, true),
+			alice:       untouched,
+			bob:         untouched,
+		},
+		{
+			label:    "free(Ok)",
+			call:     NewFreeCall(nil),
+			origin:   types.NewRawOriginSigned(alice),
+			args:     sc.NewVaryingData(index),
+			assigned: assigned(alice, ci.Deposit, false),
+			account:  unassigned,
+			alice:    untouched,
+			bob:      untouched,
+		},
+		{
+			label:       "force_transfer(BadOrigin)",
+			call:        NewForceTransferCall(nil),
+			origin:      types.NewRawOriginSigned(alice),
+			args:        sc.NewVaryingData(types.NewMultiAddress32(bob), index, sc.Bool(false)),
+			assigned:    assigned(alice, ci.Deposit, false),
+			expectation: types.NewDispatchErrorBadOrigin(),
+			account:     assigned(alice, ci.Deposit, false),
+			alice:       reserved,
+			bob:         untouched,
+		},
+		{
+			label:    "force_transfer(Ok)",
+			call:     NewForceTransferCall(nil),
+			origin:   types.NewRawOriginRoot(),
+			args:     sc.NewVaryingData(types.NewMultiAddress32(bob), index, sc.Bool(true)),
+			assigned: assigned(alice, ci.Deposit, false),
+			account:  assigned(bob, zero, true),
+			alice:    untouched,
+			bob:      untouched,
+		},
+		{
+			label:    "freeze(Ok)",
+			call:     NewFreezeCall(nil),
+			origin:   types.NewRawOriginSigned(alice),
+			args:     sc.NewVaryingData(index),
+			assigned: assigned(alice, ci.Deposit, false),
+			account:  assigned(alice, zero, true),
+			alice:    withoutDeposit,
+			bob:      untouched,
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			host.Reset()
+			RegisterCurrency(balances.Currency{})
+			setupAccount(alice)
+			setupAccount(bob)
+			if testExample.assigned.HasValue {
+				owner := testExample.assigned.Value
+				if owner.Deposit.ToBigInt().Sign() != 0 {
+					assert.Nil(t, currency.Reserve(owner.Who, owner.Deposit))
+				}
+				indices.StorageSetAccount(index, owner)
+			}
+
+			result := testExample.call.Dispatch(testExample.origin, testExample.args)
+
+			if testExample.expectation != nil {
+				assert.True(t, bool(result.HasError))
+				assert.Equal(t, testExample.expectation, result.Err.Error)
+			} else {
+				assert.False(t, bool(result.HasError))
+			}
+			assert.Equal(t, testExample.account, indices.StorageGetAccount(index))
+			assert.Equal(t, testExample.alice, system.StorageGetAccount(alice.FixedSequence).Data)
+			assert.Equal(t, testExample.bob, system.StorageGetAccount(bob.FixedSequence).Data)
+		})
+	}
+}
+
+func Test_Indices_Lookup(t *testing.T) {
+	host.Reset()
+	RegisterCurrency(balances.Currency{})
+	types.RegisterAccountIndexLookup(indices.AccountIndexLookup{})
+	setupAccount(alice)
+
+	_, err := types.DefaultAccountIdLookup().Lookup(types.NewMultiAddressIndex(index))
+	assert.Equal(t, types.NewTransactionValidityError(types.NewUnknownTransactionCannotLookup()), err)
+
+	result := NewClaimCall(nil).Dispatch(types.NewRawOriginSigned(alice), sc.NewVaryingData(index))
+	assert.False(t, bool(result.HasError))
+
+	who, err := types.DefaultAccountIdLookup().Lookup(types.NewMultiAddressIndex(index))
+	assert.Nil(t, err)
+	assert.Equal(t, alice, who)
+}
@@ -0,0 +1,12 @@
+package errors
+
+import sc "github.com/LimeChain/goscale"
+
+// Indices module errors.
+const (
+	ErrorNotAssigned sc.U8 = iota
+	ErrorNotOwner
+	ErrorInUse
+	ErrorNotTransfer
+	ErrorPermanent
+)
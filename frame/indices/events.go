@@ -0,0 +1,56 @@
+package indices
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/indices"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Indices module events.
+const (
+	EventIndexAssigned sc.U8 = iota
+	EventIndexFreed
+	EventIndexFrozen
+)
+
+func NewEventIndexAssigned(who types.Address32, index types.AccountIndex) types.Event {
+	return types.NewEvent(indices.ModuleIndex, EventIndexAssigned, who, index)
+}
+
+func NewEventIndexFreed(index types.AccountIndex) types.Event {
+	return types.NewEvent(indices.ModuleIndex, EventIndexFreed, index)
+}
+
+func NewEventIndexFrozen(index types.AccountIndex, who types.Address32) types.Event {
+	return types.NewEvent(indices.ModuleIndex, EventIndexFrozen, index, who)
+}
+
+func DecodeEvent(buffer *bytes.Buffer) types.Event {
+	moduleIndex := sc.DecodeU8(buffer)
+	if moduleIndex != indices.ModuleIndex {
+		log.Critical("invalid indices.Event")
+	}
+
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case EventIndexAssigned:
+		who := types.DecodeAddress32(buffer)
+		index := sc.DecodeU32(buffer)
+		return NewEventIndexAssigned(who, index)
+	case EventIndexFreed:
+		index := sc.DecodeU32(buffer)
+		return NewEventIndexFreed(index)
+	case EventIndexFrozen:
+		index := sc.DecodeU32(buffer)
+		who := types.DecodeAddress32(buffer)
+		return NewEventIndexFrozen(index, who)
+	default:
+		log.Critical("invalid indices.Event type")
+	}
+
+	panic("unreachable")
+}
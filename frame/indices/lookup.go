@@ -0,0 +1,18 @@
+package indices
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// AccountIndexLookup resolves account indices to the accounts they are assigned to in the module storage.
+type AccountIndexLookup struct{}
+
+func (l AccountIndexLookup) LookupIndex(index types.AccountIndex) sc.Option[types.Address32] {
+	info := StorageGetAccount(index)
+	if !info.HasValue {
+		return sc.NewOption[types.Address32](nil)
+	}
+
+	return sc.NewOption[types.Address32](info.Value.Who)
+}
@@ -0,0 +1,192 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	ci "github.com/LimeChain/gosemble/constants/indices"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/indices"
+	"github.com/LimeChain/gosemble/frame/indices/dispatchables"
+	"github.com/LimeChain/gosemble/frame/indices/errors"
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type IndicesModule struct {
+	primitives.DefaultHooks
+	functions map[sc.U8]primitives.Call
+}
+
+func NewIndicesModule() IndicesModule {
+	functions := make(map[sc.U8]primitives.Call)
+	functions[ci.FunctionClaimIndex] = dispatchables.NewClaimCall(nil)
+	functions[ci.FunctionTransferIndex] = dispatchables.NewTransferCall(nil)
+	functions[ci.FunctionFreeIndex] = dispatchables.NewFreeCall(nil)
+	functions[ci.FunctionForceTransferIndex] = dispatchables.NewForceTransferCall(nil)
+	functions[ci.FunctionFreezeIndex] = dispatchables.NewFreezeCall(nil)
+
+	return IndicesModule{
+		functions: functions,
+	}
+}
+
+func (im IndicesModule) Functions() map[sc.U8]primitives.Call {
+	return im.functions
+}
+
+func (im IndicesModule) PreDispatch(_ primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (im IndicesModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+func (im IndicesModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
+	declaredTypes, metadataModule := im.declaration().Metadata()
+
+	return append(im.metadataTypes(), declaredTypes...), metadataModule
+}
+
+// declaration declares the storage, calls, events, errors and constants of the module,
+// from which its metadata is derived.
+func (im IndicesModule) declaration() support.ModuleDeclaration {
+	return support.ModuleDeclaration{
+		Name:    "Indices",
+		Index:   ci.ModuleIndex,
+		Path:    "pallet_indices",
+		Storage: indices.StorageDeclarations(),
+		Calls: &support.EnumDeclaration{
+			TypeId:   metadata.IndicesCalls,
+			Variants: callDeclarations,
+		},
+		Events: &support.EnumDeclaration{
+			TypeId:   metadata.TypesIndicesEvent,
+			Variants: eventDeclarations,
+		},
+		Errors: &support.EnumDeclaration{
+			TypeId:   metadata.TypesIndicesErrors,
+			Variants: errorDeclarations,
+		},
+		Constants: []support.ConstantDeclaration{
+			{
+				Name:  "Deposit",
+				Value: ci.Deposit,
+				Docs:  "The deposit needed for reserving an index.",
+			},
+		},
+	}
+}
+
+func (im IndicesModule) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataType(metadata.TypesTupleAddress32BalanceBool, "(AccountId, Balance, bool)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{
+				sc.ToCompact(metadata.TypesAddress32),
+				sc.ToCompact(metadata.PrimitiveTypesU128),
+				sc.ToCompact(metadata.PrimitiveTypesBool),
+			})),
+	}
+}
+
+var callDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "claim",
+		Index: ci.FunctionClaimIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "T::AccountIndex"),
+		},
+		Docs: "Assign a previously-unassigned index. The deposit is reserved from the sender account.",
+	},
+	{
+		Name:  "transfer",
+		Index: ci.FunctionTransferIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "new", "AccountIdLookupOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "T::AccountIndex"),
+		},
+		Docs: "Assign an index already owned by the sender to another account. The balance reservation is effectively transferred to the new account.",
+	},
+	{
+		Name:  "free",
+		Index: ci.FunctionFreeIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "T::AccountIndex"),
+		},
+		Docs: "Free up an index owned by the sender. Any deposit reserved by the sender is unreserved.",
+	},
+	{
+		Name:  "force_transfer",
+		Index: ci.FunctionForceTransferIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "new", "AccountIdLookupOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "T::AccountIndex"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "freeze", "bool"),
+		},
+		Docs: "Force an index to an account. This doesn't require a deposit. If the index is already held, then any deposit is unreserved. The dispatch origin for this call must be `Root`.",
+	},
+	{
+		Name:  "freeze",
+		Index: ci.FunctionFreezeIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "T::AccountIndex"),
+		},
+		Docs: "Freeze an index so it will always point to the sender account. This consumes the deposit.",
+	},
+}
+
+var eventDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "IndexAssigned",
+		Index: indices.EventIndexAssigned,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "T::AccountIndex"),
+		},
+		Docs: "A account index was assigned.",
+	},
+	{
+		Name:  "IndexFreed",
+		Index: indices.EventIndexFreed,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "T::AccountIndex"),
+		},
+		Docs: "A account index has been freed up (unassigned).",
+	},
+	{
+		Name:  "IndexFrozen",
+		Index: indices.EventIndexFrozen,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "T::AccountIndex"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
+		},
+		Docs: "A account index has been frozen to its current account ID.",
+	},
+}
+
+var errorDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "NotAssigned",
+		Index: errors.ErrorNotAssigned,
+		Docs:  "The index was not already assigned.",
+	},
+	{
+		Name:  "NotOwner",
+		Index: errors.ErrorNotOwner,
+		Docs:  "The index is assigned to another account.",
+	},
+	{
+		Name:  "InUse",
+		Index: errors.ErrorInUse,
+		Docs:  "The index was not available.",
+	},
+	{
+		Name:  "NotTransfer",
+		Index: errors.ErrorNotTransfer,
+		Docs:  "The source and destination accounts are identical.",
+	},
+	{
+		Name:  "Permanent",
+		Index: errors.ErrorPermanent,
+		Docs:  "The index is permanent and may not be freed/changed.",
+	},
+}
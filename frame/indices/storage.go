@@ -0,0 +1,39 @@
+package indices
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var storageAccounts = support.NewStorageMap[types.AccountIndex, AccountIndexInfo](constants.KeyIndices, constants.KeyAccounts, support.Blake2_128Concat{}, sc.DecodeU32, DecodeAccountIndexInfo)
+
+func init() {
+	support.RegisterTypeId(metadata.TypesTupleAddress32BalanceBool, support.TypeOf[AccountIndexInfo]())
+}
+
+// StorageDeclarations returns the declarations of the storage items of the module, in the order of the metadata.
+func StorageDeclarations() []support.StorageDeclaration {
+	return []support.StorageDeclaration{
+		{
+			Item:     storageAccounts,
+			Modifier: types.MetadataModuleStorageEntryModifierOptional,
+			Docs:     "The lookup from index to account.",
+		},
+	}
+}
+
+// StorageGetAccount returns the account, to which the index is assigned, if there is one.
+func StorageGetAccount(index types.AccountIndex) sc.Option[AccountIndexInfo] {
+	return storageAccounts.GetOption(index)
+}
+
+func StorageSetAccount(index types.AccountIndex, info AccountIndexInfo) {
+	storageAccounts.Put(index, info)
+}
+
+func StorageRemoveAccount(index types.AccountIndex) {
+	storageAccounts.Remove(index)
+}
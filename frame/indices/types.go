@@ -0,0 +1,34 @@
+package indices
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// AccountIndexInfo is the account, to which an index is assigned, along with the deposit,
+// which is reserved for the index, and whether the index is frozen (permanently assigned).
+type AccountIndexInfo struct {
+	Who     types.Address32
+	Deposit types.Balance
+	Frozen  sc.Bool
+}
+
+func (aii AccountIndexInfo) Encode(buffer *bytes.Buffer) {
+	aii.Who.Encode(buffer)
+	aii.Deposit.Encode(buffer)
+	aii.Frozen.Encode(buffer)
+}
+
+func DecodeAccountIndexInfo(buffer *bytes.Buffer) AccountIndexInfo {
+	return AccountIndexInfo{
+		Who:     types.DecodeAddress32(buffer),
+		Deposit: sc.DecodeU128(buffer),
+		Frozen:  sc.DecodeBool(buffer),
+	}
+}
+
+func (aii AccountIndexInfo) Bytes() []byte {
+	return sc.EncodedBytes(aii)
+}
@@ -1,14 +1,11 @@
 package metadata
 
 import (
+	"bytes"
+
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/config"
-	"github.com/LimeChain/gosemble/constants/balances"
-	"github.com/LimeChain/gosemble/constants/grandpa"
 	"github.com/LimeChain/gosemble/constants/metadata"
-	"github.com/LimeChain/gosemble/constants/system"
-	"github.com/LimeChain/gosemble/constants/timestamp"
-	"github.com/LimeChain/gosemble/constants/transaction_payment"
 	"github.com/LimeChain/gosemble/execution/types"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 	"github.com/LimeChain/gosemble/utils"
@@ -24,32 +21,51 @@ func Metadata() int64 {
 	return utils.BytesToOffsetAndSize(bMetadata.Bytes())
 }
 
-func buildMetadata() primitives.Metadata {
-	metadataTypes := append(primitiveTypes(), basicTypes()...)
-	metadataTypes = append(metadataTypes, runtimeTypes()...)
-
-	var modules sc.Sequence[primitives.MetadataModule]
+// MetadataAtVersion returns the metadata of the runtime at a specific version.
+// It takes two arguments:
+// - dataPtr: Pointer to the data in the Wasm memory.
+// - dataLen: Length of the data.
+// which represent the SCALE-encoded metadata version.
+// Returns a pointer-size of the SCALE-encoded optional metadata, which is empty if the version is not supported.
+// [Specification](https://spec.polkadot.network/chap-runtime-api#sect-rte-metadata-metadata-at-version)
+func MetadataAtVersion(dataPtr int32, dataLen int32) int64 {
+	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
+	buffer := bytes.NewBuffer(b)
+
+	version := sc.DecodeU32(buffer)
+
+	var bMetadata sc.Option[sc.Sequence[sc.U8]]
+	switch version {
+	case sc.U32(primitives.MetadataVersion):
+		bMetadata = sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(buildMetadata().Bytes()))
+	case sc.U32(primitives.MetadataVersion15):
+		bMetadata = sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(buildMetadataV15().Bytes()))
+	default:
+		bMetadata = sc.NewOption[sc.Sequence[sc.U8]](nil)
+	}
 
-	for _, module := range config.Modules {
-		mTypes, mModule := module.Metadata()
+	return utils.BytesToOffsetAndSize(bMetadata.Bytes())
+}
 
-		metadataTypes = append(metadataTypes, mTypes...)
-		modules = append(modules, mModule)
+// MetadataVersions returns the metadata versions, supported by the runtime.
+// Returns a pointer-size of the SCALE-encoded sequence of versions.
+// [Specification](https://spec.polkadot.network/chap-runtime-api#sect-rte-metadata-metadata-versions)
+func MetadataVersions() int64 {
+	versions := sc.Sequence[sc.U32]{
+		sc.U32(primitives.MetadataVersion),
+		sc.U32(primitives.MetadataVersion15),
 	}
 
+	return utils.BytesToOffsetAndSize(versions.Bytes())
+}
+
+func buildMetadata() primitives.Metadata {
+	metadataTypes, modules := buildTypesAndModules()
+
 	extrinsic := primitives.MetadataExtrinsic{
-		Type:    sc.ToCompact(metadata.UncheckedExtrinsic),
-		Version: types.ExtrinsicFormatVersion,
-		SignedExtensions: sc.Sequence[primitives.MetadataSignedExtension]{
-			primitives.NewMetadataSignedExtension("CheckNonZeroSender", metadata.CheckNonZeroSender, metadata.TypesEmptyTuple),
-			primitives.NewMetadataSignedExtension("CheckSpecVersion", metadata.CheckSpecVersion, metadata.PrimitiveTypesU32),
-			primitives.NewMetadataSignedExtension("CheckTxVersion", metadata.CheckTxVersion, metadata.PrimitiveTypesU32),
-			primitives.NewMetadataSignedExtension("CheckGenesis", metadata.CheckGenesis, metadata.TypesH256),
-			primitives.NewMetadataSignedExtension("CheckMortality", metadata.CheckMortality, metadata.TypesH256),
-			primitives.NewMetadataSignedExtension("CheckNonce", metadata.CheckNonce, metadata.TypesEmptyTuple),
-			primitives.NewMetadataSignedExtension("CheckWeight", metadata.CheckWeight, metadata.TypesEmptyTuple),
-			primitives.NewMetadataSignedExtension("ChargeTransactionPayment", metadata.ChargeTransactionPayment, metadata.TypesEmptyTuple),
-		},
+		Type:             sc.ToCompact(metadata.UncheckedExtrinsic),
+		Version:          types.ExtrinsicFormatVersion,
+		SignedExtensions: signedExtensions(),
 	}
 
 	runtimeV14Metadata := primitives.RuntimeMetadataV14{
@@ -62,6 +78,165 @@ func buildMetadata() primitives.Metadata {
 	return primitives.NewMetadata(runtimeV14Metadata)
 }
 
+func buildMetadataV15() primitives.MetadataV15 {
+	metadataTypes, modules := buildTypesAndModules()
+	metadataTypes = append(metadataTypes, apiTypes()...)
+	metadataTypes = append(metadataTypes, consensusApiTypes()...)
+
+	modulesV15 := sc.Sequence[primitives.MetadataModuleV15]{}
+	for _, module := range modules {
+		modulesV15 = append(modulesV15, primitives.MetadataModuleV15{
+			Module: module,
+			Docs:   sc.Sequence[sc.Str]{},
+		})
+	}
+
+	extrinsic := primitives.MetadataExtrinsicV15{
+		Version:          types.ExtrinsicFormatVersion,
+		Address:          sc.ToCompact(metadata.TypesMultiAddress),
+		Call:             sc.ToCompact(metadata.RuntimeCall),
+		Signature:        sc.ToCompact(metadata.TypesMultiSignature),
+		Extra:            sc.ToCompact(metadata.SignedExtra),
+		SignedExtensions: signedExtensions(),
+	}
+
+	runtimeV15Metadata := primitives.RuntimeMetadataV15{
+		Types:      metadataTypes,
+		Modules:    modulesV15,
+		Extrinsic:  extrinsic,
+		Type:       sc.ToCompact(metadata.Runtime),
+		Apis:       runtimeApis(),
+		OuterEnums: primitives.NewOuterEnums(metadata.RuntimeCall, metadata.TypesRuntimeEvent, metadata.TypesRuntimeError),
+		// The runtime does not define any custom values.
+		Custom: primitives.CustomMetadata{Map: sc.Sequence[primitives.CustomMetadataEntry]{}},
+	}
+
+	return primitives.NewMetadataV15(runtimeV15Metadata)
+}
+
+// buildTypesAndModules returns the types and the metadata of the runtime modules, which are common for all metadata versions.
+func buildTypesAndModules() (sc.Sequence[primitives.MetadataType], sc.Sequence[primitives.MetadataModule]) {
+	metadataTypes := append(primitiveTypes(), basicTypes()...)
+	metadataTypes = append(metadataTypes, runtimeTypes()...)
+
+	var modules sc.Sequence[primitives.MetadataModule]
+
+	for _, index := range config.ModuleIndices() {
+		mTypes, mModule := config.Modules[index].Metadata()
+
+		metadataTypes = append(metadataTypes, mTypes...)
+		modules = append(modules, mModule)
+	}
+
+	metadataTypes = append(metadataTypes,
+		runtimeCallType(modules),
+		runtimeEventType(modules, metadataTypes),
+		runtimeErrorType(modules, metadataTypes))
+
+	return metadataTypes, modules
+}
+
+// signedExtensions returns the metadata of the signed extensions, in the order they are configured in the runtime.
+func signedExtensions() sc.Sequence[primitives.MetadataSignedExtension] {
+	return config.SignedExtra.Metadata()
+}
+
+// signedExtraType derives the tuple of the signed extensions, which is the extra of the extrinsic.
+func signedExtraType() primitives.MetadataType {
+	extensionTypes := sc.Sequence[sc.Compact]{}
+	for _, extension := range signedExtensions() {
+		extensionTypes = append(extensionTypes, extension.Type)
+	}
+
+	return primitives.NewMetadataType(metadata.SignedExtra, "SignedExtra", primitives.NewMetadataTypeDefinitionTuple(extensionTypes))
+}
+
+// runtimeCallType derives the outer call enum from the modules which declare calls.
+func runtimeCallType(modules sc.Sequence[primitives.MetadataModule]) primitives.MetadataType {
+	variants := sc.Sequence[primitives.MetadataDefinitionVariant]{}
+
+	for _, module := range modules {
+		if !module.Call.HasValue {
+			continue
+		}
+
+		variants = append(variants,
+			primitives.NewMetadataDefinitionVariant(
+				string(module.Name),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(int(module.Call.Value.ToBigInt().Int64()), sc.Str("self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<"+string(module.Name)+", Runtime>")),
+				},
+				module.Index,
+				"Call."+string(module.Name)))
+	}
+
+	return primitives.NewMetadataTypeWithPath(metadata.RuntimeCall, "RuntimeCall", sc.Sequence[sc.Str]{"node_template_runtime", "RuntimeCall"},
+		primitives.NewMetadataTypeDefinitionVariant(variants))
+}
+
+// runtimeEventType derives the outer event enum from the modules which declare events.
+func runtimeEventType(modules sc.Sequence[primitives.MetadataModule], metadataTypes sc.Sequence[primitives.MetadataType]) primitives.MetadataType {
+	variants := sc.Sequence[primitives.MetadataDefinitionVariant]{}
+
+	for _, module := range modules {
+		if !module.Event.HasValue {
+			continue
+		}
+
+		eventType := module.Event.Value.ToBigInt().Int64()
+		crate := typeCrate(eventType, metadataTypes, string(module.Name))
+
+		variants = append(variants,
+			primitives.NewMetadataDefinitionVariant(
+				string(module.Name),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(int(eventType), sc.Str(crate+"::Event<Runtime>")),
+				},
+				module.Index,
+				"Events."+string(module.Name)))
+	}
+
+	return primitives.NewMetadataTypeWithPath(metadata.TypesRuntimeEvent, "node_template_runtime RuntimeEvent", sc.Sequence[sc.Str]{"node_template_runtime", "RuntimeEvent"},
+		primitives.NewMetadataTypeDefinitionVariant(variants))
+}
+
+// runtimeErrorType derives the outer error enum from the modules which declare errors.
+func runtimeErrorType(modules sc.Sequence[primitives.MetadataModule], metadataTypes sc.Sequence[primitives.MetadataType]) primitives.MetadataType {
+	variants := sc.Sequence[primitives.MetadataDefinitionVariant]{}
+
+	for _, module := range modules {
+		if !module.Error.HasValue {
+			continue
+		}
+
+		errorType := module.Error.Value.ToBigInt().Int64()
+		crate := typeCrate(errorType, metadataTypes, string(module.Name))
+
+		variants = append(variants,
+			primitives.NewMetadataDefinitionVariant(
+				string(module.Name),
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(int(errorType), sc.Str(crate+"::Error<Runtime>")),
+				},
+				module.Index,
+				"Errors."+string(module.Name)))
+	}
+
+	return primitives.NewMetadataTypeWithPath(metadata.TypesRuntimeError, "node_template_runtime RuntimeError", sc.Sequence[sc.Str]{"node_template_runtime", "RuntimeError"},
+		primitives.NewMetadataTypeDefinitionVariant(variants))
+}
+
+// typeCrate returns the crate in the path of the type with the given id, or the fallback if the type has no path.
+func typeCrate(id int64, metadataTypes sc.Sequence[primitives.MetadataType], fallback string) string {
+	for _, metadataType := range metadataTypes {
+		if metadataType.Id.ToBigInt().Int64() == id && len(metadataType.Path) > 0 {
+			return string(metadataType.Path[0])
+		}
+	}
+
+	return fallback
+}
+
 // primitiveTypes returns all primitive types
 func primitiveTypes() sc.Sequence[primitives.MetadataType] {
 	return sc.Sequence[primitives.MetadataType]{
@@ -86,11 +261,13 @@ func primitiveTypes() sc.Sequence[primitives.MetadataType] {
 func basicTypes() sc.Sequence[primitives.MetadataType] {
 	return sc.Sequence[primitives.MetadataType]{
 		primitives.NewMetadataType(metadata.TypesFixedSequence4U8, "[4]byte", primitives.NewMetadataTypeDefinitionFixedSequence(4, sc.ToCompact(metadata.PrimitiveTypesU8))),
+		primitives.NewMetadataType(metadata.TypesFixedSequence8U8, "[8]byte", primitives.NewMetadataTypeDefinitionFixedSequence(8, sc.ToCompact(metadata.PrimitiveTypesU8))),
 		primitives.NewMetadataType(metadata.TypesFixedSequence20U8, "[20]byte", primitives.NewMetadataTypeDefinitionFixedSequence(20, sc.ToCompact(metadata.PrimitiveTypesU8))),
 		primitives.NewMetadataType(metadata.TypesFixedSequence32U8, "[32]byte", primitives.NewMetadataTypeDefinitionFixedSequence(32, sc.ToCompact(metadata.PrimitiveTypesU8))),
 		primitives.NewMetadataType(metadata.TypesFixedSequence64U8, "[64]byte", primitives.NewMetadataTypeDefinitionFixedSequence(64, sc.ToCompact(metadata.PrimitiveTypesU8))),
 		primitives.NewMetadataType(metadata.TypesFixedSequence65U8, "[65]byte", primitives.NewMetadataTypeDefinitionFixedSequence(65, sc.ToCompact(metadata.PrimitiveTypesU8))),
 		primitives.NewMetadataType(metadata.TypesSequenceU8, "[]byte", primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.PrimitiveTypesU8))),
+		primitives.NewMetadataType(metadata.TypesSequenceU32, "Vec<u32>", primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.PrimitiveTypesU32))),
 		primitives.NewMetadataType(metadata.TypesCompactU32, "CompactU32", primitives.NewMetadataTypeDefinitionCompact(sc.ToCompact(metadata.PrimitiveTypesU32))),
 		primitives.NewMetadataType(metadata.TypesCompactU64, "CompactU64", primitives.NewMetadataTypeDefinitionCompact(sc.ToCompact(metadata.PrimitiveTypesU64))),
 		primitives.NewMetadataType(metadata.TypesCompactU128, "CompactU128", primitives.NewMetadataTypeDefinitionCompact(sc.ToCompact(metadata.PrimitiveTypesU128))),
@@ -108,24 +285,6 @@ func basicTypes() sc.Sequence[primitives.MetadataType] {
 			sc.Sequence[primitives.MetadataTypeDefinitionField]{primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesFixedSequence32U8, "[u8; 32]")},
 		)),
 
-		primitives.NewMetadataTypeWithPath(metadata.TypesAccountData, "AccountData", sc.Sequence[sc.Str]{"pallet_balances", "AccountData"}, primitives.NewMetadataTypeDefinitionComposite(
-			sc.Sequence[primitives.MetadataTypeDefinitionField]{
-				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "free", "Balance"),
-				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "reserved", "Balance"),
-				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "misc_frozen", "Balance"),
-				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "fee_frozen", "Balance"),
-			},
-		)),
-		primitives.NewMetadataTypeWithPath(metadata.TypesAccountInfo, "AccountInfo", sc.Sequence[sc.Str]{"frame_system", "AccountInfo"}, primitives.NewMetadataTypeDefinitionComposite(
-			sc.Sequence[primitives.MetadataTypeDefinitionField]{
-				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "nonce", "Index"),
-				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "consumers", "RefCount"),
-				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "providers", "RefCount"),
-				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "sufficients", "RefCount"),
-				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAccountData, "data", "AccountData"),
-			},
-		)),
-
 		primitives.NewMetadataTypeWithPath(metadata.TypesWeight, "Weight", sc.Sequence[sc.Str]{"sp_weights", "weight_v2", "Weight"}, primitives.NewMetadataTypeDefinitionComposite(
 			sc.Sequence[primitives.MetadataTypeDefinitionField]{
 				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU64, "ref_time", "u64"),
@@ -465,6 +624,25 @@ func basicTypes() sc.Sequence[primitives.MetadataType] {
 					"TransactionalError.NoLayer"),
 			})),
 
+		resultType(metadata.TypesResultEmptyTupleDispatchError, metadata.TypesEmptyTuple, metadata.TypesDispatchError, "Result<(), DispatchError>"),
+		primitives.NewMetadataTypeWithParam(metadata.TypesOptionAddress32, "Option<AccountId>", sc.Sequence[sc.Str]{"Option"}, primitives.NewMetadataTypeDefinitionVariant(
+			sc.Sequence[primitives.MetadataDefinitionVariant]{
+				primitives.NewMetadataDefinitionVariant(
+					"None",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+					0,
+					"Option<AccountId>(nil)"),
+				primitives.NewMetadataDefinitionVariant(
+					"Some",
+					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionField(metadata.TypesAddress32),
+					},
+					1,
+					"Option<AccountId>(value)"),
+			}),
+			primitives.NewMetadataTypeParameter(metadata.TypesAddress32, "T"),
+		),
+
 		primitives.NewMetadataType(metadata.TypesVecTopics, "Vec<Topics>", primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesH256))),
 
 		primitives.NewMetadataTypeWithPath(metadata.TypesDigestItem, "DigestItem", sc.Sequence[sc.Str]{"sp_runtime", "generic", "digest", "DigestItem"}, primitives.NewMetadataTypeDefinitionVariant(
@@ -498,7 +676,7 @@ func basicTypes() sc.Sequence[primitives.MetadataType] {
 					sc.Sequence[primitives.MetadataTypeDefinitionField]{
 						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesSequenceU8, "Vec<u8>"),
 					},
-					0,
+					primitives.DigestTypeOther,
 					"DigestItem.Other"),
 				primitives.NewMetadataDefinitionVariant(
 					"RuntimeEnvironmentUpdated",
@@ -513,41 +691,21 @@ func basicTypes() sc.Sequence[primitives.MetadataType] {
 					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSliceDigestItem, "logs", "Vec<DigestItem>"),
 				})),
 		primitives.NewMetadataType(metadata.TypesSliceDigestItem, "Vec<DigestItem>", primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesDigestItem))),
+		// The header is part of the BABE equivocation proof, so it is declared for all metadata versions.
+		primitives.NewMetadataTypeWithPath(metadata.TypesHeader, "Header", sc.Sequence[sc.Str]{"sp_runtime", "generic", "header", "Header"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "parent_hash", "Hash::Output"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU32, "number", "Number"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "state_root", "Hash::Output"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "extrinsics_root", "Hash::Output"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesDigest, "digest", "Digest"),
+				})),
 	}
 }
 
 func runtimeTypes() sc.Sequence[primitives.MetadataType] {
 	return sc.Sequence[primitives.MetadataType]{
-		primitives.NewMetadataTypeWithPath(metadata.TypesRuntimeEvent, "node_template_runtime RuntimeEvent", sc.Sequence[sc.Str]{"node_template_runtime", "RuntimeEvent"}, primitives.NewMetadataTypeDefinitionVariant(
-			sc.Sequence[primitives.MetadataDefinitionVariant]{
-				primitives.NewMetadataDefinitionVariant(
-					"System",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesSystemEvent, "frame_system::Event<Runtime>"),
-					},
-					system.ModuleIndex,
-					"Events.System"),
-				primitives.NewMetadataDefinitionVariant( // TODO:
-					"Grandpa",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-					grandpa.ModuleIndex,
-					"Events.Grandpa"),
-				primitives.NewMetadataDefinitionVariant(
-					"Balances",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesBalancesEvent, "pallet_balances::Event<Runtime>"),
-					},
-					balances.ModuleIndex,
-					"Events.Balances"),
-				primitives.NewMetadataDefinitionVariant(
-					"TransactionPayment",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesTransactionPaymentEvent, "pallet_transaction_payment::Event<Runtime>"),
-					},
-					transaction_payment.ModuleIndex,
-					"Events.TransactionPayment"),
-			})),
-
 		primitives.NewMetadataTypeWithPath(metadata.TypesRuntimeVersion, "sp_version RuntimeVersion", sc.Sequence[sc.Str]{"sp_version", "RuntimeVersion"}, primitives.NewMetadataTypeDefinitionComposite(
 			sc.Sequence[primitives.MetadataTypeDefinitionField]{
 				primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesString), // spec_name
@@ -560,17 +718,7 @@ func runtimeTypes() sc.Sequence[primitives.MetadataType] {
 				primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesU8),     // state_version
 			})),
 
-		primitives.NewMetadataType(metadata.SignedExtra, "SignedExtra", primitives.NewMetadataTypeDefinitionTuple(
-			sc.Sequence[sc.Compact]{
-				sc.ToCompact(metadata.CheckNonZeroSender),
-				sc.ToCompact(metadata.CheckSpecVersion),
-				sc.ToCompact(metadata.CheckTxVersion),
-				sc.ToCompact(metadata.CheckGenesis),
-				sc.ToCompact(metadata.CheckMortality),
-				sc.ToCompact(metadata.CheckNonce),
-				sc.ToCompact(metadata.CheckWeight),
-				sc.ToCompact(metadata.ChargeTransactionPayment),
-			})),
+		signedExtraType(),
 
 		primitives.NewMetadataTypeWithParams(metadata.UncheckedExtrinsic, "UncheckedExtrinsic",
 			sc.Sequence[sc.Str]{"sp_runtime", "generic", "unchecked_extrinsic", "UncheckedExtrinsic"},
@@ -586,37 +734,6 @@ func runtimeTypes() sc.Sequence[primitives.MetadataType] {
 			},
 		),
 
-		primitives.NewMetadataTypeWithPath(metadata.RuntimeCall, "RuntimeCall", sc.Sequence[sc.Str]{"node_template_runtime", "RuntimeCall"},
-			primitives.NewMetadataTypeDefinitionVariant(sc.Sequence[primitives.MetadataDefinitionVariant]{
-				primitives.NewMetadataDefinitionVariant(
-					"System",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.SystemCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<System, Runtime>"),
-					},
-					system.ModuleIndex,
-					"Call.System"),
-				primitives.NewMetadataDefinitionVariant(
-					"Timestamp",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TimestampCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Timestamp, Runtime>"),
-					},
-					timestamp.ModuleIndex,
-					"Call.Timestamp"),
-				primitives.NewMetadataDefinitionVariant(
-					"Grandpa",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TimestampCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Grandpa, Runtime>"),
-					},
-					grandpa.ModuleIndex,
-					"Call.Grandpa"),
-				primitives.NewMetadataDefinitionVariant(
-					"Balances",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithName(metadata.BalancesCalls, "self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch\n::CallableCallFor<Balances, Runtime>"),
-					},
-					balances.ModuleIndex,
-					"Call.Balances"),
-			})),
 		primitives.NewMetadataType(metadata.Runtime, "Runtime", primitives.NewMetadataTypeDefinitionComposite(
 			sc.Sequence[primitives.MetadataTypeDefinitionField]{})),
 	}
@@ -0,0 +1,298 @@
+//go:build nonwasmenv
+
+package metadata
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/config"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	ctypes "github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Metadata_Consistency(t *testing.T) {
+	metadata := buildMetadata()
+
+	decoded := &ctypes.Metadata{}
+	err := codec.Decode(metadata.Bytes(), decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(primitives.MetadataVersion), decoded.Version)
+
+	for _, module := range metadata.Data.Modules {
+		name := string(module.Name)
+
+		t.Run(name, func(t *testing.T) {
+			if module.Storage.HasValue {
+				for _, item := range module.Storage.Value.Items {
+					entry, err := decoded.FindStorageEntryMetadata(name, string(item.Name))
+					assert.NoError(t, err)
+
+					isPlain := item.Definition[0] == primitives.MetadataModuleStorageEntryDefinitionPlain
+					assert.Equal(t, isPlain, entry.IsPlain(), string(item.Name))
+
+					if !isPlain {
+						hashers, err := entry.Hashers()
+						assert.NoError(t, err)
+						assert.Len(t, hashers, len(item.Definition[1].(sc.Sequence[primitives.MetadataModuleStorageHashFunc])))
+					}
+				}
+			}
+
+			if module.Call.HasValue {
+				functions := config.Modules[module.Index].Functions()
+
+				for _, variant := range enumVariants(t, metadata.Data.Types, module.Call.Value) {
+					callIndex, err := decoded.FindCallIndex(name + "." + string(variant.Name))
+					assert.NoError(t, err)
+					assert.Equal(t, uint8(module.Index), callIndex.SectionIndex)
+					assert.Equal(t, uint8(variant.Index), callIndex.MethodIndex)
+
+					_, ok := functions[variant.Index]
+					assert.True(t, ok, "call %s is declared, but not registered", variant.Name)
+				}
+			}
+		})
+	}
+}
+
+func enumVariants(t *testing.T, metadataTypes sc.Sequence[primitives.MetadataType], id sc.Compact) sc.Sequence[primitives.MetadataDefinitionVariant] {
+	for _, metadataType := range metadataTypes {
+		if metadataType.Id.ToBigInt().Cmp(id.ToBigInt()) != 0 {
+			continue
+		}
+
+		assert.Equal(t, primitives.MetadataTypeDefinitionVariant, metadataType.Definition[0])
+		return metadataType.Definition[1].(sc.Sequence[primitives.MetadataDefinitionVariant])
+	}
+
+	assert.Fail(t, "type is not declared", id.ToBigInt().String())
+	return nil
+}
+
+func Test_MetadataV15_Encoding(t *testing.T) {
+	metadata := buildMetadataV15()
+
+	decoded, err := primitives.DecodeMetadataV15(bytes.NewBuffer(metadata.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, metadata.Bytes(), decoded.Bytes())
+
+	v14 := buildMetadata()
+	assert.Equal(t, len(v14.Data.Modules), len(decoded.Data.Modules))
+	for i, module := range decoded.Data.Modules {
+		assert.Equal(t, v14.Data.Modules[i].Bytes(), module.Module.Bytes())
+	}
+}
+
+func Test_MetadataV15_TypesAreRegistered(t *testing.T) {
+	metadata := buildMetadataV15()
+
+	registered := map[int64]bool{}
+	for _, metadataType := range metadata.Data.Types {
+		id := metadataType.Id.ToBigInt().Int64()
+		assert.False(t, registered[id], "type %d is registered more than once", id)
+		registered[id] = true
+	}
+
+	assertRegistered := func(id sc.Compact, context string) {
+		assert.True(t, registered[id.ToBigInt().Int64()], "type %s of %s is not registered", id.ToBigInt().String(), context)
+	}
+
+	for _, api := range metadata.Data.Apis {
+		for _, method := range api.Methods {
+			context := string(api.Name) + "_" + string(method.Name)
+
+			for _, input := range method.Inputs {
+				assertRegistered(input.Type, context)
+			}
+			assertRegistered(method.Output, context)
+		}
+	}
+
+	assertRegistered(metadata.Data.OuterEnums.CallEnumType, "outer enums")
+	assertRegistered(metadata.Data.OuterEnums.EventEnumType, "outer enums")
+	assertRegistered(metadata.Data.OuterEnums.ErrorEnumType, "outer enums")
+	assertRegistered(metadata.Data.Extrinsic.Address, "extrinsic")
+	assertRegistered(metadata.Data.Extrinsic.Call, "extrinsic")
+	assertRegistered(metadata.Data.Extrinsic.Signature, "extrinsic")
+	assertRegistered(metadata.Data.Extrinsic.Extra, "extrinsic")
+}
+
+func Test_Metadata_ModuleTypesAreRegistered(t *testing.T) {
+	metadata := buildMetadata()
+
+	registered := map[int64]bool{}
+	for _, metadataType := range metadata.Data.Types {
+		registered[metadataType.Id.ToBigInt().Int64()] = true
+	}
+
+	assertRegistered := func(id sc.Compact, context string) {
+		assert.True(t, registered[id.ToBigInt().Int64()], "type %s of %s is not registered", id.ToBigInt().String(), context)
+	}
+
+	for _, module := range metadata.Data.Modules {
+		name := string(module.Name)
+
+		if module.Storage.HasValue {
+			for _, item := range module.Storage.Value.Items {
+				context := name + "." + string(item.Name)
+
+				if item.Definition[0] == primitives.MetadataModuleStorageEntryDefinitionPlain {
+					assertRegistered(item.Definition[1].(sc.Compact), context)
+				} else {
+					assertRegistered(item.Definition[2].(sc.Compact), context)
+					assertRegistered(item.Definition[3].(sc.Compact), context)
+				}
+			}
+		}
+
+		for _, enum := range []sc.Option[sc.Compact]{module.Call, module.Event, module.Error} {
+			if !enum.HasValue {
+				continue
+			}
+
+			for _, variant := range enumVariants(t, metadata.Data.Types, enum.Value) {
+				for _, field := range variant.Fields {
+					assertRegistered(field.Type, name+"."+string(variant.Name))
+				}
+			}
+		}
+
+		for _, constant := range module.Constants {
+			assertRegistered(constant.Type, name+"."+string(constant.Name))
+		}
+	}
+}
+
+func Test_Metadata_CallArgs(t *testing.T) {
+	metadata := buildMetadata()
+
+	metadataTypes := map[int64]primitives.MetadataType{}
+	for _, metadataType := range metadata.Data.Types {
+		metadataTypes[metadataType.Id.ToBigInt().Int64()] = metadataType
+	}
+
+	for _, module := range metadata.Data.Modules {
+		if !module.Call.HasValue {
+			continue
+		}
+
+		functions := config.Modules[module.Index].Functions()
+
+		for _, variant := range enumVariants(t, metadata.Data.Types, module.Call.Value) {
+			name := string(module.Name) + "." + string(variant.Name)
+
+			t.Run(name, func(t *testing.T) {
+				call, ok := functions[variant.Index]
+				if !ok {
+					t.Skip("call is not registered")
+				}
+
+				args := &bytes.Buffer{}
+				for _, field := range variant.Fields {
+					encodeSample(t, metadataTypes, field.Type, args, 0)
+				}
+				expectation := append([]byte{byte(module.Index), byte(variant.Index)}, args.Bytes()...)
+
+				decoded := call.DecodeArgs(args)
+
+				assert.Equal(t, 0, args.Len(), "the declared fields are longer than the arguments of the call")
+				assert.Equal(t, expectation, decoded.Bytes())
+			})
+		}
+	}
+}
+
+// encodeSample encodes a sample value of the metadata type, which has one element in each sequence
+// and the first variant with fields of each enum, so that the type of every field is part of the encoding.
+func encodeSample(t *testing.T, metadataTypes map[int64]primitives.MetadataType, id sc.Compact, buffer *bytes.Buffer, depth int) {
+	if depth > 16 {
+		assert.Fail(t, "the sample is too deeply nested", id.ToBigInt().String())
+		return
+	}
+
+	metadataType, ok := metadataTypes[id.ToBigInt().Int64()]
+	if !ok {
+		assert.Fail(t, "type is not declared", id.ToBigInt().String())
+		return
+	}
+
+	definition := metadataType.Definition
+	switch definition[0] {
+	case primitives.MetadataTypeDefinitionComposite:
+		for _, field := range definition[1].(sc.Sequence[primitives.MetadataTypeDefinitionField]) {
+			encodeSample(t, metadataTypes, field.Type, buffer, depth+1)
+		}
+	case primitives.MetadataTypeDefinitionVariant:
+		variants := definition[1].(sc.Sequence[primitives.MetadataDefinitionVariant])
+		if len(variants) == 0 {
+			assert.Fail(t, "enum has no variants", id.ToBigInt().String())
+			return
+		}
+
+		variant := variants[0]
+		for _, v := range variants {
+			if len(v.Fields) > 0 {
+				variant = v
+				break
+			}
+		}
+
+		variant.Index.Encode(buffer)
+		for _, field := range variant.Fields {
+			encodeSample(t, metadataTypes, field.Type, buffer, depth+1)
+		}
+	case primitives.MetadataTypeDefinitionSequence:
+		sc.ToCompact(1).Encode(buffer)
+		encodeSample(t, metadataTypes, definition[1].(sc.Compact), buffer, depth+1)
+	case primitives.MetadataTypeDefinitionFixedSequence:
+		for i := 0; i < int(definition[1].(sc.U32)); i++ {
+			encodeSample(t, metadataTypes, definition[2].(sc.Compact), buffer, depth+1)
+		}
+	case primitives.MetadataTypeDefinitionTuple:
+		for _, element := range definition[1].(sc.Sequence[sc.Compact]) {
+			encodeSample(t, metadataTypes, element, buffer, depth+1)
+		}
+	case primitives.MetadataTypeDefinitionPrimitive:
+		encodeSamplePrimitive(t, definition[1].(primitives.MetadataDefinitionPrimitive), buffer)
+	case primitives.MetadataTypeDefinitionCompact:
+		sc.ToCompact(1).Encode(buffer)
+	default:
+		assert.Fail(t, "unsupported type definition", id.ToBigInt().String())
+	}
+}
+
+func encodeSamplePrimitive(t *testing.T, primitive primitives.MetadataDefinitionPrimitive, buffer *bytes.Buffer) {
+	sizes := map[primitives.MetadataDefinitionPrimitive]int{
+		primitives.MetadataDefinitionPrimitiveBoolean: 1,
+		primitives.MetadataDefinitionPrimitiveChar:    4,
+		primitives.MetadataDefinitionPrimitiveU8:      1,
+		primitives.MetadataDefinitionPrimitiveU16:     2,
+		primitives.MetadataDefinitionPrimitiveU32:     4,
+		primitives.MetadataDefinitionPrimitiveU64:     8,
+		primitives.MetadataDefinitionPrimitiveU128:    16,
+		primitives.MetadataDefinitionPrimitiveU256:    32,
+		primitives.MetadataDefinitionPrimitiveI8:      1,
+		primitives.MetadataDefinitionPrimitiveI16:     2,
+		primitives.MetadataDefinitionPrimitiveI32:     4,
+		primitives.MetadataDefinitionPrimitiveI64:     8,
+		primitives.MetadataDefinitionPrimitiveI128:    16,
+		primitives.MetadataDefinitionPrimitiveI256:    32,
+	}
+
+	if primitive == primitives.MetadataDefinitionPrimitiveString {
+		sc.Str("a").Encode(buffer)
+		return
+	}
+
+	size, ok := sizes[primitive]
+	if !ok {
+		assert.Fail(t, "unsupported primitive type")
+		return
+	}
+
+	buffer.Write(make([]byte, size))
+}
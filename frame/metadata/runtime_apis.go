@@ -0,0 +1,374 @@
+package metadata
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// runtimeApis returns the runtime APIs, exported by the runtime, with the signatures of their methods.
+func runtimeApis() sc.Sequence[primitives.RuntimeApiMetadata] {
+	return sc.Sequence[primitives.RuntimeApiMetadata]{
+		primitives.NewRuntimeApiMetadata("Core",
+			sc.Sequence[primitives.RuntimeApiMethodMetadata]{
+				primitives.NewRuntimeApiMethodMetadata("version",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{},
+					metadata.TypesRuntimeVersion,
+					"Returns the version of the runtime."),
+				primitives.NewRuntimeApiMethodMetadata("execute_block",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+						primitives.NewRuntimeApiMethodParamMetadata("block", metadata.TypesBlock),
+					},
+					metadata.TypesEmptyTuple,
+					"Execute the given block."),
+				primitives.NewRuntimeApiMethodMetadata("initialize_block",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+						primitives.NewRuntimeApiMethodParamMetadata("header", metadata.TypesHeader),
+					},
+					metadata.TypesEmptyTuple,
+					"Initialize a block with the given header."),
+			},
+			"The `Core` runtime api that every Substrate runtime needs to implement."),
+
+		primitives.NewRuntimeApiMetadata("Metadata",
+			sc.Sequence[primitives.RuntimeApiMethodMetadata]{
+				primitives.NewRuntimeApiMethodMetadata("metadata",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{},
+					metadata.TypesSequenceU8,
+					"Returns the metadata of a runtime."),
+				primitives.NewRuntimeApiMethodMetadata("metadata_at_version",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+						primitives.NewRuntimeApiMethodParamMetadata("version", metadata.PrimitiveTypesU32),
+					},
+					metadata.TypesOptionSequenceU8,
+					"Returns the metadata at a given version."),
+				primitives.NewRuntimeApiMethodMetadata("metadata_versions",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{},
+					metadata.TypesSequenceU32,
+					"Returns the supported metadata versions."),
+			},
+			"The `Metadata` api trait that returns metadata for the runtime."),
+
+		primitives.NewRuntimeApiMetadata("BlockBuilder",
+			sc.Sequence[primitives.RuntimeApiMethodMetadata]{
+				primitives.NewRuntimeApiMethodMetadata("apply_extrinsic",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+						primitives.NewRuntimeApiMethodParamMetadata("extrinsic", metadata.UncheckedExtrinsic),
+					},
+					metadata.TypesApplyExtrinsicResult,
+					"Apply the given extrinsic."),
+				primitives.NewRuntimeApiMethodMetadata("finalize_block",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{},
+					metadata.TypesHeader,
+					"Finish the current block."),
+				primitives.NewRuntimeApiMethodMetadata("inherent_extrinsics",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+						primitives.NewRuntimeApiMethodParamMetadata("inherent", metadata.TypesInherentData),
+					},
+					metadata.TypesSequenceUncheckedExtrinsics,
+					"Generate inherent extrinsics. The inherent data will vary from chain to chain."),
+				primitives.NewRuntimeApiMethodMetadata("check_inherents",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+						primitives.NewRuntimeApiMethodParamMetadata("block", metadata.TypesBlock),
+						primitives.NewRuntimeApiMethodParamMetadata("data", metadata.TypesInherentData),
+					},
+					metadata.TypesCheckInherentsResult,
+					"Check that the inherents are valid. The inherent data will vary from chain to chain."),
+			},
+			"The `BlockBuilder` api trait that provides the required functionality for building a block."),
+
+		primitives.NewRuntimeApiMetadata("TaggedTransactionQueue",
+			sc.Sequence[primitives.RuntimeApiMethodMetadata]{
+				primitives.NewRuntimeApiMethodMetadata("validate_transaction",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+						primitives.NewRuntimeApiMethodParamMetadata("source", metadata.TypesTransactionSource),
+						primitives.NewRuntimeApiMethodParamMetadata("tx", metadata.UncheckedExtrinsic),
+						primitives.NewRuntimeApiMethodParamMetadata("block_hash", metadata.TypesH256),
+					},
+					metadata.TypesTransactionValidity,
+					"Validate the transaction."),
+			},
+			"The `TaggedTransactionQueue` api trait for interfering with the transaction queue."),
+
+		primitives.NewRuntimeApiMetadata("OffchainWorkerApi",
+			sc.Sequence[primitives.RuntimeApiMethodMetadata]{
+				primitives.NewRuntimeApiMethodMetadata("offchain_worker",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+						primitives.NewRuntimeApiMethodParamMetadata("header", metadata.TypesHeader),
+					},
+					metadata.TypesEmptyTuple,
+					"Starts the off-chain task for given block header."),
+			},
+			"The offchain worker api."),
+
+		consensusApi(),
+
+		primitives.NewRuntimeApiMetadata("SessionKeys",
+			sc.Sequence[primitives.RuntimeApiMethodMetadata]{
+				primitives.NewRuntimeApiMethodMetadata("generate_session_keys",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+						primitives.NewRuntimeApiMethodParamMetadata("seed", metadata.TypesOptionSequenceU8),
+					},
+					metadata.TypesSequenceU8,
+					"Generate a set of session keys with optionally using the given seed."),
+				primitives.NewRuntimeApiMethodMetadata("decode_session_keys",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+						primitives.NewRuntimeApiMethodParamMetadata("encoded", metadata.TypesSequenceU8),
+					},
+					metadata.TypesOptionSequenceTupleSequenceU8KeyTypeId,
+					"Decode the given public session keys."),
+			},
+			"Session keys runtime api."),
+
+		primitives.NewRuntimeApiMetadata("GrandpaApi",
+			sc.Sequence[primitives.RuntimeApiMethodMetadata]{
+				primitives.NewRuntimeApiMethodMetadata("grandpa_authorities",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{},
+					metadata.TypesSequenceTupleGrandpaAppPublicU64,
+					"Get the current GRANDPA authorities and weights. This should not change except for when changes are scheduled and the corresponding delay has passed."),
+				primitives.NewRuntimeApiMethodMetadata("submit_report_equivocation_unsigned_extrinsic",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+						primitives.NewRuntimeApiMethodParamMetadata("equivocation_proof", metadata.TypesGrandpaEquivocationProof),
+						primitives.NewRuntimeApiMethodParamMetadata("key_owner_proof", metadata.TypesOpaqueKeyOwnershipProof),
+					},
+					metadata.TypesOptionEmptyTuple,
+					"Submits an unsigned extrinsic to report an equivocation. The caller must provide the equivocation proof and a key ownership proof (should be obtained using `generate_key_ownership_proof`). The extrinsic will be unsigned and should only be accepted for local authorship (not to be broadcast to the network). This method returns `None` when creation of the extrinsic fails, e.g. if equivocation reporting is disabled for the given runtime (i.e. this method is hardcoded to return `None`). Only useful in an offchain context."),
+				primitives.NewRuntimeApiMethodMetadata("generate_key_ownership_proof",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+						primitives.NewRuntimeApiMethodParamMetadata("set_id", metadata.PrimitiveTypesU64),
+						primitives.NewRuntimeApiMethodParamMetadata("authority_id", metadata.TypesGrandpaAppPublic),
+					},
+					metadata.TypesOptionOpaqueKeyOwnershipProof,
+					"Generates a proof of key ownership for the given authority in the given set. An example usage of this module is coupled with the session historical module to prove that a given authority key is tied to a given staking identity during a specific session. Proofs of key ownership are necessary for submitting equivocation reports. NOTE: even though the API takes a `set_id` as parameter the current implementations ignore this parameter and instead rely on this method being called at the correct block height, i.e. any point at which the given set id is live on-chain. Future implementations will instead use indexed data through an offchain worker, not requiring older states to be available."),
+				primitives.NewRuntimeApiMethodMetadata("current_set_id",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{},
+					metadata.PrimitiveTypesU64,
+					"Get current GRANDPA authority set id."),
+			},
+			"APIs for integrating the GRANDPA finality gadget into runtimes."),
+
+		primitives.NewRuntimeApiMetadata("AccountNonceApi",
+			sc.Sequence[primitives.RuntimeApiMethodMetadata]{
+				primitives.NewRuntimeApiMethodMetadata("account_nonce",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+						primitives.NewRuntimeApiMethodParamMetadata("account", metadata.TypesAddress32),
+					},
+					metadata.PrimitiveTypesU32,
+					"Get current account nonce of given `AccountId`."),
+			},
+			"The API to query account nonce."),
+
+		primitives.NewRuntimeApiMetadata("TransactionPaymentApi",
+			sc.Sequence[primitives.RuntimeApiMethodMetadata]{
+				primitives.NewRuntimeApiMethodMetadata("query_info",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+						primitives.NewRuntimeApiMethodParamMetadata("uxt", metadata.UncheckedExtrinsic),
+						primitives.NewRuntimeApiMethodParamMetadata("len", metadata.PrimitiveTypesU32),
+					},
+					metadata.TypesRuntimeDispatchInfo,
+					"Query the dispatch info of an extrinsic."),
+				primitives.NewRuntimeApiMethodMetadata("query_fee_details",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+						primitives.NewRuntimeApiMethodParamMetadata("uxt", metadata.UncheckedExtrinsic),
+						primitives.NewRuntimeApiMethodParamMetadata("len", metadata.PrimitiveTypesU32),
+					},
+					metadata.TypesFeeDetails,
+					"Query the fee details of an extrinsic."),
+			},
+			"The API to query the fees of extrinsics."),
+
+		primitives.NewRuntimeApiMetadata("TransactionPaymentCallApi",
+			sc.Sequence[primitives.RuntimeApiMethodMetadata]{
+				primitives.NewRuntimeApiMethodMetadata("query_call_info",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+						primitives.NewRuntimeApiMethodParamMetadata("call", metadata.RuntimeCall),
+						primitives.NewRuntimeApiMethodParamMetadata("len", metadata.PrimitiveTypesU32),
+					},
+					metadata.TypesRuntimeDispatchInfo,
+					"Query information of a dispatch class, weight, and fee of a given encoded `Call`."),
+				primitives.NewRuntimeApiMethodMetadata("query_call_fee_details",
+					sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+						primitives.NewRuntimeApiMethodParamMetadata("call", metadata.RuntimeCall),
+						primitives.NewRuntimeApiMethodParamMetadata("len", metadata.PrimitiveTypesU32),
+					},
+					metadata.TypesFeeDetails,
+					"Query fee details of a given encoded `Call`."),
+			},
+			"The API to query the fees of calls."),
+	}
+}
+
+// apiTypes returns the types used in the signatures of the runtime APIs.
+func apiTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		optionType(metadata.TypesOptionSequenceU8, metadata.TypesSequenceU8, "Option<Vec<u8>>"),
+
+		primitives.NewMetadataType(metadata.TypesSequenceUncheckedExtrinsics, "Vec<UncheckedExtrinsic>", primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.UncheckedExtrinsic))),
+		primitives.NewMetadataTypeWithPath(metadata.TypesBlock, "Block", sc.Sequence[sc.Str]{"sp_runtime", "generic", "block", "Block"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesHeader, "header", "Header"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceUncheckedExtrinsics, "extrinsics", "Vec<Extrinsic>"),
+				})),
+
+		primitives.NewMetadataType(metadata.TypesTupleInherentIdentifierSequenceU8, "(InherentIdentifier, Vec<u8>)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.TypesFixedSequence8U8), sc.ToCompact(metadata.TypesSequenceU8)})),
+		primitives.NewMetadataType(metadata.TypesSequenceTupleInherentIdentifierSequenceU8, "Vec<(InherentIdentifier, Vec<u8>)>",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesTupleInherentIdentifierSequenceU8))),
+		primitives.NewMetadataTypeWithPath(metadata.TypesInherentData, "InherentData", sc.Sequence[sc.Str]{"sp_inherents", "InherentData"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceTupleInherentIdentifierSequenceU8, "data", "BTreeMap<InherentIdentifier, Vec<u8>>"),
+				})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesCheckInherentsResult, "CheckInherentsResult", sc.Sequence[sc.Str]{"sp_inherents", "CheckInherentsResult"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "okay", "bool"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "fatal_error", "bool"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesInherentData, "errors", "InherentData"),
+				})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesTransactionSource, "TransactionSource", sc.Sequence[sc.Str]{"sp_runtime", "transaction_validity", "TransactionSource"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant("InBlock", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.TransactionSourceInBlock, "TransactionSource.InBlock"),
+					primitives.NewMetadataDefinitionVariant("Local", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.TransactionSourceLocal, "TransactionSource.Local"),
+					primitives.NewMetadataDefinitionVariant("External", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.TransactionSourceExternal, "TransactionSource.External"),
+				})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesValidTransaction, "ValidTransaction", sc.Sequence[sc.Str]{"sp_runtime", "transaction_validity", "ValidTransaction"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "priority", "TransactionPriority"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceSequenceU8, "requires", "Vec<TransactionTag>"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceSequenceU8, "provides", "Vec<TransactionTag>"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "longevity", "TransactionLongevity"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesBool, "propagate", "bool"),
+				})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesInvalidTransaction, "InvalidTransaction", sc.Sequence[sc.Str]{"sp_runtime", "transaction_validity", "InvalidTransaction"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant("Call", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.InvalidTransactionCall, "InvalidTransaction.Call"),
+					primitives.NewMetadataDefinitionVariant("Payment", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.InvalidTransactionPayment, "InvalidTransaction.Payment"),
+					primitives.NewMetadataDefinitionVariant("Future", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.InvalidTransactionFuture, "InvalidTransaction.Future"),
+					primitives.NewMetadataDefinitionVariant("Stale", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.InvalidTransactionStale, "InvalidTransaction.Stale"),
+					primitives.NewMetadataDefinitionVariant("BadProof", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.InvalidTransactionBadProof, "InvalidTransaction.BadProof"),
+					primitives.NewMetadataDefinitionVariant("AncientBirthBlock", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.InvalidTransactionAncientBirthBlock, "InvalidTransaction.AncientBirthBlock"),
+					primitives.NewMetadataDefinitionVariant("ExhaustsResources", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.InvalidTransactionExhaustsResources, "InvalidTransaction.ExhaustsResources"),
+					primitives.NewMetadataDefinitionVariant("Custom",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithName(metadata.PrimitiveTypesU8, "u8"),
+						},
+						primitives.InvalidTransactionCustom, "InvalidTransaction.Custom"),
+					primitives.NewMetadataDefinitionVariant("BadMandatory", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.InvalidTransactionBadMandatory, "InvalidTransaction.BadMandatory"),
+					primitives.NewMetadataDefinitionVariant("MandatoryValidation", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.InvalidTransactionMandatoryValidation, "InvalidTransaction.MandatoryValidation"),
+					primitives.NewMetadataDefinitionVariant("BadSigner", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.InvalidTransactionBadSigner, "InvalidTransaction.BadSigner"),
+				})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesUnknownTransaction, "UnknownTransaction", sc.Sequence[sc.Str]{"sp_runtime", "transaction_validity", "UnknownTransaction"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant("CannotLookup", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.UnknownTransactionCannotLookup, "UnknownTransaction.CannotLookup"),
+					primitives.NewMetadataDefinitionVariant("NoUnsignedValidator", sc.Sequence[primitives.MetadataTypeDefinitionField]{}, primitives.UnknownTransactionNoUnsignedValidator, "UnknownTransaction.NoUnsignedValidator"),
+					primitives.NewMetadataDefinitionVariant("Custom",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithName(metadata.PrimitiveTypesU8, "u8"),
+						},
+						primitives.UnknownTransactionCustomUnknownTransaction, "UnknownTransaction.Custom"),
+				})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesTransactionValidityError, "TransactionValidityError", sc.Sequence[sc.Str]{"sp_runtime", "transaction_validity", "TransactionValidityError"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant("Invalid",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesInvalidTransaction, "InvalidTransaction"),
+						},
+						primitives.TransactionValidityErrorInvalidTransaction, "TransactionValidityError.Invalid"),
+					primitives.NewMetadataDefinitionVariant("Unknown",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesUnknownTransaction, "UnknownTransaction"),
+						},
+						primitives.TransactionValidityErrorUnknownTransaction, "TransactionValidityError.Unknown"),
+				})),
+		resultType(metadata.TypesTransactionValidity, metadata.TypesValidTransaction, metadata.TypesTransactionValidityError, "Result<ValidTransaction, TransactionValidityError>"),
+		resultType(metadata.TypesApplyExtrinsicResult, metadata.TypesResultEmptyTupleDispatchError, metadata.TypesTransactionValidityError, "Result<DispatchOutcome, TransactionValidityError>"),
+
+		primitives.NewMetadataType(metadata.TypesTupleSequenceU8KeyTypeId, "(Vec<u8>, KeyTypeId)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.TypesSequenceU8), sc.ToCompact(metadata.TypesFixedSequence4U8)})),
+		primitives.NewMetadataType(metadata.TypesSequenceTupleSequenceU8KeyTypeId, "Vec<(Vec<u8>, KeyTypeId)>",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesTupleSequenceU8KeyTypeId))),
+		optionType(metadata.TypesOptionSequenceTupleSequenceU8KeyTypeId, metadata.TypesSequenceTupleSequenceU8KeyTypeId, "Option<Vec<(Vec<u8>, KeyTypeId)>>"),
+
+		optionType(metadata.TypesOptionEmptyTuple, metadata.TypesEmptyTuple, "Option<()>"),
+		primitives.NewMetadataTypeWithPath(metadata.TypesOpaqueKeyOwnershipProof, "OpaqueKeyOwnershipProof", sc.Sequence[sc.Str]{"sp_consensus_grandpa", "OpaqueKeyOwnershipProof"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesSequenceU8, "Vec<u8>"),
+				})),
+		optionType(metadata.TypesOptionOpaqueKeyOwnershipProof, metadata.TypesOpaqueKeyOwnershipProof, "Option<OpaqueKeyOwnershipProof>"),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesRuntimeDispatchInfo, "RuntimeDispatchInfo", sc.Sequence[sc.Str]{"pallet_transaction_payment", "types", "RuntimeDispatchInfo"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesWeight, "weight", "Weight"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesDispatchClass, "class", "DispatchClass"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "partial_fee", "Balance"),
+				})),
+		primitives.NewMetadataTypeWithPath(metadata.TypesInclusionFee, "InclusionFee", sc.Sequence[sc.Str]{"pallet_transaction_payment", "types", "InclusionFee"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "base_fee", "Balance"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "len_fee", "Balance"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "adjusted_weight_fee", "Balance"),
+				})),
+		optionType(metadata.TypesOptionInclusionFee, metadata.TypesInclusionFee, "Option<InclusionFee>"),
+		primitives.NewMetadataTypeWithPath(metadata.TypesFeeDetails, "FeeDetails", sc.Sequence[sc.Str]{"pallet_transaction_payment", "types", "FeeDetails"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionInclusionFee, "inclusion_fee", "Option<InclusionFee<Balance>>"),
+				})),
+	}
+}
+
+func optionType(id int, value int, docs string) primitives.MetadataType {
+	return primitives.NewMetadataTypeWithParam(id, docs, sc.Sequence[sc.Str]{"Option"}, primitives.NewMetadataTypeDefinitionVariant(
+		sc.Sequence[primitives.MetadataDefinitionVariant]{
+			primitives.NewMetadataDefinitionVariant(
+				"None",
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+				0,
+				docs+"(nil)"),
+			primitives.NewMetadataDefinitionVariant(
+				"Some",
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(value),
+				},
+				1,
+				docs+"(value)"),
+		}),
+		primitives.NewMetadataTypeParameter(value, "T"),
+	)
+}
+
+func resultType(id int, ok int, err int, docs string) primitives.MetadataType {
+	return primitives.NewMetadataTypeWithParams(id, docs, sc.Sequence[sc.Str]{"Result"}, primitives.NewMetadataTypeDefinitionVariant(
+		sc.Sequence[primitives.MetadataDefinitionVariant]{
+			primitives.NewMetadataDefinitionVariant(
+				"Ok",
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(ok),
+				},
+				0,
+				docs+"(Ok)"),
+			primitives.NewMetadataDefinitionVariant(
+				"Err",
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionField(err),
+				},
+				1,
+				docs+"(Err)"),
+		}),
+		sc.Sequence[primitives.MetadataTypeParameter]{
+			primitives.NewMetadataTypeParameter(ok, "T"),
+			primitives.NewMetadataTypeParameter(err, "E"),
+		},
+	)
+}
@@ -0,0 +1,31 @@
+//go:build !babe
+
+package metadata
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// consensusApi returns the runtime API of the block authoring module.
+func consensusApi() primitives.RuntimeApiMetadata {
+	return primitives.NewRuntimeApiMetadata("AuraApi",
+		sc.Sequence[primitives.RuntimeApiMethodMetadata]{
+			primitives.NewRuntimeApiMethodMetadata("slot_duration",
+				sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{},
+				metadata.PrimitiveTypesU64,
+				"Returns the slot duration for Aura."),
+			primitives.NewRuntimeApiMethodMetadata("authorities",
+				sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{},
+				metadata.TypesSequencePubKeys,
+				"Return the current set of authorities."),
+		},
+		"API necessary for block authorship with aura.")
+}
+
+// consensusApiTypes returns the types used in the signatures of the runtime API of the block authoring module,
+// which are not declared by the module itself.
+func consensusApiTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{}
+}
@@ -0,0 +1,75 @@
+//go:build babe
+
+package metadata
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// consensusApi returns the runtime API of the block authoring module.
+func consensusApi() primitives.RuntimeApiMetadata {
+	return primitives.NewRuntimeApiMetadata("BabeApi",
+		sc.Sequence[primitives.RuntimeApiMethodMetadata]{
+			primitives.NewRuntimeApiMethodMetadata("configuration",
+				sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{},
+				metadata.TypesBabeConfiguration,
+				"Return the configuration for BABE."),
+			primitives.NewRuntimeApiMethodMetadata("current_epoch_start",
+				sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{},
+				metadata.TypesBabeSlot,
+				"Returns the slot that started the current epoch."),
+			primitives.NewRuntimeApiMethodMetadata("current_epoch",
+				sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{},
+				metadata.TypesBabeEpoch,
+				"Returns information regarding the current epoch."),
+			primitives.NewRuntimeApiMethodMetadata("next_epoch",
+				sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{},
+				metadata.TypesBabeEpoch,
+				"Returns information regarding the next epoch (which was already previously announced)."),
+			primitives.NewRuntimeApiMethodMetadata("generate_key_ownership_proof",
+				sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+					primitives.NewRuntimeApiMethodParamMetadata("slot", metadata.TypesBabeSlot),
+					primitives.NewRuntimeApiMethodParamMetadata("authority_id", metadata.TypesBabeAppPublic),
+				},
+				metadata.TypesOptionOpaqueKeyOwnershipProof,
+				"Generates a proof of key ownership for the given authority in the current epoch. An example usage of this module is coupled with the session historical module to prove that a given authority key is tied to a given staking identity during a specific session. Proofs of key ownership are necessary for submitting equivocation reports. NOTE: even though the API takes a `slot` as parameter the current implementations ignores this parameter and instead relies on this method being called at the correct block height, i.e. any point at which the epoch for the given slot is live on-chain. Future implementations will instead use indexed data through an offchain worker, not requiring older states to be available."),
+			primitives.NewRuntimeApiMethodMetadata("submit_report_equivocation_unsigned_extrinsic",
+				sc.Sequence[primitives.RuntimeApiMethodParamMetadata]{
+					primitives.NewRuntimeApiMethodParamMetadata("equivocation_proof", metadata.TypesBabeEquivocationProof),
+					primitives.NewRuntimeApiMethodParamMetadata("key_owner_proof", metadata.TypesOpaqueKeyOwnershipProof),
+				},
+				metadata.TypesOptionEmptyTuple,
+				"Submits an unsigned extrinsic to report an equivocation. The caller must provide the equivocation proof and a key ownership proof (should be obtained using `generate_key_ownership_proof`). The extrinsic will be unsigned and should only be accepted for local authorship (not to be broadcast to the network). This method returns `None` when creation of the extrinsic fails, e.g. if equivocation reporting is disabled for the given runtime (i.e. this method is hardcoded to return `None`). Only useful in an offchain context."),
+		},
+		"API necessary for block authorship with BABE.")
+}
+
+// consensusApiTypes returns the types used in the signatures of the runtime API of the block authoring module,
+// which are not declared by the module itself.
+func consensusApiTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithPath(metadata.TypesBabeConfiguration, "BabeConfiguration", sc.Sequence[sc.Str]{"sp_consensus_babe", "BabeConfiguration"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "slot_duration", "u64"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "epoch_length", "u64"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesTupleU64U64, "c", "(u64, u64)"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceTupleBabeAppPublicU64, "authorities", "Vec<(AuthorityId, BabeAuthorityWeight)>"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence32U8, "randomness", "Randomness"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBabeAllowedSlots, "allowed_slots", "AllowedSlots"),
+				})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesBabeEpoch, "Epoch", sc.Sequence[sc.Str]{"sp_consensus_babe", "Epoch"},
+			primitives.NewMetadataTypeDefinitionComposite(
+				sc.Sequence[primitives.MetadataTypeDefinitionField]{
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "epoch_index", "u64"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBabeSlot, "start_slot", "Slot"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "duration", "u64"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceTupleBabeAppPublicU64, "authorities", "Vec<(AuthorityId, BabeAuthorityWeight)>"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence32U8, "randomness", "Randomness"),
+					primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesBabeEpochConfiguration, "config", "BabeEpochConfiguration"),
+				})),
+	}
+}
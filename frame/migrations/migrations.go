@@ -0,0 +1,214 @@
+package migrations
+
+import (
+	"fmt"
+	"math"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	cm "github.com/LimeChain/gosemble/constants/migrations"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// migrations are executed in order after a runtime upgrade.
+var migrations []types.SteppedMigration
+
+// RegisterMigrations sets the migrations, which are executed after a runtime upgrade.
+func RegisterMigrations(steppedMigrations []types.SteppedMigration) {
+	migrations = steppedMigrations
+}
+
+// Ongoing checks whether migrations are ongoing. Only mandatory extrinsics are applied
+// while migrations are ongoing.
+func Ongoing() bool {
+	return storageCursor.Exists()
+}
+
+// OnRuntimeUpgrade starts the registered migrations in block `n`, unless migrations are already
+// ongoing, and returns the consumed weight. The migrations are executed by Step, starting in the same block.
+func OnRuntimeUpgrade(n types.BlockNumber) types.Weight {
+	weight := constants.DbWeight.Reads(1)
+
+	if Ongoing() {
+		log.Warn("runtime upgraded while migrations are ongoing, continuing the ongoing migrations")
+		return weight
+	}
+
+	if len(migrations) == 0 {
+		return weight
+	}
+
+	storageSetCursor(MigrationCursor{
+		Index:       0,
+		InnerCursor: sc.NewOption[sc.Sequence[sc.U8]](nil),
+		StartedAt:   n,
+		Stuck:       false,
+	})
+	log.Info(fmt.Sprintf("starting %d migrations", len(migrations)))
+
+	return weight.SaturatingAdd(constants.DbWeight.Writes(1))
+}
+
+// Step executes the steps of the ongoing migrations, which fit in the service weight of the block,
+// and returns the consumed weight. A migration is skipped if the storage version of its module
+// is not its FromVersion, and the storage version is set to its ToVersion once it completes.
+//
+// Each step is executed in its own storage layer and its writes are rolled back if it fails.
+// A migration, which fails or whose step does not fit in an empty block, is handled
+// according to the FailedMigrationHandling of the runtime.
+func Step() types.Weight {
+	meter := types.NewWeightMeter(maxServiceWeight())
+	meter.Consume(constants.DbWeight.Reads(1))
+
+	option := StorageGetCursor()
+	if !option.HasValue {
+		return meter.Consumed
+	}
+
+	cursor := option.Value
+	if cursor.Stuck {
+		// The migrations got stuck under a runtime, which kept them stuck.
+		onFailedMigration(cursor)
+		meter.Consume(constants.DbWeight.Writes(1))
+		return meter.Consumed
+	}
+
+	stepped := false
+	for int(cursor.Index) < len(migrations) {
+		migration := migrations[cursor.Index]
+		version := support.NewStorageVersion(migration.ModulePrefix())
+
+		if !cursor.InnerCursor.HasValue {
+			meter.Consume(constants.DbWeight.Reads(1))
+
+			if version.Get() != migration.FromVersion() {
+				log.Info(fmt.Sprintf("skipping migration [%x], storage version is not %d", migration.Id(), migration.FromVersion()))
+				cursor.Index++
+				continue
+			}
+		}
+
+		next, err := step(migration, cursor.InnerCursor, &meter)
+		if err != nil {
+			if err[0] == types.MigrationErrorInsufficientWeight && stepped {
+				break
+			}
+
+			log.Warn(fmt.Sprintf("migration [%x] failed with error [%v]", migration.Id(), err))
+			cursor.Stuck = true
+			break
+		}
+		stepped = true
+
+		if next.HasValue {
+			cursor.InnerCursor = next
+			continue
+		}
+
+		version.Put(migration.ToVersion())
+		meter.Consume(constants.DbWeight.Writes(1))
+		log.Info(fmt.Sprintf("completed migration [%x]", migration.Id()))
+
+		cursor.Index++
+		cursor.InnerCursor = sc.NewOption[sc.Sequence[sc.U8]](nil)
+	}
+
+	meter.Consume(constants.DbWeight.Writes(1))
+
+	if cursor.Stuck {
+		onFailedMigration(cursor)
+		return meter.Consumed
+	}
+
+	if int(cursor.Index) >= len(migrations) {
+		storageClearCursor()
+		log.Info(fmt.Sprintf("completed all migrations, started at block %d", cursor.StartedAt))
+		return meter.Consumed
+	}
+
+	storageSetCursor(cursor)
+
+	return meter.Consumed
+}
+
+// step executes a step of the migration in a new storage layer, so the writes of a failed step are rolled back.
+func step(migration types.SteppedMigration, cursor sc.Option[sc.Sequence[sc.U8]], meter *types.WeightMeter) (sc.Option[sc.Sequence[sc.U8]], types.MigrationError) {
+	return support.WithStorageLayer[sc.Option[sc.Sequence[sc.U8]], types.MigrationError](
+		func() (sc.Option[sc.Sequence[sc.U8]], types.DispatchError) {
+			return migration.Step(cursor, meter)
+		},
+	)
+}
+
+// onFailedMigration handles the stuck `cursor` according to the FailedMigrationHandling of the runtime.
+// The cursor is cleared to resume the extrinsics, or stored to keep the migrations stuck.
+func onFailedMigration(cursor MigrationCursor) {
+	switch cm.FailedMigrationHandling {
+	case cm.FailedMigrationHandlingForceUnstuck:
+		storageClearCursor()
+		log.Warn(fmt.Sprintf("migrations started at block %d failed, resuming the extrinsics", cursor.StartedAt))
+	default:
+		storageSetCursor(cursor)
+		log.Warn("migrations are stuck")
+	}
+}
+
+func maxServiceWeight() types.Weight {
+	return cm.MaxServiceRatio.Mul(constants.MaximumBlockWeight).(types.Weight)
+}
+
+// TryExecuteAll executes all pending migrations to completion, without the service weight limit of a block,
+// and returns the consumed weight. The PreUpgrade and PostUpgrade checks of each executed migration
+// are run around it if `checks` is set. It is not called during block execution and is meant
+// to be run by the try-runtime checks.
+func TryExecuteAll(checks bool) (types.Weight, types.MigrationError) {
+	meter := types.NewWeightMeter(types.WeightFromParts(math.MaxUint64, math.MaxUint64))
+
+	for _, migration := range migrations {
+		version := support.NewStorageVersion(migration.ModulePrefix())
+		meter.Consume(constants.DbWeight.Reads(1))
+
+		if version.Get() != migration.FromVersion() {
+			log.Info(fmt.Sprintf("skipping migration [%x], storage version is not %d", migration.Id(), migration.FromVersion()))
+			continue
+		}
+
+		state := sc.Sequence[sc.U8]{}
+		if checks {
+			preUpgradeState, err := migration.PreUpgrade()
+			if err != nil {
+				return meter.Consumed, err
+			}
+			state = preUpgradeState
+		}
+
+		cursor := sc.NewOption[sc.Sequence[sc.U8]](nil)
+		for {
+			next, err := step(migration, cursor, &meter)
+			if err != nil {
+				return meter.Consumed, err
+			}
+
+			if !next.HasValue {
+				break
+			}
+			cursor = next
+		}
+
+		version.Put(migration.ToVersion())
+		meter.Consume(constants.DbWeight.Writes(1))
+
+		if checks {
+			err := migration.PostUpgrade(state)
+			if err != nil {
+				return meter.Consumed, err
+			}
+		}
+
+		log.Info(fmt.Sprintf("completed migration [%x]", migration.Id()))
+	}
+
+	return meter.Consumed, nil
+}
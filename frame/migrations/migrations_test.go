@@ -0,0 +1,300 @@
+//go:build nonwasmenv
+
+package migrations
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	cm "github.com/LimeChain/gosemble/constants/migrations"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	modulePrefix = []byte("Mock")
+	partialKey   = []byte("partial")
+)
+
+// mockMigration migrates its module in `steps` steps, each consuming `stepWeight`.
+// The cursor is the number of the executed steps.
+type mockMigration struct {
+	steps      sc.U32
+	stepWeight types.Weight
+	fail       bool
+	executed   *[]sc.U32
+}
+
+func (m mockMigration) Id() sc.Sequence[sc.U8] {
+	return sc.BytesToSequenceU8([]byte("mock"))
+}
+
+func (m mockMigration) ModulePrefix() []byte {
+	return modulePrefix
+}
+
+func (m mockMigration) FromVersion() sc.U16 {
+	return 1
+}
+
+func (m mockMigration) ToVersion() sc.U16 {
+	return 2
+}
+
+func (m mockMigration) Step(cursor sc.Option[sc.Sequence[sc.U8]], meter *types.WeightMeter) (sc.Option[sc.Sequence[sc.U8]], types.MigrationError) {
+	if m.fail {
+		host.DefaultStorage().Set(partialKey, []byte{1})
+		return sc.Option[sc.Sequence[sc.U8]]{}, types.NewMigrationErrorFailed()
+	}
+
+	if !meter.TryConsume(m.stepWeight) {
+		return sc.Option[sc.Sequence[sc.U8]]{}, types.NewMigrationErrorInsufficientWeight(m.stepWeight)
+	}
+
+	step := sc.U32(0)
+	if cursor.HasValue {
+		step = sc.DecodeU32(bytes.NewBuffer(sc.SequenceU8ToBytes(cursor.Value)))
+	}
+	step++
+	*m.executed = append(*m.executed, step)
+
+	if step == m.steps {
+		return sc.NewOption[sc.Sequence[sc.U8]](nil), nil
+	}
+
+	return sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(step.Bytes())), nil
+}
+
+func (m mockMigration) PreUpgrade() (sc.Sequence[sc.U8], types.MigrationError) {
+	return sc.Sequence[sc.U8]{}, nil
+}
+
+func (m mockMigration) PostUpgrade(_ sc.Sequence[sc.U8]) types.MigrationError {
+	return nil
+}
+
+func setup(steps sc.U32, stepWeight types.Weight, fail bool) (*[]sc.U32, func()) {
+	host.Reset()
+	support.NewStorageVersion(modulePrefix).Put(1)
+
+	executed := &[]sc.U32{}
+	RegisterMigrations([]types.SteppedMigration{
+		mockMigration{steps: steps, stepWeight: stepWeight, fail: fail, executed: executed},
+	})
+
+	return executed, func() { RegisterMigrations(nil) }
+}
+
+// keepStuck keeps the migrations stuck after a failed migration, until the returned teardown is called.
+func keepStuck() func() {
+	handling := cm.FailedMigrationHandling
+	cm.FailedMigrationHandling = cm.FailedMigrationHandlingKeepStuck
+
+	return func() { cm.FailedMigrationHandling = handling }
+}
+
+func Test_Migrations(t *testing.T) {
+	maxWeight := maxServiceWeight()
+	thirdOfMaxWeight := types.WeightFromParts(maxWeight.RefTime/3, 0)
+
+	var testExamples = []struct {
+		label       string
+		expectation func(t *testing.T)
+	}{
+		{
+			label: "No migrations",
+			expectation: func(t *testing.T) {
+				host.Reset()
+
+				OnRuntimeUpgrade(1)
+
+				assert.False(t, Ongoing())
+			},
+		},
+		{
+			label: "Migration completed in a single block",
+			expectation: func(t *testing.T) {
+				executed, teardown := setup(2, types.WeightFromParts(1, 0), false)
+				defer teardown()
+
+				OnRuntimeUpgrade(1)
+				assert.True(t, Ongoing())
+
+				weight := Step()
+
+				assert.Equal(t, []sc.U32{1, 2}, *executed)
+				assert.False(t, Ongoing())
+				assert.Equal(t, sc.U16(2), support.NewStorageVersion(modulePrefix).Get())
+				assert.Greater(t, weight.RefTime, sc.U64(2))
+			},
+		},
+		{
+			label: "Migration spread over several blocks",
+			expectation: func(t *testing.T) {
+				executed, teardown := setup(5, thirdOfMaxWeight, false)
+				defer teardown()
+
+				OnRuntimeUpgrade(1)
+
+				Step()
+				assert.Equal(t, []sc.U32{1, 2}, *executed)
+				assert.True(t, Ongoing())
+				assert.Equal(t, sc.U16(1), support.NewStorageVersion(modulePrefix).Get())
+
+				Step()
+				assert.Equal(t, []sc.U32{1, 2, 3, 4}, *executed)
+				assert.True(t, Ongoing())
+
+				Step()
+				assert.Equal(t, []sc.U32{1, 2, 3, 4, 5}, *executed)
+				assert.False(t, Ongoing())
+				assert.Equal(t, sc.U16(2), support.NewStorageVersion(modulePrefix).Get())
+			},
+		},
+		{
+			label: "Migration skipped for another storage version",
+			expectation: func(t *testing.T) {
+				executed, teardown := setup(1, types.WeightFromParts(1, 0), false)
+				defer teardown()
+				support.NewStorageVersion(modulePrefix).Put(2)
+
+				OnRuntimeUpgrade(1)
+				Step()
+
+				assert.Empty(t, *executed)
+				assert.False(t, Ongoing())
+				assert.Equal(t, sc.U16(2), support.NewStorageVersion(modulePrefix).Get())
+			},
+		},
+		{
+			label: "Failed migration resumes the extrinsics",
+			expectation: func(t *testing.T) {
+				_, teardown := setup(1, types.WeightFromParts(1, 0), true)
+				defer teardown()
+
+				OnRuntimeUpgrade(1)
+				assert.True(t, Ongoing())
+
+				Step()
+
+				assert.False(t, Ongoing())
+				assert.Equal(t, sc.U16(1), support.NewStorageVersion(modulePrefix).Get())
+			},
+		},
+		{
+			label: "Failed step rolls back its writes",
+			expectation: func(t *testing.T) {
+				_, teardown := setup(1, types.WeightFromParts(1, 0), true)
+				defer teardown()
+
+				OnRuntimeUpgrade(1)
+				Step()
+
+				assert.False(t, host.DefaultStorage().Exists(partialKey))
+			},
+		},
+		{
+			label: "Step exceeding the service weight resumes the extrinsics",
+			expectation: func(t *testing.T) {
+				executed, teardown := setup(1, maxWeight, false)
+				defer teardown()
+
+				OnRuntimeUpgrade(1)
+				Step()
+
+				assert.Empty(t, *executed)
+				assert.False(t, Ongoing())
+			},
+		},
+		{
+			label: "Failed migration leaves the migrations stuck",
+			expectation: func(t *testing.T) {
+				_, teardown := setup(1, types.WeightFromParts(1, 0), true)
+				defer teardown()
+				defer keepStuck()()
+
+				OnRuntimeUpgrade(1)
+				Step()
+				Step()
+
+				cursor := StorageGetCursor()
+				assert.True(t, Ongoing())
+				assert.True(t, bool(cursor.Value.Stuck))
+				assert.Equal(t, sc.U16(1), support.NewStorageVersion(modulePrefix).Get())
+			},
+		},
+		{
+			label: "Step exceeding the service weight leaves the migrations stuck",
+			expectation: func(t *testing.T) {
+				executed, teardown := setup(1, maxWeight, false)
+				defer teardown()
+				defer keepStuck()()
+
+				OnRuntimeUpgrade(1)
+				Step()
+
+				assert.Empty(t, *executed)
+				assert.True(t, bool(StorageGetCursor().Value.Stuck))
+			},
+		},
+		{
+			label: "Stuck migrations recover after the failed migration handling changes",
+			expectation: func(t *testing.T) {
+				executed, teardown := setup(1, types.WeightFromParts(1, 0), true)
+				defer teardown()
+				restoreHandling := keepStuck()
+
+				OnRuntimeUpgrade(1)
+				Step()
+				assert.True(t, Ongoing())
+
+				// A runtime upgrade, which forces the migrations unstuck, resumes the extrinsics.
+				restoreHandling()
+				RegisterMigrations([]types.SteppedMigration{
+					mockMigration{steps: 1, stepWeight: types.WeightFromParts(1, 0), executed: executed},
+				})
+				OnRuntimeUpgrade(2)
+				Step()
+
+				assert.False(t, Ongoing())
+				assert.Empty(t, *executed)
+
+				// The fixed migration is executed by the next runtime upgrade.
+				OnRuntimeUpgrade(3)
+				Step()
+
+				assert.False(t, Ongoing())
+				assert.Equal(t, []sc.U32{1}, *executed)
+				assert.Equal(t, sc.U16(2), support.NewStorageVersion(modulePrefix).Get())
+			},
+		},
+		{
+			label: "Runtime upgrade continues the ongoing migrations",
+			expectation: func(t *testing.T) {
+				executed, teardown := setup(3, thirdOfMaxWeight, false)
+				defer teardown()
+
+				OnRuntimeUpgrade(1)
+				Step()
+				OnRuntimeUpgrade(2)
+
+				cursor := StorageGetCursor()
+				assert.Equal(t, types.BlockNumber(1), cursor.Value.StartedAt)
+				assert.True(t, bool(cursor.Value.InnerCursor.HasValue))
+
+				Step()
+				assert.Equal(t, []sc.U32{1, 2, 3}, *executed)
+				assert.False(t, Ongoing())
+			},
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			testExample.expectation(t)
+		})
+	}
+}
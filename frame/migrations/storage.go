@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/frame/support"
+)
+
+var (
+	storageCursor = support.NewStorageValue[MigrationCursor](constants.KeyMultiBlockMigrations, constants.KeyCursor, DecodeMigrationCursor)
+)
+
+// StorageGetCursor returns the cursor of the ongoing migrations, if any.
+func StorageGetCursor() sc.Option[MigrationCursor] {
+	return storageCursor.GetOption()
+}
+
+func storageSetCursor(cursor MigrationCursor) {
+	storageCursor.Put(cursor)
+}
+
+func storageClearCursor() {
+	storageCursor.Clear()
+}
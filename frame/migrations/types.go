@@ -0,0 +1,41 @@
+package migrations
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// MigrationCursor points to the ongoing migration and its next step.
+type MigrationCursor struct {
+	// Index is the index of the ongoing migration in the registered migrations.
+	Index sc.U32
+	// InnerCursor is the cursor of the next step of the ongoing migration.
+	InnerCursor sc.Option[sc.Sequence[sc.U8]]
+	// StartedAt is the block, in which the migrations started.
+	StartedAt types.BlockNumber
+	// Stuck is set when a migration failed and the migrations are kept stuck.
+	// The migrations are not continued, and extrinsics stay paused until the cursor is cleared.
+	Stuck sc.Bool
+}
+
+func (mc MigrationCursor) Encode(buffer *bytes.Buffer) {
+	mc.Index.Encode(buffer)
+	mc.InnerCursor.Encode(buffer)
+	mc.StartedAt.Encode(buffer)
+	mc.Stuck.Encode(buffer)
+}
+
+func (mc MigrationCursor) Bytes() []byte {
+	return sc.EncodedBytes(mc)
+}
+
+func DecodeMigrationCursor(buffer *bytes.Buffer) MigrationCursor {
+	return MigrationCursor{
+		Index:       sc.DecodeU32(buffer),
+		InnerCursor: sc.DecodeOptionWith(buffer, sc.DecodeSequence[sc.U8]),
+		StartedAt:   sc.DecodeU32(buffer),
+		Stuck:       sc.DecodeBool(buffer),
+	}
+}
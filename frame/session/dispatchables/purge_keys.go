@@ -0,0 +1,111 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	cs "github.com/LimeChain/gosemble/constants/session"
+	"github.com/LimeChain/gosemble/frame/session"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type PurgeKeysCall struct {
+	primitives.Callable
+}
+
+func NewPurgeKeysCall(args sc.VaryingData) PurgeKeysCall {
+	call := PurgeKeysCall{
+		Callable: primitives.Callable{
+			ModuleId:   cs.ModuleIndex,
+			FunctionId: cs.FunctionPurgeKeysIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c PurgeKeysCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	return c
+}
+
+func (c PurgeKeysCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c PurgeKeysCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c PurgeKeysCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c PurgeKeysCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c PurgeKeysCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ PurgeKeysCall) BaseWeight(b ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `1791`
+	//  Estimated: `5256`
+	// Minimum execution time: 41_313 nanoseconds.
+	r := constants.DbWeight.Reads(2)
+	w := constants.DbWeight.Writes(5)
+	e := types.WeightFromParts(0, 5256)
+	return types.WeightFromParts(41_313_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ PurgeKeysCall) IsInherent() bool {
+	return false
+}
+
+func (_ PurgeKeysCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ PurgeKeysCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ PurgeKeysCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ PurgeKeysCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := purgeKeys(origin)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// purgeKeys removes the session keys of the caller.
+func purgeKeys(origin types.RuntimeOrigin) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return session.PurgeKeys(origin.AsSigned())
+}
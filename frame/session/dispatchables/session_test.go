@@ -0,0 +1,210 @@
+//go:build nonwasmenv
+
+package dispatchables
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	cs "github.com/LimeChain/gosemble/constants/session"
+	"github.com/LimeChain/gosemble/frame/session"
+	"github.com/LimeChain/gosemble/frame/session/errors"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/frame/testutils"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	alice   = testutils.NewAddress(1)
+	bob     = testutils.NewAddress(2)
+	charlie = testutils.NewAddress(3)
+)
+
+// plannedValidators is a session manager, which plans `validators` for every new session.
+type plannedValidators struct {
+	validators sc.Sequence[types.Address32]
+	started    *[]sc.U32
+	ended      *[]sc.U32
+}
+
+func (m plannedValidators) NewSession(_ sc.U32) sc.Option[sc.Sequence[types.Address32]] {
+	return sc.NewOption[sc.Sequence[types.Address32]](m.validators)
+}
+
+func (m plannedValidators) EndSession(endIndex sc.U32) {
+	*m.ended = append(*m.ended, endIndex)
+}
+
+func (m plannedValidators) StartSession(startIndex sc.U32) {
+	*m.started = append(*m.started, startIndex)
+}
+
+func newKey(b sc.U8) types.PublicKey {
+	key := make(types.PublicKey, 32)
+	key[0] = b
+	return key
+}
+
+func newKeys(b sc.U8) session.SessionKeys {
+	return session.SessionKeys{Keys: []types.PublicKey{newKey(b), newKey(b + 1)}}
+}
+
+func setup() testutils.SessionHandler {
+	host.Reset()
+	handler := testutils.NewSessionHandler([4]byte{'t', 's', 't', '1'})
+	session.RegisterHandlers([]types.SessionHandler{handler, testutils.NewSessionHandler([4]byte{'t', 's', 't', '2'})})
+
+	session.RegisterSessionManager(session.StaticValidators{})
+	session.RegisterValidatorIdOf(session.CurrentValidatorOf)
+
+	testutils.SetupAccount(alice, sc.NewU128FromUint64(0))
+	testutils.SetupAccount(bob, sc.NewU128FromUint64(0))
+	testutils.SetupAccount(charlie, sc.NewU128FromUint64(0))
+	session.StorageSetValidators(sc.Sequence[types.Address32]{alice, bob})
+
+	return handler
+}
+
+func Test_Session(t *testing.T) {
+	noKeys := sc.NewOption[session.SessionKeys](nil)
+
+	var testExamples = []struct {
+		label       string
+		call        types.Call
+		origin      types.RuntimeOrigin
+		args        sc.VaryingData
+		bobKeys     sc.Option[session.SessionKeys]
+		expectation types.DispatchError
+		aliceKeys   sc.Option[session.SessionKeys]
+	}{
+		{
+			label:       "set_keys(BadOrigin)",
+			call:        NewSetKeysCall(nil),
+			origin:      types.NewRawOriginRoot(),
+			args:        sc.NewVaryingData(newKeys(1), sc.Sequence[sc.U8]{}),
+			bobKeys:     noKeys,
+			expectation: types.NewDispatchErrorBadOrigin(),
+			aliceKeys:   noKeys,
+		},
+		{
+			label:       "set_keys(DuplicatedKey)",
+			call:        NewSetKeysCall(nil),
+			origin:      types.NewRawOriginSigned(alice),
+			args:        sc.NewVaryingData(newKeys(1), sc.Sequence[sc.U8]{}),
+			bobKeys:     sc.NewOption[session.SessionKeys](newKeys(1)),
+			expectation: testutils.NewModuleError(cs.ModuleIndex, errors.ErrorDuplicatedKey),
+			aliceKeys:   noKeys,
+		},
+		{
+			label:       "set_keys(NoAssociatedValidatorId)",
+			call:        NewSetKeysCall(nil),
+			origin:      types.NewRawOriginSigned(charlie),
+			args:        sc.NewVaryingData(newKeys(1), sc.Sequence[sc.U8]{}),
+			bobKeys:     noKeys,
+			expectation: testutils.NewModuleError(cs.ModuleIndex, errors.ErrorNoAssociatedValidatorId),
+			aliceKeys:   noKeys,
+		},
+		{
+			label:     "set_keys(Ok)",
+			call:      NewSetKeysCall(nil),
+			origin:    types.NewRawOriginSigned(alice),
+			args:      sc.NewVaryingData(newKeys(1), sc.Sequence[sc.U8]{}),
+			bobKeys:   sc.NewOption[session.SessionKeys](newKeys(3)),
+			aliceKeys: sc.NewOption[session.SessionKeys](newKeys(1)),
+		},
+		{
+			label:       "purge_keys(NoKeys)",
+			call:        NewPurgeKeysCall(nil),
+			origin:      types.NewRawOriginSigned(alice),
+			args:        sc.NewVaryingData(),
+			bobKeys:     noKeys,
+			expectation: testutils.NewModuleError(cs.ModuleIndex, errors.ErrorNoKeys),
+			aliceKeys:   noKeys,
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			setup()
+			if testExample.bobKeys.HasValue {
+				assert.Nil(t, session.SetKeys(bob, testExample.bobKeys.Value))
+			}
+
+			result := testExample.call.Dispatch(testExample.origin, testExample.args)
+
+			if testExample.expectation != nil {
+				assert.True(t, bool(result.HasError))
+				assert.Equal(t, testExample.expectation, result.Err.Error)
+			} else {
+				assert.False(t, bool(result.HasError))
+			}
+			assert.Equal(t, testExample.aliceKeys, session.StorageGetNextKeys(alice))
+		})
+	}
+}
+
+func Test_Session_PurgeKeys(t *testing.T) {
+	setup()
+	assert.Nil(t, session.SetKeys(alice, newKeys(1)))
+	assert.Equal(t, sc.U32(1), system.StorageGetAccount(alice.FixedSequence).Consumers)
+
+	result := NewPurgeKeysCall(nil).Dispatch(types.NewRawOriginSigned(alice), sc.NewVaryingData())
+
+	assert.False(t, bool(result.HasError))
+	assert.Equal(t, sc.NewOption[session.SessionKeys](nil), session.StorageGetNextKeys(alice))
+	assert.Equal(t, sc.NewOption[types.Address32](nil), session.StorageGetKeyOwner([4]byte{'t', 's', 't', '1'}, newKey(1)))
+	assert.Equal(t, sc.U32(0), system.StorageGetAccount(alice.FixedSequence).Consumers)
+}
+
+func Test_Session_RotateSession(t *testing.T) {
+	handler := setup()
+	session.GenesisConfig{Keys: []session.GenesisKeys{{Account: alice, Keys: newKeys(1)}}}.BuildGenesis()
+
+	result := NewSetKeysCall(nil).Dispatch(types.NewRawOriginSigned(alice), sc.NewVaryingData(newKeys(5), sc.Sequence[sc.U8]{}))
+	assert.False(t, bool(result.HasError))
+
+	// The new keys are queued in the first rotation and enacted in the second one.
+	session.RotateSession()
+	session.RotateSession()
+
+	assert.Equal(t, sc.U32(2), session.StorageGetCurrentIndex())
+	assert.Equal(t, sc.Sequence[types.Address32]{alice}, session.StorageGetValidators())
+	assert.Equal(t, []bool{false, true}, *handler.Changed)
+	assert.Equal(t, []types.ValidatorKey{{Validator: alice, Key: newKey(1)}}, (*handler.SessionsKeys)[0])
+	assert.Equal(t, []types.ValidatorKey{{Validator: alice, Key: newKey(5)}}, (*handler.SessionsKeys)[1])
+}
+
+func Test_Session_RotateSession_SessionManager(t *testing.T) {
+	handler := setup()
+	session.GenesisConfig{Keys: []session.GenesisKeys{{Account: alice, Keys: newKeys(1)}}}.BuildGenesis()
+
+	manager := plannedValidators{
+		validators: sc.Sequence[types.Address32]{alice, bob},
+		started:    &[]sc.U32{},
+		ended:      &[]sc.U32{},
+	}
+	session.RegisterSessionManager(manager)
+	defer session.RegisterSessionManager(session.StaticValidators{})
+
+	// Bob is not a validator of the current session, but sets the keys for the planned one.
+	assert.Equal(t, testutils.NewModuleError(cs.ModuleIndex, errors.ErrorNoAssociatedValidatorId), session.SetKeys(bob, newKeys(3)))
+	session.RegisterValidatorIdOf(func(account types.Address32) sc.Option[types.Address32] {
+		return sc.NewOption[types.Address32](account)
+	})
+	defer session.RegisterValidatorIdOf(session.CurrentValidatorOf)
+	assert.Nil(t, session.SetKeys(bob, newKeys(3)))
+
+	// The planned validators are queued in the first rotation and enacted in the second one.
+	session.RotateSession()
+	assert.Equal(t, sc.Sequence[types.Address32]{alice}, session.StorageGetValidators())
+
+	session.RotateSession()
+	assert.Equal(t, sc.Sequence[types.Address32]{alice, bob}, session.StorageGetValidators())
+
+	assert.Equal(t, []sc.U32{0, 1}, *manager.ended)
+	assert.Equal(t, []sc.U32{1, 2}, *manager.started)
+	assert.Equal(t, []bool{false, true}, *handler.Changed)
+	assert.Equal(t, []types.ValidatorKey{{Validator: alice, Key: newKey(1)}, {Validator: bob, Key: newKey(3)}}, (*handler.SessionsKeys)[1])
+}
@@ -0,0 +1,116 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	cs "github.com/LimeChain/gosemble/constants/session"
+	"github.com/LimeChain/gosemble/frame/session"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetKeysCall struct {
+	primitives.Callable
+}
+
+func NewSetKeysCall(args sc.VaryingData) SetKeysCall {
+	call := SetKeysCall{
+		Callable: primitives.Callable{
+			ModuleId:   cs.ModuleIndex,
+			FunctionId: cs.FunctionSetKeysIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetKeysCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		session.DecodeSessionKeys(buffer),
+		sc.DecodeSequence[sc.U8](buffer),
+	)
+	return c
+}
+
+func (c SetKeysCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetKeysCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetKeysCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetKeysCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c SetKeysCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ SetKeysCall) BaseWeight(b ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `1924`
+	//  Estimated: `12814`
+	// Minimum execution time: 56_180 nanoseconds.
+	r := constants.DbWeight.Reads(6)
+	w := constants.DbWeight.Writes(5)
+	e := types.WeightFromParts(0, 12814)
+	return types.WeightFromParts(56_180_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ SetKeysCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetKeysCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ SetKeysCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ SetKeysCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetKeysCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := setKeys(origin, args[0].(session.SessionKeys), args[1].(sc.Sequence[sc.U8]))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
+
+// setKeys sets the session keys of the caller for the next session.
+// The ownership proof is not validated, as the configured session keys do not define one.
+func setKeys(origin types.RuntimeOrigin, keys session.SessionKeys, _ sc.Sequence[sc.U8]) types.DispatchError {
+	if !origin.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	return session.SetKeys(origin.AsSigned(), keys)
+}
@@ -0,0 +1,12 @@
+package errors
+
+import sc "github.com/LimeChain/goscale"
+
+// Session module errors.
+const (
+	ErrorInvalidProof sc.U8 = iota
+	ErrorNoAssociatedValidatorId
+	ErrorDuplicatedKey
+	ErrorNoKeys
+	ErrorNoAccount
+)
@@ -0,0 +1,38 @@
+package session
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/session"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Session module events.
+const (
+	EventNewSession sc.U8 = iota
+)
+
+func NewEventNewSession(sessionIndex sc.U32) types.Event {
+	return types.NewEvent(session.ModuleIndex, EventNewSession, sessionIndex)
+}
+
+func DecodeEvent(buffer *bytes.Buffer) types.Event {
+	moduleIndex := sc.DecodeU8(buffer)
+	if moduleIndex != session.ModuleIndex {
+		log.Critical("invalid session.Event")
+	}
+
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case EventNewSession:
+		sessionIndex := sc.DecodeU32(buffer)
+		return NewEventNewSession(sessionIndex)
+	default:
+		log.Critical("invalid session.Event type")
+	}
+
+	panic("unreachable")
+}
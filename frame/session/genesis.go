@@ -0,0 +1,52 @@
+package session
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// GenesisKeys are the initial session keys of a validator.
+type GenesisKeys struct {
+	Account types.Address32
+	Keys    SessionKeys
+}
+
+// GenesisConfig is the genesis configuration of the module.
+type GenesisConfig struct {
+	// Keys are the initial validators with their session keys.
+	Keys []GenesisKeys
+}
+
+// BuildGenesis sets the session keys of the initial validators, which form the validator set of the
+// genesis session and are queued for the next one, and notifies the session handlers.
+func (gc GenesisConfig) BuildGenesis() {
+	validators := sc.Sequence[types.Address32]{}
+	for _, genesisKeys := range gc.Keys {
+		_, err := innerSetKeys(genesisKeys.Account, genesisKeys.Keys)
+		if err != nil {
+			log.Critical("genesis config contains duplicate session keys")
+		}
+
+		err = system.IncConsumersWithoutLimit(genesisKeys.Account)
+		if err != nil {
+			log.Warn("genesis session keys are set for an account without providers")
+		}
+
+		validators = append(validators, genesisKeys.Account)
+	}
+
+	queuedKeys := sc.Sequence[QueuedKeys]{}
+	for _, validator := range validators {
+		keys := StorageGetNextKeys(validator)
+		if keys.HasValue {
+			queuedKeys = append(queuedKeys, QueuedKeys{Validator: validator, Keys: keys.Value})
+		}
+	}
+
+	onGenesisSession(queuedKeys)
+
+	StorageSetValidators(validators)
+	storageSetQueuedKeys(queuedKeys)
+}
@@ -0,0 +1,63 @@
+package session
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// handlers are the session handlers of the runtime. Their order defines the order of the session keys.
+var handlers []types.SessionHandler
+
+// RegisterHandlers sets the ordered session handlers of the runtime.
+// The handlers are registered by the runtime configuration, since the consensus modules,
+// which handle the sessions, depend on this module for the disabled validators.
+func RegisterHandlers(sessionHandlers []types.SessionHandler) {
+	handlers = sessionHandlers
+}
+
+// KeyTypeIds returns the key types of the session keys, in the order of the registered handlers.
+func KeyTypeIds() [][4]byte {
+	ids := make([][4]byte, len(handlers))
+	for i, handler := range handlers {
+		ids[i] = handler.KeyTypeId()
+	}
+
+	return ids
+}
+
+func onGenesisSession(validators sc.Sequence[QueuedKeys]) {
+	for i, handler := range handlers {
+		handler.OnGenesisSession(validatorKeys(validators, i))
+	}
+}
+
+func onNewSession(changed bool, validators sc.Sequence[QueuedKeys], queuedValidators sc.Sequence[QueuedKeys]) {
+	for i, handler := range handlers {
+		handler.OnNewSession(changed, validatorKeys(validators, i), validatorKeys(queuedValidators, i))
+	}
+}
+
+func onBeforeSessionEnding() {
+	for _, handler := range handlers {
+		handler.OnBeforeSessionEnding()
+	}
+}
+
+func onDisabled(validatorIndex sc.U32) {
+	for _, handler := range handlers {
+		handler.OnDisabled(validatorIndex)
+	}
+}
+
+// validatorKeys returns the validators with their session keys of the type of the handler at `handlerIndex`.
+func validatorKeys(validators sc.Sequence[QueuedKeys], handlerIndex int) []types.ValidatorKey {
+	result := make([]types.ValidatorKey, len(validators))
+	for i, validator := range validators {
+		result[i] = types.ValidatorKey{
+			Validator: validator.Validator,
+			Key:       validator.Keys.Keys[handlerIndex],
+		}
+	}
+
+	return result
+}
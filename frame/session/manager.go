@@ -0,0 +1,50 @@
+package session
+
+import (
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// manager decides the validator set of each session. It defaults to the static validators of the genesis.
+var manager types.SessionManager = StaticValidators{}
+
+// validatorIdOf converts an account to the validator, whose session keys it can set.
+// It defaults to the validators of the current session.
+var validatorIdOf = CurrentValidatorOf
+
+// RegisterSessionManager sets the session manager, which decides the validators of the planned sessions.
+func RegisterSessionManager(sessionManager types.SessionManager) {
+	manager = sessionManager
+}
+
+// RegisterValidatorIdOf sets the function, which converts an account to its validator, if it has one.
+// Only validators can set session keys.
+func RegisterValidatorIdOf(f func(account types.Address32) sc.Option[types.Address32]) {
+	validatorIdOf = f
+}
+
+// StaticValidators is a session manager, which never changes the validators, set in the genesis.
+// The session keys of the validators can still be rotated.
+type StaticValidators struct{}
+
+func (StaticValidators) NewSession(_ sc.U32) sc.Option[sc.Sequence[types.Address32]] {
+	return sc.NewOption[sc.Sequence[types.Address32]](nil)
+}
+
+func (StaticValidators) EndSession(_ sc.U32) {}
+
+func (StaticValidators) StartSession(_ sc.U32) {}
+
+// CurrentValidatorOf returns the account itself, if it is one of the validators of the current session.
+// Used with static validators, where accounts cannot become validators after the genesis.
+func CurrentValidatorOf(account types.Address32) sc.Option[types.Address32] {
+	for _, validator := range StorageGetValidators() {
+		if reflect.DeepEqual(validator, account) {
+			return sc.NewOption[types.Address32](account)
+		}
+	}
+
+	return sc.NewOption[types.Address32](nil)
+}
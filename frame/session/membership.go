@@ -0,0 +1,62 @@
+package session
+
+import (
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// ProveKeyOwnership returns a proof that the session key `key` of type `typeId` belongs to
+// a validator of the current session. Without historical sessions, proofs can only be generated
+// for, and checked in, the session in which they are created. The owner is resolved through the
+// key owners of the next session keys, so keys, rotated since the session started, cannot be proven.
+func ProveKeyOwnership(typeId [4]byte, key types.PublicKey) sc.Option[MembershipProof] {
+	validators := StorageGetValidators()
+
+	_, ok := validatorIndex(validators, typeId, key)
+	if !ok {
+		return sc.NewOption[MembershipProof](nil)
+	}
+
+	return sc.NewOption[MembershipProof](MembershipProof{
+		Session:        StorageGetCurrentIndex(),
+		TrieNodes:      sc.Sequence[sc.Sequence[sc.U8]]{},
+		ValidatorCount: sc.U32(len(validators)),
+	})
+}
+
+// CheckKeyOwnershipProof checks that `proof` proves the ownership of the session key `key` of type
+// `typeId` and returns the validator, which owns it, and its index in the current validators.
+func CheckKeyOwnershipProof(typeId [4]byte, key types.PublicKey, proof MembershipProof) (types.Address32, sc.U32, bool) {
+	validators := StorageGetValidators()
+
+	if proof.Session != StorageGetCurrentIndex() ||
+		proof.ValidatorCount != sc.U32(len(validators)) ||
+		len(proof.TrieNodes) != 0 {
+		return types.Address32{}, 0, false
+	}
+
+	index, ok := validatorIndex(validators, typeId, key)
+	if !ok {
+		return types.Address32{}, 0, false
+	}
+
+	return validators[index], index, true
+}
+
+// validatorIndex returns the index of the validator, which owns the session key, in `validators`.
+func validatorIndex(validators sc.Sequence[types.Address32], typeId [4]byte, key types.PublicKey) (sc.U32, bool) {
+	owner := StorageGetKeyOwner(typeId, key)
+	if !owner.HasValue {
+		return 0, false
+	}
+
+	for i, validator := range validators {
+		if reflect.DeepEqual(validator, owner.Value) {
+			return sc.U32(i), true
+		}
+	}
+
+	return 0, false
+}
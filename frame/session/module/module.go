@@ -0,0 +1,171 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	cs "github.com/LimeChain/gosemble/constants/session"
+	"github.com/LimeChain/gosemble/frame/session"
+	"github.com/LimeChain/gosemble/frame/session/dispatchables"
+	"github.com/LimeChain/gosemble/frame/session/errors"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SessionModule struct {
+	primitives.DefaultHooks
+	functions map[sc.U8]primitives.Call
+}
+
+func NewSessionModule() SessionModule {
+	functions := make(map[sc.U8]primitives.Call)
+	functions[cs.FunctionSetKeysIndex] = dispatchables.NewSetKeysCall(nil)
+	functions[cs.FunctionPurgeKeysIndex] = dispatchables.NewPurgeKeysCall(nil)
+
+	return SessionModule{
+		functions: functions,
+	}
+}
+
+// OnInitialize rotates the session, if the session ends in block `n`.
+func (sm SessionModule) OnInitialize(n primitives.BlockNumber) primitives.Weight {
+	if !session.ShouldEndSession(n) {
+		return primitives.WeightZero()
+	}
+
+	session.RotateSession()
+
+	// The weight of the rotation depends on the number of validators and the session handlers,
+	// so the whole block is accounted for, as in Substrate.
+	return system.DefaultBlockWeights().MaxBlock
+}
+
+func (sm SessionModule) Functions() map[sc.U8]primitives.Call {
+	return sm.functions
+}
+
+func (sm SessionModule) PreDispatch(_ primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (sm SessionModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+func (sm SessionModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
+	declaredTypes, metadataModule := sm.declaration().Metadata()
+
+	return append(sm.metadataTypes(), declaredTypes...), metadataModule
+}
+
+// declaration declares the storage, calls, events and errors of the module,
+// from which its metadata is derived.
+func (sm SessionModule) declaration() support.ModuleDeclaration {
+	return support.ModuleDeclaration{
+		Name:    "Session",
+		Index:   cs.ModuleIndex,
+		Path:    "pallet_session",
+		Storage: session.StorageDeclarations(),
+		Calls: &support.EnumDeclaration{
+			TypeId:   metadata.SessionCalls,
+			Variants: callDeclarations,
+		},
+		Events: &support.EnumDeclaration{
+			TypeId:   metadata.TypesSessionEvent,
+			Variants: eventDeclarations,
+		},
+		Errors: &support.EnumDeclaration{
+			TypeId:   metadata.TypesSessionErrors,
+			Variants: errorDeclarations,
+		},
+	}
+}
+
+func (sm SessionModule) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithPath(metadata.TypesSessionKeys, "SessionKeys", sc.Sequence[sc.Str]{"node_template_runtime", "opaque", "SessionKeys"},
+			primitives.NewMetadataTypeDefinitionComposite(sessionKeysFields())),
+		primitives.NewMetadataType(metadata.TypesSequenceAddress32, "Vec<AccountId>",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesAddress32))),
+		primitives.NewMetadataType(metadata.TypesTupleAddress32SessionKeys, "(AccountId, SessionKeys)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{
+				sc.ToCompact(metadata.TypesAddress32),
+				sc.ToCompact(metadata.TypesSessionKeys),
+			})),
+		primitives.NewMetadataType(metadata.TypesSequenceTupleAddress32SessionKeys, "Vec<(AccountId, SessionKeys)>",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesTupleAddress32SessionKeys))),
+		primitives.NewMetadataType(metadata.TypesTupleKeyTypeIdSequenceU8, "(KeyTypeId, Vec<u8>)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{
+				sc.ToCompact(metadata.TypesFixedSequence4U8),
+				sc.ToCompact(metadata.TypesSequenceU8),
+			})),
+	}
+}
+
+// sessionKeysFields declares a public key field for each of the registered session handlers,
+// named after the key type of the handler.
+func sessionKeysFields() sc.Sequence[primitives.MetadataTypeDefinitionField] {
+	fields := sc.Sequence[primitives.MetadataTypeDefinitionField]{}
+	for _, keyTypeId := range session.KeyTypeIds() {
+		fields = append(fields,
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesFixedSequence32U8, sc.Str(keyTypeId[:]), "Public"))
+	}
+
+	return fields
+}
+
+var callDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "set_keys",
+		Index: cs.FunctionSetKeysIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSessionKeys, "keys", "T::Keys"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceU8, "proof", "Vec<u8>"),
+		},
+		Docs: "Sets the session key(s) of the function caller to `keys`. Allows an account to set its session key prior to becoming a validator. The caller must have an associated validator ID. This doesn't take effect until the next session.",
+	},
+	{
+		Name:  "purge_keys",
+		Index: cs.FunctionPurgeKeysIndex,
+		Docs:  "Removes any session key(s) of the function caller. This doesn't take effect until the next session.",
+	},
+}
+
+var eventDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "NewSession",
+		Index: session.EventNewSession,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "session_index", "SessionIndex"),
+		},
+		Docs: "New session has happened. Note that the argument is the session index, not the block number as the type might suggest.",
+	},
+}
+
+var errorDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "InvalidProof",
+		Index: errors.ErrorInvalidProof,
+		Docs:  "Invalid ownership proof.",
+	},
+	{
+		Name:  "NoAssociatedValidatorId",
+		Index: errors.ErrorNoAssociatedValidatorId,
+		Docs:  "No associated validator ID for account.",
+	},
+	{
+		Name:  "DuplicatedKey",
+		Index: errors.ErrorDuplicatedKey,
+		Docs:  "Registered duplicate key.",
+	},
+	{
+		Name:  "NoKeys",
+		Index: errors.ErrorNoKeys,
+		Docs:  "No keys are associated with this account.",
+	},
+	{
+		Name:  "NoAccount",
+		Index: errors.ErrorNoAccount,
+		Docs:  "Key setting account is not live, so it's impossible to associate keys.",
+	},
+}
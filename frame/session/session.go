@@ -0,0 +1,219 @@
+package session
+
+import (
+	"reflect"
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	cs "github.com/LimeChain/gosemble/constants/session"
+	"github.com/LimeChain/gosemble/frame/session/errors"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// shouldEndSession decides whether the session ends in a block. It defaults to periodic sessions.
+var shouldEndSession = PeriodicSessions
+
+// RegisterShouldEndSession sets the function, which decides whether the session ends in block `now`.
+// It is called by the runtime configuration, when the sessions follow the epochs of the consensus module.
+func RegisterShouldEndSession(f func(now types.BlockNumber) bool) {
+	shouldEndSession = f
+}
+
+// ShouldEndSession returns true, if the session should end in block `now`.
+func ShouldEndSession(now types.BlockNumber) bool {
+	return shouldEndSession(now)
+}
+
+// PeriodicSessions returns true, if the session should end in block `now`.
+// Sessions end periodically, every `Period` blocks, starting from `Offset`.
+func PeriodicSessions(now types.BlockNumber) bool {
+	return now >= cs.Offset && (now-cs.Offset)%cs.Period == 0
+}
+
+// RotateSession ends the current session and starts the next one.
+// The validators, queued in the previous rotation, become the validators of the new session,
+// and the validators, planned by the session manager for the next session, are queued with their
+// latest session keys. If the session manager does not change them, the current validators are queued.
+func RotateSession() {
+	sessionIndex := StorageGetCurrentIndex()
+	changed := storageGetQueuedChanged()
+
+	onBeforeSessionEnding()
+	manager.EndSession(sessionIndex)
+
+	sessionKeys := StorageGetQueuedKeys()
+	validators := make(sc.Sequence[types.Address32], len(sessionKeys))
+	for i, queued := range sessionKeys {
+		validators[i] = queued.Validator
+	}
+	StorageSetValidators(validators)
+
+	if changed {
+		storageClearDisabledValidators()
+	}
+
+	sessionIndex += 1
+	storageSetCurrentIndex(sessionIndex)
+
+	manager.StartSession(sessionIndex)
+
+	// Even if the validator set does not change, the session keys of the validators might.
+	nextValidators := validators
+	nextChanged := false
+	planned := manager.NewSession(sessionIndex + 1)
+	if planned.HasValue {
+		nextValidators = planned.Value
+		nextChanged = true
+	}
+
+	queuedKeys := sc.Sequence[QueuedKeys]{}
+	for _, validator := range nextValidators {
+		keys := StorageGetNextKeys(validator)
+		if !keys.HasValue {
+			continue
+		}
+
+		index := len(queuedKeys)
+		if !nextChanged && index < len(sessionKeys) && !sessionKeys[index].Keys.Equal(keys.Value) {
+			nextChanged = true
+		}
+
+		queuedKeys = append(queuedKeys, QueuedKeys{Validator: validator, Keys: keys.Value})
+	}
+
+	if len(queuedKeys) != len(sessionKeys) {
+		nextChanged = true
+	}
+
+	storageSetQueuedKeys(queuedKeys)
+	storageSetQueuedChanged(sc.Bool(nextChanged))
+
+	system.DepositEvent(NewEventNewSession(sessionIndex))
+
+	onNewSession(bool(changed), sessionKeys, queuedKeys)
+}
+
+// DisableIndex disables the validator at index `i` of the current validators for the rest of the session.
+// Returns false, if the validator is already disabled.
+func DisableIndex(i sc.U32) bool {
+	if i >= sc.U32(len(StorageGetValidators())) {
+		return false
+	}
+
+	disabled := StorageGetDisabledValidators()
+
+	index := sort.Search(len(disabled), func(j int) bool {
+		return disabled[j] >= i
+	})
+	if index < len(disabled) && disabled[index] == i {
+		return false
+	}
+
+	disabled = append(disabled, 0)
+	copy(disabled[index+1:], disabled[index:])
+	disabled[index] = i
+	storageSetDisabledValidators(disabled)
+
+	onDisabled(i)
+
+	return true
+}
+
+// IsDisabled returns true, if the validator at index `i` is disabled for the rest of the session.
+func IsDisabled(i sc.U32) bool {
+	disabled := StorageGetDisabledValidators()
+
+	index := sort.Search(len(disabled), func(j int) bool {
+		return disabled[j] >= i
+	})
+
+	return index < len(disabled) && disabled[index] == i
+}
+
+// SetKeys sets the session keys of `account` for the next session.
+// Only accounts, which have an associated validator, can set session keys.
+// The account holds a consumer reference while it has session keys.
+func SetKeys(account types.Address32, keys SessionKeys) types.DispatchError {
+	if !system.CanIncConsumer(account) {
+		return newModuleError(errors.ErrorNoAccount)
+	}
+
+	validator := validatorIdOf(account)
+	if !validator.HasValue {
+		return newModuleError(errors.ErrorNoAssociatedValidatorId)
+	}
+
+	oldKeys, err := innerSetKeys(validator.Value, keys)
+	if err != nil {
+		return err
+	}
+
+	if !oldKeys.HasValue {
+		// Can only fail if the account has no providers, which was checked above.
+		err := system.IncConsumersWithoutLimit(account)
+		if err != nil {
+			return newModuleError(errors.ErrorNoAccount)
+		}
+	}
+
+	return nil
+}
+
+// PurgeKeys removes the session keys of `account` and releases its consumer reference.
+func PurgeKeys(account types.Address32) types.DispatchError {
+	oldKeys := StorageGetNextKeys(account)
+	if !oldKeys.HasValue {
+		return newModuleError(errors.ErrorNoKeys)
+	}
+
+	storageRemoveNextKeys(account)
+	for i, typeId := range KeyTypeIds() {
+		storageRemoveKeyOwner(typeId, oldKeys.Value.Keys[i])
+	}
+
+	system.DecConsumers(account)
+
+	return nil
+}
+
+// innerSetKeys sets the session keys of `validator` and the owner of each of the keys.
+// Fails if any of the keys is owned by another validator. Returns the previous session keys.
+func innerSetKeys(validator types.Address32, keys SessionKeys) (sc.Option[SessionKeys], types.DispatchError) {
+	oldKeys := StorageGetNextKeys(validator)
+	typeIds := KeyTypeIds()
+
+	for i, typeId := range typeIds {
+		owner := StorageGetKeyOwner(typeId, keys.Keys[i])
+		if owner.HasValue && !sc.Bool(reflect.DeepEqual(owner.Value, validator)) {
+			return oldKeys, newModuleError(errors.ErrorDuplicatedKey)
+		}
+	}
+
+	for i, typeId := range typeIds {
+		key := keys.Keys[i]
+
+		if oldKeys.HasValue {
+			oldKey := oldKeys.Value.Keys[i]
+			if reflect.DeepEqual(key, oldKey) {
+				continue
+			}
+
+			storageRemoveKeyOwner(typeId, oldKey)
+		}
+
+		storageSetKeyOwner(typeId, key, validator)
+	}
+
+	storageSetNextKeys(validator, keys)
+
+	return oldKeys, nil
+}
+
+func newModuleError(err sc.U8) types.DispatchError {
+	return types.NewDispatchErrorModule(types.CustomModuleError{
+		Index:   cs.ModuleIndex,
+		Error:   sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
@@ -0,0 +1,154 @@
+package session
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	storageValidators         = support.NewStorageValue[sc.Sequence[types.Address32]](constants.KeySession, constants.KeyValidators, decodeValidators)
+	storageCurrentIndex       = support.NewStorageValue[sc.U32](constants.KeySession, constants.KeyCurrentIndex, sc.DecodeU32)
+	storageQueuedChanged      = support.NewStorageValue[sc.Bool](constants.KeySession, constants.KeyQueuedChanged, sc.DecodeBool)
+	storageQueuedKeys         = support.NewStorageValue[sc.Sequence[QueuedKeys]](constants.KeySession, constants.KeyQueuedKeys, decodeQueuedKeys)
+	storageDisabledValidators = support.NewStorageValue[sc.Sequence[sc.U32]](constants.KeySession, constants.KeyDisabledValidators, decodeDisabledValidators)
+	storageNextKeys           = support.NewStorageMap[types.Address32, SessionKeys](constants.KeySession, constants.KeyNextKeys, support.Twox64Concat{}, types.DecodeAddress32, DecodeSessionKeys)
+	storageKeyOwner           = support.NewStorageMap[KeyOwnerKey, types.Address32](constants.KeySession, constants.KeyKeyOwner, support.Twox64Concat{}, DecodeKeyOwnerKey, types.DecodeAddress32)
+)
+
+func init() {
+	support.RegisterTypeId(metadata.TypesSequenceTupleAddress32SessionKeys, support.TypeOf[sc.Sequence[QueuedKeys]]())
+	support.RegisterTypeId(metadata.TypesSessionKeys, support.TypeOf[SessionKeys]())
+	support.RegisterTypeId(metadata.TypesTupleKeyTypeIdSequenceU8, support.TypeOf[KeyOwnerKey]())
+}
+
+// StorageDeclarations returns the declarations of the storage items of the module, in the order of the metadata.
+func StorageDeclarations() []support.StorageDeclaration {
+	return []support.StorageDeclaration{
+		{
+			Item:     storageValidators,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "The current set of validators.",
+		},
+		{
+			Item:     storageCurrentIndex,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "Current index of the session.",
+		},
+		{
+			Item:     storageQueuedChanged,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "True if the underlying economic identities or weighting behind the validators has changed in the queued validator set.",
+		},
+		{
+			Item:     storageQueuedKeys,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "The queued keys for the next session. When the next session begins, these keys will be used to determine the validator's session keys.",
+		},
+		{
+			Item:     storageDisabledValidators,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "Indices of disabled validators. The vec is always kept sorted so that we can find whether a given validator is disabled using binary search.",
+		},
+		{
+			Item:     storageNextKeys,
+			Modifier: types.MetadataModuleStorageEntryModifierOptional,
+			Docs:     "The next session keys for a validator.",
+		},
+		{
+			Item:     storageKeyOwner,
+			Modifier: types.MetadataModuleStorageEntryModifierOptional,
+			Docs:     "The owner of a key. The key is the `KeyTypeId` + the encoded key.",
+		},
+	}
+}
+
+// StorageGetValidators returns the validators of the current session.
+func StorageGetValidators() sc.Sequence[types.Address32] {
+	return storageValidators.Get()
+}
+
+func StorageSetValidators(validators sc.Sequence[types.Address32]) {
+	storageValidators.Put(validators)
+}
+
+// StorageGetCurrentIndex returns the index of the current session.
+func StorageGetCurrentIndex() sc.U32 {
+	return storageCurrentIndex.Get()
+}
+
+func storageSetCurrentIndex(index sc.U32) {
+	storageCurrentIndex.Put(index)
+}
+
+func storageGetQueuedChanged() sc.Bool {
+	return storageQueuedChanged.Get()
+}
+
+func storageSetQueuedChanged(changed sc.Bool) {
+	storageQueuedChanged.Put(changed)
+}
+
+// StorageGetQueuedKeys returns the validators with their session keys, queued for the next session.
+func StorageGetQueuedKeys() sc.Sequence[QueuedKeys] {
+	return storageQueuedKeys.Get()
+}
+
+func storageSetQueuedKeys(queuedKeys sc.Sequence[QueuedKeys]) {
+	storageQueuedKeys.Put(queuedKeys)
+}
+
+// StorageGetDisabledValidators returns the sorted indices of the disabled validators of the current session.
+func StorageGetDisabledValidators() sc.Sequence[sc.U32] {
+	return storageDisabledValidators.Get()
+}
+
+func storageSetDisabledValidators(indices sc.Sequence[sc.U32]) {
+	storageDisabledValidators.Put(indices)
+}
+
+func storageClearDisabledValidators() {
+	storageDisabledValidators.Clear()
+}
+
+// StorageGetNextKeys returns the session keys of the validator for the next session, if it has set any.
+func StorageGetNextKeys(validator types.Address32) sc.Option[SessionKeys] {
+	return storageNextKeys.GetOption(validator)
+}
+
+func storageSetNextKeys(validator types.Address32, keys SessionKeys) {
+	storageNextKeys.Put(validator, keys)
+}
+
+func storageRemoveNextKeys(validator types.Address32) {
+	storageNextKeys.Remove(validator)
+}
+
+// StorageGetKeyOwner returns the validator, which owns the session key, if there is one.
+func StorageGetKeyOwner(typeId [4]byte, key types.PublicKey) sc.Option[types.Address32] {
+	return storageKeyOwner.GetOption(NewKeyOwnerKey(typeId, key))
+}
+
+func storageSetKeyOwner(typeId [4]byte, key types.PublicKey, validator types.Address32) {
+	storageKeyOwner.Put(NewKeyOwnerKey(typeId, key), validator)
+}
+
+func storageRemoveKeyOwner(typeId [4]byte, key types.PublicKey) {
+	storageKeyOwner.Remove(NewKeyOwnerKey(typeId, key))
+}
+
+func decodeValidators(buffer *bytes.Buffer) sc.Sequence[types.Address32] {
+	return sc.DecodeSequenceWith(buffer, types.DecodeAddress32)
+}
+
+func decodeQueuedKeys(buffer *bytes.Buffer) sc.Sequence[QueuedKeys] {
+	return sc.DecodeSequenceWith(buffer, DecodeQueuedKeys)
+}
+
+func decodeDisabledValidators(buffer *bytes.Buffer) sc.Sequence[sc.U32] {
+	return sc.DecodeSequenceWith(buffer, sc.DecodeU32)
+}
@@ -0,0 +1,120 @@
+package session
+
+import (
+	"bytes"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// SessionKeys are the session keys of a validator. They contain a public key for each
+// of the configured session handlers, in the order of the handlers.
+type SessionKeys struct {
+	Keys []types.PublicKey
+}
+
+func (sk SessionKeys) Encode(buffer *bytes.Buffer) {
+	for _, key := range sk.Keys {
+		key.Encode(buffer)
+	}
+}
+
+func (sk SessionKeys) Bytes() []byte {
+	return sc.EncodedBytes(sk)
+}
+
+// DecodeSessionKeys decodes a public key for each of the registered session handlers.
+func DecodeSessionKeys(buffer *bytes.Buffer) SessionKeys {
+	keys := make([]types.PublicKey, len(handlers))
+	for i := range handlers {
+		keys[i] = types.DecodePublicKey(buffer)
+	}
+
+	return SessionKeys{Keys: keys}
+}
+
+// Equal returns true, if the session keys contain the same public keys.
+func (sk SessionKeys) Equal(other SessionKeys) bool {
+	return reflect.DeepEqual(sk.Keys, other.Keys)
+}
+
+// QueuedKeys are the session keys of a validator, queued for the next session.
+type QueuedKeys struct {
+	Validator types.Address32
+	Keys      SessionKeys
+}
+
+func (qk QueuedKeys) Encode(buffer *bytes.Buffer) {
+	qk.Validator.Encode(buffer)
+	qk.Keys.Encode(buffer)
+}
+
+func (qk QueuedKeys) Bytes() []byte {
+	return sc.EncodedBytes(qk)
+}
+
+func DecodeQueuedKeys(buffer *bytes.Buffer) QueuedKeys {
+	return QueuedKeys{
+		Validator: types.DecodeAddress32(buffer),
+		Keys:      DecodeSessionKeys(buffer),
+	}
+}
+
+// KeyOwnerKey identifies a single session key by its key type and raw public key.
+type KeyOwnerKey struct {
+	TypeId sc.FixedSequence[sc.U8]
+	Key    sc.Sequence[sc.U8]
+}
+
+func NewKeyOwnerKey(typeId [4]byte, key types.PublicKey) KeyOwnerKey {
+	return KeyOwnerKey{
+		TypeId: sc.BytesToFixedSequenceU8(typeId[:]),
+		Key:    sc.BytesToSequenceU8(sc.FixedSequenceU8ToBytes(key)),
+	}
+}
+
+func (kok KeyOwnerKey) Encode(buffer *bytes.Buffer) {
+	kok.TypeId.Encode(buffer)
+	kok.Key.Encode(buffer)
+}
+
+func (kok KeyOwnerKey) Bytes() []byte {
+	return sc.EncodedBytes(kok)
+}
+
+func DecodeKeyOwnerKey(buffer *bytes.Buffer) KeyOwnerKey {
+	return KeyOwnerKey{
+		TypeId: sc.DecodeFixedSequence[sc.U8](4, buffer),
+		Key:    sc.DecodeSequence[sc.U8](buffer),
+	}
+}
+
+// MembershipProof proves that a session key belonged to a validator in the given session.
+type MembershipProof struct {
+	// Session is the session index, in which the key belonged to the validator.
+	Session sc.U32
+	// TrieNodes are the nodes of the historical session trie, which contain the key.
+	// They are empty, since only proofs of the current session are supported.
+	TrieNodes sc.Sequence[sc.Sequence[sc.U8]]
+	// ValidatorCount is the number of validators in the session.
+	ValidatorCount sc.U32
+}
+
+func (mp MembershipProof) Encode(buffer *bytes.Buffer) {
+	mp.Session.Encode(buffer)
+	mp.TrieNodes.Encode(buffer)
+	mp.ValidatorCount.Encode(buffer)
+}
+
+func (mp MembershipProof) Bytes() []byte {
+	return sc.EncodedBytes(mp)
+}
+
+func DecodeMembershipProof(buffer *bytes.Buffer) MembershipProof {
+	return MembershipProof{
+		Session:        sc.DecodeU32(buffer),
+		TrieNodes:      sc.DecodeSequence[sc.Sequence[sc.U8]](buffer),
+		ValidatorCount: sc.DecodeU32(buffer),
+	}
+}
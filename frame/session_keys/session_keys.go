@@ -4,13 +4,16 @@ import (
 	"bytes"
 
 	sc "github.com/LimeChain/goscale"
-	"github.com/LimeChain/gosemble/constants/aura"
 	"github.com/LimeChain/gosemble/constants/grandpa"
+	"github.com/LimeChain/gosemble/frame/session"
 	"github.com/LimeChain/gosemble/primitives/crypto"
 	"github.com/LimeChain/gosemble/primitives/types"
 	"github.com/LimeChain/gosemble/utils"
 )
 
+// publicKeyLength is the length of each of the public keys in the session keys.
+const publicKeyLength = 32
+
 // GenerateSessionKeys generates a set of session keys with an optional seed.
 // The keys should be stored within the keystore exposed by the Host Api.
 // It takes two arguments:
@@ -25,10 +28,17 @@ func GenerateSessionKeys(dataPtr int32, dataLen int32) int64 {
 
 	seed := sc.DecodeOptionWith(buffer, sc.DecodeSequence[sc.U8])
 
-	auraPubKey := crypto.ExtCryptoSr25519GenerateVersion1(aura.KeyTypeId[:], seed.Bytes())
-	grandpaPubKey := crypto.ExtCryptoEd25519GenerateVersion1(grandpa.KeyTypeId[:], seed.Bytes())
+	// GRANDPA keys are ed25519 keys, the keys of the block authoring modules are sr25519 keys.
+	keys := []byte{}
+	for _, keyTypeId := range session.KeyTypeIds() {
+		if keyTypeId == grandpa.KeyTypeId {
+			keys = append(keys, crypto.ExtCryptoEd25519GenerateVersion1(keyTypeId[:], seed.Bytes())...)
+		} else {
+			keys = append(keys, crypto.ExtCryptoSr25519GenerateVersion1(keyTypeId[:], seed.Bytes())...)
+		}
+	}
 
-	res := sc.BytesToSequenceU8(append(auraPubKey, grandpaPubKey...))
+	res := sc.BytesToSequenceU8(keys)
 
 	return utils.BytesToOffsetAndSize(res.Bytes())
 }
@@ -38,17 +48,25 @@ func GenerateSessionKeys(dataPtr int32, dataLen int32) int64 {
 // - dataPtr: Pointer to the data in the Wasm memory.
 // - dataLen: Length of the data.
 // which represent the SCALE-encoded keys.
-// Returns a pointer-size of the SCALE-encoded set of raw keys and their respective key type.
+// The keys must contain a public key for each of the key types of the session handlers, in their order.
+// Returns a pointer-size of the SCALE-encoded set of raw keys and their respective key type,
+// or none, if the keys are not valid.
 // [Specification](https://spec.polkadot.network/chap-runtime-api#id-sessionkeys_decode_session_keys)
 func DecodeSessionKeys(dataPtr int32, dataLen int32) int64 {
 	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
 	buffer := bytes.NewBuffer(b)
 	sequence := sc.DecodeSequenceWith(buffer, sc.DecodeU8)
 
+	keyTypeIds := session.KeyTypeIds()
+	if len(sequence) != len(keyTypeIds)*publicKeyLength {
+		result := sc.NewOption[sc.Sequence[types.SessionKey]](nil)
+		return utils.BytesToOffsetAndSize(result.Bytes())
+	}
+
 	buffer = bytes.NewBuffer(sc.SequenceU8ToBytes(sequence))
-	sessionKeys := sc.Sequence[types.SessionKey]{
-		types.NewSessionKey(sc.FixedSequenceU8ToBytes(types.DecodePublicKey(buffer)), aura.KeyTypeId),
-		types.NewSessionKey(sc.FixedSequenceU8ToBytes(types.DecodePublicKey(buffer)), grandpa.KeyTypeId),
+	sessionKeys := sc.Sequence[types.SessionKey]{}
+	for _, keyTypeId := range keyTypeIds {
+		sessionKeys = append(sessionKeys, types.NewSessionKey(sc.FixedSequenceU8ToBytes(types.DecodePublicKey(buffer)), keyTypeId))
 	}
 
 	result := sc.NewOption[sc.Sequence[types.SessionKey]](sessionKeys)
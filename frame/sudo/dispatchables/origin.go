@@ -0,0 +1,55 @@
+package dispatchables
+
+import (
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	cs "github.com/LimeChain/gosemble/constants/sudo"
+	"github.com/LimeChain/gosemble/frame/sudo"
+	"github.com/LimeChain/gosemble/frame/sudo/errors"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Ensure that the origin `o` represents either the sudo key or the root.
+// Returns `RequireSudo` if it is signed by any other account, or `BadOrigin` if it is neither signed nor root.
+func ensureSudo(o types.RawOrigin) types.DispatchError {
+	if o.IsRootOrigin() {
+		return nil
+	}
+
+	if !o.IsSignedOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+
+	key := sudo.StorageGetKey()
+	if !key.HasValue || !sc.Bool(reflect.DeepEqual(key.Value, o.AsSigned())) {
+		return types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   cs.ModuleIndex,
+			Error:   sc.U32(errors.ErrorRequireSudo),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+
+	return nil
+}
+
+// dispatchCall dispatches the call with the given origin in a new storage layer, which is rolled back if the
+// call fails. Returns the outcome of the call.
+func dispatchCall(origin types.RawOrigin, call types.Call) types.DispatchOutcome {
+	_, err := support.WithStorageLayer[types.PostDispatchInfo, types.DispatchError](
+		func() (types.PostDispatchInfo, types.DispatchError) {
+			result := call.Dispatch(origin, call.Args())
+			if result.HasError {
+				return types.PostDispatchInfo{}, result.Err.Error
+			}
+
+			return result.Ok, nil
+		},
+	)
+	if err != nil {
+		return types.NewDispatchOutcome(err)
+	}
+
+	return types.NewDispatchOutcome(nil)
+}
@@ -0,0 +1,118 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	cs "github.com/LimeChain/gosemble/constants/sudo"
+	"github.com/LimeChain/gosemble/frame/sudo"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type RemoveKeyCall struct {
+	primitives.Callable
+}
+
+func NewRemoveKeyCall(args sc.VaryingData) RemoveKeyCall {
+	call := RemoveKeyCall{
+		Callable: primitives.Callable{
+			ModuleId:   cs.ModuleIndex,
+			FunctionId: cs.FunctionRemoveKeyIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c RemoveKeyCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	return c
+}
+
+func (c RemoveKeyCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c RemoveKeyCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c RemoveKeyCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c RemoveKeyCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c RemoveKeyCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ RemoveKeyCall) BaseWeight(b ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `165`
+	//  Estimated: `1517`
+	// Minimum execution time: 8_760 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.WeightFromParts(0, 1517)
+	return types.WeightFromParts(8_760_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ RemoveKeyCall) IsInherent() bool {
+	return false
+}
+
+func (_ RemoveKeyCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ RemoveKeyCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ RemoveKeyCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ RemoveKeyCall) Dispatch(origin types.RuntimeOrigin, _ sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := removeKey(origin)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok: types.PostDispatchInfo{
+			PaysFee: types.PaysNo,
+		},
+	}
+}
+
+// removeKey authenticates the current sudo key and permanently removes it.
+func removeKey(origin types.RuntimeOrigin) types.DispatchError {
+	err := ensureSudo(origin)
+	if err != nil {
+		return err
+	}
+
+	system.DepositEvent(sudo.NewEventKeyRemoved())
+	sudo.StorageClearKey()
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	cs "github.com/LimeChain/gosemble/constants/sudo"
+	"github.com/LimeChain/gosemble/frame/sudo"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetKeyCall struct {
+	primitives.Callable
+}
+
+func NewSetKeyCall(args sc.VaryingData) SetKeyCall {
+	call := SetKeyCall{
+		Callable: primitives.Callable{
+			ModuleId:   cs.ModuleIndex,
+			FunctionId: cs.FunctionSetKeyIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetKeyCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(types.DecodeMultiAddress(buffer))
+	return c
+}
+
+func (c SetKeyCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetKeyCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetKeyCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetKeyCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c SetKeyCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+func (_ SetKeyCall) BaseWeight(b ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `165`
+	//  Estimated: `1517`
+	// Minimum execution time: 9_703 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(1)
+	e := types.WeightFromParts(0, 1517)
+	return types.WeightFromParts(9_703_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ SetKeyCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetKeyCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ SetKeyCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ SetKeyCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetKeyCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := setKey(origin, args[0].(types.MultiAddress))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok: types.PostDispatchInfo{
+			PaysFee: types.PaysNo,
+		},
+	}
+}
+
+// setKey authenticates the current sudo key and sets the given account as the new sudo key.
+func setKey(origin types.RuntimeOrigin, new types.MultiAddress) types.DispatchError {
+	err := ensureSudo(origin)
+	if err != nil {
+		return err
+	}
+
+	newKey, lookupErr := types.DefaultAccountIdLookup().Lookup(new)
+	if lookupErr != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	oldKey := sudo.StorageGetKey()
+	sudo.StorageSetKey(newKey)
+	system.DepositEvent(sudo.NewEventKeyChanged(oldKey, newKey))
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	cs "github.com/LimeChain/gosemble/constants/sudo"
+	ext "github.com/LimeChain/gosemble/execution/types"
+	"github.com/LimeChain/gosemble/frame/sudo"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SudoCall struct {
+	primitives.Callable
+}
+
+func NewSudoCall(args sc.VaryingData) SudoCall {
+	call := SudoCall{
+		Callable: primitives.Callable{
+			ModuleId:   cs.ModuleIndex,
+			FunctionId: cs.FunctionSudoIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SudoCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(ext.DecodeCall(buffer))
+	return c
+}
+
+func (c SudoCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SudoCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SudoCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SudoCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c SudoCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+// The weight of the dispatched call is added to the weight of `sudo`.
+func (_ SudoCall) BaseWeight(args ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `165`
+	//  Estimated: `1517`
+	// Minimum execution time: 10_308 nanoseconds.
+	call := args[0].(sc.VaryingData)[0].(types.Call)
+	r := constants.DbWeight.Reads(1)
+	e := types.WeightFromParts(0, 1517)
+	return types.WeightFromParts(10_308_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(types.GetDispatchInfo(call).Weight)
+}
+
+func (_ SudoCall) IsInherent() bool {
+	return false
+}
+
+func (_ SudoCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+// The dispatch class of the dispatched call.
+func (c SudoCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	if len(c.Arguments) == 0 {
+		return types.NewDispatchClassNormal()
+	}
+
+	return types.GetDispatchInfo(c.Arguments[0].(types.Call)).Class
+}
+
+func (_ SudoCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SudoCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	return sudoCall(origin, args[0].(types.Call))
+}
+
+// sudoCall authenticates the sudo key and dispatches a function call with `Root` origin.
+// The outcome of the call is deposited in a `Sudid` event. Sudo user does not pay a fee.
+func sudoCall(origin types.RuntimeOrigin, call types.Call) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := ensureSudo(origin)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	outcome := dispatchCall(types.NewRawOriginRoot(), call)
+	system.DepositEvent(sudo.NewEventSudid(outcome))
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok: types.PostDispatchInfo{
+			PaysFee: types.PaysNo,
+		},
+	}
+}
@@ -0,0 +1,135 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	cs "github.com/LimeChain/gosemble/constants/sudo"
+	ext "github.com/LimeChain/gosemble/execution/types"
+	"github.com/LimeChain/gosemble/frame/sudo"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SudoAsCall struct {
+	primitives.Callable
+}
+
+func NewSudoAsCall(args sc.VaryingData) SudoAsCall {
+	call := SudoAsCall{
+		Callable: primitives.Callable{
+			ModuleId:   cs.ModuleIndex,
+			FunctionId: cs.FunctionSudoAsIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SudoAsCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeMultiAddress(buffer),
+		ext.DecodeCall(buffer),
+	)
+	return c
+}
+
+func (c SudoAsCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SudoAsCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SudoAsCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SudoAsCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c SudoAsCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+// The weight of the dispatched call is added to the weight of `sudo_as`.
+func (_ SudoAsCall) BaseWeight(args ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `165`
+	//  Estimated: `1517`
+	// Minimum execution time: 10_273 nanoseconds.
+	call := args[0].(sc.VaryingData)[1].(types.Call)
+	r := constants.DbWeight.Reads(1)
+	e := types.WeightFromParts(0, 1517)
+	return types.WeightFromParts(10_273_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(types.GetDispatchInfo(call).Weight)
+}
+
+func (_ SudoAsCall) IsInherent() bool {
+	return false
+}
+
+func (_ SudoAsCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+// The dispatch class of the dispatched call.
+func (c SudoAsCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	if len(c.Arguments) == 0 {
+		return types.NewDispatchClassNormal()
+	}
+
+	return types.GetDispatchInfo(c.Arguments[1].(types.Call)).Class
+}
+
+func (_ SudoAsCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SudoAsCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := sudoAs(origin, args[0].(types.MultiAddress), args[1].(types.Call))
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok: types.PostDispatchInfo{
+			PaysFee: types.PaysNo,
+		},
+	}
+}
+
+// sudoAs authenticates the sudo key and dispatches a function call with `Signed` origin from the given account.
+// The outcome of the call is deposited in a `SudoAsDone` event.
+func sudoAs(origin types.RuntimeOrigin, who types.MultiAddress, call types.Call) types.DispatchError {
+	err := ensureSudo(origin)
+	if err != nil {
+		return err
+	}
+
+	whoAddress, lookupErr := types.DefaultAccountIdLookup().Lookup(who)
+	if lookupErr != nil {
+		return types.NewDispatchErrorCannotLookup()
+	}
+
+	outcome := dispatchCall(types.NewRawOriginSigned(whoAddress), call)
+	system.DepositEvent(sudo.NewEventSudoAsDone(outcome))
+
+	return nil
+}
@@ -0,0 +1,129 @@
+//go:build nonwasmenv
+
+package dispatchables
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	cs "github.com/LimeChain/gosemble/constants/sudo"
+	"github.com/LimeChain/gosemble/frame/sudo"
+	"github.com/LimeChain/gosemble/frame/sudo/errors"
+	"github.com/LimeChain/gosemble/frame/testutils"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	alice = testutils.NewAddress(1)
+	bob   = testutils.NewAddress(2)
+)
+
+func Test_Sudo(t *testing.T) {
+	requireSudo := testutils.NewModuleError(cs.ModuleIndex, errors.ErrorRequireSudo)
+
+	setKeyToBob := NewSetKeyCall(sc.NewVaryingData(types.NewMultiAddress32(bob)))
+	// remove_key does not accept a `Signed` origin, other than the sudo key, so it always fails in `sudo_as`.
+	removeKey := NewRemoveKeyCall(nil)
+
+	var testExamples = []struct {
+		label       string
+		call        types.Call
+		origin      types.RuntimeOrigin
+		args        sc.VaryingData
+		expectation types.DispatchError
+		key         sc.Option[types.Address32]
+	}{
+		{
+			label:       "sudo(BadOrigin)",
+			call:        NewSudoCall(nil),
+			origin:      types.NewRawOriginNone(),
+			args:        sc.NewVaryingData(setKeyToBob),
+			expectation: types.NewDispatchErrorBadOrigin(),
+			key:         sc.NewOption[types.Address32](alice),
+		},
+		{
+			label:       "sudo(RequireSudo)",
+			call:        NewSudoCall(nil),
+			origin:      types.NewRawOriginSigned(bob),
+			args:        sc.NewVaryingData(setKeyToBob),
+			expectation: requireSudo,
+			key:         sc.NewOption[types.Address32](alice),
+		},
+		{
+			label:  "sudo(Ok)",
+			call:   NewSudoCall(nil),
+			origin: types.NewRawOriginSigned(alice),
+			args:   sc.NewVaryingData(setKeyToBob),
+			key:    sc.NewOption[types.Address32](bob),
+		},
+		{
+			label:  "sudo_unchecked_weight(Ok)",
+			call:   NewSudoUncheckedWeightCall(nil),
+			origin: types.NewRawOriginSigned(alice),
+			args:   sc.NewVaryingData(setKeyToBob, types.WeightFromParts(1, 1)),
+			key:    sc.NewOption[types.Address32](bob),
+		},
+		{
+			label:  "sudo_as(failed call is rolled back)",
+			call:   NewSudoAsCall(nil),
+			origin: types.NewRawOriginSigned(alice),
+			args:   sc.NewVaryingData(types.NewMultiAddress32(bob), removeKey),
+			key:    sc.NewOption[types.Address32](alice),
+		},
+		{
+			label:       "set_key(RequireSudo)",
+			call:        NewSetKeyCall(nil),
+			origin:      types.NewRawOriginSigned(bob),
+			args:        sc.NewVaryingData(types.NewMultiAddress32(bob)),
+			expectation: requireSudo,
+			key:         sc.NewOption[types.Address32](alice),
+		},
+		{
+			label:  "set_key(Ok)",
+			call:   NewSetKeyCall(nil),
+			origin: types.NewRawOriginRoot(),
+			args:   sc.NewVaryingData(types.NewMultiAddress32(bob)),
+			key:    sc.NewOption[types.Address32](bob),
+		},
+		{
+			label:  "remove_key(Ok)",
+			call:   NewRemoveKeyCall(nil),
+			origin: types.NewRawOriginSigned(alice),
+			args:   sc.NewVaryingData(),
+			key:    sc.NewOption[types.Address32](nil),
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			host.Reset()
+			sudo.GenesisConfig{Key: sc.NewOption[types.Address32](alice)}.BuildGenesis()
+
+			result := testExample.call.Dispatch(testExample.origin, testExample.args)
+
+			if testExample.expectation != nil {
+				assert.True(t, bool(result.HasError))
+				assert.Equal(t, testExample.expectation, result.Err.Error)
+			} else {
+				assert.False(t, bool(result.HasError))
+				assert.Equal(t, types.PaysNo, result.Ok.PaysFee)
+			}
+			assert.Equal(t, testExample.key, sudo.StorageGetKey())
+		})
+	}
+}
+
+func Test_Sudo_DispatchInfo(t *testing.T) {
+	setKey := NewSetKeyCall(sc.NewVaryingData(types.NewMultiAddress32(bob)))
+	sudoCall := NewSudoCall(sc.NewVaryingData(setKey))
+	uncheckedCall := NewSudoUncheckedWeightCall(sc.NewVaryingData(setKey, types.WeightFromParts(7, 0)))
+
+	expectedRefTime := sc.U64(10_308_000) + constants.DbWeight.Reads(1).RefTime + types.GetDispatchInfo(setKey).Weight.RefTime
+
+	assert.Equal(t, types.WeightFromParts(expectedRefTime, 0), types.GetDispatchInfo(sudoCall).Weight)
+	assert.Equal(t, types.WeightFromParts(7, 0), types.GetDispatchInfo(uncheckedCall).Weight)
+	assert.Equal(t, types.NewDispatchClassNormal(), types.GetDispatchInfo(uncheckedCall).Class)
+}
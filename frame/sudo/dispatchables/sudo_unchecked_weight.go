@@ -0,0 +1,88 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	cs "github.com/LimeChain/gosemble/constants/sudo"
+	ext "github.com/LimeChain/gosemble/execution/types"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SudoUncheckedWeightCall struct {
+	primitives.Callable
+}
+
+func NewSudoUncheckedWeightCall(args sc.VaryingData) SudoUncheckedWeightCall {
+	call := SudoUncheckedWeightCall{
+		Callable: primitives.Callable{
+			ModuleId:   cs.ModuleIndex,
+			FunctionId: cs.FunctionSudoUncheckedWeightIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SudoUncheckedWeightCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		ext.DecodeCall(buffer),
+		types.DecodeWeight(buffer),
+	)
+	return c
+}
+
+func (c SudoUncheckedWeightCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SudoUncheckedWeightCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SudoUncheckedWeightCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SudoUncheckedWeightCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c SudoUncheckedWeightCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+// The weight is the one, given by the sudo key.
+func (_ SudoUncheckedWeightCall) BaseWeight(args ...any) types.Weight {
+	return args[0].(sc.VaryingData)[1].(types.Weight)
+}
+
+func (_ SudoUncheckedWeightCall) IsInherent() bool {
+	return false
+}
+
+func (_ SudoUncheckedWeightCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+// The dispatch class of the dispatched call.
+func (c SudoUncheckedWeightCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	if len(c.Arguments) == 0 {
+		return types.NewDispatchClassNormal()
+	}
+
+	return types.GetDispatchInfo(c.Arguments[0].(types.Call)).Class
+}
+
+func (_ SudoUncheckedWeightCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SudoUncheckedWeightCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	return sudoCall(origin, args[0].(types.Call))
+}
@@ -0,0 +1,8 @@
+package errors
+
+import sc "github.com/LimeChain/goscale"
+
+// Sudo module errors.
+const (
+	ErrorRequireSudo sc.U8 = iota
+)
@@ -0,0 +1,62 @@
+package sudo
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/sudo"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Sudo module events.
+const (
+	EventSudid sc.U8 = iota
+	EventKeyChanged
+	EventKeyRemoved
+	EventSudoAsDone
+)
+
+func NewEventSudid(sudoResult types.DispatchOutcome) types.Event {
+	return types.NewEvent(sudo.ModuleIndex, EventSudid, sudoResult)
+}
+
+func NewEventKeyChanged(oldKey sc.Option[types.Address32], newKey types.Address32) types.Event {
+	return types.NewEvent(sudo.ModuleIndex, EventKeyChanged, oldKey, newKey)
+}
+
+func NewEventKeyRemoved() types.Event {
+	return types.NewEvent(sudo.ModuleIndex, EventKeyRemoved)
+}
+
+func NewEventSudoAsDone(sudoResult types.DispatchOutcome) types.Event {
+	return types.NewEvent(sudo.ModuleIndex, EventSudoAsDone, sudoResult)
+}
+
+func DecodeEvent(buffer *bytes.Buffer) types.Event {
+	moduleIndex := sc.DecodeU8(buffer)
+	if moduleIndex != sudo.ModuleIndex {
+		log.Critical("invalid sudo.Event")
+	}
+
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case EventSudid:
+		sudoResult := types.DecodeDispatchOutcome(buffer)
+		return NewEventSudid(sudoResult)
+	case EventKeyChanged:
+		oldKey := sc.DecodeOptionWith(buffer, types.DecodeAddress32)
+		newKey := types.DecodeAddress32(buffer)
+		return NewEventKeyChanged(oldKey, newKey)
+	case EventKeyRemoved:
+		return NewEventKeyRemoved()
+	case EventSudoAsDone:
+		sudoResult := types.DecodeDispatchOutcome(buffer)
+		return NewEventSudoAsDone(sudoResult)
+	default:
+		log.Critical("invalid sudo.Event type")
+	}
+
+	panic("unreachable")
+}
@@ -0,0 +1,19 @@
+package sudo
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// GenesisConfig is the genesis configuration of the module.
+type GenesisConfig struct {
+	// Key is the `AccountId` of the initial sudo key. No key is set, if it has no value.
+	Key sc.Option[types.Address32]
+}
+
+// BuildGenesis sets the initial sudo key.
+func (gc GenesisConfig) BuildGenesis() {
+	if gc.Key.HasValue {
+		StorageSetKey(gc.Key.Value)
+	}
+}
@@ -0,0 +1,152 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	cs "github.com/LimeChain/gosemble/constants/sudo"
+	"github.com/LimeChain/gosemble/frame/sudo"
+	"github.com/LimeChain/gosemble/frame/sudo/dispatchables"
+	"github.com/LimeChain/gosemble/frame/sudo/errors"
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SudoModule struct {
+	primitives.DefaultHooks
+	functions map[sc.U8]primitives.Call
+}
+
+func NewSudoModule() SudoModule {
+	functions := make(map[sc.U8]primitives.Call)
+	functions[cs.FunctionSudoIndex] = dispatchables.NewSudoCall(nil)
+	functions[cs.FunctionSudoUncheckedWeightIndex] = dispatchables.NewSudoUncheckedWeightCall(nil)
+	functions[cs.FunctionSetKeyIndex] = dispatchables.NewSetKeyCall(nil)
+	functions[cs.FunctionSudoAsIndex] = dispatchables.NewSudoAsCall(nil)
+	functions[cs.FunctionRemoveKeyIndex] = dispatchables.NewRemoveKeyCall(nil)
+
+	return SudoModule{
+		functions: functions,
+	}
+}
+
+func (sm SudoModule) Functions() map[sc.U8]primitives.Call {
+	return sm.functions
+}
+
+func (sm SudoModule) PreDispatch(_ primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (sm SudoModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+func (sm SudoModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
+	return sm.declaration().Metadata()
+}
+
+// declaration declares the storage, calls, events and errors of the module,
+// from which its metadata is derived.
+func (sm SudoModule) declaration() support.ModuleDeclaration {
+	return support.ModuleDeclaration{
+		Name:    "Sudo",
+		Index:   cs.ModuleIndex,
+		Path:    "pallet_sudo",
+		Storage: sudo.StorageDeclarations(),
+		Calls: &support.EnumDeclaration{
+			TypeId:   metadata.SudoCalls,
+			Variants: callDeclarations,
+		},
+		Events: &support.EnumDeclaration{
+			TypeId:   metadata.TypesSudoEvent,
+			Variants: eventDeclarations,
+		},
+		Errors: &support.EnumDeclaration{
+			TypeId:   metadata.TypesSudoErrors,
+			Variants: errorDeclarations,
+		},
+	}
+}
+
+var callDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "sudo",
+		Index: cs.FunctionSudoIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.RuntimeCall, "call", "Box<<T as Config>::RuntimeCall>"),
+		},
+		Docs: "Authenticates the sudo key and dispatches a function call with `Root` origin.",
+	},
+	{
+		Name:  "sudo_unchecked_weight",
+		Index: cs.FunctionSudoUncheckedWeightIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.RuntimeCall, "call", "Box<<T as Config>::RuntimeCall>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesWeight, "weight", "Weight"),
+		},
+		Docs: "Authenticates the sudo key and dispatches a function call with `Root` origin. This function does not check the weight of the call, and instead allows the Sudo user to specify the weight of the call.",
+	},
+	{
+		Name:  "set_key",
+		Index: cs.FunctionSetKeyIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "new", "AccountIdLookupOf<T>"),
+		},
+		Docs: "Authenticates the current sudo key and sets the given AccountId (`new`) as the new sudo key.",
+	},
+	{
+		Name:  "sudo_as",
+		Index: cs.FunctionSudoAsIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesMultiAddress, "who", "AccountIdLookupOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.RuntimeCall, "call", "Box<<T as Config>::RuntimeCall>"),
+		},
+		Docs: "Authenticates the sudo key and dispatches a function call with `Signed` origin from a given account.",
+	},
+	{
+		Name:  "remove_key",
+		Index: cs.FunctionRemoveKeyIndex,
+		Docs:  "Permanently removes the sudo key.",
+	},
+}
+
+var eventDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "Sudid",
+		Index: sudo.EventSudid,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesResultEmptyTupleDispatchError, "sudo_result", "DispatchResult"),
+		},
+		Docs: "A sudo call just took place.",
+	},
+	{
+		Name:  "KeyChanged",
+		Index: sudo.EventKeyChanged,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionAddress32, "old", "Option<T::AccountId>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "new", "T::AccountId"),
+		},
+		Docs: "The sudo key has been updated.",
+	},
+	{
+		Name:  "KeyRemoved",
+		Index: sudo.EventKeyRemoved,
+		Docs:  "The key was permanently removed.",
+	},
+	{
+		Name:  "SudoAsDone",
+		Index: sudo.EventSudoAsDone,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesResultEmptyTupleDispatchError, "sudo_result", "DispatchResult"),
+		},
+		Docs: "A sudo call just took place.",
+	},
+}
+
+var errorDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "RequireSudo",
+		Index: errors.ErrorRequireSudo,
+		Docs:  "Sender must be the Sudo account.",
+	},
+}
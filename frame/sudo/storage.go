@@ -0,0 +1,34 @@
+package sudo
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+var storageKey = support.NewStorageValue[types.Address32](constants.KeySudo, constants.KeyKey, types.DecodeAddress32)
+
+// StorageDeclarations returns the declarations of the storage items of the module, in the order of the metadata.
+func StorageDeclarations() []support.StorageDeclaration {
+	return []support.StorageDeclaration{
+		{
+			Item:     storageKey,
+			Modifier: types.MetadataModuleStorageEntryModifierOptional,
+			Docs:     "The `AccountId` of the sudo key.",
+		},
+	}
+}
+
+// StorageGetKey returns the sudo key, if there is one.
+func StorageGetKey() sc.Option[types.Address32] {
+	return storageKey.GetOption()
+}
+
+func StorageSetKey(key types.Address32) {
+	storageKey.Put(key)
+}
+
+func StorageClearKey() {
+	storageKey.Clear()
+}
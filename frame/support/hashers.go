@@ -0,0 +1,74 @@
+package support
+
+import (
+	"bytes"
+
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageHasher is a hasher used to derive the keys of storage maps.
+// All provided hashers are concatenating ones (the encoded key is appended
+// to its hash), so the key can be decoded back from the storage key.
+type StorageHasher interface {
+	// Hash returns the hashed key, used as part of the final storage key.
+	Hash(key []byte) []byte
+	// HashLength returns the length of the hash, prepended to the encoded key.
+	HashLength() int
+	// Metadata returns the hasher as described in the module metadata.
+	Metadata() types.MetadataModuleStorageHashFunc
+}
+
+// Blake2_128Concat hashes the key with Blake2 128 and appends the encoded key.
+// It is the recommended hasher for keys that can be controlled by users.
+type Blake2_128Concat struct{}
+
+func (_ Blake2_128Concat) Hash(key []byte) []byte {
+	return append(hashing.Blake128(key), key...)
+}
+
+func (_ Blake2_128Concat) HashLength() int {
+	return 16
+}
+
+func (_ Blake2_128Concat) Metadata() types.MetadataModuleStorageHashFunc {
+	return types.MetadataModuleStorageHashFuncMultiBlake128Concat
+}
+
+// Twox64Concat hashes the key with XX 64 and appends the encoded key.
+// It should only be used for keys that cannot be controlled by users.
+type Twox64Concat struct{}
+
+func (_ Twox64Concat) Hash(key []byte) []byte {
+	return append(hashing.Twox64(key), key...)
+}
+
+func (_ Twox64Concat) HashLength() int {
+	return 8
+}
+
+func (_ Twox64Concat) Metadata() types.MetadataModuleStorageHashFunc {
+	return types.MetadataModuleStorageHashFuncMultiXX64
+}
+
+// Identity uses the encoded key as is.
+// It should only be used for keys that are already secure hashes.
+type Identity struct{}
+
+func (_ Identity) Hash(key []byte) []byte {
+	return key
+}
+
+func (_ Identity) HashLength() int {
+	return 0
+}
+
+func (_ Identity) Metadata() types.MetadataModuleStorageHashFunc {
+	return types.MetadataModuleStorageHashFuncIdentity
+}
+
+// decodeHashedKey skips the hash in front of the encoded key and decodes the key.
+func decodeHashedKey[K any](buffer *bytes.Buffer, hasher StorageHasher, decodeFunc func(buffer *bytes.Buffer) K) K {
+	buffer.Next(hasher.HashLength())
+	return decodeFunc(buffer)
+}
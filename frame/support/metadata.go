@@ -0,0 +1,203 @@
+package support
+
+import (
+	"reflect"
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageItem is a storage value or map, which knows its own name, key hashers and Go types.
+type StorageItem interface {
+	Name() string
+	Hashers() []StorageHasher
+	KeyTypes() []reflect.Type
+	ValueType() reflect.Type
+}
+
+// StorageDeclaration declares a storage item of a module. The name, the hashers and the type ids
+// are taken from the item itself, so the metadata cannot drift from the actual storage layout.
+// The type ids are looked up by the Go types of the item in the registry of RegisterTypeId.
+// Maps with multiple keys use the id of the tuple of their keys.
+type StorageDeclaration struct {
+	Item     StorageItem
+	Modifier types.MetadataModuleStorageEntryModifier
+	// KeyType and ValueType set the type ids explicitly, when the item uses an alias,
+	// whose metadata type differs from the one of the Go type it stands for.
+	KeyType   *int
+	ValueType *int
+	Docs      string
+}
+
+// VariantDeclaration declares a single call, event or error of a module.
+type VariantDeclaration struct {
+	Name   string
+	Index  sc.U8
+	Fields sc.Sequence[types.MetadataTypeDefinitionField]
+	Docs   string
+}
+
+// EnumDeclaration declares the calls, events or errors of a module and the type id of their enum.
+// The enums have no Go type of their own, so their type id is declared only here.
+type EnumDeclaration struct {
+	TypeId   int
+	Variants []VariantDeclaration
+}
+
+// ConstantDeclaration declares a constant of a module. Its type id is looked up by the Go type of its value.
+type ConstantDeclaration struct {
+	Name  string
+	Value sc.Encodable
+	Docs  string
+}
+
+// ModuleDeclaration declares the storage items, calls, events, errors and constants of a module.
+// The module metadata and the types of its call, event and error enums are derived from it.
+type ModuleDeclaration struct {
+	Name  string
+	Index sc.U8
+	// Path is the crate of the module, used in the paths of its call, event and error types.
+	Path      string
+	Storage   []StorageDeclaration
+	Calls     *EnumDeclaration
+	Events    *EnumDeclaration
+	Errors    *EnumDeclaration
+	Constants []ConstantDeclaration
+}
+
+// Metadata returns the call, event and error types of the module and its metadata.
+func (md ModuleDeclaration) Metadata() (sc.Sequence[types.MetadataType], types.MetadataModule) {
+	metadataTypes := sc.Sequence[types.MetadataType]{}
+
+	metadataModule := types.MetadataModule{
+		Name:      sc.Str(md.Name),
+		Storage:   md.storageMetadata(),
+		Call:      sc.NewOption[sc.Compact](nil),
+		Event:     sc.NewOption[sc.Compact](nil),
+		Constants: md.constantsMetadata(),
+		Error:     sc.NewOption[sc.Compact](nil),
+		Index:     md.Index,
+	}
+
+	if md.Calls != nil {
+		metadataTypes = append(metadataTypes,
+			types.NewMetadataTypeWithParam(md.Calls.TypeId,
+				md.Name+" calls",
+				sc.Sequence[sc.Str]{sc.Str(md.Path), "pallet", "Call"},
+				md.Calls.definition(),
+				types.NewMetadataEmptyTypeParameter("T")))
+		metadataModule.Call = sc.NewOption[sc.Compact](sc.ToCompact(md.Calls.TypeId))
+	}
+
+	if md.Events != nil {
+		metadataTypes = append(metadataTypes,
+			types.NewMetadataTypeWithPath(md.Events.TypeId,
+				md.Path+" pallet Event",
+				sc.Sequence[sc.Str]{sc.Str(md.Path), "pallet", "Event"},
+				md.Events.definition()))
+		metadataModule.Event = sc.NewOption[sc.Compact](sc.ToCompact(md.Events.TypeId))
+	}
+
+	if md.Errors != nil {
+		metadataTypes = append(metadataTypes,
+			types.NewMetadataTypeWithPath(md.Errors.TypeId,
+				md.Path+" pallet Error",
+				sc.Sequence[sc.Str]{sc.Str(md.Path), "pallet", "Error"},
+				md.Errors.definition()))
+		metadataModule.Error = sc.NewOption[sc.Compact](sc.ToCompact(md.Errors.TypeId))
+	}
+
+	return metadataTypes, metadataModule
+}
+
+func (md ModuleDeclaration) storageMetadata() sc.Option[types.MetadataModuleStorage] {
+	if len(md.Storage) == 0 {
+		return sc.NewOption[types.MetadataModuleStorage](nil)
+	}
+
+	items := sc.Sequence[types.MetadataModuleStorageEntry]{}
+	for _, declaration := range md.Storage {
+		items = append(items, declaration.entry())
+	}
+
+	return sc.NewOption[types.MetadataModuleStorage](types.MetadataModuleStorage{
+		Prefix: sc.Str(md.Name),
+		Items:  items,
+	})
+}
+
+func (md ModuleDeclaration) constantsMetadata() sc.Sequence[types.MetadataModuleConstant] {
+	constants := sc.Sequence[types.MetadataModuleConstant]{}
+	for _, declaration := range md.Constants {
+		constants = append(constants,
+			types.NewMetadataModuleConstant(
+				declaration.Name,
+				sc.ToCompact(typeIdOf(reflect.TypeOf(declaration.Value))),
+				sc.BytesToSequenceU8(declaration.Value.Bytes()),
+				declaration.Docs,
+			))
+	}
+
+	return constants
+}
+
+func (sd StorageDeclaration) entry() types.MetadataModuleStorageEntry {
+	valueType := typeIdOrDefault(sd.ValueType, sd.Item.ValueType())
+
+	hashers := sd.Item.Hashers()
+	if len(hashers) == 0 {
+		return types.NewMetadataModuleStorageEntry(
+			sd.Item.Name(),
+			sd.Modifier,
+			types.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(valueType)),
+			sd.Docs)
+	}
+
+	hashFuncs := sc.Sequence[types.MetadataModuleStorageHashFunc]{}
+	for _, hasher := range hashers {
+		hashFuncs = append(hashFuncs, hasher.Metadata())
+	}
+
+	return types.NewMetadataModuleStorageEntry(
+		sd.Item.Name(),
+		sd.Modifier,
+		types.NewMetadataModuleStorageEntryDefinitionMap(hashFuncs, sc.ToCompact(typeIdOrDefault(sd.KeyType, sd.Item.KeyTypes()...)), sc.ToCompact(valueType)),
+		sd.Docs)
+}
+
+// typeIdOrDefault returns the explicit type id, if set, or the registered type id of the Go types.
+func typeIdOrDefault(explicit *int, goTypes ...reflect.Type) int {
+	if explicit != nil {
+		return *explicit
+	}
+
+	return typeIdOf(goTypes...)
+}
+
+// definition returns the variants ordered by their index, which must be unique.
+func (ed EnumDeclaration) definition() types.MetadataTypeDefinition {
+	declarations := make([]VariantDeclaration, len(ed.Variants))
+	copy(declarations, ed.Variants)
+
+	sort.Slice(declarations, func(i, j int) bool {
+		return declarations[i].Index < declarations[j].Index
+	})
+
+	variants := sc.Sequence[types.MetadataDefinitionVariant]{}
+	for i, declaration := range declarations {
+		if i > 0 && declarations[i-1].Index == declaration.Index {
+			log.Critical("duplicate variant index in module declaration")
+		}
+
+		fields := declaration.Fields
+		if fields == nil {
+			fields = sc.Sequence[types.MetadataTypeDefinitionField]{}
+		}
+
+		variants = append(variants, types.NewMetadataDefinitionVariant(declaration.Name, fields, declaration.Index, declaration.Docs))
+	}
+
+	return types.NewMetadataTypeDefinitionVariant(variants)
+}
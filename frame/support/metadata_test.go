@@ -0,0 +1,84 @@
+//go:build nonwasmenv
+
+package support
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ModuleDeclaration_Metadata(t *testing.T) {
+	value := NewStorageValue[sc.U32](prefix, []byte("Value"), sc.DecodeU32)
+	doubleMap := NewStorageDoubleMap[sc.U32, sc.U32, sc.U64](prefix, []byte("DoubleMap"), Twox64Concat{}, Blake2_128Concat{}, sc.DecodeU32, sc.DecodeU32, sc.DecodeU64)
+	alias := NewStorageValue[sc.U8](prefix, []byte("Alias"), sc.DecodeU8)
+
+	declaration := ModuleDeclaration{
+		Name:  "Test",
+		Index: 7,
+		Path:  "pallet_test",
+		Storage: []StorageDeclaration{
+			{Item: value, Modifier: types.MetadataModuleStorageEntryModifierOptional, Docs: "value"},
+			{Item: doubleMap, Modifier: types.MetadataModuleStorageEntryModifierDefault, Docs: "double map"},
+			{Item: alias, Modifier: types.MetadataModuleStorageEntryModifierDefault, ValueType: TypeId(metadata.TypesTransactionPaymentReleases), Docs: "alias"},
+		},
+		Constants: []ConstantDeclaration{
+			{Name: "Constant", Value: sc.U128{}, Docs: "constant"},
+		},
+		Calls: &EnumDeclaration{
+			TypeId: metadata.SystemCalls,
+			Variants: []VariantDeclaration{
+				{Name: "second", Index: 1, Docs: "second"},
+				{Name: "first", Index: 0, Docs: "first"},
+			},
+		},
+	}
+
+	metadataTypes, metadataModule := declaration.Metadata()
+
+	expectedStorage := sc.NewOption[types.MetadataModuleStorage](types.MetadataModuleStorage{
+		Prefix: "Test",
+		Items: sc.Sequence[types.MetadataModuleStorageEntry]{
+			types.NewMetadataModuleStorageEntry("Value", types.MetadataModuleStorageEntryModifierOptional,
+				types.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU32)), "value"),
+			types.NewMetadataModuleStorageEntry("DoubleMap", types.MetadataModuleStorageEntryModifierDefault,
+				types.NewMetadataModuleStorageEntryDefinitionMap(
+					sc.Sequence[types.MetadataModuleStorageHashFunc]{types.MetadataModuleStorageHashFuncMultiXX64, types.MetadataModuleStorageHashFuncMultiBlake128Concat},
+					sc.ToCompact(metadata.TypesTupleU32U32),
+					sc.ToCompact(metadata.PrimitiveTypesU64)), "double map"),
+			types.NewMetadataModuleStorageEntry("Alias", types.MetadataModuleStorageEntryModifierDefault,
+				types.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesTransactionPaymentReleases)), "alias"),
+		},
+	})
+	expectedCalls := types.NewMetadataTypeWithParam(metadata.SystemCalls, "Test calls", sc.Sequence[sc.Str]{"pallet_test", "pallet", "Call"},
+		types.NewMetadataTypeDefinitionVariant(sc.Sequence[types.MetadataDefinitionVariant]{
+			types.NewMetadataDefinitionVariant("first", sc.Sequence[types.MetadataTypeDefinitionField]{}, 0, "first"),
+			types.NewMetadataDefinitionVariant("second", sc.Sequence[types.MetadataTypeDefinitionField]{}, 1, "second"),
+		}),
+		types.NewMetadataEmptyTypeParameter("T"))
+
+	assert.Equal(t, expectedStorage.Bytes(), metadataModule.Storage.Bytes())
+	assert.Equal(t, sc.NewOption[sc.Compact](sc.ToCompact(metadata.SystemCalls)), metadataModule.Call)
+	assert.False(t, bool(metadataModule.Event.HasValue))
+	assert.False(t, bool(metadataModule.Error.HasValue))
+	assert.Equal(t, sc.U8(7), metadataModule.Index)
+	assert.Equal(t, sc.ToCompact(metadata.PrimitiveTypesU128), metadataModule.Constants[0].Type)
+	assert.Equal(t, sc.Sequence[types.MetadataType]{expectedCalls}.Bytes(), metadataTypes.Bytes())
+}
+
+func Test_RegisterTypeId_Conflict(t *testing.T) {
+	assert.PanicsWithValue(t, "metadata type id is already registered for (github.com/LimeChain/goscale.U8)", func() {
+		RegisterTypeId(metadata.TypesTransactionPaymentReleases, TypeOf[sc.U8]())
+	})
+}
+
+func Test_StorageDeclaration_NotRegistered(t *testing.T) {
+	declaration := StorageDeclaration{Item: NewStorageValue[sc.I8](prefix, []byte("Value"), sc.DecodeI8)}
+
+	assert.PanicsWithValue(t, "metadata type id is not registered for (github.com/LimeChain/goscale.I8)", func() {
+		declaration.entry()
+	})
+}
@@ -0,0 +1,88 @@
+package support
+
+import (
+	"reflect"
+	"strings"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// typeIds maps the Go types, and the tuples of Go types, of the storage items and constants to their metadata type ids.
+var typeIds = map[string]int{}
+
+func init() {
+	RegisterTypeId(metadata.PrimitiveTypesBool, TypeOf[sc.Bool]())
+	RegisterTypeId(metadata.PrimitiveTypesU8, TypeOf[sc.U8]())
+	RegisterTypeId(metadata.PrimitiveTypesU32, TypeOf[sc.U32]())
+	RegisterTypeId(metadata.PrimitiveTypesU64, TypeOf[sc.U64]())
+	RegisterTypeId(metadata.PrimitiveTypesU128, TypeOf[sc.U128]())
+	RegisterTypeId(metadata.TypesSequenceU8, TypeOf[sc.Sequence[sc.U8]]())
+	RegisterTypeId(metadata.TypesSequenceU32, TypeOf[sc.Sequence[sc.U32]]())
+	RegisterTypeId(metadata.TypesTupleU32U32, TypeOf[sc.U32](), TypeOf[sc.U32]())
+
+	RegisterTypeId(metadata.TypesAddress32, TypeOf[types.Address32]())
+	RegisterTypeId(metadata.TypesSequenceAddress32, TypeOf[sc.Sequence[types.Address32]]())
+	RegisterTypeId(metadata.TypesH256, TypeOf[types.H256]())
+	RegisterTypeId(metadata.TypesFixedSequence32U8, TypeOf[types.Blake2bHash]())
+	RegisterTypeId(metadata.TypesFixedU128, TypeOf[types.FixedU128]())
+	RegisterTypeId(metadata.TypesAccountInfo, TypeOf[types.AccountInfo]())
+	RegisterTypeId(metadata.TypesAccountData, TypeOf[types.AccountData]())
+	RegisterTypeId(metadata.TypesSequenceBalanceLock, TypeOf[sc.Sequence[types.BalanceLock]]())
+	RegisterTypeId(metadata.TypesSequenceReserveData, TypeOf[sc.Sequence[types.ReserveData]]())
+	RegisterTypeId(metadata.TypesPerDispatchClassWeight, TypeOf[types.ConsumedWeight]())
+	RegisterTypeId(metadata.TypesDigest, TypeOf[types.Digest]())
+	RegisterTypeId(metadata.TypesSystemEventStorage, TypeOf[sc.Sequence[types.EventRecord]]())
+	RegisterTypeId(metadata.TypesLastRuntimeUpgradeInfo, TypeOf[types.LastRuntimeUpgradeInfo]())
+	RegisterTypeId(metadata.TypesDbWeight, TypeOf[types.RuntimeDbWeight]())
+	RegisterTypeId(metadata.TypesRuntimeVersion, TypeOf[types.RuntimeVersion]())
+}
+
+// TypeOf returns the Go type of T.
+func TypeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// RegisterTypeId registers the metadata type id of a Go type, or of the tuple of several Go types,
+// which is used for the storage items and constants of that type.
+// An alias shares the Go type it stands for, so it cannot be registered with an id of its own.
+// The storage declarations of such aliases set the type id explicitly instead.
+func RegisterTypeId(id int, goTypes ...reflect.Type) {
+	key := typeKey(goTypes)
+	if registered, ok := typeIds[key]; ok && registered != id {
+		log.Critical("metadata type id is already registered for " + key)
+	}
+
+	typeIds[key] = id
+}
+
+// TypeId returns an explicit metadata type id of a storage declaration.
+func TypeId(id int) *int {
+	return &id
+}
+
+// typeIdOf returns the metadata type id of a Go type, or of the tuple of several Go types.
+func typeIdOf(goTypes ...reflect.Type) int {
+	key := typeKey(goTypes)
+	id, ok := typeIds[key]
+	if !ok {
+		log.Critical("metadata type id is not registered for " + key)
+	}
+
+	return id
+}
+
+func typeKey(goTypes []reflect.Type) string {
+	names := make([]string, len(goTypes))
+	for i, t := range goTypes {
+		if t.Name() == "" {
+			names[i] = t.String()
+		} else {
+			names[i] = t.PkgPath() + "." + t.Name()
+		}
+	}
+
+	return "(" + strings.Join(names, ", ") + ")"
+}
@@ -0,0 +1,158 @@
+package support
+
+import (
+	"bytes"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageDoubleMap is a storage map with two keys, where each value is stored under
+// `Twox128(prefix) ++ Twox128(name) ++ hasher1(encode(key1)) ++ hasher2(encode(key2))`.
+type StorageDoubleMap[K1, K2, V sc.Encodable] struct {
+	prefix         []byte
+	name           []byte
+	hasher1        StorageHasher
+	hasher2        StorageHasher
+	decodeKey1Func func(buffer *bytes.Buffer) K1
+	decodeKey2Func func(buffer *bytes.Buffer) K2
+	decodeFunc     func(buffer *bytes.Buffer) V
+}
+
+func NewStorageDoubleMap[K1, K2, V sc.Encodable](prefix []byte, name []byte, hasher1 StorageHasher, hasher2 StorageHasher, decodeKey1Func func(buffer *bytes.Buffer) K1, decodeKey2Func func(buffer *bytes.Buffer) K2, decodeFunc func(buffer *bytes.Buffer) V) *StorageDoubleMap[K1, K2, V] {
+	return &StorageDoubleMap[K1, K2, V]{
+		prefix,
+		name,
+		hasher1,
+		hasher2,
+		decodeKey1Func,
+		decodeKey2Func,
+		decodeFunc,
+	}
+}
+
+// Get returns the value stored under the keys, or the default value of V if there is none.
+func (sdm StorageDoubleMap[K1, K2, V]) Get(k1 K1, k2 K2) V {
+	return storage.GetDecode(sdm.Key(k1, k2), sdm.decodeFunc)
+}
+
+// GetOption returns the value stored under the keys, or `None` if there is none.
+func (sdm StorageDoubleMap[K1, K2, V]) GetOption(k1 K1, k2 K2) sc.Option[V] {
+	option := storage.Get(sdm.Key(k1, k2))
+	if !option.HasValue {
+		return sc.NewOption[V](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+	return sc.NewOption[V](sdm.decodeFunc(buffer))
+}
+
+func (sdm StorageDoubleMap[K1, K2, V]) Contains(k1 K1, k2 K2) bool {
+	return storage.Exists(sdm.Key(k1, k2)) != 0
+}
+
+func (sdm StorageDoubleMap[K1, K2, V]) Put(k1 K1, k2 K2, value V) {
+	storage.Set(sdm.Key(k1, k2), value.Bytes())
+}
+
+func (sdm StorageDoubleMap[K1, K2, V]) Remove(k1 K1, k2 K2) {
+	storage.Clear(sdm.Key(k1, k2))
+}
+
+// Take removes the value stored under the keys and returns it,
+// or the default value of V if there is none.
+func (sdm StorageDoubleMap[K1, K2, V]) Take(k1 K1, k2 K2) V {
+	return storage.TakeDecode(sdm.Key(k1, k2), sdm.decodeFunc)
+}
+
+// Mutate mutates the value stored under the keys and stores the result.
+func (sdm StorageDoubleMap[K1, K2, V]) Mutate(k1 K1, k2 K2, f func(value *V)) {
+	value := sdm.Get(k1, k2)
+	f(&value)
+	sdm.Put(k1, k2, value)
+}
+
+// TryMutate mutates the value stored under the keys. The result is stored only
+// if the function does not return an error.
+func (sdm StorageDoubleMap[K1, K2, V]) TryMutate(k1 K1, k2 K2, f func(value *V) sc.Result[sc.Encodable]) sc.Result[sc.Encodable] {
+	value := sdm.Get(k1, k2)
+
+	result := f(&value)
+	if !result.HasError {
+		sdm.Put(k1, k2, value)
+	}
+
+	return result
+}
+
+// Iterate calls f with each entry in the map, in the order of their
+// storage keys, until f returns false.
+func (sdm StorageDoubleMap[K1, K2, V]) Iterate(f func(k1 K1, k2 K2, value V) bool) {
+	storage.IteratePairs(sdm.Prefix(), func(key []byte, value []byte) bool {
+		k1, k2 := sdm.DecodeKey(key)
+		return f(k1, k2, sdm.decodeFunc(bytes.NewBuffer(value)))
+	})
+}
+
+// IteratePrefix calls f with each entry under the first key, in the order of
+// their storage keys, until f returns false.
+func (sdm StorageDoubleMap[K1, K2, V]) IteratePrefix(k1 K1, f func(k2 K2, value V) bool) {
+	storage.IteratePairs(sdm.PrefixKey1(k1), func(key []byte, value []byte) bool {
+		_, k2 := sdm.DecodeKey(key)
+		return f(k2, sdm.decodeFunc(bytes.NewBuffer(value)))
+	})
+}
+
+// Clear removes up to `limit` values from the map, or all of them if the limit is `None`.
+// The cursor of the returned results must be passed to the next call to continue the removal.
+func (sdm StorageDoubleMap[K1, K2, V]) Clear(limit sc.Option[sc.U32], cursor sc.Option[sc.Sequence[sc.U8]]) types.MultiRemovalResults {
+	return storage.ClearPrefix(sdm.Prefix(), limit, cursor)
+}
+
+// ClearPrefix removes up to `limit` values under the first key, or all of them if the limit is `None`.
+func (sdm StorageDoubleMap[K1, K2, V]) ClearPrefix(k1 K1, limit sc.Option[sc.U32], cursor sc.Option[sc.Sequence[sc.U8]]) types.MultiRemovalResults {
+	return storage.ClearPrefix(sdm.PrefixKey1(k1), limit, cursor)
+}
+
+func (sdm StorageDoubleMap[K1, K2, V]) Name() string {
+	return string(sdm.name)
+}
+
+func (sdm StorageDoubleMap[K1, K2, V]) Hashers() []StorageHasher {
+	return []StorageHasher{sdm.hasher1, sdm.hasher2}
+}
+
+func (sdm StorageDoubleMap[K1, K2, V]) KeyTypes() []reflect.Type {
+	return []reflect.Type{TypeOf[K1](), TypeOf[K2]()}
+}
+
+func (sdm StorageDoubleMap[K1, K2, V]) ValueType() reflect.Type {
+	return TypeOf[V]()
+}
+
+// Prefix returns the prefix of all storage keys in the map.
+func (sdm StorageDoubleMap[K1, K2, V]) Prefix() []byte {
+	return storagePrefix(sdm.prefix, sdm.name)
+}
+
+// PrefixKey1 returns the prefix of all storage keys under the first key.
+func (sdm StorageDoubleMap[K1, K2, V]) PrefixKey1(k1 K1) []byte {
+	return append(sdm.Prefix(), sdm.hasher1.Hash(k1.Bytes())...)
+}
+
+// Key returns the storage key of the value stored under k1 and k2.
+func (sdm StorageDoubleMap[K1, K2, V]) Key(k1 K1, k2 K2) []byte {
+	return append(sdm.PrefixKey1(k1), sdm.hasher2.Hash(k2.Bytes())...)
+}
+
+// DecodeKey decodes both keys from the full storage key.
+func (sdm StorageDoubleMap[K1, K2, V]) DecodeKey(key []byte) (K1, K2) {
+	buffer := bytes.NewBuffer(key[len(sdm.Prefix()):])
+
+	k1 := decodeHashedKey(buffer, sdm.hasher1, sdm.decodeKey1Func)
+	k2 := decodeHashedKey(buffer, sdm.hasher2, sdm.decodeKey2Func)
+
+	return k1, k2
+}
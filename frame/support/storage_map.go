@@ -0,0 +1,154 @@
+package support
+
+import (
+	"bytes"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageMap is a storage map, where each value is stored under
+// `Twox128(prefix) ++ Twox128(name) ++ hasher(encode(key))`.
+type StorageMap[K, V sc.Encodable] struct {
+	prefix        []byte
+	name          []byte
+	hasher        StorageHasher
+	decodeKeyFunc func(buffer *bytes.Buffer) K
+	decodeFunc    func(buffer *bytes.Buffer) V
+}
+
+func NewStorageMap[K, V sc.Encodable](prefix []byte, name []byte, hasher StorageHasher, decodeKeyFunc func(buffer *bytes.Buffer) K, decodeFunc func(buffer *bytes.Buffer) V) *StorageMap[K, V] {
+	return &StorageMap[K, V]{
+		prefix,
+		name,
+		hasher,
+		decodeKeyFunc,
+		decodeFunc,
+	}
+}
+
+// Get returns the value stored under the key, or the default value of V if there is none.
+func (sm StorageMap[K, V]) Get(k K) V {
+	return storage.GetDecode(sm.Key(k), sm.decodeFunc)
+}
+
+// GetOption returns the value stored under the key, or `None` if there is none.
+func (sm StorageMap[K, V]) GetOption(k K) sc.Option[V] {
+	option := storage.Get(sm.Key(k))
+	if !option.HasValue {
+		return sc.NewOption[V](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+	return sc.NewOption[V](sm.decodeFunc(buffer))
+}
+
+func (sm StorageMap[K, V]) Contains(k K) bool {
+	return storage.Exists(sm.Key(k)) != 0
+}
+
+func (sm StorageMap[K, V]) Put(k K, value V) {
+	storage.Set(sm.Key(k), value.Bytes())
+}
+
+func (sm StorageMap[K, V]) Remove(k K) {
+	storage.Clear(sm.Key(k))
+}
+
+// Append appends the encoded item to the SCALE encoded sequence stored under the key,
+// without decoding the whole sequence.
+func (sm StorageMap[K, V]) Append(k K, item sc.Encodable) {
+	storage.Append(sm.Key(k), item.Bytes())
+}
+
+// Take removes the value stored under the key and returns it,
+// or the default value of V if there is none.
+func (sm StorageMap[K, V]) Take(k K) V {
+	return storage.TakeDecode(sm.Key(k), sm.decodeFunc)
+}
+
+// Mutate mutates the value stored under the key and stores the result.
+func (sm StorageMap[K, V]) Mutate(k K, f func(value *V)) {
+	value := sm.Get(k)
+	f(&value)
+	sm.Put(k, value)
+}
+
+// TryMutate mutates the value stored under the key. The result is stored only
+// if the function does not return an error.
+func (sm StorageMap[K, V]) TryMutate(k K, f func(value *V) sc.Result[sc.Encodable]) sc.Result[sc.Encodable] {
+	value := sm.Get(k)
+
+	result := f(&value)
+	if !result.HasError {
+		sm.Put(k, value)
+	}
+
+	return result
+}
+
+// Iterate calls f with each key/value pair in the map, in the order of
+// their storage keys, until f returns false.
+func (sm StorageMap[K, V]) Iterate(f func(k K, value V) bool) {
+	storage.IteratePairs(sm.Prefix(), func(key []byte, value []byte) bool {
+		return f(sm.DecodeKey(key), sm.decodeFunc(bytes.NewBuffer(value)))
+	})
+}
+
+// Keys returns all keys in the map, in the order of their storage keys.
+func (sm StorageMap[K, V]) Keys() []K {
+	var keys []K
+	sm.Iterate(func(k K, _ V) bool {
+		keys = append(keys, k)
+		return true
+	})
+	return keys
+}
+
+// Clear removes up to `limit` values from the map, or all of them if the limit is `None`.
+// The cursor of the returned results must be passed to the next call to continue the removal.
+func (sm StorageMap[K, V]) Clear(limit sc.Option[sc.U32], cursor sc.Option[sc.Sequence[sc.U8]]) types.MultiRemovalResults {
+	return storage.ClearPrefix(sm.Prefix(), limit, cursor)
+}
+
+func (sm StorageMap[K, V]) Name() string {
+	return string(sm.name)
+}
+
+func (sm StorageMap[K, V]) Hashers() []StorageHasher {
+	return []StorageHasher{sm.hasher}
+}
+
+func (sm StorageMap[K, V]) KeyTypes() []reflect.Type {
+	return []reflect.Type{TypeOf[K]()}
+}
+
+func (sm StorageMap[K, V]) ValueType() reflect.Type {
+	return TypeOf[V]()
+}
+
+// Prefix returns the prefix of all storage keys in the map.
+func (sm StorageMap[K, V]) Prefix() []byte {
+	return storagePrefix(sm.prefix, sm.name)
+}
+
+// Key returns the storage key of the value stored under k.
+func (sm StorageMap[K, V]) Key(k K) []byte {
+	return append(sm.Prefix(), sm.hasher.Hash(k.Bytes())...)
+}
+
+// DecodeKey decodes the key from the full storage key.
+func (sm StorageMap[K, V]) DecodeKey(key []byte) K {
+	buffer := bytes.NewBuffer(key[len(sm.Prefix()):])
+	return decodeHashedKey(buffer, sm.hasher, sm.decodeKeyFunc)
+}
+
+func storagePrefix(prefix []byte, name []byte) []byte {
+	prefixHash := hashing.Twox128(prefix)
+	nameHash := hashing.Twox128(name)
+
+	return append(prefixHash, nameHash...)
+}
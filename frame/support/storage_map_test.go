@@ -0,0 +1,156 @@
+//go:build nonwasmenv
+
+package support
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	prefix = []byte("Test")
+	name   = []byte("Map")
+)
+
+func Test_StorageMap_Key(t *testing.T) {
+	key := sc.U32(7)
+	expectedPrefix := append(hashing.Twox128(prefix), hashing.Twox128(name)...)
+
+	var testExamples = []struct {
+		label       string
+		hasher      StorageHasher
+		expectation []byte
+	}{
+		{
+			label:       "Blake2_128Concat",
+			hasher:      Blake2_128Concat{},
+			expectation: append(append(expectedPrefix, hashing.Blake128(key.Bytes())...), key.Bytes()...),
+		},
+		{
+			label:       "Twox64Concat",
+			hasher:      Twox64Concat{},
+			expectation: append(append(expectedPrefix, hashing.Twox64(key.Bytes())...), key.Bytes()...),
+		},
+		{
+			label:       "Identity",
+			hasher:      Identity{},
+			expectation: append(expectedPrefix, key.Bytes()...),
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			storageMap := NewStorageMap[sc.U32, sc.U64](prefix, name, testExample.hasher, sc.DecodeU32, sc.DecodeU64)
+
+			assert.Equal(t, testExample.expectation, storageMap.Key(key))
+			assert.Equal(t, key, storageMap.DecodeKey(storageMap.Key(key)))
+		})
+	}
+}
+
+func Test_StorageMap_Operations(t *testing.T) {
+	host.Reset()
+
+	storageMap := NewStorageMap[sc.U32, sc.U64](prefix, name, Twox64Concat{}, sc.DecodeU32, sc.DecodeU64)
+
+	assert.False(t, storageMap.Contains(1))
+	assert.False(t, bool(storageMap.GetOption(1).HasValue))
+	assert.Equal(t, sc.U64(0), storageMap.Get(1))
+
+	storageMap.Put(1, 10)
+	storageMap.Mutate(1, func(value *sc.U64) { *value += 5 })
+	assert.True(t, storageMap.Contains(1))
+	assert.Equal(t, sc.NewOption[sc.U64](sc.U64(15)), storageMap.GetOption(1))
+
+	result := storageMap.TryMutate(1, func(value *sc.U64) sc.Result[sc.Encodable] {
+		*value = 100
+		return sc.Result[sc.Encodable]{HasError: true}
+	})
+	assert.True(t, bool(result.HasError))
+	assert.Equal(t, sc.U64(15), storageMap.Get(1))
+
+	assert.Equal(t, sc.U64(15), storageMap.Take(1))
+	assert.False(t, storageMap.Contains(1))
+}
+
+func Test_StorageMap_Iterate(t *testing.T) {
+	host.Reset()
+
+	storageMap := NewStorageMap[sc.U32, sc.U64](prefix, name, Blake2_128Concat{}, sc.DecodeU32, sc.DecodeU64)
+	otherMap := NewStorageMap[sc.U32, sc.U64](prefix, []byte("Other"), Blake2_128Concat{}, sc.DecodeU32, sc.DecodeU64)
+
+	for i := sc.U32(0); i < 3; i++ {
+		storageMap.Put(i, sc.U64(i)*10)
+	}
+	otherMap.Put(5, 50)
+
+	entries := map[sc.U32]sc.U64{}
+	storageMap.Iterate(func(k sc.U32, value sc.U64) bool {
+		entries[k] = value
+		return true
+	})
+
+	assert.Equal(t, map[sc.U32]sc.U64{0: 0, 1: 10, 2: 20}, entries)
+	assert.Len(t, storageMap.Keys(), 3)
+}
+
+func Test_StorageDoubleMap(t *testing.T) {
+	host.Reset()
+
+	doubleMap := NewStorageDoubleMap[sc.U32, sc.U32, sc.U64](prefix, name, Twox64Concat{}, Blake2_128Concat{}, sc.DecodeU32, sc.DecodeU32, sc.DecodeU64)
+
+	doubleMap.Put(1, 1, 11)
+	doubleMap.Put(1, 2, 12)
+	doubleMap.Put(2, 1, 21)
+
+	k1, k2 := doubleMap.DecodeKey(doubleMap.Key(1, 2))
+	assert.Equal(t, sc.U32(1), k1)
+	assert.Equal(t, sc.U32(2), k2)
+
+	entries := map[sc.U32]sc.U64{}
+	doubleMap.IteratePrefix(1, func(k2 sc.U32, value sc.U64) bool {
+		entries[k2] = value
+		return true
+	})
+	assert.Equal(t, map[sc.U32]sc.U64{1: 11, 2: 12}, entries)
+
+	doubleMap.Remove(1, 1)
+	assert.False(t, doubleMap.Contains(1, 1))
+	assert.Equal(t, sc.U64(21), doubleMap.Get(2, 1))
+}
+
+func Test_StorageNMap(t *testing.T) {
+	host.Reset()
+
+	keys := []StorageKey{
+		NewStorageKey(Twox64Concat{}, sc.DecodeU32),
+		NewStorageKey(Blake2_128Concat{}, sc.DecodeU64),
+		NewStorageKey(Identity{}, sc.DecodeU8),
+	}
+	nMap := NewStorageNMap[sc.U64](prefix, name, keys, sc.DecodeU64)
+
+	nMap.Put(sc.NewVaryingData(sc.U32(1), sc.U64(2), sc.U8(3)), 123)
+	nMap.Put(sc.NewVaryingData(sc.U32(1), sc.U64(2), sc.U8(4)), 124)
+	nMap.Put(sc.NewVaryingData(sc.U32(2), sc.U64(2), sc.U8(3)), 223)
+
+	assert.Equal(t, sc.U64(123), nMap.Get(sc.NewVaryingData(sc.U32(1), sc.U64(2), sc.U8(3))))
+	assert.Equal(t,
+		sc.NewVaryingData(sc.U32(1), sc.U64(2), sc.U8(4)),
+		nMap.DecodeKey(nMap.Key(sc.NewVaryingData(sc.U32(1), sc.U64(2), sc.U8(4)))),
+	)
+
+	count := 0
+	nMap.IteratePrefix(sc.NewVaryingData(sc.U32(1)), func(keys sc.VaryingData, value sc.U64) bool {
+		assert.Equal(t, sc.U32(1), keys[0])
+		count++
+		return true
+	})
+	assert.Equal(t, 2, count)
+
+	assert.Equal(t, sc.U64(223), nMap.Take(sc.NewVaryingData(sc.U32(2), sc.U64(2), sc.U8(3))))
+	assert.False(t, nMap.Contains(sc.NewVaryingData(sc.U32(2), sc.U64(2), sc.U8(3))))
+}
@@ -0,0 +1,190 @@
+package support
+
+import (
+	"bytes"
+	"reflect"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// StorageKey describes a single key of a StorageNMap.
+type StorageKey struct {
+	Hasher     StorageHasher
+	Type       reflect.Type
+	DecodeFunc func(buffer *bytes.Buffer) sc.Encodable
+}
+
+func NewStorageKey[K sc.Encodable](hasher StorageHasher, decodeFunc func(buffer *bytes.Buffer) K) StorageKey {
+	return StorageKey{
+		Hasher: hasher,
+		Type:   TypeOf[K](),
+		DecodeFunc: func(buffer *bytes.Buffer) sc.Encodable {
+			return decodeFunc(buffer)
+		},
+	}
+}
+
+// StorageNMap is a storage map with an arbitrary number of keys, where each value is stored under
+// `Twox128(prefix) ++ Twox128(name) ++ hasher1(encode(key1)) ++ ... ++ hasherN(encode(keyN))`.
+// The keys are passed as sc.VaryingData in the order of their declaration.
+type StorageNMap[V sc.Encodable] struct {
+	prefix     []byte
+	name       []byte
+	keys       []StorageKey
+	decodeFunc func(buffer *bytes.Buffer) V
+}
+
+func NewStorageNMap[V sc.Encodable](prefix []byte, name []byte, keys []StorageKey, decodeFunc func(buffer *bytes.Buffer) V) *StorageNMap[V] {
+	return &StorageNMap[V]{
+		prefix,
+		name,
+		keys,
+		decodeFunc,
+	}
+}
+
+// Get returns the value stored under the keys, or the default value of V if there is none.
+func (snm StorageNMap[V]) Get(keys sc.VaryingData) V {
+	return storage.GetDecode(snm.Key(keys), snm.decodeFunc)
+}
+
+// GetOption returns the value stored under the keys, or `None` if there is none.
+func (snm StorageNMap[V]) GetOption(keys sc.VaryingData) sc.Option[V] {
+	option := storage.Get(snm.Key(keys))
+	if !option.HasValue {
+		return sc.NewOption[V](nil)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+	return sc.NewOption[V](snm.decodeFunc(buffer))
+}
+
+func (snm StorageNMap[V]) Contains(keys sc.VaryingData) bool {
+	return storage.Exists(snm.Key(keys)) != 0
+}
+
+func (snm StorageNMap[V]) Put(keys sc.VaryingData, value V) {
+	storage.Set(snm.Key(keys), value.Bytes())
+}
+
+func (snm StorageNMap[V]) Remove(keys sc.VaryingData) {
+	storage.Clear(snm.Key(keys))
+}
+
+// Take removes the value stored under the keys and returns it,
+// or the default value of V if there is none.
+func (snm StorageNMap[V]) Take(keys sc.VaryingData) V {
+	return storage.TakeDecode(snm.Key(keys), snm.decodeFunc)
+}
+
+// Mutate mutates the value stored under the keys and stores the result.
+func (snm StorageNMap[V]) Mutate(keys sc.VaryingData, f func(value *V)) {
+	value := snm.Get(keys)
+	f(&value)
+	snm.Put(keys, value)
+}
+
+// TryMutate mutates the value stored under the keys. The result is stored only
+// if the function does not return an error.
+func (snm StorageNMap[V]) TryMutate(keys sc.VaryingData, f func(value *V) sc.Result[sc.Encodable]) sc.Result[sc.Encodable] {
+	value := snm.Get(keys)
+
+	result := f(&value)
+	if !result.HasError {
+		snm.Put(keys, value)
+	}
+
+	return result
+}
+
+// Iterate calls f with each entry in the map, in the order of their
+// storage keys, until f returns false.
+func (snm StorageNMap[V]) Iterate(f func(keys sc.VaryingData, value V) bool) {
+	snm.IteratePrefix(sc.NewVaryingData(), f)
+}
+
+// IteratePrefix calls f with each entry under the given first keys, in the order of
+// their storage keys, until f returns false. The full keys are passed to f.
+func (snm StorageNMap[V]) IteratePrefix(partialKeys sc.VaryingData, f func(keys sc.VaryingData, value V) bool) {
+	storage.IteratePairs(snm.PrefixKeys(partialKeys), func(key []byte, value []byte) bool {
+		return f(snm.DecodeKey(key), snm.decodeFunc(bytes.NewBuffer(value)))
+	})
+}
+
+// Clear removes up to `limit` values from the map, or all of them if the limit is `None`.
+// The cursor of the returned results must be passed to the next call to continue the removal.
+func (snm StorageNMap[V]) Clear(limit sc.Option[sc.U32], cursor sc.Option[sc.Sequence[sc.U8]]) types.MultiRemovalResults {
+	return snm.ClearPrefix(sc.NewVaryingData(), limit, cursor)
+}
+
+// ClearPrefix removes up to `limit` values under the given first keys, or all of them if the limit is `None`.
+func (snm StorageNMap[V]) ClearPrefix(partialKeys sc.VaryingData, limit sc.Option[sc.U32], cursor sc.Option[sc.Sequence[sc.U8]]) types.MultiRemovalResults {
+	return storage.ClearPrefix(snm.PrefixKeys(partialKeys), limit, cursor)
+}
+
+func (snm StorageNMap[V]) Name() string {
+	return string(snm.name)
+}
+
+func (snm StorageNMap[V]) Hashers() []StorageHasher {
+	hashers := make([]StorageHasher, len(snm.keys))
+	for i, k := range snm.keys {
+		hashers[i] = k.Hasher
+	}
+	return hashers
+}
+
+func (snm StorageNMap[V]) KeyTypes() []reflect.Type {
+	keyTypes := make([]reflect.Type, len(snm.keys))
+	for i, k := range snm.keys {
+		keyTypes[i] = k.Type
+	}
+	return keyTypes
+}
+
+func (snm StorageNMap[V]) ValueType() reflect.Type {
+	return TypeOf[V]()
+}
+
+// Prefix returns the prefix of all storage keys in the map.
+func (snm StorageNMap[V]) Prefix() []byte {
+	return storagePrefix(snm.prefix, snm.name)
+}
+
+// PrefixKeys returns the prefix of all storage keys under the given first keys.
+func (snm StorageNMap[V]) PrefixKeys(partialKeys sc.VaryingData) []byte {
+	if len(partialKeys) > len(snm.keys) {
+		log.Critical("too many keys for StorageNMap")
+	}
+
+	key := snm.Prefix()
+	for i, k := range partialKeys {
+		key = append(key, snm.keys[i].Hasher.Hash(k.Bytes())...)
+	}
+
+	return key
+}
+
+// Key returns the storage key of the value stored under the keys.
+func (snm StorageNMap[V]) Key(keys sc.VaryingData) []byte {
+	if len(keys) != len(snm.keys) {
+		log.Critical("invalid number of keys for StorageNMap")
+	}
+
+	return snm.PrefixKeys(keys)
+}
+
+// DecodeKey decodes all keys from the full storage key.
+func (snm StorageNMap[V]) DecodeKey(key []byte) sc.VaryingData {
+	buffer := bytes.NewBuffer(key[len(snm.Prefix()):])
+
+	keys := sc.NewVaryingData()
+	for _, k := range snm.keys {
+		keys = append(keys, decodeHashedKey(buffer, k.Hasher, k.DecodeFunc))
+	}
+
+	return keys
+}
@@ -2,9 +2,9 @@ package support
 
 import (
 	"bytes"
+	"reflect"
 
 	sc "github.com/LimeChain/goscale"
-	"github.com/LimeChain/gosemble/primitives/hashing"
 	"github.com/LimeChain/gosemble/primitives/storage"
 )
 
@@ -23,38 +23,64 @@ func NewStorageValue[T sc.Encodable](prefix []byte, name []byte, decodeFunc func
 }
 
 func (sv StorageValue[T]) Get() T {
-	prefixHash := hashing.Twox128(sv.prefix)
-	nameHash := hashing.Twox128(sv.name)
+	return storage.GetDecode(sv.Key(), sv.decodeFunc)
+}
+
+func (sv StorageValue[T]) GetOption() sc.Option[T] {
+	option := storage.Get(sv.Key())
+	if !option.HasValue {
+		return sc.NewOption[T](nil)
+	}
 
-	return storage.GetDecode(append(prefixHash, nameHash...), sv.decodeFunc)
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(option.Value))
+	return sc.NewOption[T](sv.decodeFunc(buffer))
 }
 
 func (sv StorageValue[T]) Exists() bool {
-	prefixHash := hashing.Twox128(sv.prefix)
-	nameHash := hashing.Twox128(sv.name)
-
-	exists := storage.Exists(append(prefixHash, nameHash...))
+	exists := storage.Exists(sv.Key())
 
 	return exists != 0
 }
 
 func (sv StorageValue[T]) Put(value T) {
-	prefixHash := hashing.Twox128(sv.prefix)
-	nameHash := hashing.Twox128(sv.name)
+	storage.Set(sv.Key(), value.Bytes())
+}
 
-	storage.Set(append(prefixHash, nameHash...), value.Bytes())
+// Append appends the encoded item to the SCALE encoded sequence stored in the value,
+// without decoding the whole sequence.
+func (sv StorageValue[T]) Append(item sc.Encodable) {
+	storage.Append(sv.Key(), item.Bytes())
 }
 
-func (sv StorageValue[T]) Take() []byte {
-	prefixHash := hashing.Twox128(sv.prefix)
-	nameHash := hashing.Twox128(sv.name)
+func (sv StorageValue[T]) Clear() {
+	storage.Clear(sv.Key())
+}
 
-	return storage.TakeBytes(append(prefixHash, nameHash...))
+func (sv StorageValue[T]) Take() []byte {
+	return storage.TakeBytes(sv.Key())
 }
 
 func (sv StorageValue[T]) TakeExact() T {
-	prefixHash := hashing.Twox128(sv.prefix)
-	nameHash := hashing.Twox128(sv.name)
+	return storage.TakeDecode(sv.Key(), sv.decodeFunc)
+}
+
+// Key returns the storage key of the value.
+func (sv StorageValue[T]) Key() []byte {
+	return storagePrefix(sv.prefix, sv.name)
+}
+
+func (sv StorageValue[T]) Name() string {
+	return string(sv.name)
+}
+
+func (sv StorageValue[T]) Hashers() []StorageHasher {
+	return nil
+}
+
+func (sv StorageValue[T]) KeyTypes() []reflect.Type {
+	return nil
+}
 
-	return storage.TakeDecode(append(prefixHash, nameHash...), sv.decodeFunc)
+func (sv StorageValue[T]) ValueType() reflect.Type {
+	return TypeOf[T]()
 }
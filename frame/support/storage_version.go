@@ -0,0 +1,13 @@
+package support
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+)
+
+// NewStorageVersion returns the storage version of the module with the given storage prefix.
+// The version is stored under the `:__STORAGE_VERSION__:` key of the module and is bumped
+// by the migrations of its storage layout. Modules without a stored version are at version 0.
+func NewStorageVersion(prefix []byte) *StorageValue[sc.U16] {
+	return NewStorageValue[sc.U16](prefix, constants.KeyStorageVersion, sc.DecodeU16)
+}
@@ -0,0 +1,122 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	cs "github.com/LimeChain/gosemble/constants/system"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type KillPrefixCall struct {
+	primitives.Callable
+}
+
+func NewKillPrefixCall(args sc.VaryingData) KillPrefixCall {
+	call := KillPrefixCall{
+		Callable: primitives.Callable{
+			ModuleId:   cs.ModuleIndex,
+			FunctionId: cs.FunctionKillPrefixIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c KillPrefixCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeSequence[sc.U8](buffer),
+		sc.DecodeU32(buffer),
+	)
+	return c
+}
+
+func (c KillPrefixCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c KillPrefixCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c KillPrefixCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c KillPrefixCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c KillPrefixCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+// Storage: Skipped Metadata (r:0 w:0)
+// Proof Skipped: Skipped Metadata (max_values: None, max_size: None, mode: Measured)
+// The range of component `p` is `[0, 1000]`.
+func (_ KillPrefixCall) BaseWeight(args ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `116 + p * (69 ±0)`
+	//  Estimated: `128 + p * (70 ±0)`
+	// Minimum execution time: 3_873 nanoseconds.
+	// Standard Error: 1_046
+	p := sc.U64(args[0].(sc.VaryingData)[1].(sc.U32))
+	r := constants.DbWeight.Reads(1).SaturatingMul(p)
+	w := constants.DbWeight.Writes(1).SaturatingMul(p)
+	e := types.WeightFromParts(0, 70).SaturatingMul(p)
+	return types.WeightFromParts(4_048_000, 128).
+		SaturatingAdd(types.WeightFromParts(1_129_129, 0).SaturatingMul(p)).
+		SaturatingAdd(r).
+		SaturatingAdd(w).
+		SaturatingAdd(e)
+}
+
+func (_ KillPrefixCall) IsInherent() bool {
+	return false
+}
+
+func (_ KillPrefixCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ KillPrefixCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassOperational()
+}
+
+func (_ KillPrefixCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ KillPrefixCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	return killPrefix(origin, args[0].(sc.Sequence[sc.U8]), args[1].(sc.U32))
+}
+
+// killPrefix kills all storage items with a key that starts with the given prefix.
+//
+// **NOTE:** We rely on the Root origin to provide us the number of subkeys under
+// the prefix we are removing to accurately calculate the weight of this function.
+func killPrefix(origin types.RuntimeOrigin, prefix sc.Sequence[sc.U8], subkeys sc.U32) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := ensureRoot(origin)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	storage.ClearPrefix(sc.SequenceU8ToBytes(prefix), sc.NewOption[sc.U32](subkeys), sc.NewOption[sc.Sequence[sc.U8]](nil))
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
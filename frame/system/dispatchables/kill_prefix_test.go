@@ -0,0 +1,85 @@
+//go:build nonwasmenv
+
+package dispatchables
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var prefix = []byte("prefix:")
+
+func countKeys(keyPrefix []byte) int {
+	count := 0
+	storage.IterateKeys(keyPrefix, func(_ []byte) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+func Test_KillPrefix(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		origin      types.RuntimeOrigin
+		subkeys     sc.U32
+		expectation types.DispatchError
+		remaining   int
+	}{
+		{
+			label:       "kill_prefix(BadOrigin)",
+			origin:      types.NewRawOriginSigned(alice),
+			subkeys:     3,
+			expectation: types.NewDispatchErrorBadOrigin(),
+			remaining:   3,
+		},
+		{
+			label:     "kill_prefix(Ok) is limited by the subkeys",
+			origin:    types.NewRawOriginRoot(),
+			subkeys:   2,
+			remaining: 1,
+		},
+		{
+			label:     "kill_prefix(Ok)",
+			origin:    types.NewRawOriginRoot(),
+			subkeys:   3,
+			remaining: 0,
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			host.Reset()
+			for _, key := range []string{"prefix:1", "prefix:2", "prefix:3", "other"} {
+				storage.Set([]byte(key), []byte{1})
+			}
+
+			result := NewKillPrefixCall(nil).Dispatch(testExample.origin, sc.NewVaryingData(sc.BytesToSequenceU8(prefix), testExample.subkeys))
+
+			if testExample.expectation != nil {
+				assert.True(t, bool(result.HasError))
+				assert.Equal(t, testExample.expectation, result.Err.Error)
+			} else {
+				assert.False(t, bool(result.HasError))
+			}
+			assert.Equal(t, testExample.remaining, countKeys(prefix))
+			assert.Equal(t, int32(1), storage.Exists([]byte("other")))
+		})
+	}
+}
+
+func Test_KillPrefix_DispatchInfo(t *testing.T) {
+	call := NewKillPrefixCall(sc.NewVaryingData(sc.BytesToSequenceU8(prefix), sc.U32(2)))
+
+	expectedRefTime := sc.U64(4_048_000) + 2*(1_129_129+constants.DbWeight.Reads(1).RefTime+constants.DbWeight.Writes(1).RefTime)
+
+	assert.Equal(t, types.WeightFromParts(expectedRefTime, 0), types.GetDispatchInfo(call).Weight)
+	assert.Equal(t, types.NewDispatchClassOperational(), types.GetDispatchInfo(call).Class)
+	assert.Equal(t, types.NewPaysYes(), types.GetDispatchInfo(call).PaysFee)
+}
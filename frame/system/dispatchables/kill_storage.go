@@ -0,0 +1,114 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	cs "github.com/LimeChain/gosemble/constants/system"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type KillStorageCall struct {
+	primitives.Callable
+}
+
+func NewKillStorageCall(args sc.VaryingData) KillStorageCall {
+	call := KillStorageCall{
+		Callable: primitives.Callable{
+			ModuleId:   cs.ModuleIndex,
+			FunctionId: cs.FunctionKillStorageIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c KillStorageCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(sc.DecodeSequenceWith(buffer, sc.DecodeSequence[sc.U8]))
+	return c
+}
+
+func (c KillStorageCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c KillStorageCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c KillStorageCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c KillStorageCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c KillStorageCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+// Storage: Skipped Metadata (r:0 w:0)
+// Proof Skipped: Skipped Metadata (max_values: None, max_size: None, mode: Measured)
+// The range of component `i` is `[0, 1000]`.
+func (_ KillStorageCall) BaseWeight(args ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `0`
+	//  Estimated: `0`
+	// Minimum execution time: 2_070 nanoseconds.
+	// Standard Error: 821
+	i := sc.U64(len(args[0].(sc.VaryingData)[0].(sc.Sequence[sc.Sequence[sc.U8]])))
+	w := constants.DbWeight.Writes(1).SaturatingMul(i)
+	return types.WeightFromParts(2_141_000, 0).
+		SaturatingAdd(types.WeightFromParts(569_092, 0).SaturatingMul(i)).
+		SaturatingAdd(w)
+}
+
+func (_ KillStorageCall) IsInherent() bool {
+	return false
+}
+
+func (_ KillStorageCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ KillStorageCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassOperational()
+}
+
+func (_ KillStorageCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ KillStorageCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	return killStorage(origin, args[0].(sc.Sequence[sc.Sequence[sc.U8]]))
+}
+
+// killStorage kills some items from storage.
+func killStorage(origin types.RuntimeOrigin, keys sc.Sequence[sc.Sequence[sc.U8]]) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := ensureRoot(origin)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	for _, key := range keys {
+		storage.Clear(sc.SequenceU8ToBytes(key))
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
@@ -0,0 +1,66 @@
+//go:build nonwasmenv
+
+package dispatchables
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_KillStorage(t *testing.T) {
+	keys := sc.Sequence[sc.Sequence[sc.U8]]{items[0].Key}
+
+	var testExamples = []struct {
+		label       string
+		origin      types.RuntimeOrigin
+		expectation types.DispatchError
+		killed      bool
+	}{
+		{
+			label:       "kill_storage(BadOrigin)",
+			origin:      types.NewRawOriginSigned(alice),
+			expectation: types.NewDispatchErrorBadOrigin(),
+		},
+		{
+			label:  "kill_storage(Ok)",
+			origin: types.NewRawOriginRoot(),
+			killed: true,
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			host.Reset()
+			for _, item := range items {
+				storage.Set(sc.SequenceU8ToBytes(item.Key), sc.SequenceU8ToBytes(item.Value))
+			}
+
+			result := NewKillStorageCall(nil).Dispatch(testExample.origin, sc.NewVaryingData(keys))
+
+			if testExample.expectation != nil {
+				assert.True(t, bool(result.HasError))
+				assert.Equal(t, testExample.expectation, result.Err.Error)
+			} else {
+				assert.False(t, bool(result.HasError))
+			}
+			assert.Equal(t, testExample.killed, storage.Exists(sc.SequenceU8ToBytes(items[0].Key)) == 0)
+			assert.Equal(t, sc.SequenceU8ToBytes(items[1].Value), storage.TakeBytes(sc.SequenceU8ToBytes(items[1].Key)))
+		})
+	}
+}
+
+func Test_KillStorage_DispatchInfo(t *testing.T) {
+	call := NewKillStorageCall(sc.NewVaryingData(sc.Sequence[sc.Sequence[sc.U8]]{items[0].Key, items[1].Key}))
+
+	expectedRefTime := sc.U64(2_141_000) + 2*(569_092+constants.DbWeight.Writes(1).RefTime)
+
+	assert.Equal(t, types.WeightFromParts(expectedRefTime, 0), types.GetDispatchInfo(call).Weight)
+	assert.Equal(t, types.NewDispatchClassOperational(), types.GetDispatchInfo(call).Class)
+	assert.Equal(t, types.NewPaysYes(), types.GetDispatchInfo(call).PaysFee)
+}
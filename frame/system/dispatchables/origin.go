@@ -0,0 +1,37 @@
+package dispatchables
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Ensure that the origin `o` represents either a signed extrinsic (i.e. transaction) or the root.
+// Returns `Ok` with the account that signed the extrinsic, `None` if it was root,  or an `Err`
+// otherwise.
+func ensureSignedOrRoot(o types.RawOrigin) (ok sc.Option[types.Address32], err types.DispatchError) {
+	if o.IsRootOrigin() {
+		ok = sc.NewOption[types.Address32](nil)
+	} else if o.IsSignedOrigin() {
+		ok = sc.NewOption[types.Address32](o.VaryingData[1])
+	} else {
+		err = types.NewDispatchErrorBadOrigin()
+	}
+	return ok, err
+}
+
+// Ensure that the origin `o` represents the root. Returns an `Err` otherwise.
+func ensureRoot(o types.RawOrigin) types.DispatchError {
+	if !o.IsRootOrigin() {
+		return types.NewDispatchErrorBadOrigin()
+	}
+	return nil
+}
+
+// Ensure that the origin `o` represents a signed extrinsic (i.e. transaction).
+// Returns `Ok` with the account that signed the extrinsic or an `Err` otherwise.
+func ensureSigned(o types.RawOrigin) (types.Address32, types.DispatchError) {
+	if !o.IsSignedOrigin() {
+		return types.Address32{}, types.NewDispatchErrorBadOrigin()
+	}
+	return o.AsSigned(), nil
+}
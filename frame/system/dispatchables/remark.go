@@ -106,17 +106,3 @@ func remark(origin types.RuntimeOrigin) types.DispatchResultWithPostInfo[types.P
 		Ok:       types.PostDispatchInfo{},
 	}
 }
-
-// Ensure that the origin `o` represents either a signed extrinsic (i.e. transaction) or the root.
-// Returns `Ok` with the account that signed the extrinsic, `None` if it was root,  or an `Err`
-// otherwise.
-func ensureSignedOrRoot(o types.RawOrigin) (ok sc.Option[types.Address32], err types.DispatchError) {
-	if o.IsRootOrigin() {
-		ok = sc.NewOption[types.Address32](nil)
-	} else if o.IsSignedOrigin() {
-		ok = sc.NewOption[types.Address32](o.VaryingData[1])
-	} else {
-		err = types.NewDispatchErrorBadOrigin()
-	}
-	return ok, err
-}
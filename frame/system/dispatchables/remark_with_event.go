@@ -0,0 +1,109 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	cs "github.com/LimeChain/gosemble/constants/system"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type RemarkWithEventCall struct {
+	primitives.Callable
+}
+
+func NewRemarkWithEventCall(args sc.VaryingData) RemarkWithEventCall {
+	call := RemarkWithEventCall{
+		Callable: primitives.Callable{
+			ModuleId:   cs.ModuleIndex,
+			FunctionId: cs.FunctionRemarkWithEventIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c RemarkWithEventCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(sc.DecodeSequence[sc.U8](buffer))
+	return c
+}
+
+func (c RemarkWithEventCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c RemarkWithEventCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c RemarkWithEventCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c RemarkWithEventCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c RemarkWithEventCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+// The range of component `b` is `[0, 3932160]`.
+func (_ RemarkWithEventCall) BaseWeight(args ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `0`
+	//  Estimated: `0`
+	// Minimum execution time: 8_053 nanoseconds.
+	// Standard Error: 1
+	b := sc.U64(len(args[0].(sc.VaryingData)[0].(sc.Sequence[sc.U8])))
+	return types.WeightFromParts(8_392_000, 0).
+		SaturatingAdd(types.WeightFromParts(1_437, 0).SaturatingMul(b))
+}
+
+func (_ RemarkWithEventCall) IsInherent() bool {
+	return false
+}
+
+func (_ RemarkWithEventCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ RemarkWithEventCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassNormal()
+}
+
+func (_ RemarkWithEventCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ RemarkWithEventCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	return remarkWithEvent(origin, args[0].(sc.Sequence[sc.U8]))
+}
+
+// remarkWithEvent makes some on-chain remark and emits an event.
+func remarkWithEvent(origin types.RuntimeOrigin, remark sc.Sequence[sc.U8]) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	who, err := ensureSigned(origin)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	hash := hashing.Blake256(sc.SequenceU8ToBytes(remark))
+	system.DepositEvent(system.NewEventRemarked(who.FixedSequence, types.NewH256(sc.BytesToSequenceU8(hash)...)))
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
@@ -0,0 +1,75 @@
+//go:build nonwasmenv
+
+package dispatchables
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	message   = sc.BytesToSequenceU8([]byte("remark"))
+	keyEvents = append(hashing.Twox128(constants.KeySystem), hashing.Twox128(constants.KeyEvents)...)
+)
+
+func Test_RemarkWithEvent(t *testing.T) {
+	phase := types.NewExtrinsicPhaseApply(0)
+	remarked := system.NewEventRemarked(alice.FixedSequence, types.NewH256(sc.BytesToSequenceU8(hashing.Blake256(sc.SequenceU8ToBytes(message)))...))
+
+	var testExamples = []struct {
+		label       string
+		origin      types.RuntimeOrigin
+		expectation types.DispatchError
+		events      sc.Sequence[types.EventRecord]
+	}{
+		{
+			label:       "remark_with_event(BadOrigin)",
+			origin:      types.NewRawOriginRoot(),
+			expectation: types.NewDispatchErrorBadOrigin(),
+			events:      nil,
+		},
+		{
+			label:  "remark_with_event(Ok)",
+			origin: types.NewRawOriginSigned(alice),
+			events: sc.Sequence[types.EventRecord]{{Phase: phase, Event: remarked, Topics: sc.Sequence[types.H256]{}}},
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			host.Reset()
+			system.StorageSetBlockNumber(1)
+			system.StorageSetExecutionPhase(phase)
+
+			result := NewRemarkWithEventCall(nil).Dispatch(testExample.origin, sc.NewVaryingData(message))
+
+			if testExample.expectation != nil {
+				assert.True(t, bool(result.HasError))
+				assert.Equal(t, testExample.expectation, result.Err.Error)
+				assert.Equal(t, int32(0), storage.Exists(keyEvents))
+				return
+			}
+
+			assert.False(t, bool(result.HasError))
+			assert.Equal(t, testExample.events.Bytes(), storage.TakeBytes(keyEvents))
+		})
+	}
+}
+
+func Test_RemarkWithEvent_DispatchInfo(t *testing.T) {
+	call := NewRemarkWithEventCall(sc.NewVaryingData(message))
+
+	expectedRefTime := sc.U64(8_392_000) + 1_437*sc.U64(len(message))
+
+	assert.Equal(t, types.WeightFromParts(expectedRefTime, 0), types.GetDispatchInfo(call).Weight)
+	assert.Equal(t, types.NewDispatchClassNormal(), types.GetDispatchInfo(call).Class)
+	assert.Equal(t, types.NewPaysYes(), types.GetDispatchInfo(call).PaysFee)
+}
@@ -0,0 +1,130 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	cs "github.com/LimeChain/gosemble/constants/system"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetCodeCall struct {
+	primitives.Callable
+}
+
+func NewSetCodeCall(args sc.VaryingData) SetCodeCall {
+	call := SetCodeCall{
+		Callable: primitives.Callable{
+			ModuleId:   cs.ModuleIndex,
+			FunctionId: cs.FunctionSetCodeIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetCodeCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(sc.DecodeSequence[sc.U8](buffer))
+	return c
+}
+
+func (c SetCodeCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetCodeCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetCodeCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetCodeCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c SetCodeCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+// Storage: System Digest (r:1 w:1)
+// Proof Skipped: System Digest (max_values: Some(1), max_size: None, mode: Measured)
+// Storage: unknown `0x3a636f6465` (r:0 w:1)
+// Proof Skipped: unknown `0x3a636f6465` (r:0 w:1)
+func (_ SetCodeCall) BaseWeight(args ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `0`
+	//  Estimated: `1485`
+	// Minimum execution time: 58_655_041 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(2)
+	e := types.WeightFromParts(0, 1485)
+	return types.WeightFromParts(61_252_480_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ SetCodeCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetCodeCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ SetCodeCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassOperational()
+}
+
+func (_ SetCodeCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetCodeCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	return setCode(origin, args[0].(sc.Sequence[sc.U8]), true)
+}
+
+// setCode sets the new runtime code.
+// When checkVersion is true, the runtime version of the new code is checked against the current one.
+func setCode(origin types.RuntimeOrigin, code sc.Sequence[sc.U8], checkVersion bool) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := ensureRoot(origin)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	if checkVersion {
+		err = system.CanSetCode(code)
+		if err != nil {
+			return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+				HasError: true,
+				Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+					Error: err,
+				},
+			}
+		}
+	}
+
+	system.UpdateCodeInStorage(code)
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok: types.PostDispatchInfo{
+			ActualWeight: sc.NewOption[types.Weight](system.DefaultBlockWeights().MaxBlock),
+			PaysFee:      types.PaysYes,
+		},
+	}
+}
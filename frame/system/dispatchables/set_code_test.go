@@ -0,0 +1,98 @@
+//go:build nonwasmenv
+
+package dispatchables
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	cs "github.com/LimeChain/gosemble/constants/system"
+	"github.com/LimeChain/gosemble/frame/system/errors"
+	"github.com/LimeChain/gosemble/frame/testutils"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	code  = sc.BytesToSequenceU8([]byte("new runtime code"))
+	alice = testutils.NewAddress(1)
+)
+
+func Test_SetCode(t *testing.T) {
+	newVersion := constants.RuntimeVersion
+	newVersion.SpecVersion++
+
+	invalidName := newVersion
+	invalidName.SpecName = "other"
+
+	var testExamples = []struct {
+		label       string
+		origin      types.RuntimeOrigin
+		version     sc.Option[types.RuntimeVersion]
+		expectation types.DispatchError
+	}{
+		{
+			label:       "set_code(BadOrigin)",
+			origin:      types.NewRawOriginSigned(types.NewAddress32(make([]sc.U8, 32)...)),
+			version:     sc.NewOption[types.RuntimeVersion](newVersion),
+			expectation: types.NewDispatchErrorBadOrigin(),
+		},
+		{
+			label:       "set_code(FailedToExtractRuntimeVersion)",
+			origin:      types.NewRawOriginRoot(),
+			version:     sc.NewOption[types.RuntimeVersion](nil),
+			expectation: testutils.NewModuleError(cs.ModuleIndex, errors.ErrorFailedToExtractRuntimeVersion),
+		},
+		{
+			label:       "set_code(InvalidSpecName)",
+			origin:      types.NewRawOriginRoot(),
+			version:     sc.NewOption[types.RuntimeVersion](invalidName),
+			expectation: testutils.NewModuleError(cs.ModuleIndex, errors.ErrorInvalidSpecName),
+		},
+		{
+			label:       "set_code(SpecVersionNeedsToIncrease)",
+			origin:      types.NewRawOriginRoot(),
+			version:     sc.NewOption[types.RuntimeVersion](constants.RuntimeVersion),
+			expectation: testutils.NewModuleError(cs.ModuleIndex, errors.ErrorSpecVersionNeedsToIncrease),
+		},
+		{
+			label:       "set_code(Ok)",
+			origin:      types.NewRawOriginRoot(),
+			version:     sc.NewOption[types.RuntimeVersion](newVersion),
+			expectation: nil,
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			host.Reset()
+			if testExample.version.HasValue {
+				host.SetRuntimeVersion(sc.SequenceU8ToBytes(code), testExample.version.Value.Bytes())
+			}
+
+			result := NewSetCodeCall(nil).Dispatch(testExample.origin, sc.NewVaryingData(code))
+
+			if testExample.expectation != nil {
+				assert.True(t, bool(result.HasError))
+				assert.Equal(t, testExample.expectation, result.Err.Error)
+				assert.Equal(t, int32(0), storage.Exists(constants.KeyCode))
+				return
+			}
+
+			assert.False(t, bool(result.HasError))
+			assert.Equal(t, sc.SequenceU8ToBytes(code), storage.TakeBytes(constants.KeyCode))
+		})
+	}
+}
+
+func Test_SetCodeWithoutChecks(t *testing.T) {
+	host.Reset()
+
+	result := NewSetCodeWithoutChecksCall(nil).Dispatch(types.NewRawOriginRoot(), sc.NewVaryingData(code))
+
+	assert.False(t, bool(result.HasError))
+	assert.Equal(t, sc.SequenceU8ToBytes(code), storage.TakeBytes(constants.KeyCode))
+}
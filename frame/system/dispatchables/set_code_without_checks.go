@@ -0,0 +1,82 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	cs "github.com/LimeChain/gosemble/constants/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetCodeWithoutChecksCall struct {
+	primitives.Callable
+}
+
+func NewSetCodeWithoutChecksCall(args sc.VaryingData) SetCodeWithoutChecksCall {
+	call := SetCodeWithoutChecksCall{
+		Callable: primitives.Callable{
+			ModuleId:   cs.ModuleIndex,
+			FunctionId: cs.FunctionSetCodeWithoutChecksIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetCodeWithoutChecksCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(sc.DecodeSequence[sc.U8](buffer))
+	return c
+}
+
+func (c SetCodeWithoutChecksCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetCodeWithoutChecksCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetCodeWithoutChecksCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetCodeWithoutChecksCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c SetCodeWithoutChecksCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+// The weight of this call intentionally overestimates it with the `set_code` benchmark,
+// which includes reading the version of the new code, as there is no benchmark without the checks.
+func (_ SetCodeWithoutChecksCall) BaseWeight(args ...any) types.Weight {
+	return SetCodeCall{}.BaseWeight(args...)
+}
+
+func (_ SetCodeWithoutChecksCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetCodeWithoutChecksCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ SetCodeWithoutChecksCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassOperational()
+}
+
+func (_ SetCodeWithoutChecksCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+// Dispatch sets the new runtime code without doing any checks of the given code.
+// Intended for emergencies, when the new code cannot be verified by `set_code`.
+func (_ SetCodeWithoutChecksCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	return setCode(origin, args[0].(sc.Sequence[sc.U8]), false)
+}
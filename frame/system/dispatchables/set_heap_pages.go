@@ -0,0 +1,115 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	cs "github.com/LimeChain/gosemble/constants/system"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetHeapPagesCall struct {
+	primitives.Callable
+}
+
+func NewSetHeapPagesCall(args sc.VaryingData) SetHeapPagesCall {
+	call := SetHeapPagesCall{
+		Callable: primitives.Callable{
+			ModuleId:   cs.ModuleIndex,
+			FunctionId: cs.FunctionSetHeapPagesIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetHeapPagesCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(sc.DecodeU64(buffer))
+	return c
+}
+
+func (c SetHeapPagesCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetHeapPagesCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetHeapPagesCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetHeapPagesCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c SetHeapPagesCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+// Storage: System Digest (r:1 w:1)
+// Proof Skipped: System Digest (max_values: Some(1), max_size: None, mode: Measured)
+// Storage: unknown `0x3a686561707061676573` (r:0 w:1)
+// Proof Skipped: unknown `0x3a686561707061676573` (r:0 w:1)
+func (_ SetHeapPagesCall) BaseWeight(args ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `0`
+	//  Estimated: `1485`
+	// Minimum execution time: 4_135 nanoseconds.
+	r := constants.DbWeight.Reads(1)
+	w := constants.DbWeight.Writes(2)
+	e := types.WeightFromParts(0, 1485)
+	return types.WeightFromParts(4_336_000, 0).
+		SaturatingAdd(e).
+		SaturatingAdd(r).
+		SaturatingAdd(w)
+}
+
+func (_ SetHeapPagesCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetHeapPagesCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ SetHeapPagesCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassOperational()
+}
+
+func (_ SetHeapPagesCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetHeapPagesCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	return setHeapPages(origin, args[0].(sc.U64))
+}
+
+// setHeapPages sets the number of pages in the WebAssembly environment's heap.
+func setHeapPages(origin types.RuntimeOrigin, pages sc.U64) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := ensureRoot(origin)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	system.StorageSetHeapPages(pages)
+	system.DepositLog(types.NewDigestItemRuntimeEnvironmentUpgraded())
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
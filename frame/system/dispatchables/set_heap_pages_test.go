@@ -0,0 +1,65 @@
+//go:build nonwasmenv
+
+package dispatchables
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SetHeapPages(t *testing.T) {
+	pages := sc.U64(64)
+
+	var testExamples = []struct {
+		label       string
+		origin      types.RuntimeOrigin
+		expectation types.DispatchError
+	}{
+		{
+			label:       "set_heap_pages(BadOrigin)",
+			origin:      types.NewRawOriginSigned(alice),
+			expectation: types.NewDispatchErrorBadOrigin(),
+		},
+		{
+			label:  "set_heap_pages(Ok)",
+			origin: types.NewRawOriginRoot(),
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			host.Reset()
+
+			result := NewSetHeapPagesCall(nil).Dispatch(testExample.origin, sc.NewVaryingData(pages))
+
+			if testExample.expectation != nil {
+				assert.True(t, bool(result.HasError))
+				assert.Equal(t, testExample.expectation, result.Err.Error)
+				assert.Equal(t, int32(0), storage.Exists(constants.KeyHeapPages))
+				assert.Empty(t, system.StorageGetDigest().Logs)
+				return
+			}
+
+			assert.False(t, bool(result.HasError))
+			assert.Equal(t, pages.Bytes(), storage.TakeBytes(constants.KeyHeapPages))
+			assert.Equal(t, []types.DigestItem{types.NewDigestItemRuntimeEnvironmentUpgraded()}, system.StorageGetDigest().OfType(types.DigestTypeRuntimeEnvironmentUpgraded))
+		})
+	}
+}
+
+func Test_SetHeapPages_DispatchInfo(t *testing.T) {
+	call := NewSetHeapPagesCall(sc.NewVaryingData(sc.U64(64)))
+
+	expectedRefTime := sc.U64(4_336_000) + constants.DbWeight.Reads(1).RefTime + constants.DbWeight.Writes(2).RefTime
+
+	assert.Equal(t, types.WeightFromParts(expectedRefTime, 0), types.GetDispatchInfo(call).Weight)
+	assert.Equal(t, types.NewDispatchClassOperational(), types.GetDispatchInfo(call).Class)
+	assert.Equal(t, types.NewPaysYes(), types.GetDispatchInfo(call).PaysFee)
+}
@@ -0,0 +1,114 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	cs "github.com/LimeChain/gosemble/constants/system"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type SetStorageCall struct {
+	primitives.Callable
+}
+
+func NewSetStorageCall(args sc.VaryingData) SetStorageCall {
+	call := SetStorageCall{
+		Callable: primitives.Callable{
+			ModuleId:   cs.ModuleIndex,
+			FunctionId: cs.FunctionSetStorageIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c SetStorageCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(sc.DecodeSequenceWith(buffer, types.DecodeKeyValue))
+	return c
+}
+
+func (c SetStorageCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c SetStorageCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c SetStorageCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c SetStorageCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c SetStorageCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+// Storage: Skipped Metadata (r:0 w:0)
+// Proof Skipped: Skipped Metadata (max_values: None, max_size: None, mode: Measured)
+// The range of component `i` is `[0, 1000]`.
+func (_ SetStorageCall) BaseWeight(args ...any) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `0`
+	//  Estimated: `0`
+	// Minimum execution time: 2_050 nanoseconds.
+	// Standard Error: 1_212
+	i := sc.U64(len(args[0].(sc.VaryingData)[0].(sc.Sequence[types.KeyValue])))
+	w := constants.DbWeight.Writes(1).SaturatingMul(i)
+	return types.WeightFromParts(2_094_000, 0).
+		SaturatingAdd(types.WeightFromParts(764_169, 0).SaturatingMul(i)).
+		SaturatingAdd(w)
+}
+
+func (_ SetStorageCall) IsInherent() bool {
+	return false
+}
+
+func (_ SetStorageCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+func (_ SetStorageCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	return types.NewDispatchClassOperational()
+}
+
+func (_ SetStorageCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ SetStorageCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	return setStorage(origin, args[0].(sc.Sequence[types.KeyValue]))
+}
+
+// setStorage sets some items of storage.
+func setStorage(origin types.RuntimeOrigin, items sc.Sequence[types.KeyValue]) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	err := ensureRoot(origin)
+	if err != nil {
+		return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+			HasError: true,
+			Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+				Error: err,
+			},
+		}
+	}
+
+	for _, item := range items {
+		storage.Set(sc.SequenceU8ToBytes(item.Key), sc.SequenceU8ToBytes(item.Value))
+	}
+
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       types.PostDispatchInfo{},
+	}
+}
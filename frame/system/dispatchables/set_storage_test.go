@@ -0,0 +1,69 @@
+//go:build nonwasmenv
+
+package dispatchables
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var items = sc.Sequence[types.KeyValue]{
+	{Key: sc.BytesToSequenceU8([]byte("key1")), Value: sc.BytesToSequenceU8([]byte("value1"))},
+	{Key: sc.BytesToSequenceU8([]byte("key2")), Value: sc.BytesToSequenceU8([]byte("value2"))},
+}
+
+func Test_SetStorage(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		origin      types.RuntimeOrigin
+		expectation types.DispatchError
+	}{
+		{
+			label:       "set_storage(BadOrigin)",
+			origin:      types.NewRawOriginSigned(alice),
+			expectation: types.NewDispatchErrorBadOrigin(),
+		},
+		{
+			label:  "set_storage(Ok)",
+			origin: types.NewRawOriginRoot(),
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			host.Reset()
+
+			result := NewSetStorageCall(nil).Dispatch(testExample.origin, sc.NewVaryingData(items))
+
+			if testExample.expectation != nil {
+				assert.True(t, bool(result.HasError))
+				assert.Equal(t, testExample.expectation, result.Err.Error)
+				for _, item := range items {
+					assert.Equal(t, int32(0), storage.Exists(sc.SequenceU8ToBytes(item.Key)))
+				}
+				return
+			}
+
+			assert.False(t, bool(result.HasError))
+			for _, item := range items {
+				assert.Equal(t, sc.SequenceU8ToBytes(item.Value), storage.TakeBytes(sc.SequenceU8ToBytes(item.Key)))
+			}
+		})
+	}
+}
+
+func Test_SetStorage_DispatchInfo(t *testing.T) {
+	call := NewSetStorageCall(sc.NewVaryingData(items))
+
+	expectedRefTime := sc.U64(2_094_000) + 2*(764_169+constants.DbWeight.Writes(1).RefTime)
+
+	assert.Equal(t, types.WeightFromParts(expectedRefTime, 0), types.GetDispatchInfo(call).Weight)
+	assert.Equal(t, types.NewDispatchClassOperational(), types.GetDispatchInfo(call).Class)
+	assert.Equal(t, types.NewPaysYes(), types.GetDispatchInfo(call).PaysFee)
+}
@@ -29,13 +29,13 @@ func depositEventIndexed(topics []types.H256, event types.Event) {
 		Topics: topics,
 	}
 
-	oldEventCount := storageEventCount()
+	oldEventCount := storageEventCount.Get()
 	newEventCount := oldEventCount + 1 // checked_add
 	if newEventCount < oldEventCount {
 		return
 	}
 
-	storageSetEventCount(newEventCount)
+	storageEventCount.Put(newEventCount)
 
 	storageAppendEvent(eventRecord)
 
@@ -0,0 +1,38 @@
+//go:build nonwasmenv
+
+package system
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DepositEventIndexed_Topics(t *testing.T) {
+	host.Reset()
+	StorageSetBlockNumber(1)
+	StorageSetExecutionPhase(types.NewExtrinsicPhaseApply(0))
+
+	topic := types.NewH256(sc.BytesToSequenceU8(hashing.Blake256([]byte("topic")))...)
+	key := append(append(hashing.Twox128(constants.KeySystem), hashing.Twox128(constants.KeyEventTopics)...), topic.Bytes()...)
+
+	depositEventIndexed([]types.H256{topic}, NewEventCodeUpdated())
+	depositEventIndexed([]types.H256{topic}, NewEventCodeUpdated())
+
+	expectation := sc.Sequence[sc.VaryingData]{
+		sc.NewVaryingData(types.BlockNumber(1), sc.U32(0)),
+		sc.NewVaryingData(types.BlockNumber(1), sc.U32(1)),
+	}
+	assert.Equal(t, expectation.Bytes(), storage.TakeBytes(key))
+
+	depositEventIndexed([]types.H256{topic}, NewEventCodeUpdated())
+	ResetEvents()
+
+	assert.Equal(t, int32(0), storage.Exists(key))
+}
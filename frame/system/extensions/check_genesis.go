@@ -1,24 +1,38 @@
 package system
 
 import (
+	"bytes"
+
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/frame/system"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
-type CheckGenesis struct{}
+// CheckGenesis ensures that the transaction is signed for the genesis hash of the chain.
+type CheckGenesis struct {
+	primitives.DefaultSignedExtension
+}
 
-func (_ CheckGenesis) AdditionalSigned() (ok primitives.H256, err primitives.TransactionValidityError) {
-	ok = primitives.H256(system.StorageGetBlockHash(sc.U32(0)))
-	return ok, err
+func (g CheckGenesis) Encode(_ *bytes.Buffer) {}
+
+func (g CheckGenesis) Decode(_ *bytes.Buffer) primitives.SignedExtension {
+	return CheckGenesis{}
 }
 
-func (_ CheckGenesis) Validate(_who *primitives.Address32, _call *primitives.Call, _info *primitives.DispatchInfo, _length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
-	ok = primitives.DefaultValidTransaction()
-	return ok, err
+func (g CheckGenesis) Bytes() []byte {
+	return sc.EncodedBytes(g)
 }
 
-func (g CheckGenesis) PreDispatch(who *primitives.Address32, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.Pre, err primitives.TransactionValidityError) {
-	_, err = g.Validate(who, call, info, length)
+func (g CheckGenesis) Identifier() sc.Str {
+	return "CheckGenesis"
+}
+
+func (g CheckGenesis) AdditionalSigned() (ok sc.Encodable, err primitives.TransactionValidityError) {
+	ok = primitives.H256(system.StorageGetBlockHash(sc.U32(0)))
 	return ok, err
 }
+
+func (g CheckGenesis) Metadata() primitives.MetadataSignedExtension {
+	return primitives.NewMetadataSignedExtension(g.Identifier(), metadata.CheckGenesis, metadata.TypesH256)
+}
@@ -1,14 +1,44 @@
 package system
 
 import (
+	"bytes"
+
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/frame/system"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
-func (e CheckMortality) AdditionalSigned() (ok primitives.H256, err primitives.TransactionValidityError) {
+// CheckMortality ensures that the transaction is valid only within its era and
+// is signed for the hash of the block, which starts the era.
+type CheckMortality struct {
+	primitives.DefaultSignedExtension
+	Era primitives.Era
+}
+
+func NewCheckMortality(era primitives.Era) CheckMortality {
+	return CheckMortality{Era: era}
+}
+
+func (e CheckMortality) Encode(buffer *bytes.Buffer) {
+	e.Era.Encode(buffer)
+}
+
+func (e CheckMortality) Decode(buffer *bytes.Buffer) primitives.SignedExtension {
+	return NewCheckMortality(primitives.DecodeEra(buffer))
+}
+
+func (e CheckMortality) Bytes() []byte {
+	return sc.EncodedBytes(e)
+}
+
+func (e CheckMortality) Identifier() sc.Str {
+	return "CheckMortality"
+}
+
+func (e CheckMortality) AdditionalSigned() (ok sc.Encodable, err primitives.TransactionValidityError) {
 	current := sc.U64(system.StorageGetBlockNumber()) // TODO: impl saturated_into::<u64>()
-	n := sc.U32(primitives.Era(e).Birth(current))     // TODO: impl saturated_into::<T::BlockNumber>()
+	n := sc.U32(e.Era.Birth(current))                 // TODO: impl saturated_into::<T::BlockNumber>()
 
 	if !system.StorageExistsBlockHash(n) {
 		err = primitives.NewTransactionValidityError(primitives.NewInvalidTransactionAncientBirthBlock())
@@ -20,13 +50,10 @@ func (e CheckMortality) AdditionalSigned() (ok primitives.H256, err primitives.T
 	return ok, err
 }
 
-// TODO: to be able to provide a custom implementation of the Validate function
-type CheckMortality primitives.Era
-
 func (e CheckMortality) Validate(_who *primitives.Address32, _call *primitives.Call, _info *primitives.DispatchInfo, _length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
 	currentU64 := sc.U64(system.StorageGetBlockNumber()) // TODO: per module implementation
 
-	validTill := primitives.Era(e).Death(currentU64)
+	validTill := e.Era.Death(currentU64)
 
 	ok = primitives.DefaultValidTransaction()
 	ok.Longevity = validTill.SaturatingSub(currentU64)
@@ -38,3 +65,7 @@ func (e CheckMortality) PreDispatch(who *primitives.Address32, call *primitives.
 	_, err = e.Validate(who, call, info, length)
 	return ok, err
 }
+
+func (e CheckMortality) Metadata() primitives.MetadataSignedExtension {
+	return primitives.NewMetadataSignedExtension(e.Identifier(), metadata.CheckMortality, metadata.TypesH256)
+}
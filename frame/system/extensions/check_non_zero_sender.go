@@ -1,27 +1,41 @@
 package system
 
 import (
+	"bytes"
 	"reflect"
 
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
 var ZeroAddress = primitives.NewAddress32(0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0)
 
-type CheckNonZeroAddress primitives.Address32
+// CheckNonZeroAddress ensures that the sender of the transaction is not the zero address.
+type CheckNonZeroAddress struct {
+	primitives.DefaultSignedExtension
+}
 
-func (a CheckNonZeroAddress) AdditionalSigned() (ok sc.Empty, err primitives.TransactionValidityError) {
-	ok = sc.Empty{}
-	return ok, err
+func (a CheckNonZeroAddress) Encode(_ *bytes.Buffer) {}
+
+func (a CheckNonZeroAddress) Decode(_ *bytes.Buffer) primitives.SignedExtension {
+	return CheckNonZeroAddress{}
+}
+
+func (a CheckNonZeroAddress) Bytes() []byte {
+	return sc.EncodedBytes(a)
 }
 
-func (who CheckNonZeroAddress) Validate(_who *primitives.Address32, _call *primitives.Call, _info *primitives.DispatchInfo, _length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
+func (a CheckNonZeroAddress) Identifier() sc.Str {
+	return "CheckNonZeroSender"
+}
+
+func (a CheckNonZeroAddress) Validate(who *primitives.Address32, _call *primitives.Call, _info *primitives.DispatchInfo, _length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
 	// TODO:
 	// Not sure when this is possible.
 	// Checks signed transactions but will fail
 	// before this check if the address is all zeros.
-	if !reflect.DeepEqual(who, ZeroAddress) {
+	if !reflect.DeepEqual(*who, ZeroAddress) {
 		ok = primitives.DefaultValidTransaction()
 		return ok, err
 	}
@@ -35,3 +49,7 @@ func (a CheckNonZeroAddress) PreDispatch(who *primitives.Address32, call *primit
 	_, err = a.Validate(who, call, info, length)
 	return ok, err
 }
+
+func (a CheckNonZeroAddress) Metadata() primitives.MetadataSignedExtension {
+	return primitives.NewMetadataSignedExtension(a.Identifier(), metadata.CheckNonZeroSender, metadata.TypesEmptyTuple)
+}
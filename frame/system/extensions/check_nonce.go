@@ -1,37 +1,58 @@
 package system
 
 import (
+	"bytes"
 	"math"
 
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/frame/system"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
-type CheckNonce sc.U32
+// CheckNonce ensures that the nonce of the transaction matches the nonce of the sender and increments it.
+type CheckNonce struct {
+	primitives.DefaultSignedExtension
+	Nonce sc.U32
+}
 
-func (n CheckNonce) AdditionalSigned() (ok sc.Empty, err primitives.TransactionValidityError) {
-	ok = sc.Empty{}
-	return ok, err
+func NewCheckNonce(nonce sc.U32) CheckNonce {
+	return CheckNonce{Nonce: nonce}
+}
+
+func (n CheckNonce) Encode(buffer *bytes.Buffer) {
+	sc.ToCompact(n.Nonce).Encode(buffer)
+}
+
+func (n CheckNonce) Decode(buffer *bytes.Buffer) primitives.SignedExtension {
+	return NewCheckNonce(sc.U32(sc.U128(sc.DecodeCompact(buffer)).ToBigInt().Uint64()))
+}
+
+func (n CheckNonce) Bytes() []byte {
+	return sc.EncodedBytes(n)
+}
+
+func (n CheckNonce) Identifier() sc.Str {
+	return "CheckNonce"
 }
 
 func (n CheckNonce) Validate(who *primitives.Address32, _call *primitives.Call, _info *primitives.DispatchInfo, _length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
 	// TODO: check if we can use just who
 	account := system.StorageGetAccount((*who).FixedSequence)
 
-	if sc.U32(n) < account.Nonce {
+	if n.Nonce < account.Nonce {
 		err = primitives.NewTransactionValidityError(primitives.NewInvalidTransactionStale())
 		return ok, err
 	}
 
 	encoded := (*who).Bytes()
-	encoded = append(encoded, sc.ToCompact(sc.U32(n)).Bytes()...)
+	encoded = append(encoded, sc.ToCompact(n.Nonce).Bytes()...)
 	provides := sc.Sequence[primitives.TransactionTag]{sc.BytesToSequenceU8(encoded)}
 
 	var requires sc.Sequence[primitives.TransactionTag]
-	if account.Nonce < sc.U32(n) {
+	if account.Nonce < n.Nonce {
 		encoded := (*who).Bytes()
-		encoded = append(encoded, sc.ToCompact(sc.U32(n)-1).Bytes()...)
+		encoded = append(encoded, sc.ToCompact(n.Nonce-1).Bytes()...)
 		requires = sc.Sequence[primitives.TransactionTag]{sc.BytesToSequenceU8(encoded)}
 	} else {
 		requires = sc.Sequence[primitives.TransactionTag]{}
@@ -51,8 +72,8 @@ func (n CheckNonce) Validate(who *primitives.Address32, _call *primitives.Call,
 func (n CheckNonce) PreDispatch(who *primitives.Address32, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.Pre, err primitives.TransactionValidityError) {
 	account := system.StorageGetAccount(who.FixedSequence)
 
-	if sc.U32(n) != account.Nonce {
-		if sc.U32(n) < account.Nonce {
+	if n.Nonce != account.Nonce {
+		if n.Nonce < account.Nonce {
 			err = primitives.NewTransactionValidityError(primitives.NewInvalidTransactionStale())
 		} else {
 			err = primitives.NewTransactionValidityError(primitives.NewInvalidTransactionFuture())
@@ -63,6 +84,10 @@ func (n CheckNonce) PreDispatch(who *primitives.Address32, call *primitives.Call
 	account.Nonce += 1
 	system.StorageSetAccount(who.FixedSequence, account)
 
-	ok = primitives.Pre{}
+	ok = sc.Empty{}
 	return ok, err
 }
+
+func (n CheckNonce) Metadata() primitives.MetadataSignedExtension {
+	return primitives.NewMetadataSignedExtension(n.Identifier(), metadata.CheckNonce, metadata.TypesEmptyTuple)
+}
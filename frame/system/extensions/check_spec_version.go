@@ -1,23 +1,37 @@
 package system
 
 import (
+	"bytes"
+
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
-type CheckSpecVersion struct{}
+// CheckSpecVersion ensures that the transaction is signed for the current spec version of the runtime.
+type CheckSpecVersion struct {
+	primitives.DefaultSignedExtension
+}
 
-func (_ CheckSpecVersion) AdditionalSigned() (ok sc.U32, err primitives.TransactionValidityError) {
-	return constants.RuntimeVersion.SpecVersion, err
+func (v CheckSpecVersion) Encode(_ *bytes.Buffer) {}
+
+func (v CheckSpecVersion) Decode(_ *bytes.Buffer) primitives.SignedExtension {
+	return CheckSpecVersion{}
 }
 
-func (_ CheckSpecVersion) Validate(_who *primitives.Address32, _call *primitives.Call, _info *primitives.DispatchInfo, _length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
-	ok = primitives.DefaultValidTransaction()
-	return ok, err
+func (v CheckSpecVersion) Bytes() []byte {
+	return sc.EncodedBytes(v)
+}
+
+func (v CheckSpecVersion) Identifier() sc.Str {
+	return "CheckSpecVersion"
+}
+
+func (v CheckSpecVersion) AdditionalSigned() (ok sc.Encodable, err primitives.TransactionValidityError) {
+	return constants.RuntimeVersion.SpecVersion, err
 }
 
-func (v CheckSpecVersion) PreDispatch(who *primitives.Address32, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.Pre, err primitives.TransactionValidityError) {
-	_, err = v.Validate(who, call, info, length)
-	return ok, err
+func (v CheckSpecVersion) Metadata() primitives.MetadataSignedExtension {
+	return primitives.NewMetadataSignedExtension(v.Identifier(), metadata.CheckSpecVersion, metadata.PrimitiveTypesU32)
 }
@@ -1,23 +1,37 @@
 package system
 
 import (
+	"bytes"
+
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
-type CheckTxVersion struct{}
+// CheckTxVersion ensures that the transaction is signed for the current transaction version of the runtime.
+type CheckTxVersion struct {
+	primitives.DefaultSignedExtension
+}
 
-func (_ CheckTxVersion) AdditionalSigned() (ok sc.U32, err primitives.TransactionValidityError) {
-	return constants.RuntimeVersion.TransactionVersion, err
+func (v CheckTxVersion) Encode(_ *bytes.Buffer) {}
+
+func (v CheckTxVersion) Decode(_ *bytes.Buffer) primitives.SignedExtension {
+	return CheckTxVersion{}
 }
 
-func (_ CheckTxVersion) Validate(_who *primitives.Address32, _call *primitives.Call, _info *primitives.DispatchInfo, _length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
-	ok = primitives.DefaultValidTransaction()
-	return ok, err
+func (v CheckTxVersion) Bytes() []byte {
+	return sc.EncodedBytes(v)
+}
+
+func (v CheckTxVersion) Identifier() sc.Str {
+	return "CheckTxVersion"
+}
+
+func (v CheckTxVersion) AdditionalSigned() (ok sc.Encodable, err primitives.TransactionValidityError) {
+	return constants.RuntimeVersion.TransactionVersion, err
 }
 
-func (v CheckTxVersion) PreDispatch(who *primitives.Address32, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.Pre, err primitives.TransactionValidityError) {
-	_, err = v.Validate(who, call, info, length)
-	return ok, err
+func (v CheckTxVersion) Metadata() primitives.MetadataSignedExtension {
+	return primitives.NewMetadataSignedExtension(v.Identifier(), metadata.CheckTxVersion, metadata.PrimitiveTypesU32)
 }
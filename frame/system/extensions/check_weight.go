@@ -1,17 +1,32 @@
 package system
 
 import (
+	"bytes"
+
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/frame/system"
 	"github.com/LimeChain/gosemble/primitives/log"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
-type CheckWeight primitives.Weight
+// CheckWeight ensures that the transaction fits into the block with respect to the block weight and length limits.
+type CheckWeight struct {
+	primitives.DefaultSignedExtension
+}
 
-func (_ CheckWeight) AdditionalSigned() (ok sc.Empty, err primitives.TransactionValidityError) {
-	ok = sc.Empty{}
-	return ok, err
+func (_ CheckWeight) Encode(_ *bytes.Buffer) {}
+
+func (_ CheckWeight) Decode(_ *bytes.Buffer) primitives.SignedExtension {
+	return CheckWeight{}
+}
+
+func (cw CheckWeight) Bytes() []byte {
+	return sc.EncodedBytes(cw)
+}
+
+func (_ CheckWeight) Identifier() sc.Str {
+	return "CheckWeight"
 }
 
 func (_ CheckWeight) Validate(_who *primitives.Address32, _call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.ValidTransaction, err primitives.TransactionValidityError) {
@@ -32,14 +47,18 @@ func (_ CheckWeight) PreDispatchUnsigned(_call *primitives.Call, info *primitive
 	return ok, err
 }
 
-func (_ CheckWeight) PostDispatch(_pre sc.Option[primitives.Pre], info *primitives.DispatchInfo, postInfo *primitives.PostDispatchInfo, _length sc.Compact, _result *primitives.DispatchResult) (primitives.Pre, primitives.TransactionValidityError) {
+func (_ CheckWeight) PostDispatch(_pre sc.Option[primitives.Pre], info *primitives.DispatchInfo, postInfo *primitives.PostDispatchInfo, _length sc.Compact, _result *primitives.DispatchResult) primitives.TransactionValidityError {
 	unspent := postInfo.CalcUnspent(info)
 	if unspent.AnyGt(primitives.WeightZero()) {
 		currentWeight := system.StorageGetBlockWeight()
 		currentWeight.Reduce(unspent, info.Class)
 		system.StorageSetBlockWeight(currentWeight)
 	}
-	return primitives.Pre{}, nil
+	return nil
+}
+
+func (cw CheckWeight) Metadata() primitives.MetadataSignedExtension {
+	return primitives.NewMetadataSignedExtension(cw.Identifier(), metadata.CheckWeight, metadata.TypesEmptyTuple)
 }
 
 // Do the validate checks. This can be applied to both signed and unsigned.
@@ -5,19 +5,28 @@ import (
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/metadata"
 	cs "github.com/LimeChain/gosemble/constants/system"
+	"github.com/LimeChain/gosemble/frame/support"
 	"github.com/LimeChain/gosemble/frame/system"
 	"github.com/LimeChain/gosemble/frame/system/dispatchables"
+	"github.com/LimeChain/gosemble/frame/system/errors"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
 type SystemModule struct {
+	primitives.DefaultHooks
 	functions map[sc.U8]primitives.Call
-	// TODO: add more dispatchables
 }
 
 func NewSystemModule() SystemModule {
 	functions := make(map[sc.U8]primitives.Call)
 	functions[cs.FunctionRemarkIndex] = dispatchables.NewRemarkCall(nil)
+	functions[cs.FunctionSetHeapPagesIndex] = dispatchables.NewSetHeapPagesCall(nil)
+	functions[cs.FunctionSetCodeIndex] = dispatchables.NewSetCodeCall(nil)
+	functions[cs.FunctionSetCodeWithoutChecksIndex] = dispatchables.NewSetCodeWithoutChecksCall(nil)
+	functions[cs.FunctionSetStorageIndex] = dispatchables.NewSetStorageCall(nil)
+	functions[cs.FunctionKillStorageIndex] = dispatchables.NewKillStorageCall(nil)
+	functions[cs.FunctionKillPrefixIndex] = dispatchables.NewKillPrefixCall(nil)
+	functions[cs.FunctionRemarkWithEventIndex] = dispatchables.NewRemarkWithEventCall(nil)
 
 	return SystemModule{
 		functions: functions,
@@ -37,144 +46,73 @@ func (sm SystemModule) ValidateUnsigned(_ primitives.TransactionSource, _ primit
 }
 
 func (sm SystemModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
-	metadataModule := primitives.MetadataModule{
-		Name: "System",
-		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
-			Prefix: "System",
-			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
-				primitives.NewMetadataModuleStorageEntry(
-					"Account",
-					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionMap(
-						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
-						sc.ToCompact(metadata.TypesAddress32),
-						sc.ToCompact(metadata.TypesAccountInfo)),
-					"The full account information for a particular account ID."),
-				primitives.NewMetadataModuleStorageEntry(
-					"ExtrinsicCount",
-					primitives.MetadataModuleStorageEntryModifierOptional,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(
-						sc.ToCompact(metadata.PrimitiveTypesU32)),
-					"Total extrinsics count for the current block."),
-				primitives.NewMetadataModuleStorageEntry(
-					"BlockWeight",
-					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(
-						sc.ToCompact(metadata.TypesPerDispatchClassWeight)),
-					"The current weight for the block."),
-				primitives.NewMetadataModuleStorageEntry(
-					"AllExtrinsicsLen",
-					primitives.MetadataModuleStorageEntryModifierOptional,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(
-						sc.ToCompact(metadata.PrimitiveTypesU32)),
-					"Total length (in bytes) for all extrinsics put together, for the current block."),
-				primitives.NewMetadataModuleStorageEntry(
-					"BlockHash",
-					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionMap(
-						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
-						sc.ToCompact(metadata.PrimitiveTypesU32),
-						sc.ToCompact(metadata.TypesFixedSequence32U8)),
-					"Map of block numbers to block hashes."),
-				primitives.NewMetadataModuleStorageEntry(
-					"ExtrinsicData",
-					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionMap(
-						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiXX64},
-						sc.ToCompact(metadata.PrimitiveTypesU32),
-						sc.ToCompact(metadata.TypesSequenceU8)),
-					"Extrinsics data for the current block (maps an extrinsic's index to its data)."),
-				primitives.NewMetadataModuleStorageEntry(
-					"Number",
-					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(
-						sc.ToCompact(metadata.PrimitiveTypesU32)),
-					"The current block number being processed. Set by `execute_block`."),
-				primitives.NewMetadataModuleStorageEntry(
-					"ParentHash",
-					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(
-						sc.ToCompact(metadata.TypesFixedSequence32U8)),
-					"Hash of the previous block."),
-				primitives.NewMetadataModuleStorageEntry(
-					"Digest",
-					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(
-						sc.ToCompact(metadata.TypesDigest)),
-					"Digest of the current block, also part of the block header."),
-				primitives.NewMetadataModuleStorageEntry(
-					"Events",
-					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesSystemEventStorage)),
-					"Events deposited for the current block.   NOTE: The item is unbound and should therefore never be read on chain."),
-				primitives.NewMetadataModuleStorageEntry(
-					"EventTopics",
-					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionMap(
-						sc.Sequence[primitives.MetadataModuleStorageHashFunc]{primitives.MetadataModuleStorageHashFuncMultiBlake128Concat},
-						sc.ToCompact(metadata.TypesH256),
-						sc.ToCompact(metadata.TypesVecBlockNumEventIndex)), "Mapping between a topic (represented by T::Hash) and a vector of indexes  of events in the `<Events<T>>` list."),
-				primitives.NewMetadataModuleStorageEntry(
-					"EventCount",
-					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(
-						sc.ToCompact(metadata.PrimitiveTypesU32)),
-					"The number of events in the `Events<T>` list."),
-				primitives.NewMetadataModuleStorageEntry(
-					"LastRuntimeUpgrade",
-					primitives.MetadataModuleStorageEntryModifierOptional,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesLastRuntimeUpgradeInfo)),
-					"Stores the `spec_version` and `spec_name` of when the last runtime upgrade happened."),
-				primitives.NewMetadataModuleStorageEntry(
-					"ExecutionPhase",
-					primitives.MetadataModuleStorageEntryModifierOptional,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesPhase)),
-					"The execution phase of the block."),
+	declaredTypes, metadataModule := sm.declaration().Metadata()
+
+	return append(sm.metadataTypes(), declaredTypes...), metadataModule
+}
+
+// declaration declares the storage, calls, events, errors and constants of the module,
+// from which its metadata is derived.
+func (sm SystemModule) declaration() support.ModuleDeclaration {
+	return support.ModuleDeclaration{
+		Name:    "System",
+		Index:   cs.ModuleIndex,
+		Path:    "frame_system",
+		Storage: system.StorageDeclarations(),
+		Calls: &support.EnumDeclaration{
+			TypeId:   metadata.SystemCalls,
+			Variants: callDeclarations,
+		},
+		Events: &support.EnumDeclaration{
+			TypeId:   metadata.TypesSystemEvent,
+			Variants: eventDeclarations,
+		},
+		Errors: &support.EnumDeclaration{
+			TypeId:   metadata.TypesSystemErrors,
+			Variants: errorDeclarations,
+		},
+		Constants: []support.ConstantDeclaration{
+			{
+				Name:  "BlockWeights",
+				Value: system.DefaultBlockWeights(),
+				Docs:  "Block & extrinsics weights: base values and limits.",
+			},
+			{
+				Name:  "BlockLength",
+				Value: system.DefaultBlockLength(),
+				Docs:  "The maximum length of a block (in bytes).",
+			},
+			{
+				Name:  "BlockHashCount",
+				Value: constants.BlockHashCount,
+				Docs:  "Maximum number of block number to block hash mappings to keep (oldest pruned first).",
+			},
+			{
+				Name:  "DbWeight",
+				Value: constants.DbWeight,
+				Docs:  "The weight of runtime database operations the runtime can invoke.",
+			},
+			{
+				Name:  "Version",
+				Value: constants.RuntimeVersion,
+				Docs:  "Get the chain's current version.",
 			},
-		}),
-		Call:  sc.NewOption[sc.Compact](sc.ToCompact(metadata.SystemCalls)),
-		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesSystemEvent)),
-		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
-			primitives.NewMetadataModuleConstant(
-				"BlockWeights",
-				sc.ToCompact(metadata.TypesBlockWeights),
-				sc.BytesToSequenceU8(system.DefaultBlockWeights().Bytes()),
-				"Block & extrinsics weights: base values and limits.",
-			),
-			primitives.NewMetadataModuleConstant(
-				"BlockLength",
-				sc.ToCompact(metadata.TypesBlockLength),
-				sc.BytesToSequenceU8(system.DefaultBlockLength().Bytes()),
-				"The maximum length of a block (in bytes).",
-			),
-			primitives.NewMetadataModuleConstant(
-				"BlockHashCount",
-				sc.ToCompact(metadata.PrimitiveTypesU32),
-				sc.BytesToSequenceU8(constants.BlockHashCount.Bytes()),
-				"Maximum number of block number to block hash mappings to keep (oldest pruned first).",
-			),
-			primitives.NewMetadataModuleConstant(
-				"DbWeight",
-				sc.ToCompact(metadata.TypesDbWeight),
-				sc.BytesToSequenceU8(constants.DbWeight.Bytes()),
-				"The weight of runtime database operations the runtime can invoke.",
-			),
-			primitives.NewMetadataModuleConstant(
-				"Version",
-				sc.ToCompact(metadata.TypesRuntimeVersion),
-				sc.BytesToSequenceU8(constants.RuntimeVersion.Bytes()),
-				"Get the chain's current version.",
-			),
 		},
-		Error: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesSystemErrors)),
-		Index: cs.ModuleIndex,
 	}
-
-	return sm.metadataTypes(), metadataModule
 }
 
 func (sm SystemModule) metadataTypes() sc.Sequence[primitives.MetadataType] {
 	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataTypeWithPath(metadata.TypesAccountInfo, "AccountInfo", sc.Sequence[sc.Str]{"frame_system", "AccountInfo"}, primitives.NewMetadataTypeDefinitionComposite(
+			sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "nonce", "Index"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "consumers", "RefCount"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "providers", "RefCount"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "sufficients", "RefCount"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAccountData, "data", "AccountData"),
+			},
+		)),
+
 		primitives.NewMetadataTypeWithPath(metadata.TypesPhase,
 			"frame_system Phase",
 			sc.Sequence[sc.Str]{"frame_system", "Phase"},
@@ -263,54 +201,6 @@ func (sm SystemModule) metadataTypes() sc.Sequence[primitives.MetadataType] {
 				primitives.NewMetadataTypeParameter(metadata.TypesRuntimeEvent, "E"),
 				primitives.NewMetadataTypeParameter(metadata.TypesH256, "T"),
 			}),
-		primitives.NewMetadataTypeWithPath(metadata.TypesSystemEvent,
-			"frame_system pallet Event",
-			sc.Sequence[sc.Str]{"frame_system", "pallet", "Event"}, primitives.NewMetadataTypeDefinitionVariant(
-				sc.Sequence[primitives.MetadataDefinitionVariant]{
-					primitives.NewMetadataDefinitionVariant(
-						"ExtrinsicSuccess",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{
-							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesDispatchInfo, "dispatch_info", "DispatchInfo"),
-						},
-						system.EventExtrinsicSuccess,
-						"Event.ExtrinsicSuccess"),
-					primitives.NewMetadataDefinitionVariant(
-						"ExtrinsicFailed",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{
-							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesDispatchError, "dispatch_error", "DispatchError"),
-							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesDispatchInfo, "dispatch_info", "DispatchInfo"),
-						},
-						system.EventExtrinsicFailed,
-						"Events.ExtrinsicFailed"),
-					primitives.NewMetadataDefinitionVariant(
-						"CodeUpdated",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						system.EventCodeUpdated,
-						"Events.CodeUpdated"),
-					primitives.NewMetadataDefinitionVariant(
-						"NewAccount",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{
-							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "account", "T::AccountId"),
-						},
-						system.EventNewAccount,
-						"Events.NewAccount"),
-					primitives.NewMetadataDefinitionVariant(
-						"KilledAccount",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{
-							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "account", "T::AccountId"),
-						},
-						system.EventKilledAccount,
-						"Events.KilledAccount"),
-					primitives.NewMetadataDefinitionVariant(
-						"Remarked",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{
-							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "sender", "T::AccountId"),
-							primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "hash", "T::Hash"),
-						},
-						system.EventRemarked,
-						"Events.Remarked"),
-				})),
-
 		primitives.NewMetadataTypeWithPath(metadata.TypesLastRuntimeUpgradeInfo,
 			"LastRuntimeUpgradeInfo",
 			sc.Sequence[sc.Str]{"frame_system", "LastRuntimeUpgradeInfo"}, primitives.NewMetadataTypeDefinitionComposite(
@@ -319,56 +209,12 @@ func (sm SystemModule) metadataTypes() sc.Sequence[primitives.MetadataType] {
 					primitives.NewMetadataTypeDefinitionField(metadata.PrimitiveTypesString),
 				})),
 
-		primitives.NewMetadataTypeWithPath(metadata.TypesSystemErrors,
-			"frame_system pallet Error",
-			sc.Sequence[sc.Str]{"frame_system", "pallet", "Error"}, primitives.NewMetadataTypeDefinitionVariant(
-				sc.Sequence[primitives.MetadataDefinitionVariant]{
-					primitives.NewMetadataDefinitionVariant(
-						"InvalidSpecName",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						0,
-						"The name of specification does not match between the current runtime and the new runtime."),
-					primitives.NewMetadataDefinitionVariant(
-						"SpecVersionNeedsToIncrease",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						1,
-						"The specification version is not allowed to decrease between the current runtime and the new runtime."),
-					primitives.NewMetadataDefinitionVariant(
-						"FailedToExtractRuntimeVersion",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						2,
-						"Failed to extract the runtime version from the new runtime.  Either calling `Core_version` or decoding `RuntimeVersion` failed."),
-					primitives.NewMetadataDefinitionVariant(
-						"NonDefaultComposite",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						3,
-						"Suicide called when the account has non-default composite data."),
-					primitives.NewMetadataDefinitionVariant(
-						"NonZeroRefCount",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						4,
-						"There is a non-zero reference count preventing the account from being purged."),
-					primitives.NewMetadataDefinitionVariant(
-						"CallFiltered",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
-						5,
-						"The origin filter prevent the call to be dispatched."),
-				})),
-
-		primitives.NewMetadataTypeWithParam(metadata.SystemCalls,
-			"System calls",
-			sc.Sequence[sc.Str]{"frame_system", "pallet", "Call"},
-			primitives.NewMetadataTypeDefinitionVariant(
-				sc.Sequence[primitives.MetadataDefinitionVariant]{
-					primitives.NewMetadataDefinitionVariant(
-						"remark",
-						sc.Sequence[primitives.MetadataTypeDefinitionField]{
-							primitives.NewMetadataTypeDefinitionField(metadata.TypesSequenceU8),
-						},
-						cs.FunctionRemarkIndex,
-						"Make some on-chain remark."),
-				}),
-			primitives.NewMetadataEmptyTypeParameter("T")),
+		primitives.NewMetadataType(metadata.TypesKeyValue, "(Vec<u8>, Vec<u8>)",
+			primitives.NewMetadataTypeDefinitionTuple(sc.Sequence[sc.Compact]{sc.ToCompact(metadata.TypesSequenceU8), sc.ToCompact(metadata.TypesSequenceU8)})),
+		primitives.NewMetadataType(metadata.TypesSequenceKeyValue, "Vec<KeyValue>",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesKeyValue))),
+		primitives.NewMetadataType(metadata.TypesSequenceSequenceU8, "Vec<Vec<u8>>",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.TypesSequenceU8))),
 
 		primitives.NewMetadataTypeWithPath(metadata.CheckNonZeroSender, "CheckNonZeroSender", sc.Sequence[sc.Str]{"frame_system", "extensions", "check_non_zero_sender", "CheckNonZeroSender"},
 			primitives.NewMetadataTypeDefinitionComposite(
@@ -388,3 +234,154 @@ func (sm SystemModule) metadataTypes() sc.Sequence[primitives.MetadataType] {
 		primitives.NewMetadataTypeWithPath(metadata.TypesEra, "Era", sc.Sequence[sc.Str]{"sp_runtime", "generic", "era", "Era"}, primitives.NewMetadataTypeDefinitionVariant(primitives.EraTypeDefinition())),
 	}
 }
+
+var callDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "remark",
+		Index: cs.FunctionRemarkIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionField(metadata.TypesSequenceU8),
+		},
+		Docs: "Make some on-chain remark.",
+	},
+	{
+		Name:  "set_heap_pages",
+		Index: cs.FunctionSetHeapPagesIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU64, "pages", "u64"),
+		},
+		Docs: "Set the number of pages in the WebAssembly environment's heap.",
+	},
+	{
+		Name:  "set_code",
+		Index: cs.FunctionSetCodeIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceU8, "code", "Vec<u8>"),
+		},
+		Docs: "Set the new runtime code.",
+	},
+	{
+		Name:  "set_code_without_checks",
+		Index: cs.FunctionSetCodeWithoutChecksIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceU8, "code", "Vec<u8>"),
+		},
+		Docs: "Set the new runtime code without doing any checks of the given `code`.",
+	},
+	{
+		Name:  "set_storage",
+		Index: cs.FunctionSetStorageIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceKeyValue, "items", "Vec<KeyValue>"),
+		},
+		Docs: "Set some items of storage.",
+	},
+	{
+		Name:  "kill_storage",
+		Index: cs.FunctionKillStorageIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceSequenceU8, "keys", "Vec<Key>"),
+		},
+		Docs: "Kill some items from storage.",
+	},
+	{
+		Name:  "kill_prefix",
+		Index: cs.FunctionKillPrefixIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceU8, "prefix", "Key"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "subkeys", "u32"),
+		},
+		Docs: "Kill all storage items with a key that starts with the given prefix.",
+	},
+	{
+		Name:  "remark_with_event",
+		Index: cs.FunctionRemarkWithEventIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceU8, "remark", "Vec<u8>"),
+		},
+		Docs: "Make some on-chain remark and emit event.",
+	},
+}
+
+var eventDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "ExtrinsicSuccess",
+		Index: system.EventExtrinsicSuccess,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesDispatchInfo, "dispatch_info", "DispatchInfo"),
+		},
+		Docs: "Event.ExtrinsicSuccess",
+	},
+	{
+		Name:  "ExtrinsicFailed",
+		Index: system.EventExtrinsicFailed,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesDispatchError, "dispatch_error", "DispatchError"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesDispatchInfo, "dispatch_info", "DispatchInfo"),
+		},
+		Docs: "Events.ExtrinsicFailed",
+	},
+	{
+		Name:  "CodeUpdated",
+		Index: system.EventCodeUpdated,
+		Docs:  "Events.CodeUpdated",
+	},
+	{
+		Name:  "NewAccount",
+		Index: system.EventNewAccount,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "account", "T::AccountId"),
+		},
+		Docs: "Events.NewAccount",
+	},
+	{
+		Name:  "KilledAccount",
+		Index: system.EventKilledAccount,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "account", "T::AccountId"),
+		},
+		Docs: "Events.KilledAccount",
+	},
+	{
+		Name:  "Remarked",
+		Index: system.EventRemarked,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "sender", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesH256, "hash", "T::Hash"),
+		},
+		Docs: "Events.Remarked",
+	},
+}
+
+var errorDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "InvalidSpecName",
+		Index: errors.ErrorInvalidSpecName,
+		Docs:  "The name of specification does not match between the current runtime and the new runtime.",
+	},
+	{
+		Name:  "SpecVersionNeedsToIncrease",
+		Index: errors.ErrorSpecVersionNeedsToIncrease,
+		Docs:  "The specification version is not allowed to decrease between the current runtime and the new runtime.",
+	},
+	{
+		Name:  "FailedToExtractRuntimeVersion",
+		Index: errors.ErrorFailedToExtractRuntimeVersion,
+		Docs:  "Failed to extract the runtime version from the new runtime.  Either calling `Core_version` or decoding `RuntimeVersion` failed.",
+	},
+	{
+		Name:  "NonDefaultComposite",
+		Index: errors.ErrorNonDefaultComposite,
+		Docs:  "Suicide called when the account has non-default composite data.",
+	},
+	{
+		Name:  "NonZeroRefCount",
+		Index: errors.ErrorNonZeroRefCount,
+		Docs:  "There is a non-zero reference count preventing the account from being purged.",
+	},
+	{
+		Name:  "CallFiltered",
+		Index: errors.ErrorCallFiltered,
+		Docs:  "The origin filter prevent the call to be dispatched.",
+	},
+}
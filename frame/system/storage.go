@@ -1,200 +1,230 @@
 package system
 
 import (
+	"bytes"
+
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
-	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
 	"github.com/LimeChain/gosemble/primitives/storage"
 	"github.com/LimeChain/gosemble/primitives/types"
 )
 
+var (
+	storageAccount          = support.NewStorageMap[types.PublicKey, types.AccountInfo](constants.KeySystem, constants.KeyAccount, support.Blake2_128Concat{}, types.DecodePublicKey, types.DecodeAccountInfo)
+	storageExtrinsicCount   = support.NewStorageValue[sc.U32](constants.KeySystem, constants.KeyExtrinsicCount, sc.DecodeU32)
+	storageBlockWeight      = support.NewStorageValue[types.ConsumedWeight](constants.KeySystem, constants.KeyBlockWeight, types.DecodeConsumedWeight)
+	storageAllExtrinsicsLen = support.NewStorageValue[sc.U32](constants.KeySystem, constants.KeyAllExtrinsicsLen, sc.DecodeU32)
+	storageBlockHash        = support.NewStorageMap[sc.U32, types.Blake2bHash](constants.KeySystem, constants.KeyBlockHash, support.Twox64Concat{}, sc.DecodeU32, types.DecodeBlake2bHash)
+	storageExtrinsicData    = support.NewStorageMap[sc.U32, sc.Sequence[sc.U8]](constants.KeySystem, constants.KeyExtrinsicData, support.Twox64Concat{}, sc.DecodeU32, sc.DecodeSequence[sc.U8])
+	storageNumber           = support.NewStorageValue[types.BlockNumber](constants.KeySystem, constants.KeyNumber, sc.DecodeU32)
+	storageParentHash       = support.NewStorageValue[types.Blake2bHash](constants.KeySystem, constants.KeyParentHash, types.DecodeBlake2bHash)
+	storageDigest           = support.NewStorageValue[types.Digest](constants.KeySystem, constants.KeyDigest, types.DecodeDigest)
+	// Events are only appended and cleared, they are never read on chain.
+	storageEvents             = support.NewStorageValue[sc.Sequence[types.EventRecord]](constants.KeySystem, constants.KeyEvents, nil)
+	storageEventTopics        = support.NewStorageMap[types.H256, sc.Sequence[sc.VaryingData]](constants.KeySystem, constants.KeyEventTopics, support.Identity{}, types.DecodeH256, decodeEventTopicValues)
+	storageEventCount         = support.NewStorageValue[sc.U32](constants.KeySystem, constants.KeyEventCount, sc.DecodeU32)
+	storageLastRuntimeUpgrade = support.NewStorageValue[types.LastRuntimeUpgradeInfo](constants.KeySystem, constants.KeyLastRuntimeUpgrade, types.DecodeLastRuntimeUpgradeInfo)
+	storageExecutionPhase     = support.NewStorageValue[types.ExtrinsicPhase](constants.KeySystem, constants.KeyExecutionPhase, types.DecodeExtrinsicPhase)
+)
+
+func init() {
+	support.RegisterTypeId(metadata.TypesBlockWeights, support.TypeOf[BlockWeights]())
+	support.RegisterTypeId(metadata.TypesBlockLength, support.TypeOf[BlockLength]())
+}
+
+// StorageDeclarations returns the declarations of the storage items of the module, in the order of the metadata.
+func StorageDeclarations() []support.StorageDeclaration {
+	return []support.StorageDeclaration{
+		{
+			Item:     storageAccount,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			KeyType:  support.TypeId(metadata.TypesAddress32),
+			Docs:     "The full account information for a particular account ID.",
+		},
+		{
+			Item:     storageExtrinsicCount,
+			Modifier: types.MetadataModuleStorageEntryModifierOptional,
+			Docs:     "Total extrinsics count for the current block.",
+		},
+		{
+			Item:     storageBlockWeight,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "The current weight for the block.",
+		},
+		{
+			Item:     storageAllExtrinsicsLen,
+			Modifier: types.MetadataModuleStorageEntryModifierOptional,
+			Docs:     "Total length (in bytes) for all extrinsics put together, for the current block.",
+		},
+		{
+			Item:     storageBlockHash,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "Map of block numbers to block hashes.",
+		},
+		{
+			Item:     storageExtrinsicData,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "Extrinsics data for the current block (maps an extrinsic's index to its data).",
+		},
+		{
+			Item:     storageNumber,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "The current block number being processed. Set by `execute_block`.",
+		},
+		{
+			Item:     storageParentHash,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "Hash of the previous block.",
+		},
+		{
+			Item:     storageDigest,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "Digest of the current block, also part of the block header.",
+		},
+		{
+			Item:     storageEvents,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "Events deposited for the current block.   NOTE: The item is unbound and should therefore never be read on chain.",
+		},
+		{
+			Item:      storageEventTopics,
+			Modifier:  types.MetadataModuleStorageEntryModifierDefault,
+			ValueType: support.TypeId(metadata.TypesVecBlockNumEventIndex),
+			Docs:      "Mapping between a topic (represented by T::Hash) and a vector of indexes  of events in the `<Events<T>>` list.",
+		},
+		{
+			Item:     storageEventCount,
+			Modifier: types.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "The number of events in the `Events<T>` list.",
+		},
+		{
+			Item:     storageLastRuntimeUpgrade,
+			Modifier: types.MetadataModuleStorageEntryModifierOptional,
+			Docs:     "Stores the `spec_version` and `spec_name` of when the last runtime upgrade happened.",
+		},
+		{
+			Item:      storageExecutionPhase,
+			Modifier:  types.MetadataModuleStorageEntryModifierOptional,
+			ValueType: support.TypeId(metadata.TypesPhase),
+			Docs:      "The execution phase of the block.",
+		},
+	}
+}
+
 // StorageGetBlockNumber returns the current block number being processed. Set by `execute_block`.
 func StorageGetBlockNumber() types.BlockNumber {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	numberHash := hashing.Twox128(constants.KeyNumber)
-	return storage.GetDecode(append(systemHash, numberHash...), sc.DecodeU32)
+	return storageNumber.Get()
 }
 
 func StorageSetBlockNumber(number types.BlockNumber) {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	numberHash := hashing.Twox128(constants.KeyNumber)
-	storage.Set(append(systemHash, numberHash...), number.Bytes())
+	storageNumber.Put(number)
 }
 
 // StorageGetAllExtrinsicsLen returns the total length (in bytes) for all extrinsics put together, for the current block.
 func StorageGetAllExtrinsicsLen() sc.U32 {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	allExtrinsicsLenHash := hashing.Twox128(constants.KeyAllExtrinsicsLen)
-	return storage.GetDecode(append(systemHash, allExtrinsicsLenHash...), sc.DecodeU32)
+	return storageAllExtrinsicsLen.Get()
 }
 
 func StorageSetAllExtrinsicsLen(length sc.U32) {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	allExtrinsicsLenHash := hashing.Twox128(constants.KeyAllExtrinsicsLen)
-	storage.Set(append(systemHash, allExtrinsicsLenHash...), length.Bytes())
+	storageAllExtrinsicsLen.Put(length)
 }
 
 func StorageClearAllExtrinsicsLength() {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	allExtrinsicsLenHash := hashing.Twox128(constants.KeyAllExtrinsicsLen)
-	storage.Clear(append(systemHash, allExtrinsicsLenHash...))
+	storageAllExtrinsicsLen.Clear()
 }
 
 func StorageGetExtrinsicCount(clear sc.Bool) sc.U32 {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	extrinsicCountHash := hashing.Twox128(constants.KeyExtrinsicCount)
 	if clear {
-		return storage.TakeDecode(append(systemHash, extrinsicCountHash...), sc.DecodeU32)
+		return storageExtrinsicCount.TakeExact()
 	} else {
-		return storage.GetDecode(append(systemHash, extrinsicCountHash...), sc.DecodeU32)
+		return storageExtrinsicCount.Get()
 	}
 }
 
 func StorageSetExtrinsicCount(extrinsicIndex sc.U32) {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	extrinsicCountHash := hashing.Twox128(constants.KeyExtrinsicCount)
-	storage.Set(append(systemHash, extrinsicCountHash...), extrinsicIndex.Bytes())
+	storageExtrinsicCount.Put(extrinsicIndex)
 }
 
 func StorageGetAccount(who types.PublicKey) types.AccountInfo {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	accountHash := hashing.Twox128(constants.KeyAccount)
-
-	whoBytes := sc.FixedSequenceU8ToBytes(who)
-
-	key := append(systemHash, accountHash...)
-	key = append(key, hashing.Blake128(whoBytes)...)
-	key = append(key, whoBytes...)
-
-	return storage.GetDecode(key, types.DecodeAccountInfo)
+	return storageAccount.Get(who)
 }
 
 func StorageSetAccount(who types.PublicKey, account types.AccountInfo) {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	accountHash := hashing.Twox128(constants.KeyAccount)
-
-	whoBytes := sc.FixedSequenceU8ToBytes(who)
+	storageAccount.Put(who, account)
+}
 
-	key := append(systemHash, accountHash...)
-	key = append(key, hashing.Blake128(whoBytes)...)
-	key = append(key, whoBytes...)
+func StorageRemoveAccount(who types.PublicKey) {
+	storageAccount.Remove(who)
+}
 
-	storage.Set(key, account.Bytes())
+// StorageIterateAccounts calls f with each account and its information, until f returns false.
+func StorageIterateAccounts(f func(who types.PublicKey, account types.AccountInfo) bool) {
+	storageAccount.Iterate(f)
 }
 
 // Map of block numbers to block hashes.
 func StorageGetBlockHash(blockNumber sc.U32) types.Blake2bHash {
-	// Module prefix
-	systemHash := hashing.Twox128(constants.KeySystem)
-	// Storage prefix
-	blockHashHash := hashing.Twox128(constants.KeyBlockHash)
-	// Block number hash
-	blockNumHash := hashing.Twox64(blockNumber.Bytes())
-
-	key := append(systemHash, blockHashHash...)
-	key = append(key, blockNumHash...)
-	key = append(key, blockNumber.Bytes()...)
-
-	return storage.GetDecode(key, types.DecodeBlake2bHash)
+	return storageBlockHash.Get(blockNumber)
 }
 
 func StorageSetBlockHash(blockNumber sc.U32, hash types.Blake2bHash) {
-	// Module prefix
-	systemHash := hashing.Twox128(constants.KeySystem)
-	// Storage prefix
-	blockHashHash := hashing.Twox128(constants.KeyBlockHash)
-	// Block number hash
-	blockNumHash := hashing.Twox64(blockNumber.Bytes())
-
-	key := append(systemHash, blockHashHash...)
-	key = append(key, blockNumHash...)
-	key = append(key, blockNumber.Bytes()...)
-
-	storage.Set(key, hash.Bytes())
+	storageBlockHash.Put(blockNumber, hash)
 }
 
 // Map of block numbers to block hashes.
 func StorageExistsBlockHash(blockNumber sc.U32) sc.Bool {
-	// Module prefix
-	systemHash := hashing.Twox128(constants.KeySystem)
-	// Storage prefix
-	blockHashHash := hashing.Twox128(constants.KeyBlockHash)
-	// Block number hash
-	blockNumHash := hashing.Twox64(blockNumber.Bytes())
-
-	key := append(systemHash, blockHashHash...)
-	key = append(key, blockNumHash...)
-	key = append(key, blockNumber.Bytes()...)
-
-	return storage.Exists(key) == 1
+	return sc.Bool(storageBlockHash.Contains(blockNumber))
 }
 
-func StorageExecutionPhase() types.ExtrinsicPhase {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	executionPhaseHash := hashing.Twox128(constants.KeyExecutionPhase)
-	return storage.GetDecode(append(systemHash, executionPhaseHash...), types.DecodeExtrinsicPhase)
+func StorageRemoveBlockHash(blockNumber sc.U32) {
+	storageBlockHash.Remove(blockNumber)
 }
 
-func StorageSetExecutionPhase(phase types.ExtrinsicPhase) {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	executionPhaseHash := hashing.Twox128(constants.KeyExecutionPhase)
-	storage.Set(append(systemHash, executionPhaseHash...), phase.Bytes())
+// StorageSetExtrinsicData stores the encoded extrinsic under its index in the current block.
+func StorageSetExtrinsicData(index sc.U32, extrinsic sc.Sequence[sc.U8]) {
+	storageExtrinsicData.Put(index, extrinsic)
 }
 
-func StorageClearExecutionPhase() {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	executionPhaseHash := hashing.Twox128(constants.KeyExecutionPhase)
-	storage.Clear(append(systemHash, executionPhaseHash...))
+// StorageTakeExtrinsicData removes the encoded extrinsic stored under its index in the current block and returns it.
+func StorageTakeExtrinsicData(index sc.U32) sc.Sequence[sc.U8] {
+	return storageExtrinsicData.Take(index)
 }
 
-func storageEventCount() sc.U32 {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	eventCountHash := hashing.Twox128(constants.KeyEventCount)
+func StorageExecutionPhase() types.ExtrinsicPhase {
+	return storageExecutionPhase.Get()
+}
 
-	key := append(systemHash, eventCountHash...)
-	return storage.GetDecode(key, sc.DecodeU32)
+func StorageSetExecutionPhase(phase types.ExtrinsicPhase) {
+	storageExecutionPhase.Put(phase)
 }
 
-func storageSetEventCount(eventCount sc.U32) {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	eventCountHash := hashing.Twox128(constants.KeyEventCount)
-	key := append(systemHash, eventCountHash...)
-	storage.Set(key, eventCount.Bytes())
+func StorageClearExecutionPhase() {
+	storageExecutionPhase.Clear()
 }
 
 func storageAppendEvent(eventRecord types.EventRecord) {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	key := append(systemHash, hashing.Twox128(constants.KeyEvents)...)
-	storage.Append(key, eventRecord.Bytes())
+	storageEvents.Append(eventRecord)
 }
 
+// storageAppendTopic appends the event index to the topic. Topics are already hashes,
+// so they are stored under the prefix of the map as is.
 func storageAppendTopic(topic types.H256, value sc.VaryingData) {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	eventTopicsHash := hashing.Twox128(constants.KeyEventTopics)
-
-	eventTopicsPrefix := append(systemHash, eventTopicsHash...)
-
-	key := append(eventTopicsPrefix, topic.Bytes()...)
-	storage.Append(key, value.Bytes())
+	storageEventTopics.Append(topic, value)
 }
 
 // block weight
 func StorageGetBlockWeight() types.ConsumedWeight {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	blockWeightHash := hashing.Twox128(constants.KeyBlockWeight)
-	return storage.GetDecode(append(systemHash, blockWeightHash...), types.DecodeConsumedWeight)
+	return storageBlockWeight.Get()
 }
 
 func StorageSetBlockWeight(weight types.ConsumedWeight) {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	blockWeightHash := hashing.Twox128(constants.KeyBlockWeight)
-	storage.Set(append(systemHash, blockWeightHash...), weight.Bytes())
+	storageBlockWeight.Put(weight)
 }
 
 func StorageClearBlockWeight() {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	blockWeightHash := hashing.Twox128(constants.KeyBlockWeight)
-	storage.Clear(append(systemHash, blockWeightHash...))
+	storageBlockWeight.Clear()
 }
 
 // StorageGetExtrinsicIndex returns the index of extrinsic that is currently executing.
@@ -211,43 +241,60 @@ func StorageSetExtrinsicIndex(index sc.U32) {
 }
 
 func StorageClearEvents() {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	eventsHash := hashing.Twox128(constants.KeyEvents)
-	storage.Clear(append(systemHash, eventsHash...))
+	storageEvents.Clear()
 }
 
 func StorageClearEventCount() {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	eventCountHash := hashing.Twox128(constants.KeyEventCount)
-	storage.Clear(append(systemHash, eventCountHash...))
+	storageEventCount.Clear()
 }
 
 func StorageClearEventTopics(limit sc.U32) {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	eventTopicsHash := hashing.Twox128(constants.KeyEventTopics)
-	storage.ClearPrefix(append(systemHash, eventTopicsHash...), sc.NewOption[sc.U32](limit).Bytes())
+	storageEventTopics.Clear(sc.NewOption[sc.U32](limit), sc.NewOption[sc.Sequence[sc.U8]](nil))
 }
 
 func StorageGetDigest() types.Digest {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	digestHash := hashing.Twox128(constants.KeyDigest)
-	return storage.GetDecode(append(systemHash, digestHash...), types.DecodeDigest)
+	return storageDigest.Get()
 }
 
 func StorageSetDigest(digest types.Digest) {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	digestHash := hashing.Twox128(constants.KeyDigest)
-	storage.Set(append(systemHash, digestHash...), digest.Bytes())
+	storageDigest.Put(digest)
 }
 
 func StorageGetParentHash() types.Blake2bHash {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	parentHashHash := hashing.Twox128(constants.KeyParentHash)
-	return storage.GetDecode(append(systemHash, parentHashHash...), types.DecodeBlake2bHash)
+	return storageParentHash.Get()
 }
 
 func StorageSetParentHash(parentHash types.Blake2bHash) {
-	systemHash := hashing.Twox128(constants.KeySystem)
-	parentHashHash := hashing.Twox128(constants.KeyParentHash)
-	storage.Set(append(systemHash, parentHashHash...), parentHash.Bytes())
+	storageParentHash.Put(parentHash)
+}
+
+// StorageGetLastRuntimeUpgrade returns the `spec_version` and `spec_name` of when the last runtime upgrade happened.
+func StorageGetLastRuntimeUpgrade() types.LastRuntimeUpgradeInfo {
+	return storageLastRuntimeUpgrade.Get()
+}
+
+func StorageSetLastRuntimeUpgrade(info types.LastRuntimeUpgradeInfo) {
+	storageLastRuntimeUpgrade.Put(info)
+}
+
+// StorageSetCode sets the Wasm code of the runtime, stored under the well-known `:code` key.
+func StorageSetCode(code sc.Sequence[sc.U8]) {
+	storage.Set(constants.KeyCode, sc.SequenceU8ToBytes(code))
+}
+
+// StorageSetHeapPages sets the number of pages in the Wasm heap, stored under the well-known `:heappages` key.
+func StorageSetHeapPages(pages sc.U64) {
+	storage.Set(constants.KeyHeapPages, pages.Bytes())
+}
+
+// decodeEventTopicValues decodes the `(BlockNumber, EventIndex)` pairs stored under an event topic.
+func decodeEventTopicValues(buffer *bytes.Buffer) sc.Sequence[sc.VaryingData] {
+	length := sc.DecodeCompact(buffer)
+
+	values := make(sc.Sequence[sc.VaryingData], length.ToBigInt().Int64())
+	for i := range values {
+		values[i] = sc.NewVaryingData(sc.DecodeU32(buffer), sc.DecodeU32(buffer))
+	}
+
+	return values
 }
@@ -8,16 +8,16 @@ import (
 
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
-	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/constants/system"
+	"github.com/LimeChain/gosemble/frame/system/errors"
 	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/misc"
 	"github.com/LimeChain/gosemble/primitives/storage"
 	"github.com/LimeChain/gosemble/primitives/trie"
 	"github.com/LimeChain/gosemble/primitives/types"
 )
 
 func Finalize() types.Header {
-	systemHash := hashing.Twox128(constants.KeySystem)
-
 	StorageClearExecutionPhase()
 	StorageClearAllExtrinsicsLength()
 
@@ -27,16 +27,11 @@ func Finalize() types.Header {
 	extrinsicCount := StorageGetExtrinsicCount(true)
 
 	var extrinsics []byte
-	extrinsicDataPrefixHash := append(systemHash, hashing.Twox128(constants.KeyExtrinsicData)...)
 
 	for i := 0; i < int(extrinsicCount); i++ {
-		sci := sc.U32(i)
-		hashIndex := hashing.Twox64(sci.Bytes())
-
-		extrinsicDataHashIndexHash := append(extrinsicDataPrefixHash, hashIndex...)
-		extrinsic := storage.TakeBytes(append(extrinsicDataHashIndexHash, sci.Bytes()...))
+		extrinsic := StorageTakeExtrinsicData(sc.U32(i))
 
-		extrinsics = append(extrinsics, extrinsic...)
+		extrinsics = append(extrinsics, extrinsic.Bytes()...)
 	}
 
 	buf := &bytes.Buffer{}
@@ -52,12 +47,7 @@ func Finalize() types.Header {
 	}
 
 	if toRemove != 0 {
-		blockNumHash := hashing.Twox64(toRemove.Bytes())
-		blockNumKey := append(systemHash, hashing.Twox128(constants.KeyBlockHash)...)
-		blockNumKey = append(blockNumKey, blockNumHash...)
-		blockNumKey = append(blockNumKey, toRemove.Bytes()...)
-
-		storage.Clear(blockNumKey)
+		StorageRemoveBlockHash(toRemove)
 	}
 
 	storageRootBytes := storage.Root(int32(constants.RuntimeVersion.StateVersion))
@@ -99,16 +89,9 @@ func NoteFinishedExtrinsics() {
 // This is required to be called before applying an extrinsic. The data will used
 // in [`finalize`] to calculate the correct extrinsics root.
 func NoteExtrinsic(encodedExt []byte) {
-	keySystemHash := hashing.Twox128(constants.KeySystem)
-	keyExtrinsicData := hashing.Twox128(constants.KeyExtrinsicData)
-
-	keyExtrinsicDataPrefixHash := append(keySystemHash, keyExtrinsicData...)
 	extrinsicIndex := StorageGetExtrinsicIndex(false)
 
-	hashIndex := hashing.Twox64(extrinsicIndex.Bytes())
-
-	keySystemExtrinsicDataHashIndex := append(keyExtrinsicDataPrefixHash, hashIndex...)
-	storage.Set(append(keySystemExtrinsicDataHashIndex, extrinsicIndex.Bytes()...), sc.BytesToSequenceU8(encodedExt).Bytes())
+	StorageSetExtrinsicData(extrinsicIndex, sc.BytesToSequenceU8(encodedExt))
 }
 
 // NoteAppliedExtrinsic - To be called immediately after an extrinsic has been applied.
@@ -130,12 +113,9 @@ func NoteAppliedExtrinsic(r *types.DispatchResultWithPostInfo[types.PostDispatch
 
 	nextExtrinsicIndex := StorageGetExtrinsicIndex(false) + sc.U32(1)
 
-	keySystemHash := hashing.Twox128(constants.KeySystem)
-
 	StorageSetExtrinsicIndex(nextExtrinsicIndex)
 
-	keyExecutionPhaseHash := hashing.Twox128(constants.KeyExecutionPhase)
-	storage.Set(append(keySystemHash, keyExecutionPhaseHash...), types.NewExtrinsicPhaseApply(nextExtrinsicIndex).Bytes())
+	StorageSetExecutionPhase(types.NewExtrinsicPhaseApply(nextExtrinsicIndex))
 }
 
 func Mutate(who types.Address32, f func(who *types.AccountInfo) sc.Result[sc.Encodable]) sc.Result[sc.Encodable] {
@@ -143,16 +123,7 @@ func Mutate(who types.Address32, f func(who *types.AccountInfo) sc.Result[sc.Enc
 
 	result := f(&accountInfo)
 	if !result.HasError {
-		systemHash := hashing.Twox128(constants.KeySystem)
-		accountHash := hashing.Twox128(constants.KeyAccount)
-
-		whoBytes := sc.FixedSequenceU8ToBytes(who.FixedSequence)
-
-		key := append(systemHash, accountHash...)
-		key = append(key, hashing.Blake128(whoBytes)...)
-		key = append(key, whoBytes...)
-
-		storage.Set(key, accountInfo.Bytes())
+		StorageSetAccount(who.FixedSequence, accountInfo)
 	}
 
 	return result
@@ -282,6 +253,107 @@ func decProviders(who types.Address32) (types.DecRefStatus, types.DispatchError)
 	return result.Value.(types.DecRefStatus), nil
 }
 
+// IncConsumersWithoutLimit increments the reference counter on an account, ignoring any max consumers limit.
+// The account `who`'s `providers` must be non-zero or this will return an error.
+func IncConsumersWithoutLimit(who types.Address32) types.DispatchError {
+	result := Mutate(who, func(account *types.AccountInfo) sc.Result[sc.Encodable] {
+		if account.Providers == 0 {
+			return sc.Result[sc.Encodable]{
+				HasError: true,
+				Value:    types.NewDispatchErrorNoProviders(),
+			}
+		}
+
+		if account.Consumers < math.MaxUint32 {
+			account.Consumers += 1
+		}
+
+		return sc.Result[sc.Encodable]{}
+	})
+
+	if result.HasError {
+		return result.Value.(types.DispatchError)
+	}
+
+	return nil
+}
+
+// CanIncConsumer returns true, if the consumer reference counter of an account can be incremented.
+func CanIncConsumer(who types.Address32) bool {
+	account := StorageGetAccount(who.FixedSequence)
+
+	return account.Providers > 0 && account.Consumers < math.MaxUint32
+}
+
+// DecConsumers decrements the reference counter on an account.
+// This *MUST* only be done once for every time `IncConsumersWithoutLimit` was called.
+func DecConsumers(who types.Address32) {
+	Mutate(who, func(account *types.AccountInfo) sc.Result[sc.Encodable] {
+		if account.Consumers > 0 {
+			account.Consumers -= 1
+		} else {
+			log.Warn("Logic error: Unexpected underflow in reducing consumer")
+		}
+
+		return sc.Result[sc.Encodable]{}
+	})
+}
+
+// IncSufficients increments the self-sufficient reference counter on an account.
+// The account is created, if it has no providers and no sufficients.
+func IncSufficients(who types.Address32) types.IncRefStatus {
+	result := Mutate(who, func(account *types.AccountInfo) sc.Result[sc.Encodable] {
+		if account.Providers == 0 && account.Sufficients == 0 {
+			account.Sufficients = 1
+			onCreatedAccount(who)
+
+			return sc.Result[sc.Encodable]{
+				HasError: false,
+				Value:    types.IncRefStatusCreated,
+			}
+		}
+
+		if account.Sufficients < math.MaxUint32 {
+			account.Sufficients += 1
+		}
+
+		return sc.Result[sc.Encodable]{
+			HasError: false,
+			Value:    types.IncRefStatusExisted,
+		}
+	})
+
+	return result.Value.(types.IncRefStatus)
+}
+
+// DecSufficients decrements the self-sufficient reference counter on an account.
+// This *MUST* only be done once for every time `IncSufficients` was called.
+// The account is reaped, if it has no providers and no other sufficients left.
+func DecSufficients(who types.Address32) types.DecRefStatus {
+	account := StorageGetAccount(who.FixedSequence)
+
+	if account.Sufficients == 0 {
+		log.Warn("Logic error: Unexpected underflow in reducing sufficients")
+		return types.DecRefStatusExists
+	}
+
+	if account.Sufficients == 1 && account.Providers == 0 {
+		if account.Consumers > 0 {
+			log.Warn("Logic error: Account reaped with consumers remaining")
+		}
+
+		StorageRemoveAccount(who.FixedSequence)
+		onKilledAccount(who)
+
+		return types.DecRefStatusReaped
+	}
+
+	account.Sufficients -= 1
+	StorageSetAccount(who.FixedSequence, account)
+
+	return types.DecRefStatusExists
+}
+
 func CanDecProviders(who types.Address32) bool {
 	acc := StorageGetAccount(who.FixedSequence)
 
@@ -315,3 +387,51 @@ func ResetEvents() {
 	limit := sc.U32(math.MaxUint32)
 	StorageClearEventTopics(limit)
 }
+
+// DepositLog deposits a log and ensures it ends up in the digest of the current block,
+// after the logs deposited before it.
+func DepositLog(item types.DigestItem) {
+	digest := StorageGetDigest()
+	digest.Push(item)
+	StorageSetDigest(digest)
+}
+
+// CanSetCode determines whether the given code can replace the current runtime code.
+// The spec name of the new runtime must match the current one and its spec version must increase.
+func CanSetCode(code sc.Sequence[sc.U8]) types.DispatchError {
+	currentVersion := constants.RuntimeVersion
+
+	encodedVersion := misc.RuntimeVersion(sc.SequenceU8ToBytes(code))
+	if !encodedVersion.HasValue {
+		return newDispatchErrorModule(errors.ErrorFailedToExtractRuntimeVersion)
+	}
+
+	buffer := bytes.NewBuffer(sc.SequenceU8ToBytes(encodedVersion.Value))
+	newVersion := types.DecodeRuntimeVersion(buffer)
+
+	if newVersion.SpecName != currentVersion.SpecName {
+		return newDispatchErrorModule(errors.ErrorInvalidSpecName)
+	}
+
+	if newVersion.SpecVersion <= currentVersion.SpecVersion {
+		return newDispatchErrorModule(errors.ErrorSpecVersionNeedsToIncrease)
+	}
+
+	return nil
+}
+
+// UpdateCodeInStorage writes the code to storage, deposits a `RuntimeEnvironmentUpdated`
+// digest item and emits a `CodeUpdated` event.
+func UpdateCodeInStorage(code sc.Sequence[sc.U8]) {
+	StorageSetCode(code)
+	DepositLog(types.NewDigestItemRuntimeEnvironmentUpgraded())
+	DepositEvent(NewEventCodeUpdated())
+}
+
+func newDispatchErrorModule(err sc.U8) types.DispatchError {
+	return types.NewDispatchErrorModule(types.CustomModuleError{
+		Index:   system.ModuleIndex,
+		Error:   sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
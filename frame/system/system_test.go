@@ -0,0 +1,44 @@
+//go:build nonwasmenv
+
+package system
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Finalize_Digest(t *testing.T) {
+	host.Reset()
+
+	babe := sc.BytesToFixedSequenceU8([]byte("BABE"))
+	preRuntime := types.NewDigestItemPreRuntime(babe, sc.Sequence[sc.U8]{1, 2, 3})
+	nextEpochData := types.NewDigestItemConsensusMessage(babe, sc.Sequence[sc.U8]{1, 4})
+	nextConfigData := types.NewDigestItemConsensusMessage(babe, sc.Sequence[sc.U8]{3, 5})
+
+	parentHash := types.NewBlake2bHash(sc.BytesToSequenceU8(bytes.Repeat([]byte{1}, 32))...)
+	Initialize(1, parentHash, types.Digest{Logs: sc.Sequence[types.DigestItem]{preRuntime}})
+
+	DepositLog(nextEpochData)
+	DepositLog(nextConfigData)
+
+	header := Finalize()
+
+	assert.Equal(t, sc.Sequence[types.DigestItem]{preRuntime, nextEpochData, nextConfigData}, header.Digest.Logs)
+	assert.Equal(t,
+		[]byte{
+			0x0c,
+			types.DigestTypePreRuntime, 'B', 'A', 'B', 'E', 0x0c, 1, 2, 3,
+			types.DigestTypeConsensusMessage, 'B', 'A', 'B', 'E', 0x08, 1, 4,
+			types.DigestTypeConsensusMessage, 'B', 'A', 'B', 'E', 0x08, 3, 5,
+		},
+		header.Digest.Bytes())
+
+	buffer := bytes.NewBuffer(header.Bytes())
+	assert.Equal(t, header, types.DecodeHeader(buffer))
+	assert.Equal(t, 0, buffer.Len())
+}
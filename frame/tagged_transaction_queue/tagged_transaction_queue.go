@@ -3,6 +3,7 @@ package tagged_transaction_queue
 import (
 	"bytes"
 
+	"github.com/LimeChain/gosemble/config"
 	"github.com/LimeChain/gosemble/execution/types"
 	"github.com/LimeChain/gosemble/frame/executive"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
@@ -25,7 +26,7 @@ func ValidateTransaction(dataPtr int32, dataLen int32) int64 {
 	buffer := bytes.NewBuffer(data)
 
 	txSource := primitives.DecodeTransactionSource(buffer)
-	tx := types.DecodeUncheckedExtrinsic(buffer)
+	tx := types.DecodeUncheckedExtrinsic(config.SignedExtra, buffer)
 	blockHash := primitives.DecodeBlake2bHash(buffer)
 
 	ok, err := executive.ValidateTransaction(txSource, tx, blockHash)
@@ -2,12 +2,15 @@ package module
 
 import (
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/constants/testable"
+	"github.com/LimeChain/gosemble/frame/support"
 	"github.com/LimeChain/gosemble/frame/testable/dispatchables"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
 type TestableModule struct {
+	primitives.DefaultHooks
 	functions map[sc.U8]primitives.Call
 }
 
@@ -33,14 +36,27 @@ func (tm TestableModule) ValidateUnsigned(_ primitives.TransactionSource, _ prim
 }
 
 func (tm TestableModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
-	// TODO: types
-	return sc.Sequence[primitives.MetadataType]{}, primitives.MetadataModule{
-		Name:      "Testable",
-		Storage:   sc.Option[primitives.MetadataModuleStorage]{},
-		Call:      sc.NewOption[sc.Compact](nil),
-		Event:     sc.NewOption[sc.Compact](nil),
-		Constants: sc.Sequence[primitives.MetadataModuleConstant]{},
-		Error:     sc.NewOption[sc.Compact](nil),
-		Index:     testable.ModuleIndex,
+	return tm.declaration().Metadata()
+}
+
+// declaration declares the calls of the module, from which its metadata is derived.
+func (tm TestableModule) declaration() support.ModuleDeclaration {
+	return support.ModuleDeclaration{
+		Name:  "Testable",
+		Index: testable.ModuleIndex,
+		Path:  "testable",
+		Calls: &support.EnumDeclaration{
+			TypeId: metadata.TestableCalls,
+			Variants: []support.VariantDeclaration{
+				{
+					Name:  "test",
+					Index: testable.FunctionTestIndex,
+					Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceU8, "value", "Vec<u8>"),
+					},
+					Docs: "Sets a storage value in a storage layer, which is reverted.",
+				},
+			},
+		},
 	}
 }
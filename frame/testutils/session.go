@@ -0,0 +1,81 @@
+//go:build nonwasmenv
+
+package testutils
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/session"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// SessionHandler is a session handler, which records the validators of the new sessions
+// and forwards the notifications to the functions, which are set.
+// Tests of a module use it in place of the module, which cannot be imported by its dispatchables.
+type SessionHandler struct {
+	KeyType      [4]byte
+	Genesis      func(validators []types.ValidatorKey)
+	NewSession   func(changed bool, validators []types.ValidatorKey, queuedValidators []types.ValidatorKey)
+	Disabled     func(validatorIndex sc.U32)
+	Changed      *[]bool
+	SessionsKeys *[][]types.ValidatorKey
+}
+
+// NewSessionHandler returns a session handler of the key type, which only records the new sessions.
+func NewSessionHandler(keyTypeId [4]byte) SessionHandler {
+	return SessionHandler{
+		KeyType:      keyTypeId,
+		Changed:      &[]bool{},
+		SessionsKeys: &[][]types.ValidatorKey{},
+	}
+}
+
+func (h SessionHandler) KeyTypeId() [4]byte {
+	return h.KeyType
+}
+
+func (h SessionHandler) OnGenesisSession(validators []types.ValidatorKey) {
+	if h.Genesis != nil {
+		h.Genesis(validators)
+	}
+}
+
+func (h SessionHandler) OnNewSession(changed bool, validators []types.ValidatorKey, queuedValidators []types.ValidatorKey) {
+	*h.Changed = append(*h.Changed, changed)
+	*h.SessionsKeys = append(*h.SessionsKeys, validators)
+
+	if h.NewSession != nil {
+		h.NewSession(changed, validators, queuedValidators)
+	}
+}
+
+func (h SessionHandler) OnBeforeSessionEnding() {}
+
+func (h SessionHandler) OnDisabled(validatorIndex sc.U32) {
+	if h.Disabled != nil {
+		h.Disabled(validatorIndex)
+	}
+}
+
+// SetupValidators resets the host and starts the genesis session with `validators`, which have a key
+// of the `scheme` for the single session `handler`. Returns the keys of the validators in their order.
+func SetupValidators(handler types.SessionHandler, scheme host.Scheme, validators ...types.Address32) []types.PublicKey {
+	host.Reset()
+	session.RegisterHandlers([]types.SessionHandler{handler})
+
+	keyTypeId := handler.KeyTypeId()
+
+	keys := make([]types.PublicKey, 0, len(validators))
+	genesisKeys := make([]session.GenesisKeys, 0, len(validators))
+	for _, validator := range validators {
+		key := sc.BytesToFixedSequenceU8(host.DefaultKeystore().Generate(scheme, keyTypeId[:], nil))
+		keys = append(keys, key)
+
+		SetupAccount(validator, sc.NewU128FromUint64(0))
+		genesisKeys = append(genesisKeys, session.GenesisKeys{Account: validator, Keys: session.SessionKeys{Keys: []types.PublicKey{key}}})
+	}
+
+	session.GenesisConfig{Keys: genesisKeys}.BuildGenesis()
+
+	return keys
+}
@@ -0,0 +1,38 @@
+//go:build nonwasmenv
+
+/*
+Package testutils provides the fixtures, shared by the tests of the modules.
+*/
+package testutils
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// NewAddress returns the address, whose last byte is `b`.
+func NewAddress(b sc.U8) types.Address32 {
+	address := make([]sc.U8, 32)
+	address[31] = b
+	return types.NewAddress32(address...)
+}
+
+// NewModuleError returns the dispatch error `err` of the module at `moduleIndex`.
+func NewModuleError(moduleIndex sc.U8, err sc.U8) types.DispatchError {
+	return types.NewDispatchErrorModule(types.CustomModuleError{
+		Index:   moduleIndex,
+		Error:   sc.U32(err),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+}
+
+// SetupAccount creates the account of `who` with a provider and the `free` balance.
+func SetupAccount(who types.Address32, free types.Balance) {
+	system.StorageSetAccount(who.FixedSequence, types.AccountInfo{
+		Providers: 1,
+		Data: types.AccountData{
+			Free: free,
+		},
+	})
+}
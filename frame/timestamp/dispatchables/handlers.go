@@ -0,0 +1,21 @@
+package dispatchables
+
+import (
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// handlers are notified when the timestamp of the block is set.
+var handlers []primitives.OnTimestampSet
+
+// RegisterOnTimestampSetHandlers sets the handlers, which are notified when the timestamp of the block is set.
+// The handlers depend on the consensus module, selected by the runtime configuration.
+func RegisterOnTimestampSetHandlers(onTimestampSetHandlers []primitives.OnTimestampSet) {
+	handlers = onTimestampSetHandlers
+}
+
+func onTimestampSet(now sc.U64) {
+	for _, handler := range handlers {
+		handler.OnTimestampSet(now)
+	}
+}
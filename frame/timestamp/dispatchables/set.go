@@ -6,10 +6,7 @@ import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/timestamp"
-	"github.com/LimeChain/gosemble/frame/aura"
-	"github.com/LimeChain/gosemble/primitives/hashing"
 	"github.com/LimeChain/gosemble/primitives/log"
-	"github.com/LimeChain/gosemble/primitives/storage"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
@@ -118,31 +115,20 @@ func set(origin primitives.RuntimeOrigin, now sc.U64) primitives.DispatchResultW
 		}
 	}
 
-	timestampHash := hashing.Twox128(constants.KeyTimestamp)
-	didUpdateHash := hashing.Twox128(constants.KeyDidUpdate)
-
-	didUpdate := storage.Exists(append(timestampHash, didUpdateHash...))
-
-	if didUpdate == 1 {
+	if StorageExistsDidUpdate() {
 		log.Critical("Timestamp must be updated only once in the block")
 	}
 
-	nowHash := hashing.Twox128(constants.KeyNow)
-	previousTimestamp := storage.GetDecode(append(timestampHash, nowHash...), sc.DecodeU64)
+	previousTimestamp := StorageGetNow()
 
 	if !(previousTimestamp == 0 || now >= previousTimestamp+timestamp.MinimumPeriod) {
 		log.Critical("Timestamp must increment by at least <MinimumPeriod> between sequential blocks")
 	}
 
-	storage.Set(append(timestampHash, nowHash...), now.Bytes())
-	storage.Set(append(timestampHash, didUpdateHash...), sc.Bool(true).Bytes())
-
-	// TODO: Every consensus that uses the timestamp must implement
-	// <T::OnTimestampSet as OnTimestampSet<_>>::on_timestamp_set(now)
+	storageSetNow(now)
+	storageSetDidUpdate()
 
-	// TODO:
-	// timestamp module should not depend on the aura module
-	aura.OnTimestampSet(now)
+	onTimestampSet(now)
 
 	return primitives.DispatchResultWithPostInfo[primitives.PostDispatchInfo]{
 		HasError: false,
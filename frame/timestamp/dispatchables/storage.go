@@ -0,0 +1,52 @@
+package dispatchables
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	storageNow       = support.NewStorageValue[sc.U64](constants.KeyTimestamp, constants.KeyNow, sc.DecodeU64)
+	storageDidUpdate = support.NewStorageValue[sc.Bool](constants.KeyTimestamp, constants.KeyDidUpdate, sc.DecodeBool)
+)
+
+// StorageDeclarations returns the declarations of the storage items of the module, in the order of the metadata.
+func StorageDeclarations() []support.StorageDeclaration {
+	return []support.StorageDeclaration{
+		{
+			Item:     storageNow,
+			Modifier: primitives.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "Current time for the current block.",
+		},
+		{
+			Item:     storageDidUpdate,
+			Modifier: primitives.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "Did the timestamp get updated in this block?",
+		},
+	}
+}
+
+// StorageGetNow returns the timestamp of the current block.
+func StorageGetNow() sc.U64 {
+	return storageNow.Get()
+}
+
+func storageSetNow(now sc.U64) {
+	storageNow.Put(now)
+}
+
+// StorageExistsDidUpdate checks whether the timestamp is updated in the current block.
+func StorageExistsDidUpdate() bool {
+	return storageDidUpdate.Exists()
+}
+
+func storageSetDidUpdate() {
+	storageDidUpdate.Put(true)
+}
+
+// StorageClearDidUpdate clears the update flag of the timestamp at the end of the block.
+func StorageClearDidUpdate() {
+	storageDidUpdate.Clear()
+}
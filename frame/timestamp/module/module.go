@@ -3,13 +3,15 @@ package module
 import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants/metadata"
-	"github.com/LimeChain/gosemble/constants/timestamp"
 	ts "github.com/LimeChain/gosemble/constants/timestamp"
+	"github.com/LimeChain/gosemble/frame/support"
 	"github.com/LimeChain/gosemble/frame/timestamp/dispatchables"
+	"github.com/LimeChain/gosemble/primitives/log"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
 type TimestampModule struct {
+	primitives.DefaultHooks
 	functions map[sc.U8]primitives.Call
 }
 
@@ -34,50 +36,45 @@ func (tm TimestampModule) ValidateUnsigned(_ primitives.TransactionSource, _ pri
 	return primitives.DefaultValidTransaction(), nil
 }
 
-func (tm TimestampModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
-	return tm.metadataTypes(), primitives.MetadataModule{
-		Name: "Timestamp",
-		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
-			Prefix: "Timestamp",
-			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
-				primitives.NewMetadataModuleStorageEntry(
-					"Now",
-					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesU64)),
-					"Current time for the current block."),
-				primitives.NewMetadataModuleStorageEntry(
-					"DidUpdate",
-					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.PrimitiveTypesBool)),
-					"Did the timestamp get updated in this block?"),
-			},
-		}),
-		Call:  sc.NewOption[sc.Compact](sc.ToCompact(metadata.TimestampCalls)),
-		Event: sc.NewOption[sc.Compact](nil),
-		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
-			primitives.NewMetadataModuleConstant(
-				"MinimumPeriod",
-				sc.ToCompact(metadata.PrimitiveTypesU64),
-				sc.BytesToSequenceU8(sc.U64(ts.MinimumPeriod).Bytes()),
-				"The minimum period between blocks. Beware that this is different to the *expected*  period that the block production apparatus provides.",
-			),
-		},
-		Error: sc.NewOption[sc.Compact](nil),
-		Index: timestamp.ModuleIndex,
+func (tm TimestampModule) OnFinalize(_ primitives.BlockNumber) {
+	if dispatchables.StorageExistsDidUpdate() {
+		dispatchables.StorageClearDidUpdate()
+	} else {
+		log.Critical("Timestamp must be updated once in the block")
 	}
 }
 
-func (tm TimestampModule) metadataTypes() sc.Sequence[primitives.MetadataType] {
-	return sc.Sequence[primitives.MetadataType]{
-		primitives.NewMetadataTypeWithParam(metadata.TimestampCalls, "Timestamp calls", sc.Sequence[sc.Str]{"pallet_timestamp", "pallet", "Call"}, primitives.NewMetadataTypeDefinitionVariant(
-			sc.Sequence[primitives.MetadataDefinitionVariant]{
-				primitives.NewMetadataDefinitionVariant(
-					"set",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
+func (tm TimestampModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
+	return tm.declaration().Metadata()
+}
+
+// declaration declares the storage, calls and constants of the module,
+// from which its metadata is derived.
+func (tm TimestampModule) declaration() support.ModuleDeclaration {
+	return support.ModuleDeclaration{
+		Name:    "Timestamp",
+		Index:   ts.ModuleIndex,
+		Path:    "pallet_timestamp",
+		Storage: dispatchables.StorageDeclarations(),
+		Calls: &support.EnumDeclaration{
+			TypeId: metadata.TimestampCalls,
+			Variants: []support.VariantDeclaration{
+				{
+					Name:  "set",
+					Index: ts.FunctionSetIndex,
+					Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
 						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU64, "now", "T::Moment"),
 					},
-					timestamp.FunctionSetIndex,
-					"Set the current time."),
-			}), primitives.NewMetadataEmptyTypeParameter("T")),
+					Docs: "Set the current time.",
+				},
+			},
+		},
+		Constants: []support.ConstantDeclaration{
+			{
+				Name:  "MinimumPeriod",
+				Value: sc.U64(ts.MinimumPeriod),
+				Docs:  "The minimum period between blocks. Beware that this is different to the *expected*  period that the block production apparatus provides.",
+			},
+		},
 	}
 }
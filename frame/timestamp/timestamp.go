@@ -4,13 +4,10 @@ import (
 	"bytes"
 
 	sc "github.com/LimeChain/goscale"
-	"github.com/LimeChain/gosemble/constants"
 	timestampConstants "github.com/LimeChain/gosemble/constants/timestamp"
 	"github.com/LimeChain/gosemble/execution/types"
 	timestamp "github.com/LimeChain/gosemble/frame/timestamp/dispatchables"
-	"github.com/LimeChain/gosemble/primitives/hashing"
 	"github.com/LimeChain/gosemble/primitives/log"
-	"github.com/LimeChain/gosemble/primitives/storage"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
@@ -27,10 +24,7 @@ func CreateInherent(inherent primitives.InherentData) []byte {
 	// TODO: err if not able to parse it.
 	buffer.Reset()
 
-	timestampHash := hashing.Twox128(constants.KeyTimestamp)
-	nowHash := hashing.Twox128(constants.KeyNow)
-
-	nextTimestamp := storage.GetDecode(append(timestampHash, nowHash...), sc.DecodeU64) + timestampConstants.MinimumPeriod
+	nextTimestamp := timestamp.StorageGetNow() + timestampConstants.MinimumPeriod
 
 	if ts > nextTimestamp {
 		nextTimestamp = ts
@@ -62,9 +56,7 @@ func CheckInherent(args sc.VaryingData, inherent primitives.InherentData) error
 	// TODO: err if not able to parse it.
 	buffer.Reset()
 
-	timestampHash := hashing.Twox128(constants.KeyTimestamp)
-	nowHash := hashing.Twox128(constants.KeyNow)
-	systemNow := storage.GetDecode(append(timestampHash, nowHash...), sc.DecodeU64)
+	systemNow := timestamp.StorageGetNow()
 
 	minimum := systemNow + timestampConstants.MinimumPeriod
 	if t > ts+timestampConstants.MaxTimestampDriftMillis {
@@ -0,0 +1,69 @@
+package transaction_payment
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/balances"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// BalanceToAsset converts fees in the native balance to fees in a non-native asset.
+type BalanceToAsset interface {
+	// ToAssetBalance returns `balance` in units of the asset `id`, or none if the asset cannot pay fees.
+	ToAssetBalance(balance primitives.Balance, id primitives.AssetId) sc.Option[primitives.Balance]
+}
+
+// FixedRate converts the native balance to any asset with the same rate of asset units per native unit.
+type FixedRate struct {
+	Rate primitives.FixedU128
+}
+
+func (fr FixedRate) ToAssetBalance(balance primitives.Balance, _ primitives.AssetId) sc.Option[primitives.Balance] {
+	return sc.NewOption[primitives.Balance](fr.Rate.SaturatingMulInt(balance))
+}
+
+// MinBalanceRatio converts the native balance to an asset with the ratio between the minimum balance
+// of the asset and the existential deposit of the native balance.
+// Only sufficient assets can pay fees, since the minimum balance of the other assets can be set freely by their owners.
+type MinBalanceRatio struct{}
+
+func (mbr MinBalanceRatio) ToAssetBalance(balance primitives.Balance, id primitives.AssetId) sc.Option[primitives.Balance] {
+	if assets == nil {
+		return sc.NewOption[primitives.Balance](nil)
+	}
+
+	minBalance := assets.MinimumBalance(id)
+	if !minBalance.HasValue || !sc.Bool(assets.IsSufficient(id)) {
+		return sc.NewOption[primitives.Balance](nil)
+	}
+
+	rate := primitives.NewFixedU128FromRational(minBalance.Value.ToBigInt(), balances.ExistentialDeposit, primitives.RoundingNearestPrefDown)
+	if !rate.HasValue {
+		return sc.NewOption[primitives.Balance](nil)
+	}
+
+	return sc.NewOption[primitives.Balance](rate.Value.SaturatingMulInt(balance))
+}
+
+// assets keeps the balances of the assets, in which fees can be paid.
+var assets primitives.FungibleAssets
+
+// balanceToAsset converts fees to the asset, in which they are paid.
+var balanceToAsset BalanceToAsset = MinBalanceRatio{}
+
+// onChargeAssetTransaction withdraws and handles the fees, which are paid in an asset. It burns the paid fees by default.
+var onChargeAssetTransaction OnChargeAssetTransaction = FungiblesAdapter{Credit: BurnAssetCredit{}}
+
+// RegisterAssets sets the module, which keeps the balances of the assets, in which fees can be paid.
+func RegisterAssets(fungibleAssets primitives.FungibleAssets) {
+	assets = fungibleAssets
+}
+
+// RegisterBalanceToAsset sets the conversion of fees to the asset, in which they are paid.
+func RegisterBalanceToAsset(conversion BalanceToAsset) {
+	balanceToAsset = conversion
+}
+
+// RegisterOnChargeAssetTransaction sets the handler of the fees, which are paid in an asset.
+func RegisterOnChargeAssetTransaction(handler OnChargeAssetTransaction) {
+	onChargeAssetTransaction = handler
+}
@@ -0,0 +1,136 @@
+package transaction_payment
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// ChargeAssetTxPayment withdraws the fee of the transaction, including the tip, from the sender,
+// either in the native balance or in the asset `AssetId`, and refunds the overpaid fee after dispatch.
+// Fees in an asset are withdrawn and handled by the registered OnChargeAssetTransaction.
+//
+// It is used instead of ChargeTransactionPayment in the signed extensions of the runtime.
+type ChargeAssetTxPayment struct {
+	primitives.DefaultSignedExtension
+	Tip     primitives.Balance
+	AssetId sc.Option[primitives.AssetId]
+}
+
+func NewChargeAssetTxPayment(tip primitives.Balance, assetId sc.Option[primitives.AssetId]) ChargeAssetTxPayment {
+	return ChargeAssetTxPayment{
+		Tip:     tip,
+		AssetId: assetId,
+	}
+}
+
+// ChargeAssetTxPaymentPre is passed from the pre dispatch of ChargeAssetTxPayment to its post dispatch.
+type ChargeAssetTxPaymentPre struct {
+	ChargeTransactionPaymentPre
+	// AssetId is the asset, in which the fee is paid, or none if it is paid in the native balance.
+	AssetId sc.Option[primitives.AssetId]
+}
+
+func (p ChargeAssetTxPaymentPre) Encode(buffer *bytes.Buffer) {
+	p.ChargeTransactionPaymentPre.Encode(buffer)
+	p.AssetId.Encode(buffer)
+}
+
+func (p ChargeAssetTxPaymentPre) Bytes() []byte {
+	return sc.EncodedBytes(p)
+}
+
+func (catp ChargeAssetTxPayment) Encode(buffer *bytes.Buffer) {
+	sc.Compact(catp.Tip).Encode(buffer)
+	catp.AssetId.Encode(buffer)
+}
+
+func (catp ChargeAssetTxPayment) Decode(buffer *bytes.Buffer) primitives.SignedExtension {
+	return NewChargeAssetTxPayment(
+		primitives.Balance(sc.DecodeCompact(buffer)),
+		sc.DecodeOptionWith(buffer, sc.DecodeU32),
+	)
+}
+
+func (catp ChargeAssetTxPayment) Bytes() []byte {
+	return sc.EncodedBytes(catp)
+}
+
+func (catp ChargeAssetTxPayment) Identifier() sc.Str {
+	return "ChargeAssetTxPayment"
+}
+
+func (catp ChargeAssetTxPayment) Validate(who *primitives.Address32, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	finalFee, _, err := catp.withdrawFee(who, call, info, length)
+	if err != nil {
+		return primitives.ValidTransaction{}, err
+	}
+
+	validTransaction := primitives.DefaultValidTransaction()
+	validTransaction.Priority = NewChargeTransactionPayment(catp.Tip).getPriority(info, length, catp.Tip, finalFee)
+
+	return validTransaction, nil
+}
+
+func (catp ChargeAssetTxPayment) PreDispatch(who *primitives.Address32, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.Pre, err primitives.TransactionValidityError) {
+	_, imbalance, err := catp.withdrawFee(who, call, info, length)
+	return ChargeAssetTxPaymentPre{
+		ChargeTransactionPaymentPre: ChargeTransactionPaymentPre{
+			Tip:       catp.Tip,
+			Who:       *who,
+			Imbalance: imbalance,
+		},
+		AssetId: catp.AssetId,
+	}, err
+}
+
+func (catp ChargeAssetTxPayment) PostDispatch(pre sc.Option[primitives.Pre], info *primitives.DispatchInfo, postInfo *primitives.PostDispatchInfo, length sc.Compact, result *primitives.DispatchResult) primitives.TransactionValidityError {
+	if !pre.HasValue {
+		return nil
+	}
+
+	preValue, ok := pre.Value.(ChargeAssetTxPaymentPre)
+	if !ok {
+		log.Critical("invalid pre dispatch output of ChargeAssetTxPayment")
+	}
+
+	if !preValue.AssetId.HasValue {
+		nativePre := sc.NewOption[primitives.Pre](preValue.ChargeTransactionPaymentPre)
+		return NewChargeTransactionPayment(preValue.Tip).PostDispatch(nativePre, info, postInfo, length, result)
+	}
+
+	assetId := preValue.AssetId.Value
+	actualFee := computeActualFee(sc.U32(length.ToBigInt().Uint64()), *info, *postInfo, preValue.Tip)
+
+	paidFee, paidTip := onChargeAssetTransaction.CorrectAndDepositFee(preValue.Who, assetId, actualFee, preValue.Tip, preValue.Imbalance)
+	system.DepositEvent(NewEventAssetTxFeePaid(preValue.Who.FixedSequence, paidFee, paidTip, preValue.AssetId))
+
+	return nil
+}
+
+func (catp ChargeAssetTxPayment) Metadata() primitives.MetadataSignedExtension {
+	return primitives.NewMetadataSignedExtension(catp.Identifier(), metadata.ChargeAssetTxPayment, metadata.TypesEmptyTuple)
+}
+
+// withdrawFee withdraws the fee in the native balance, or in the asset, if one is set.
+// Returns the fee in the native balance and the withdrawn amount.
+func (catp ChargeAssetTxPayment) withdrawFee(who *primitives.Address32, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (primitives.Balance, sc.Option[primitives.Balance], primitives.TransactionValidityError) {
+	fee := computeFee(sc.U32(length.ToBigInt().Uint64()), *info, catp.Tip)
+
+	var imbalance sc.Option[primitives.Balance]
+	var err primitives.TransactionValidityError
+	if catp.AssetId.HasValue {
+		imbalance, err = onChargeAssetTransaction.WithdrawFee(*who, catp.AssetId.Value, fee)
+	} else {
+		imbalance, err = withdrawFee(who, call, info, fee, catp.Tip)
+	}
+	if err != nil {
+		return primitives.Balance{}, sc.NewOption[primitives.Balance](nil), err
+	}
+
+	return fee, imbalance, nil
+}
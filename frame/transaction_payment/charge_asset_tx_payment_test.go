@@ -0,0 +1,348 @@
+//go:build nonwasmenv
+
+package transaction_payment
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/balances"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testAssetId              = primitives.AssetId(7)
+	testNonSufficientAssetId = primitives.AssetId(8)
+)
+
+var (
+	testWho  = primitives.NewAddress32(make([]sc.U8, 32)...)
+	testInfo = primitives.DispatchInfo{
+		Weight:  primitives.WeightFromParts(1_000_000, 0),
+		Class:   primitives.NewDispatchClassNormal(),
+		PaysFee: primitives.NewPaysYes(),
+	}
+	testLength = sc.ToCompact(100)
+	testPhase  = primitives.NewExtrinsicPhaseApply(0)
+	keyEvents  = append(hashing.Twox128(constants.KeySystem), hashing.Twox128(constants.KeyEvents)...)
+)
+
+// recordCredit records the fees, which are paid in an asset.
+type recordCredit struct {
+	credits map[primitives.AssetId]*big.Int
+}
+
+func (rc recordCredit) HandleCredit(assetId primitives.AssetId, credit primitives.Balance) {
+	rc.credits[assetId] = credit.ToBigInt()
+}
+
+// setupEvents starts the application of an extrinsic in block 1, so that its events are deposited.
+func setupEvents() {
+	system.StorageSetBlockNumber(1)
+	system.StorageSetExecutionPhase(testPhase)
+}
+
+func assertEvents(t *testing.T, events ...primitives.Event) {
+	records := sc.Sequence[primitives.EventRecord]{}
+	for _, event := range events {
+		records = append(records, primitives.EventRecord{Phase: testPhase, Event: event, Topics: sc.Sequence[primitives.H256]{}})
+	}
+
+	assert.Equal(t, records.Bytes(), storage.TakeBytes(keyEvents))
+}
+
+type mockAssets struct {
+	minBalances map[primitives.AssetId]primitives.Balance
+	sufficient  map[primitives.AssetId]bool
+	balances    map[primitives.AssetId]*big.Int
+	// depositFails makes the deposits fail, for example because the account is frozen.
+	depositFails bool
+}
+
+func newMockAssets(balance *big.Int) *mockAssets {
+	return &mockAssets{
+		minBalances: map[primitives.AssetId]primitives.Balance{
+			testAssetId:              sc.NewU128FromBigInt(new(big.Int).Mul(balances.ExistentialDeposit, big.NewInt(2))),
+			testNonSufficientAssetId: sc.NewU128FromBigInt(new(big.Int).Mul(balances.ExistentialDeposit, big.NewInt(2))),
+		},
+		sufficient: map[primitives.AssetId]bool{
+			testAssetId: true,
+		},
+		balances: map[primitives.AssetId]*big.Int{
+			testAssetId:              balance,
+			testNonSufficientAssetId: new(big.Int).Set(balance),
+		},
+	}
+}
+
+func (m *mockAssets) MinimumBalance(id primitives.AssetId) sc.Option[primitives.Balance] {
+	minBalance, ok := m.minBalances[id]
+	if !ok {
+		return sc.NewOption[primitives.Balance](nil)
+	}
+
+	return sc.NewOption[primitives.Balance](minBalance)
+}
+
+func (m *mockAssets) IsSufficient(id primitives.AssetId) bool {
+	return m.sufficient[id]
+}
+
+func (m *mockAssets) TotalIssuance(id primitives.AssetId) primitives.Balance {
+	return sc.NewU128FromBigInt(m.balances[id])
+}
+
+func (m *mockAssets) Balance(id primitives.AssetId, _ primitives.Address32) primitives.Balance {
+	return sc.NewU128FromBigInt(m.balances[id])
+}
+
+func (m *mockAssets) Withdraw(id primitives.AssetId, _ primitives.Address32, amount primitives.Balance) primitives.DispatchError {
+	balance, ok := m.balances[id]
+	if !ok || balance.Cmp(amount.ToBigInt()) < 0 {
+		return primitives.NewDispatchErrorToken(primitives.NewTokenErrorNoFounds())
+	}
+
+	m.balances[id] = new(big.Int).Sub(balance, amount.ToBigInt())
+	return nil
+}
+
+func (m *mockAssets) Deposit(id primitives.AssetId, _ primitives.Address32, amount primitives.Balance) primitives.DispatchError {
+	if m.depositFails {
+		return primitives.NewDispatchErrorToken(primitives.NewTokenErrorFrozen())
+	}
+
+	m.balances[id] = new(big.Int).Add(m.balances[id], amount.ToBigInt())
+	return nil
+}
+
+func (m *mockAssets) Transfer(_ primitives.AssetId, _ primitives.Address32, _ primitives.Address32, _ primitives.Balance, _ bool) primitives.DispatchError {
+	return nil
+}
+
+func Test_ChargeAssetTxPayment_Encode_Decode(t *testing.T) {
+	var testExamples = []struct {
+		label     string
+		extension ChargeAssetTxPayment
+	}{
+		{
+			label:     "Native balance",
+			extension: NewChargeAssetTxPayment(sc.NewU128FromUint64(5), sc.NewOption[primitives.AssetId](nil)),
+		},
+		{
+			label:     "Asset",
+			extension: NewChargeAssetTxPayment(sc.NewU128FromUint64(5), sc.NewOption[primitives.AssetId](testAssetId)),
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			buffer := bytes.NewBuffer(testExample.extension.Bytes())
+
+			result := ChargeAssetTxPayment{}.Decode(buffer)
+
+			assert.Equal(t, testExample.extension, result)
+			assert.Equal(t, 0, buffer.Len())
+		})
+	}
+}
+
+func Test_BalanceToAsset_ToAssetBalance(t *testing.T) {
+	RegisterAssets(newMockAssets(big.NewInt(0)))
+	defer RegisterAssets(nil)
+
+	var testExamples = []struct {
+		label       string
+		conversion  BalanceToAsset
+		assetId     primitives.AssetId
+		expectation sc.Option[primitives.Balance]
+	}{
+		{
+			label:       "Fixed rate",
+			conversion:  FixedRate{Rate: primitives.NewFixedU128FromUint64(3)},
+			assetId:     testAssetId,
+			expectation: sc.NewOption[primitives.Balance](sc.NewU128FromUint64(300)),
+		},
+		{
+			label:       "Minimum balance ratio",
+			conversion:  MinBalanceRatio{},
+			assetId:     testAssetId,
+			expectation: sc.NewOption[primitives.Balance](sc.NewU128FromUint64(200)),
+		},
+		{
+			label:       "Minimum balance ratio of a missing asset",
+			conversion:  MinBalanceRatio{},
+			assetId:     testAssetId + 2,
+			expectation: sc.NewOption[primitives.Balance](nil),
+		},
+		{
+			label:       "Minimum balance ratio of a non-sufficient asset",
+			conversion:  MinBalanceRatio{},
+			assetId:     testNonSufficientAssetId,
+			expectation: sc.NewOption[primitives.Balance](nil),
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			result := testExample.conversion.ToAssetBalance(sc.NewU128FromUint64(100), testExample.assetId)
+
+			assert.Equal(t, testExample.expectation, result)
+		})
+	}
+}
+
+func Test_ChargeAssetTxPayment_PreDispatch_NoAssets(t *testing.T) {
+	host.Reset()
+	RegisterAssets(nil)
+
+	extension := NewChargeAssetTxPayment(sc.NewU128FromUint64(0), sc.NewOption[primitives.AssetId](testAssetId))
+
+	_, err := extension.PreDispatch(&testWho, nil, &testInfo, testLength)
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewInvalidTransactionPayment()), err)
+}
+
+func Test_ChargeAssetTxPayment_PreDispatch_InsufficientBalance(t *testing.T) {
+	host.Reset()
+	RegisterAssets(newMockAssets(big.NewInt(1)))
+	defer RegisterAssets(nil)
+
+	extension := NewChargeAssetTxPayment(sc.NewU128FromUint64(0), sc.NewOption[primitives.AssetId](testAssetId))
+
+	_, err := extension.PreDispatch(&testWho, nil, &testInfo, testLength)
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewInvalidTransactionPayment()), err)
+}
+
+func Test_ChargeAssetTxPayment_PreDispatch_NonSufficientAsset(t *testing.T) {
+	host.Reset()
+	mock := newMockAssets(big.NewInt(1_000_000_000_000_000))
+	RegisterAssets(mock)
+	defer RegisterAssets(nil)
+
+	extension := NewChargeAssetTxPayment(sc.NewU128FromUint64(0), sc.NewOption[primitives.AssetId](testNonSufficientAssetId))
+
+	_, err := extension.PreDispatch(&testWho, nil, &testInfo, testLength)
+
+	assert.Equal(t, primitives.NewTransactionValidityError(primitives.NewInvalidTransactionPayment()), err)
+	assert.Equal(t, big.NewInt(1_000_000_000_000_000), mock.balances[testNonSufficientAssetId])
+}
+
+func Test_ChargeAssetTxPayment_PreDispatch_PostDispatch(t *testing.T) {
+	host.Reset()
+	setupEvents()
+	// the weight fee of the default multiplier rounds down to zero, leaving nothing to refund
+	storageSetNextFeeMultiplier(primitives.FixedU128One())
+	initialBalance := big.NewInt(1_000_000_000_000_000)
+	mock := newMockAssets(new(big.Int).Set(initialBalance))
+	credit := recordCredit{credits: map[primitives.AssetId]*big.Int{}}
+	RegisterAssets(mock)
+	RegisterBalanceToAsset(FixedRate{Rate: primitives.NewFixedU128FromUint64(2)})
+	RegisterOnChargeAssetTransaction(FungiblesAdapter{Credit: credit})
+	defer func() {
+		RegisterAssets(nil)
+		RegisterBalanceToAsset(MinBalanceRatio{})
+		RegisterOnChargeAssetTransaction(FungiblesAdapter{Credit: BurnAssetCredit{}})
+	}()
+
+	tip := sc.NewU128FromUint64(10)
+	extension := NewChargeAssetTxPayment(tip, sc.NewOption[primitives.AssetId](testAssetId))
+
+	pre, err := extension.PreDispatch(&testWho, nil, &testInfo, testLength)
+	assert.Nil(t, err)
+
+	fee := computeFee(100, testInfo, tip)
+	withdrawn := new(big.Int).Mul(fee.ToBigInt(), big.NewInt(2))
+	assert.Equal(t, sc.NewOption[primitives.Balance](sc.NewU128FromBigInt(withdrawn)), pre.(ChargeAssetTxPaymentPre).Imbalance)
+	assert.Equal(t, new(big.Int).Sub(initialBalance, withdrawn), mock.balances[testAssetId])
+
+	postInfo := primitives.PostDispatchInfo{
+		ActualWeight: sc.NewOption[primitives.Weight](primitives.WeightFromParts(500_000, 0)),
+		PaysFee:      primitives.PaysYes,
+	}
+	result := primitives.DispatchResult{}
+
+	err = extension.PostDispatch(sc.NewOption[primitives.Pre](pre), &testInfo, &postInfo, testLength, &result)
+	assert.Nil(t, err)
+
+	actualFee := computeActualFee(100, testInfo, postInfo, tip)
+	charged := new(big.Int).Mul(actualFee.ToBigInt(), big.NewInt(2))
+	assert.Equal(t, -1, actualFee.ToBigInt().Cmp(fee.ToBigInt()))
+	assert.Equal(t, new(big.Int).Sub(initialBalance, charged), mock.balances[testAssetId])
+	assert.Equal(t, charged, credit.credits[testAssetId])
+
+	assertEvents(t, NewEventAssetTxFeePaid(testWho.FixedSequence, sc.NewU128FromBigInt(charged), sc.NewU128FromUint64(20), sc.NewOption[primitives.AssetId](testAssetId)))
+}
+
+func Test_ChargeAssetTxPayment_PostDispatch_RefundFails(t *testing.T) {
+	host.Reset()
+	setupEvents()
+	storageSetNextFeeMultiplier(primitives.FixedU128One())
+	initialBalance := big.NewInt(1_000_000_000_000_000)
+	mock := newMockAssets(new(big.Int).Set(initialBalance))
+	credit := recordCredit{credits: map[primitives.AssetId]*big.Int{}}
+	RegisterAssets(mock)
+	RegisterOnChargeAssetTransaction(FungiblesAdapter{Credit: credit})
+	defer func() {
+		RegisterAssets(nil)
+		RegisterOnChargeAssetTransaction(FungiblesAdapter{Credit: BurnAssetCredit{}})
+	}()
+
+	extension := NewChargeAssetTxPayment(sc.NewU128FromUint64(0), sc.NewOption[primitives.AssetId](testAssetId))
+
+	pre, err := extension.PreDispatch(&testWho, nil, &testInfo, testLength)
+	assert.Nil(t, err)
+	withdrawn := pre.(ChargeAssetTxPaymentPre).Imbalance.Value.ToBigInt()
+
+	mock.depositFails = true
+	postInfo := primitives.PostDispatchInfo{
+		ActualWeight: sc.NewOption[primitives.Weight](primitives.WeightFromParts(500_000, 0)),
+		PaysFee:      primitives.PaysYes,
+	}
+
+	err = extension.PostDispatch(sc.NewOption[primitives.Pre](pre), &testInfo, &postInfo, testLength, &primitives.DispatchResult{})
+	assert.Nil(t, err)
+
+	// The overpaid fee cannot be refunded, so the whole withdrawn fee is kept.
+	assert.Equal(t, new(big.Int).Sub(initialBalance, withdrawn), mock.balances[testAssetId])
+	assert.Equal(t, withdrawn, credit.credits[testAssetId])
+	assertEvents(t, NewEventAssetTxFeePaid(testWho.FixedSequence, sc.NewU128FromBigInt(withdrawn), sc.NewU128FromUint64(0), sc.NewOption[primitives.AssetId](testAssetId)))
+}
+
+func Test_ChargeAssetTxPayment_PostDispatch_ZeroFee(t *testing.T) {
+	host.Reset()
+	setupEvents()
+	mock := newMockAssets(big.NewInt(1_000_000))
+	credit := recordCredit{credits: map[primitives.AssetId]*big.Int{}}
+	RegisterAssets(mock)
+	RegisterOnChargeAssetTransaction(FungiblesAdapter{Credit: credit})
+	defer func() {
+		RegisterAssets(nil)
+		RegisterOnChargeAssetTransaction(FungiblesAdapter{Credit: BurnAssetCredit{}})
+	}()
+
+	info := testInfo
+	info.PaysFee = primitives.NewPaysNo()
+	extension := NewChargeAssetTxPayment(sc.NewU128FromUint64(0), sc.NewOption[primitives.AssetId](testAssetId))
+
+	pre, err := extension.PreDispatch(&testWho, nil, &info, testLength)
+	assert.Nil(t, err)
+	assert.Equal(t, sc.NewOption[primitives.Balance](nil), pre.(ChargeAssetTxPaymentPre).Imbalance)
+
+	postInfo := primitives.PostDispatchInfo{PaysFee: primitives.PaysNo}
+	err = extension.PostDispatch(sc.NewOption[primitives.Pre](pre), &info, &postInfo, testLength, &primitives.DispatchResult{})
+	assert.Nil(t, err)
+
+	// The fee is not paid, but the event is still deposited, like the one of the native payment.
+	assert.Equal(t, big.NewInt(1_000_000), mock.balances[testAssetId])
+	assert.Empty(t, credit.credits)
+	assertEvents(t, NewEventAssetTxFeePaid(testWho.FixedSequence, sc.NewU128FromUint64(0), sc.NewU128FromUint64(0), sc.NewOption[primitives.AssetId](testAssetId)))
+}
@@ -1,20 +1,62 @@
 package transaction_payment
 
 import (
+	"bytes"
 	"math/big"
 
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/constants/transaction_payment"
 	"github.com/LimeChain/gosemble/frame/balances/dispatchables"
 	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/primitives/log"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
-type ChargeTransactionPayment primitives.Balance
+// ChargeTransactionPayment withdraws the fee of the transaction, including the tip, from the sender,
+// and refunds the overpaid fee after dispatch.
+type ChargeTransactionPayment struct {
+	primitives.DefaultSignedExtension
+	Tip primitives.Balance
+}
+
+func NewChargeTransactionPayment(tip primitives.Balance) ChargeTransactionPayment {
+	return ChargeTransactionPayment{Tip: tip}
+}
+
+// ChargeTransactionPaymentPre is passed from the pre dispatch of ChargeTransactionPayment to its post dispatch.
+type ChargeTransactionPaymentPre struct {
+	Tip primitives.Balance
+	Who primitives.Address32
+	// Imbalance is the withdrawn fee, or none if no fee is withdrawn.
+	Imbalance sc.Option[primitives.Balance]
+}
+
+func (p ChargeTransactionPaymentPre) Encode(buffer *bytes.Buffer) {
+	p.Tip.Encode(buffer)
+	p.Who.Encode(buffer)
+	p.Imbalance.Encode(buffer)
+}
+
+func (p ChargeTransactionPaymentPre) Bytes() []byte {
+	return sc.EncodedBytes(p)
+}
+
+func (ctp ChargeTransactionPayment) Encode(buffer *bytes.Buffer) {
+	sc.Compact(ctp.Tip).Encode(buffer)
+}
+
+func (ctp ChargeTransactionPayment) Decode(buffer *bytes.Buffer) primitives.SignedExtension {
+	return NewChargeTransactionPayment(primitives.Balance(sc.DecodeCompact(buffer)))
+}
+
+func (ctp ChargeTransactionPayment) Bytes() []byte {
+	return sc.EncodedBytes(ctp)
+}
 
-func (ctp ChargeTransactionPayment) AdditionalSigned() (ok sc.Empty, err primitives.TransactionValidityError) {
-	return sc.Empty{}, nil
+func (ctp ChargeTransactionPayment) Identifier() sc.Str {
+	return "ChargeTransactionPayment"
 }
 
 func (ctp ChargeTransactionPayment) Validate(who *primitives.Address32, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (primitives.ValidTransaction, primitives.TransactionValidityError) {
@@ -23,34 +65,41 @@ func (ctp ChargeTransactionPayment) Validate(who *primitives.Address32, call *pr
 		return primitives.ValidTransaction{}, err
 	}
 
-	tip := primitives.Balance(ctp)
 	validTransaction := primitives.DefaultValidTransaction()
-	validTransaction.Priority = ctp.getPriority(info, length, tip, finalFee)
+	validTransaction.Priority = ctp.getPriority(info, length, ctp.Tip, finalFee)
 
 	return validTransaction, nil
 }
 
 func (ctp ChargeTransactionPayment) PreDispatch(who *primitives.Address32, call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (ok primitives.Pre, err primitives.TransactionValidityError) {
 	_, imbalance, err := ctp.withdrawFee(who, call, info, length)
-	return primitives.Pre{
-		Tip:       primitives.Balance(ctp),
+	return ChargeTransactionPaymentPre{
+		Tip:       ctp.Tip,
 		Who:       *who,
 		Imbalance: imbalance,
 	}, err
 }
 
-func (ctp ChargeTransactionPayment) PostDispatch(pre sc.Option[primitives.Pre], info *primitives.DispatchInfo, postInfo *primitives.PostDispatchInfo, length sc.Compact, result *primitives.DispatchResult) (primitives.Pre, primitives.TransactionValidityError) {
+func (ctp ChargeTransactionPayment) PostDispatch(pre sc.Option[primitives.Pre], info *primitives.DispatchInfo, postInfo *primitives.PostDispatchInfo, length sc.Compact, result *primitives.DispatchResult) primitives.TransactionValidityError {
 	if pre.HasValue {
-		preValue := pre.Value
+		preValue, ok := pre.Value.(ChargeTransactionPaymentPre)
+		if !ok {
+			log.Critical("invalid pre dispatch output of ChargeTransactionPayment")
+		}
+
 		actualFee := computeActualFee(sc.U32(length.ToBigInt().Uint64()), *info, *postInfo, preValue.Tip)
 		err := correctAndDepositFee(&preValue.Who, actualFee, preValue.Tip, preValue.Imbalance)
 		if err != nil {
-			return primitives.Pre{}, err
+			return err
 		}
 
 		system.DepositEvent(NewEventTransactionFeePaid(preValue.Who.FixedSequence, actualFee, preValue.Tip))
 	}
-	return primitives.Pre{}, nil
+	return nil
+}
+
+func (ctp ChargeTransactionPayment) Metadata() primitives.MetadataSignedExtension {
+	return primitives.NewMetadataSignedExtension(ctp.Identifier(), metadata.ChargeTransactionPayment, metadata.TypesEmptyTuple)
 }
 
 func (ctp ChargeTransactionPayment) getPriority(info *primitives.DispatchInfo, len sc.Compact, tip primitives.Balance, finalFee primitives.Balance) primitives.TransactionPriority {
@@ -106,10 +155,9 @@ func (ctp ChargeTransactionPayment) getPriority(info *primitives.DispatchInfo, l
 }
 
 func (ctp ChargeTransactionPayment) withdrawFee(who *primitives.Address32, _call *primitives.Call, info *primitives.DispatchInfo, length sc.Compact) (primitives.Balance, sc.Option[primitives.Balance], primitives.TransactionValidityError) {
-	tip := primitives.Balance(ctp)
-	fee := computeFee(sc.U32(length.ToBigInt().Uint64()), *info, tip)
+	fee := computeFee(sc.U32(length.ToBigInt().Uint64()), *info, ctp.Tip)
 
-	imbalance, err := withdrawFee(who, _call, info, fee, sc.NewU128FromBigInt(tip.ToBigInt()))
+	imbalance, err := withdrawFee(who, _call, info, fee, sc.NewU128FromBigInt(ctp.Tip.ToBigInt()))
 	if err != nil {
 		return primitives.Balance{}, sc.NewOption[primitives.Balance](nil), err
 	}
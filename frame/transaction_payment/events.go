@@ -12,12 +12,17 @@ import (
 // TransactionPayment module events.
 const (
 	EventTransactionFeePaid sc.U8 = iota
+	EventAssetTxFeePaid
 )
 
 func NewEventTransactionFeePaid(account types.PublicKey, actualFee types.Balance, tip types.Balance) types.Event {
 	return types.NewEvent(transaction_payment.ModuleIndex, EventTransactionFeePaid, account, actualFee, tip)
 }
 
+func NewEventAssetTxFeePaid(account types.PublicKey, actualFee types.Balance, tip types.Balance, assetId sc.Option[types.AssetId]) types.Event {
+	return types.NewEvent(transaction_payment.ModuleIndex, EventAssetTxFeePaid, account, actualFee, tip, assetId)
+}
+
 func DecodeEvent(buffer *bytes.Buffer) types.Event {
 	module := sc.DecodeU8(buffer)
 	if module != transaction_payment.ModuleIndex {
@@ -32,6 +37,12 @@ func DecodeEvent(buffer *bytes.Buffer) types.Event {
 		actualFee := sc.DecodeU128(buffer)
 		tip := sc.DecodeU128(buffer)
 		return NewEventTransactionFeePaid(account, actualFee, tip)
+	case EventAssetTxFeePaid:
+		account := types.DecodePublicKey(buffer)
+		actualFee := sc.DecodeU128(buffer)
+		tip := sc.DecodeU128(buffer)
+		assetId := sc.DecodeOptionWith(buffer, sc.DecodeU32)
+		return NewEventAssetTxFeePaid(account, actualFee, tip, assetId)
 	default:
 		log.Critical("invalid transaction_payment.Event type")
 	}
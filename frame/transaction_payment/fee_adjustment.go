@@ -0,0 +1,86 @@
+package transaction_payment
+
+import (
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// TargetedFeeAdjustment updates the fee multiplier after each block, depending on how full the
+// normal dispatch class of the block is compared to a target fullness. With `s` the fullness of
+// the block, `s*` the target fullness and `v` the adjustment variable, the next multiplier is
+//
+//	next = previous * (1 + v * (s - s*) + v^2 * (s - s*)^2 / 2)
+//
+// limited to the range [MinimumMultiplier, MaximumMultiplier]. The fullness is measured in the
+// weight dimension, which is closer to its limit.
+type TargetedFeeAdjustment struct {
+	TargetBlockFullness primitives.Perquintill
+	AdjustmentVariable  primitives.FixedU128
+	MinimumMultiplier   primitives.FixedU128
+	MaximumMultiplier   primitives.FixedU128
+}
+
+// Convert returns the fee multiplier of the next block from the multiplier of the current block,
+// given the consumed and the maximum weight of the normal dispatch class of the current block.
+func (tfa TargetedFeeAdjustment) Convert(previous primitives.FixedU128, normalBlockWeight primitives.Weight, normalMaxWeight primitives.Weight) primitives.FixedU128 {
+	if previous.Cmp(tfa.MinimumMultiplier) < 0 {
+		previous = tfa.MinimumMultiplier
+	}
+
+	normalBlockWeight = normalBlockWeight.Min(normalMaxWeight)
+
+	// The fullness of the block is measured in the limiting dimension of the weight.
+	blockWeight, maxWeight := normalBlockWeight.RefTime, normalMaxWeight.RefTime
+	normalizedRefTime := normalizedWeight(normalBlockWeight.RefTime, normalMaxWeight.RefTime)
+	normalizedProofSize := normalizedWeight(normalBlockWeight.ProofSize, normalMaxWeight.ProofSize)
+	if normalizedRefTime.Deconstruct().Cmp(normalizedProofSize.Deconstruct()) < 0 {
+		blockWeight, maxWeight = normalBlockWeight.ProofSize, normalMaxWeight.ProofSize
+	}
+
+	targetWeight := tfa.TargetBlockFullness.Mul(maxWeight).(sc.U64)
+
+	positive := blockWeight >= targetWeight
+	diffAbs := new(big.Int).Abs(new(big.Int).Sub(
+		new(big.Int).SetUint64(uint64(blockWeight)),
+		new(big.Int).SetUint64(uint64(targetWeight)),
+	))
+
+	// The difference does not exceed the maximum weight, so the ratio is at most one.
+	diff := primitives.NewFixedU128FromRational(diffAbs, new(big.Int).SetUint64(uint64(maxWeight.Max(1))), primitives.RoundingDown).Value
+	diffSquared := diff.SaturatingMul(diff)
+
+	v := tfa.AdjustmentVariable
+	vSquared2 := v.SaturatingMul(v).CheckedDiv(primitives.NewFixedU128FromUint64(2)).Value
+
+	firstTerm := v.SaturatingMul(diff)
+	secondTerm := vSquared2.SaturatingMul(diffSquared)
+
+	var next primitives.FixedU128
+	if positive {
+		excess := firstTerm.SaturatingAdd(secondTerm).SaturatingMul(previous)
+		next = previous.SaturatingAdd(excess)
+	} else {
+		negative := firstTerm.SaturatingSub(secondTerm).SaturatingMul(previous)
+		next = previous.SaturatingSub(negative)
+	}
+
+	if next.Cmp(tfa.MinimumMultiplier) < 0 {
+		return tfa.MinimumMultiplier
+	}
+	if next.Cmp(tfa.MaximumMultiplier) > 0 {
+		return tfa.MaximumMultiplier
+	}
+
+	return next
+}
+
+// normalizedWeight returns the share of `max`, which `weight` takes. `weight` must not exceed `max`.
+func normalizedWeight(weight sc.U64, max sc.U64) primitives.Perbill {
+	return primitives.NewPerbillFromRational(
+		new(big.Int).SetUint64(uint64(weight)),
+		new(big.Int).SetUint64(uint64(max.Max(1))),
+		primitives.RoundingDown,
+	).Value
+}
@@ -0,0 +1,129 @@
+//go:build nonwasmenv
+
+package transaction_payment
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testFeeAdjustment = TargetedFeeAdjustment{
+		TargetBlockFullness: primitives.NewPerquintillFromPercent(25),
+		AdjustmentVariable:  fixedU128(30_000_000_000_000),
+		MinimumMultiplier:   fixedU128(1_000_000_000),
+		MaximumMultiplier:   primitives.FixedU128Max(),
+	}
+	testMaxWeight = primitives.WeightFromParts(1_000_000, 1_000_000)
+)
+
+func fixedU128(inner uint64) primitives.FixedU128 {
+	return primitives.NewFixedU128FromInner(sc.NewU128FromUint64(inner))
+}
+
+func Test_TargetedFeeAdjustment_Convert(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		previous    primitives.FixedU128
+		blockWeight primitives.Weight
+		expectation primitives.FixedU128
+	}{
+		{
+			label:       "Block at target fullness",
+			previous:    primitives.FixedU128One(),
+			blockWeight: primitives.WeightFromParts(250_000, 0),
+			expectation: primitives.FixedU128One(),
+		},
+		{
+			label:       "Saturated block",
+			previous:    primitives.FixedU128One(),
+			blockWeight: testMaxWeight,
+			expectation: fixedU128(1_000_022_500_253_125_000),
+		},
+		{
+			label:       "Block over the maximum weight",
+			previous:    primitives.FixedU128One(),
+			blockWeight: primitives.WeightFromParts(5_000_000, 0),
+			expectation: fixedU128(1_000_022_500_253_125_000),
+		},
+		{
+			label:       "Saturated block in proof size",
+			previous:    primitives.FixedU128One(),
+			blockWeight: primitives.WeightFromParts(0, 1_000_000),
+			expectation: fixedU128(1_000_022_500_253_125_000),
+		},
+		{
+			label:       "Empty block",
+			previous:    primitives.FixedU128One(),
+			blockWeight: primitives.WeightZero(),
+			expectation: fixedU128(999_992_500_028_125_000),
+		},
+		{
+			label:       "Empty block at the minimum multiplier",
+			previous:    testFeeAdjustment.MinimumMultiplier,
+			blockWeight: primitives.WeightZero(),
+			expectation: testFeeAdjustment.MinimumMultiplier,
+		},
+		{
+			label:       "Previous multiplier below the minimum",
+			previous:    fixedU128(0),
+			blockWeight: primitives.WeightFromParts(250_000, 0),
+			expectation: testFeeAdjustment.MinimumMultiplier,
+		},
+		{
+			label:       "Default multiplier",
+			previous:    DefaultMultiplierValue,
+			blockWeight: primitives.WeightZero(),
+			expectation: testFeeAdjustment.MinimumMultiplier,
+		},
+		{
+			label:       "Saturated block at the maximum multiplier",
+			previous:    testFeeAdjustment.MaximumMultiplier,
+			blockWeight: testMaxWeight,
+			expectation: testFeeAdjustment.MaximumMultiplier,
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			result := testFeeAdjustment.Convert(testExample.previous, testExample.blockWeight, testMaxWeight)
+
+			assert.Equal(t, testExample.expectation, result)
+		})
+	}
+}
+
+func Test_TargetedFeeAdjustment_Convert_Consecutive(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		blockWeight primitives.Weight
+		expectation int
+	}{
+		{
+			label:       "Saturated blocks increase the multiplier",
+			blockWeight: testMaxWeight,
+			expectation: 1,
+		},
+		{
+			label:       "Empty blocks decrease the multiplier",
+			blockWeight: primitives.WeightZero(),
+			expectation: -1,
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			previous := primitives.FixedU128One()
+
+			for i := 0; i < 100; i++ {
+				next := testFeeAdjustment.Convert(previous, testExample.blockWeight, testMaxWeight)
+
+				assert.Equal(t, testExample.expectation, next.Cmp(previous))
+				previous = next
+			}
+		})
+	}
+}
@@ -4,10 +4,13 @@ import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants/metadata"
 	"github.com/LimeChain/gosemble/constants/transaction_payment"
+	"github.com/LimeChain/gosemble/frame/support"
+	tp "github.com/LimeChain/gosemble/frame/transaction_payment"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 )
 
 type TransactionPaymentModule struct {
+	primitives.DefaultHooks
 }
 
 func NewTransactionPaymentModule() TransactionPaymentModule {
@@ -26,36 +29,35 @@ func (tpm TransactionPaymentModule) ValidateUnsigned(_ primitives.TransactionSou
 	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
 }
 
+func (tpm TransactionPaymentModule) OnFinalize(n primitives.BlockNumber) {
+	tp.OnFinalize(n)
+}
+
 func (tpm TransactionPaymentModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
-	return tpm.metadataTypes(), primitives.MetadataModule{
-		Name: "TransactionPayment",
-		Storage: sc.NewOption[primitives.MetadataModuleStorage](primitives.MetadataModuleStorage{
-			Prefix: "TransactionPayment",
-			Items: sc.Sequence[primitives.MetadataModuleStorageEntry]{
-				primitives.NewMetadataModuleStorageEntry(
-					"NextFeeMultiplier",
-					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesFixedU128)),
-					"NextFeeMultiplier"),
-				primitives.NewMetadataModuleStorageEntry(
-					"StorageVersion",
-					primitives.MetadataModuleStorageEntryModifierDefault,
-					primitives.NewMetadataModuleStorageEntryDefinitionPlain(sc.ToCompact(metadata.TypesTransactionPaymentReleases)),
-					"StorageVersion"),
+	declaredTypes, metadataModule := tpm.declaration().Metadata()
+
+	return append(tpm.metadataTypes(), declaredTypes...), metadataModule
+}
+
+// declaration declares the storage, events and constants of the module,
+// from which its metadata is derived.
+func (tpm TransactionPaymentModule) declaration() support.ModuleDeclaration {
+	return support.ModuleDeclaration{
+		Name:    "TransactionPayment",
+		Index:   transaction_payment.ModuleIndex,
+		Path:    "pallet_transaction_payment",
+		Storage: tp.StorageDeclarations(),
+		Events: &support.EnumDeclaration{
+			TypeId:   metadata.TypesTransactionPaymentEvent,
+			Variants: eventDeclarations,
+		},
+		Constants: []support.ConstantDeclaration{
+			{
+				Name:  "OperationalFeeMultiplier",
+				Value: transaction_payment.OperationalFeeMultiplier,
+				Docs:  "A fee multiplier for `Operational` extrinsics to compute \"virtual tip\" to boost their  `priority` ",
 			},
-		}),
-		Call:  sc.NewOption[sc.Compact](nil),
-		Event: sc.NewOption[sc.Compact](sc.ToCompact(metadata.TypesTransactionPaymentEvent)),
-		Constants: sc.Sequence[primitives.MetadataModuleConstant]{
-			primitives.NewMetadataModuleConstant(
-				"OperationalFeeMultiplier",
-				sc.ToCompact(metadata.PrimitiveTypesU8),
-				sc.BytesToSequenceU8(transaction_payment.OperationalFeeMultiplier.Bytes()),
-				"A fee multiplier for `Operational` extrinsics to compute \"virtual tip\" to boost their  `priority` ",
-			),
 		},
-		Error: sc.NewOption[sc.Compact](nil),
-		Index: transaction_payment.ModuleIndex,
 	}
 }
 
@@ -75,24 +77,43 @@ func (tpm TransactionPaymentModule) metadataTypes() sc.Sequence[primitives.Metad
 					"One that bumps the usage to FixedU128 from FixedI128."),
 			})),
 
-		primitives.NewMetadataTypeWithParam(metadata.TypesTransactionPaymentEvent, "pallet_transaction_payment pallet Event", sc.Sequence[sc.Str]{"pallet_transaction_payment", "pallet", "Event"}, primitives.NewMetadataTypeDefinitionVariant(
-			sc.Sequence[primitives.MetadataDefinitionVariant]{
-				primitives.NewMetadataDefinitionVariant(
-					"TransactionFeePaid",
-					sc.Sequence[primitives.MetadataTypeDefinitionField]{
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "actual_fee", "BalanceOf<T>"),
-						primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "tip", "BalanceOf<T>"),
-					},
-					0,
-					"Event.TransactionFeePaid"),
-			}), primitives.NewMetadataEmptyTypeParameter("T")),
-
 		primitives.NewMetadataTypeWithParam(metadata.ChargeTransactionPayment, "ChargeTransactionPayment", sc.Sequence[sc.Str]{"pallet_transaction_payment", "ChargeTransactionPayment"},
 			primitives.NewMetadataTypeDefinitionComposite(sc.Sequence[primitives.MetadataTypeDefinitionField]{
 				primitives.NewMetadataTypeDefinitionFieldWithName(metadata.TypesCompactU128, "BalanceOf<T>"),
 			}),
 			primitives.NewMetadataEmptyTypeParameter("T"),
 		),
+
+		primitives.NewMetadataTypeWithParam(metadata.ChargeAssetTxPayment, "ChargeAssetTxPayment", sc.Sequence[sc.Str]{"pallet_asset_tx_payment", "ChargeAssetTxPayment"},
+			primitives.NewMetadataTypeDefinitionComposite(sc.Sequence[primitives.MetadataTypeDefinitionField]{
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesCompactU128, "tip", "BalanceOf<T>"),
+				primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionU32, "asset_id", "Option<T::AssetId>"),
+			}),
+			primitives.NewMetadataEmptyTypeParameter("T"),
+		),
 	}
 }
+
+var eventDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "TransactionFeePaid",
+		Index: tp.EventTransactionFeePaid,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "actual_fee", "BalanceOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "tip", "BalanceOf<T>"),
+		},
+		Docs: "A transaction fee `actual_fee`, of which `tip` was added to the minimum inclusion fee, has been paid by `who`.",
+	},
+	{
+		Name:  "AssetTxFeePaid",
+		Index: tp.EventAssetTxFeePaid,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesAddress32, "who", "T::AccountId"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "actual_fee", "AssetBalanceOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU128, "tip", "AssetBalanceOf<T>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOptionU32, "asset_id", "Option<AssetIdOf<T>>"),
+		},
+		Docs: "A transaction fee `actual_fee`, of which `tip` was added to the minimum inclusion fee, has been paid by `who` in an asset `asset_id`.",
+	},
+}
@@ -0,0 +1,112 @@
+package transaction_payment
+
+import (
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/primitives/log"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+// OnChargeAssetTransaction withdraws the fees of the transactions, which are paid in an asset,
+// and handles them after dispatch.
+type OnChargeAssetTransaction interface {
+	// WithdrawFee withdraws `fee`, given in the native balance, from `who` in the asset `assetId`.
+	// Returns the withdrawn amount of the asset, or none if the fee is zero.
+	WithdrawFee(who primitives.Address32, assetId primitives.AssetId, fee primitives.Balance) (sc.Option[primitives.Balance], primitives.TransactionValidityError)
+
+	// CorrectAndDepositFee refunds to `who` the amount, withdrawn in excess of `correctedFee`, and handles
+	// the paid fee. The corrected fee and the tip are given in the native balance.
+	// Returns the paid fee and tip in the asset. It is called after the dispatch, so it cannot fail:
+	// an amount, which cannot be refunded, is kept as part of the paid fee.
+	CorrectAndDepositFee(who primitives.Address32, assetId primitives.AssetId, correctedFee primitives.Balance, tip primitives.Balance, alreadyWithdrawn sc.Option[primitives.Balance]) (primitives.Balance, primitives.Balance)
+}
+
+// HandleAssetCredit handles the fees, which are paid in an asset, once the overpaid fee is refunded.
+type HandleAssetCredit interface {
+	HandleCredit(assetId primitives.AssetId, credit primitives.Balance)
+}
+
+// BurnAssetCredit burns the fees, which are paid in an asset. Withdrawing the fee already decreases
+// the supply of the asset, so there is nothing left to do.
+type BurnAssetCredit struct{}
+
+func (BurnAssetCredit) HandleCredit(_ primitives.AssetId, _ primitives.Balance) {}
+
+// CreditAssetTo deposits the fees, which are paid in an asset, to `Account`.
+// If the deposit fails, for example because it is below the minimum balance of the asset, the fee is burned.
+type CreditAssetTo struct {
+	Account primitives.Address32
+}
+
+func (cat CreditAssetTo) HandleCredit(assetId primitives.AssetId, credit primitives.Balance) {
+	if credit.ToBigInt().Cmp(constants.Zero) == 0 {
+		return
+	}
+
+	_ = assets.Deposit(assetId, cat.Account, credit)
+}
+
+// FungiblesAdapter withdraws the fees from the registered assets, converting them with the registered
+// BalanceToAsset, and passes the paid fees to `Credit`.
+type FungiblesAdapter struct {
+	Credit HandleAssetCredit
+}
+
+func (fa FungiblesAdapter) WithdrawFee(who primitives.Address32, assetId primitives.AssetId, fee primitives.Balance) (sc.Option[primitives.Balance], primitives.TransactionValidityError) {
+	if fee.ToBigInt().Cmp(constants.Zero) == 0 {
+		return sc.NewOption[primitives.Balance](nil), nil
+	}
+
+	if assets == nil {
+		return sc.NewOption[primitives.Balance](nil), primitives.NewTransactionValidityError(primitives.NewInvalidTransactionPayment())
+	}
+
+	convertedFee := balanceToAsset.ToAssetBalance(fee, assetId)
+	if !convertedFee.HasValue {
+		return sc.NewOption[primitives.Balance](nil), primitives.NewTransactionValidityError(primitives.NewInvalidTransactionPayment())
+	}
+
+	err := assets.Withdraw(assetId, who, convertedFee.Value)
+	if err != nil {
+		return sc.NewOption[primitives.Balance](nil), primitives.NewTransactionValidityError(primitives.NewInvalidTransactionPayment())
+	}
+
+	return sc.NewOption[primitives.Balance](convertedFee.Value), nil
+}
+
+func (fa FungiblesAdapter) CorrectAndDepositFee(who primitives.Address32, assetId primitives.AssetId, correctedFee primitives.Balance, tip primitives.Balance, alreadyWithdrawn sc.Option[primitives.Balance]) (primitives.Balance, primitives.Balance) {
+	// Nothing was withdrawn, since the fee, including the tip, is zero.
+	if !alreadyWithdrawn.HasValue {
+		return sc.NewU128FromUint64(0), sc.NewU128FromUint64(0)
+	}
+
+	paidTip := sc.NewU128FromUint64(0)
+	convertedTip := balanceToAsset.ToAssetBalance(tip, assetId)
+	if convertedTip.HasValue {
+		paidTip = convertedTip.Value
+	}
+
+	paid := alreadyWithdrawn.Value
+	convertedFee := balanceToAsset.ToAssetBalance(correctedFee, assetId)
+	if !convertedFee.HasValue {
+		log.Warn("failed to convert the corrected fee to the asset, keeping the withdrawn fee")
+		fa.Credit.HandleCredit(assetId, paid)
+		return paid, paidTip
+	}
+
+	refundAmount := new(big.Int).Sub(alreadyWithdrawn.Value.ToBigInt(), convertedFee.Value.ToBigInt())
+	if refundAmount.Cmp(constants.Zero) > 0 {
+		err := assets.Deposit(assetId, who, sc.NewU128FromBigInt(refundAmount))
+		if err != nil {
+			log.Warn("failed to refund the overpaid fee, keeping the withdrawn fee")
+		} else {
+			paid = convertedFee.Value
+		}
+	}
+
+	fa.Credit.HandleCredit(assetId, paid)
+
+	return paid, paidTip
+}
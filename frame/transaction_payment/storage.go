@@ -0,0 +1,46 @@
+package transaction_payment
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/LimeChain/gosemble/frame/support"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+var (
+	storageNextFeeMultiplierValue = support.NewStorageValue[primitives.FixedU128](constants.KeyTransactionPayment, constants.KeyNextFeeMultiplier, primitives.DecodeFixedU128)
+	// storageVersion is the release of the storage layout of the module. It is never written,
+	// since the runtime started with the latest release.
+	storageVersion = support.NewStorageValue[sc.U8](constants.KeyTransactionPayment, constants.KeyStorageVersionValue, sc.DecodeU8)
+)
+
+// StorageDeclarations returns the declarations of the storage items of the module, in the order of the metadata.
+func StorageDeclarations() []support.StorageDeclaration {
+	return []support.StorageDeclaration{
+		{
+			Item:     storageNextFeeMultiplierValue,
+			Modifier: primitives.MetadataModuleStorageEntryModifierDefault,
+			Docs:     "NextFeeMultiplier",
+		},
+		{
+			Item:      storageVersion,
+			Modifier:  primitives.MetadataModuleStorageEntryModifierDefault,
+			ValueType: support.TypeId(metadata.TypesTransactionPaymentReleases),
+			Docs:      "StorageVersion",
+		},
+	}
+}
+
+func storageNextFeeMultiplier() primitives.FixedU128 {
+	multiplier := storageNextFeeMultiplierValue.GetOption()
+	if !multiplier.HasValue {
+		return DefaultMultiplierValue
+	}
+
+	return multiplier.Value
+}
+
+func storageSetNextFeeMultiplier(multiplier primitives.FixedU128) {
+	storageNextFeeMultiplierValue.Put(multiplier)
+}
@@ -2,33 +2,62 @@ package transaction_payment
 
 import (
 	"bytes"
-	"math/big"
 
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
+	"github.com/LimeChain/gosemble/constants/transaction_payment"
 	"github.com/LimeChain/gosemble/execution/types"
 	"github.com/LimeChain/gosemble/frame/system"
-	"github.com/LimeChain/gosemble/primitives/hashing"
-	"github.com/LimeChain/gosemble/primitives/storage"
 	primitives "github.com/LimeChain/gosemble/primitives/types"
 	"github.com/LimeChain/gosemble/utils"
 )
 
-var DefaultMultiplierValue = sc.NewU128FromUint64(1)
+// DefaultMultiplierValue is the fee multiplier, while none is stored, i.e. until the end of the first block.
+// It is the smallest positive multiplier (inner value 1), as before the multiplier was adjusted to the fullness
+// of the blocks, so that the fees of the genesis state are unchanged: the adjusted weight fee rounds down to zero.
+// The first update raises the multiplier to at least MinimumMultiplier.
+var DefaultMultiplierValue = primitives.NewFixedU128FromInner(sc.NewU128FromUint64(1))
 var DefaultTip = sc.NewU128FromUint64(0)
 
-// QueryInfo queries the data of an extrinsic.
+// FeeMultiplierUpdate updates the fee multiplier after each block, depending on how full the block is.
+var FeeMultiplierUpdate = TargetedFeeAdjustment{
+	TargetBlockFullness: transaction_payment.TargetBlockFullness,
+	AdjustmentVariable:  transaction_payment.AdjustmentVariable,
+	MinimumMultiplier:   transaction_payment.MinimumMultiplier,
+	MaximumMultiplier:   transaction_payment.MaximumMultiplier,
+}
+
+// OnFinalize updates the fee multiplier of the next block from the weight, consumed by
+// the normal dispatch class of the block.
+func OnFinalize(_ primitives.BlockNumber) {
+	normalClass := primitives.NewDispatchClassNormal()
+
+	weights := system.DefaultBlockWeights()
+	normalMaxWeight := weights.MaxBlock
+	maxTotal := weights.Get(normalClass).MaxTotal
+	if maxTotal.HasValue {
+		normalMaxWeight = maxTotal.Value
+	}
+
+	blockWeight := system.StorageGetBlockWeight()
+	normalBlockWeight := *blockWeight.Get(normalClass)
+
+	multiplier := FeeMultiplierUpdate.Convert(storageNextFeeMultiplier(), normalBlockWeight, normalMaxWeight)
+	storageSetNextFeeMultiplier(multiplier)
+}
+
+// QueryInfo queries the data of an extrinsic, which is decoded by the signed extensions of `extra`.
 // It takes two arguments:
 // - dataPtr: Pointer to the data in the Wasm memory.
 // - dataLen: Length of the data.
 // which represent the SCALE-encoded extrinsic and its length.
 // Returns a pointer-size of the SCALE-encoded weight, dispatch class and partial fee.
 // [Specification](https://spec.polkadot.network/chap-runtime-api#sect-rte-transactionpaymentapi-query-info)
-func QueryInfo(dataPtr int32, dataLen int32) int64 {
+func QueryInfo(extra primitives.SignedExtra, dataPtr int32, dataLen int32) int64 {
 	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
 	buffer := bytes.NewBuffer(b)
 
-	ext := types.DecodeUncheckedExtrinsic(buffer)
+	ext := types.DecodeUncheckedExtrinsic(extra, buffer)
 	length := sc.DecodeU32(buffer)
 
 	dispatchInfo := primitives.GetDispatchInfo(ext.Function)
@@ -47,18 +76,18 @@ func QueryInfo(dataPtr int32, dataLen int32) int64 {
 	return utils.BytesToOffsetAndSize(runtimeDispatchInfo.Bytes())
 }
 
-// QueryFeeDetails queries the detailed fee of an extrinsic.
+// QueryFeeDetails queries the detailed fee of an extrinsic, which is decoded by the signed extensions of `extra`.
 // It takes two arguments:
 // - dataPtr: Pointer to the data in the Wasm memory.
 // - dataLen: Length of the data.
 // which represent the SCALE-encoded extrinsic and its length.
 // Returns a pointer-size of the SCALE-encoded detailed fee.
 // [Specification](https://spec.polkadot.network/chap-runtime-api#sect-rte-transactionpaymentapi-query-fee-details)
-func QueryFeeDetails(dataPtr int32, dataLen int32) int64 {
+func QueryFeeDetails(extra primitives.SignedExtra, dataPtr int32, dataLen int32) int64 {
 	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
 	buffer := bytes.NewBuffer(b)
 
-	ext := types.DecodeUncheckedExtrinsic(buffer)
+	ext := types.DecodeUncheckedExtrinsic(extra, buffer)
 	length := sc.DecodeU32(buffer)
 
 	dispatchInfo := primitives.GetDispatchInfo(ext.Function)
@@ -142,9 +171,7 @@ func computeFeeRaw(len sc.U32, weight primitives.Weight, tip primitives.Balance,
 		unadjustedWeightFee := weightToFee(weight)
 		multiplier := storageNextFeeMultiplier()
 
-		fixedU128Div := big.NewInt(1_000_000_000_000_000_000)
-		bnAdjustedWeightFee := new(big.Int).Mul(multiplier.ToBigInt(), unadjustedWeightFee.ToBigInt())
-		adjustedWeightFee := sc.NewU128FromBigInt(new(big.Int).Div(bnAdjustedWeightFee, fixedU128Div)) // TODO: Create FixedU128 type
+		adjustedWeightFee := multiplier.SaturatingMulInt(unadjustedWeightFee)
 
 		lenFee := lengthToFee(len)
 		baseFee := weightToFee(system.DefaultBlockWeights().Get(class).BaseExtrinsic)
@@ -172,15 +199,3 @@ func weightToFee(weight primitives.Weight) primitives.Balance {
 
 	return constants.WeightToFee.WeightToFee(cappedWeight)
 }
-
-func storageNextFeeMultiplier() sc.U128 {
-	// Storage value is FixedU128, which is different from U128.
-	// It implements a decimal fixed point number, which is `1 / VALUE`
-	// Example: FixedU128, VALUE is 1_000_000_000_000_000_000.
-	// FixedU64, VALUE is 1_000_000_000.
-	txPaymentHash := hashing.Twox128(constants.KeyTransactionPayment)
-	nextFeeMultiplierHash := hashing.Twox128(constants.KeyNextFeeMultiplier)
-	key := append(txPaymentHash, nextFeeMultiplierHash...)
-
-	return storage.GetDecodeOnEmpty(key, sc.DecodeU128, DefaultMultiplierValue)
-}
@@ -0,0 +1,52 @@
+package try_runtime
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/config"
+	"github.com/LimeChain/gosemble/execution/types"
+	"github.com/LimeChain/gosemble/frame/executive"
+	"github.com/LimeChain/gosemble/frame/system"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+	"github.com/LimeChain/gosemble/utils"
+)
+
+// OnRuntimeUpgrade executes the runtime upgrade and all pending migrations with the selected checks.
+// It takes two arguments:
+// - dataPtr: Pointer to the data in the Wasm memory.
+// - dataLen: Length of the data.
+// which represent the SCALE-encoded checks to execute.
+// Returns a pointer-size of the SCALE-encoded weight consumed by the upgrade and the maximum weight of a block.
+func OnRuntimeUpgrade(dataPtr int32, dataLen int32) int64 {
+	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
+	buffer := bytes.NewBuffer(b)
+
+	checks := primitives.DecodeUpgradeCheckSelect(buffer)
+
+	weight := executive.TryRuntimeUpgrade(checks)
+	result := sc.NewVaryingData(weight, system.DefaultBlockWeights().MaxBlock)
+
+	return utils.BytesToOffsetAndSize(result.Bytes())
+}
+
+// ExecuteBlock executes the block with the selected checks.
+// It takes two arguments:
+// - dataPtr: Pointer to the data in the Wasm memory.
+// - dataLen: Length of the data.
+// which represent the SCALE-encoded block, whether to check its state root and the signatures
+// of its extrinsics, and the modules, whose TryState checks to execute.
+// Returns a pointer-size of the SCALE-encoded weight of the block.
+func ExecuteBlock(dataPtr int32, dataLen int32) int64 {
+	b := utils.ToWasmMemorySlice(dataPtr, dataLen)
+	buffer := bytes.NewBuffer(b)
+
+	block := types.DecodeBlock(config.SignedExtra, buffer)
+	stateRootCheck := sc.DecodeBool(buffer)
+	signatureCheck := sc.DecodeBool(buffer)
+	selectTryState := primitives.DecodeTryStateSelect(buffer)
+
+	weight := executive.TryExecuteBlock(block, bool(stateRootCheck), bool(signatureCheck), selectTryState)
+
+	return utils.BytesToOffsetAndSize(weight.Bytes())
+}
@@ -0,0 +1,117 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants"
+	cu "github.com/LimeChain/gosemble/constants/utility"
+	ext "github.com/LimeChain/gosemble/execution/types"
+	"github.com/LimeChain/gosemble/frame/utility"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type AsDerivativeCall struct {
+	primitives.Callable
+}
+
+func NewAsDerivativeCall(args sc.VaryingData) AsDerivativeCall {
+	call := AsDerivativeCall{
+		Callable: primitives.Callable{
+			ModuleId:   cu.ModuleIndex,
+			FunctionId: cu.FunctionAsDerivativeIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c AsDerivativeCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		sc.DecodeU16(buffer),
+		ext.DecodeCall(buffer),
+	)
+	return c
+}
+
+func (c AsDerivativeCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c AsDerivativeCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c AsDerivativeCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c AsDerivativeCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c AsDerivativeCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+// The weight of the dispatched call is added to the weight of `as_derivative`.
+func (_ AsDerivativeCall) BaseWeight(args ...any) types.Weight {
+	call := args[0].(sc.VaryingData)[1].(types.Call)
+	return asDerivativeWeight().
+		SaturatingAdd(types.GetDispatchInfo(call).Weight).
+		SaturatingAdd(constants.DbWeight.ReadsWrites(1, 1))
+}
+
+func (_ AsDerivativeCall) IsInherent() bool {
+	return false
+}
+
+func (_ AsDerivativeCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+// The dispatch class of the dispatched call.
+func (c AsDerivativeCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	if len(c.Arguments) == 0 {
+		return types.NewDispatchClassNormal()
+	}
+
+	return types.GetDispatchInfo(c.Arguments[1].(types.Call)).Class
+}
+
+func (_ AsDerivativeCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ AsDerivativeCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	return asDerivative(origin, args[0].(sc.U16), args[1].(types.Call))
+}
+
+// asDerivative dispatches the call with a `Signed` origin of the sub-account, derived from the sender and the index.
+// The sender must be signed.
+func asDerivative(origin types.RuntimeOrigin, index sc.U16, call types.Call) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	if !origin.IsSignedOrigin() {
+		return errorResult(types.NewDispatchErrorBadOrigin(), types.PostDispatchInfo{})
+	}
+
+	pseudonym := utility.DerivativeAccountId(origin.AsSigned(), index)
+
+	info := types.GetDispatchInfo(call)
+	result := dispatchWithStorageLayer(types.NewRawOriginSigned(pseudonym), call)
+
+	// Always take into account the base weight of this call.
+	weight := asDerivativeWeight().
+		SaturatingAdd(types.ExtractActualWeight(&result, &info)).
+		SaturatingAdd(constants.DbWeight.ReadsWrites(1, 1))
+
+	if result.HasError {
+		return errorResult(result.Err.Error, actualWeightPostInfo(weight))
+	}
+
+	return okResult(actualWeightPostInfo(weight))
+}
@@ -0,0 +1,125 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	cu "github.com/LimeChain/gosemble/constants/utility"
+	ext "github.com/LimeChain/gosemble/execution/types"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/frame/utility"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type BatchCall struct {
+	primitives.Callable
+}
+
+func NewBatchCall(args sc.VaryingData) BatchCall {
+	call := BatchCall{
+		Callable: primitives.Callable{
+			ModuleId:   cu.ModuleIndex,
+			FunctionId: cu.FunctionBatchIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c BatchCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(sc.DecodeSequenceWith(buffer, ext.DecodeCall))
+	return c
+}
+
+func (c BatchCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c BatchCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c BatchCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c BatchCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c BatchCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+// The weights of the batched calls are added to the weight of `batch`.
+func (_ BatchCall) BaseWeight(args ...any) types.Weight {
+	calls := args[0].(sc.VaryingData)[0].(sc.Sequence[types.Call])
+	weight, _ := callsWeightAndClass(calls)
+	return weight.SaturatingAdd(batchWeight(sc.U64(len(calls))))
+}
+
+func (_ BatchCall) IsInherent() bool {
+	return false
+}
+
+func (_ BatchCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+// The dispatch class is `Operational`, if all batched calls are operational.
+func (c BatchCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	if len(c.Arguments) == 0 {
+		return types.NewDispatchClassNormal()
+	}
+
+	_, class := callsWeightAndClass(c.Arguments[0].(sc.Sequence[types.Call]))
+	return class
+}
+
+func (_ BatchCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ BatchCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	return batch(origin, args[0].(sc.Sequence[types.Call]))
+}
+
+// batch dispatches the calls one by one with the given origin. It stops at the first failed call
+// and emits `BatchInterrupted`, otherwise it emits `BatchCompleted`. The batch itself succeeds in both cases.
+// Accepts any origin, except `None`.
+func batch(origin types.RuntimeOrigin, calls sc.Sequence[types.Call]) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	if origin.IsNoneOrigin() {
+		return errorResult(types.NewDispatchErrorBadOrigin(), types.PostDispatchInfo{})
+	}
+
+	err := ensureCallsLimit(calls)
+	if err != nil {
+		return errorResult(err, types.PostDispatchInfo{})
+	}
+
+	weight := types.WeightZero()
+	for index, call := range calls {
+		info := types.GetDispatchInfo(call)
+		result := dispatchWithStorageLayer(origin, call)
+		weight = weight.SaturatingAdd(types.ExtractActualWeight(&result, &info))
+
+		if result.HasError {
+			system.DepositEvent(utility.NewEventBatchInterrupted(sc.U32(index), result.Err.Error))
+			// Take the weight of this function itself into account.
+			baseWeight := batchWeight(sc.U64(index + 1))
+			return okResult(actualWeightPostInfo(baseWeight.SaturatingAdd(weight)))
+		}
+
+		system.DepositEvent(utility.NewEventItemCompleted())
+	}
+
+	system.DepositEvent(utility.NewEventBatchCompleted())
+
+	baseWeight := batchWeight(sc.U64(len(calls)))
+	return okResult(actualWeightPostInfo(baseWeight.SaturatingAdd(weight)))
+}
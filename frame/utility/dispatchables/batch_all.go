@@ -0,0 +1,147 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	cu "github.com/LimeChain/gosemble/constants/utility"
+	ext "github.com/LimeChain/gosemble/execution/types"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/frame/utility"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type BatchAllCall struct {
+	primitives.Callable
+}
+
+func NewBatchAllCall(args sc.VaryingData) BatchAllCall {
+	call := BatchAllCall{
+		Callable: primitives.Callable{
+			ModuleId:   cu.ModuleIndex,
+			FunctionId: cu.FunctionBatchAllIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c BatchAllCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(sc.DecodeSequenceWith(buffer, ext.DecodeCall))
+	return c
+}
+
+func (c BatchAllCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c BatchAllCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c BatchAllCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c BatchAllCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c BatchAllCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+// The weights of the batched calls are added to the weight of `batch_all`.
+func (_ BatchAllCall) BaseWeight(args ...any) types.Weight {
+	calls := args[0].(sc.VaryingData)[0].(sc.Sequence[types.Call])
+	weight, _ := callsWeightAndClass(calls)
+	return weight.SaturatingAdd(batchAllWeight(sc.U64(len(calls))))
+}
+
+func (_ BatchAllCall) IsInherent() bool {
+	return false
+}
+
+func (_ BatchAllCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+// The dispatch class is `Operational`, if all batched calls are operational.
+func (c BatchAllCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	if len(c.Arguments) == 0 {
+		return types.NewDispatchClassNormal()
+	}
+
+	_, class := callsWeightAndClass(c.Arguments[0].(sc.Sequence[types.Call]))
+	return class
+}
+
+func (_ BatchAllCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ BatchAllCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	return batchAll(origin, args[0].(sc.Sequence[types.Call]))
+}
+
+// batchAll dispatches the calls atomically with the given origin. If any of the calls fails,
+// all changes of the batch are rolled back and the error of the call is returned.
+// Accepts any origin, except `None`.
+func batchAll(origin types.RuntimeOrigin, calls sc.Sequence[types.Call]) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	if origin.IsNoneOrigin() {
+		return errorResult(types.NewDispatchErrorBadOrigin(), types.PostDispatchInfo{})
+	}
+
+	err := ensureCallsLimit(calls)
+	if err != nil {
+		return errorResult(err, types.PostDispatchInfo{})
+	}
+
+	var result types.DispatchResultWithPostInfo[types.PostDispatchInfo]
+
+	_, err = support.WithTransaction[types.PostDispatchInfo, types.DispatchError](
+		func() types.TransactionOutcome {
+			result = dispatchAll(origin, calls)
+			if result.HasError {
+				return types.NewTransactionOutcomeRollback(result.Err.Error)
+			}
+
+			return types.NewTransactionOutcomeCommit(result.Ok)
+		},
+	)
+	if err != nil && !result.HasError {
+		// The transaction could not be started, so the calls were not dispatched.
+		return errorResult(err, types.PostDispatchInfo{})
+	}
+
+	return result
+}
+
+// dispatchAll dispatches the calls one by one and stops at the first failed call.
+func dispatchAll(origin types.RuntimeOrigin, calls sc.Sequence[types.Call]) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	weight := types.WeightZero()
+	for index, call := range calls {
+		info := types.GetDispatchInfo(call)
+		result := dispatchWithStorageLayer(origin, call)
+		weight = weight.SaturatingAdd(types.ExtractActualWeight(&result, &info))
+
+		if result.HasError {
+			// Take the weight of this function itself into account.
+			baseWeight := batchAllWeight(sc.U64(index + 1))
+			return errorResult(result.Err.Error, actualWeightPostInfo(baseWeight.SaturatingAdd(weight)))
+		}
+
+		system.DepositEvent(utility.NewEventItemCompleted())
+	}
+
+	system.DepositEvent(utility.NewEventBatchCompleted())
+
+	baseWeight := batchAllWeight(sc.U64(len(calls)))
+	return okResult(actualWeightPostInfo(baseWeight.SaturatingAdd(weight)))
+}
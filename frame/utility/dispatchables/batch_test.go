@@ -0,0 +1,132 @@
+//go:build nonwasmenv
+
+package dispatchables
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	cs "github.com/LimeChain/gosemble/constants/system"
+	sd "github.com/LimeChain/gosemble/frame/system/dispatchables"
+	se "github.com/LimeChain/gosemble/frame/system/errors"
+	"github.com/LimeChain/gosemble/frame/utility"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/storage"
+	"github.com/LimeChain/gosemble/primitives/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	keyFirst  = []byte("first")
+	keySecond = []byte("second")
+)
+
+func newSetStorageCall(key []byte) types.Call {
+	return sd.NewSetStorageCall(sc.NewVaryingData(sc.Sequence[types.KeyValue]{
+		{Key: sc.BytesToSequenceU8(key), Value: sc.BytesToSequenceU8([]byte{1})},
+	}))
+}
+
+func Test_Batch(t *testing.T) {
+	// set_code fails, because the new code has no runtime version.
+	failingCall := sd.NewSetCodeCall(sc.NewVaryingData(sc.BytesToSequenceU8([]byte("code"))))
+	calls := sc.Sequence[types.Call]{newSetStorageCall(keyFirst), failingCall, newSetStorageCall(keySecond)}
+
+	failedToExtractRuntimeVersion := types.NewDispatchErrorModule(types.CustomModuleError{
+		Index:   cs.ModuleIndex,
+		Error:   sc.U32(se.ErrorFailedToExtractRuntimeVersion),
+		Message: sc.NewOption[sc.Str](nil),
+	})
+
+	var testExamples = []struct {
+		label       string
+		call        types.Call
+		origin      types.RuntimeOrigin
+		expectation types.DispatchError
+		stored      []bool
+	}{
+		{
+			label:       "batch(BadOrigin)",
+			call:        NewBatchCall(nil),
+			origin:      types.NewRawOriginNone(),
+			expectation: types.NewDispatchErrorBadOrigin(),
+			stored:      []bool{false, false},
+		},
+		{
+			label:  "batch(interrupted)",
+			call:   NewBatchCall(nil),
+			origin: types.NewRawOriginRoot(),
+			stored: []bool{true, false},
+		},
+		{
+			label:       "batch_all(rolled back)",
+			call:        NewBatchAllCall(nil),
+			origin:      types.NewRawOriginRoot(),
+			expectation: failedToExtractRuntimeVersion,
+			stored:      []bool{false, false},
+		},
+		{
+			label:  "force_batch(completed with errors)",
+			call:   NewForceBatchCall(nil),
+			origin: types.NewRawOriginRoot(),
+			stored: []bool{true, true},
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			host.Reset()
+
+			result := testExample.call.Dispatch(testExample.origin, sc.NewVaryingData(calls))
+
+			if testExample.expectation != nil {
+				assert.True(t, bool(result.HasError))
+				assert.Equal(t, testExample.expectation, result.Err.Error)
+			} else {
+				assert.False(t, bool(result.HasError))
+				assert.True(t, bool(result.Ok.ActualWeight.HasValue))
+			}
+			assert.Equal(t, testExample.stored[0], storage.Exists(keyFirst) != 0)
+			assert.Equal(t, testExample.stored[1], storage.Exists(keySecond) != 0)
+		})
+	}
+}
+
+func Test_Batch_DispatchInfo(t *testing.T) {
+	calls := sc.Sequence[types.Call]{newSetStorageCall(keyFirst), newSetStorageCall(keySecond)}
+
+	expectedWeight := batchWeight(2).
+		SaturatingAdd(types.GetDispatchInfo(calls[0]).Weight).
+		SaturatingAdd(types.GetDispatchInfo(calls[1]).Weight)
+
+	info := types.GetDispatchInfo(NewBatchCall(sc.NewVaryingData(calls)))
+
+	assert.Equal(t, types.WeightFromParts(expectedWeight.RefTime, 0), info.Weight)
+	assert.Equal(t, types.GetDispatchInfo(calls[0]).Class, info.Class)
+}
+
+func Test_AsDerivative(t *testing.T) {
+	who := types.NewAddress32(make([]sc.U8, 32)...)
+
+	assert.NotEqual(t, utility.DerivativeAccountId(who, 0), utility.DerivativeAccountId(who, 1))
+
+	result := NewAsDerivativeCall(nil).Dispatch(types.NewRawOriginRoot(), sc.NewVaryingData(sc.U16(0), newSetStorageCall(keyFirst)))
+	assert.True(t, bool(result.HasError))
+	assert.Equal(t, types.NewDispatchErrorBadOrigin(), result.Err.Error)
+
+	// set_storage requires root, so the derived signed origin is rejected.
+	result = NewAsDerivativeCall(nil).Dispatch(types.NewRawOriginSigned(who), sc.NewVaryingData(sc.U16(0), newSetStorageCall(keyFirst)))
+	assert.True(t, bool(result.HasError))
+	assert.Equal(t, types.NewDispatchErrorBadOrigin(), result.Err.Error)
+	assert.True(t, bool(result.Err.PostInfo.ActualWeight.HasValue))
+}
+
+func Test_DispatchAs(t *testing.T) {
+	host.Reset()
+
+	result := NewDispatchAsCall(nil).Dispatch(types.NewRawOriginRoot(),
+		sc.NewVaryingData(types.NewOriginCallerSystem(types.NewRawOriginRoot()), newSetStorageCall(keyFirst)))
+
+	assert.False(t, bool(result.HasError))
+	assert.NotEqual(t, int32(0), storage.Exists(keyFirst))
+}
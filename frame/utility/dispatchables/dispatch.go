@@ -0,0 +1,91 @@
+package dispatchables
+
+import (
+	sc "github.com/LimeChain/goscale"
+	cu "github.com/LimeChain/gosemble/constants/utility"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/frame/utility/errors"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// dispatchWithStorageLayer dispatches the call with the given origin in a new storage layer,
+// which is rolled back if the call fails.
+func dispatchWithStorageLayer(origin types.RawOrigin, call types.Call) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	var result types.DispatchResultWithPostInfo[types.PostDispatchInfo]
+
+	_, err := support.WithStorageLayer[types.PostDispatchInfo, types.DispatchError](
+		func() (types.PostDispatchInfo, types.DispatchError) {
+			result = call.Dispatch(origin, call.Args())
+			if result.HasError {
+				return types.PostDispatchInfo{}, result.Err.Error
+			}
+
+			return result.Ok, nil
+		},
+	)
+	if err != nil && !result.HasError {
+		// The storage layer could not be added, so the call was not dispatched.
+		return errorResult(err, types.PostDispatchInfo{})
+	}
+
+	return result
+}
+
+// callsWeightAndClass returns the sum of the weights of the calls and their dispatch class.
+// The class is `Operational` only if all calls are operational.
+func callsWeightAndClass(calls sc.Sequence[types.Call]) (types.Weight, types.DispatchClass) {
+	weight := types.WeightZero()
+	allOperational := true
+
+	for _, call := range calls {
+		info := types.GetDispatchInfo(call)
+		weight = weight.SaturatingAdd(info.Weight)
+
+		if !info.Class.Is(types.DispatchClassOperational) {
+			allOperational = false
+		}
+	}
+
+	if allOperational {
+		return weight, types.NewDispatchClassOperational()
+	}
+
+	return weight, types.NewDispatchClassNormal()
+}
+
+// ensureCallsLimit returns `TooManyCalls` if the number of calls exceeds the batched calls limit.
+func ensureCallsLimit(calls sc.Sequence[types.Call]) types.DispatchError {
+	if len(calls) > cu.BatchedCallsLimit {
+		return types.NewDispatchErrorModule(types.CustomModuleError{
+			Index:   cu.ModuleIndex,
+			Error:   sc.U32(errors.ErrorTooManyCalls),
+			Message: sc.NewOption[sc.Str](nil),
+		})
+	}
+
+	return nil
+}
+
+// actualWeightPostInfo returns the post dispatch info with the given actual weight.
+func actualWeightPostInfo(weight types.Weight) types.PostDispatchInfo {
+	return types.PostDispatchInfo{
+		ActualWeight: sc.NewOption[types.Weight](weight),
+	}
+}
+
+func errorResult(err types.DispatchError, postInfo types.PostDispatchInfo) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: true,
+		Err: types.DispatchErrorWithPostInfo[types.PostDispatchInfo]{
+			PostInfo: postInfo,
+			Error:    err,
+		},
+	}
+}
+
+func okResult(postInfo types.PostDispatchInfo) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	return types.DispatchResultWithPostInfo[types.PostDispatchInfo]{
+		HasError: false,
+		Ok:       postInfo,
+	}
+}
@@ -0,0 +1,109 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	cu "github.com/LimeChain/gosemble/constants/utility"
+	ext "github.com/LimeChain/gosemble/execution/types"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/frame/utility"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type DispatchAsCall struct {
+	primitives.Callable
+}
+
+func NewDispatchAsCall(args sc.VaryingData) DispatchAsCall {
+	call := DispatchAsCall{
+		Callable: primitives.Callable{
+			ModuleId:   cu.ModuleIndex,
+			FunctionId: cu.FunctionDispatchAsIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c DispatchAsCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(
+		types.DecodeOriginCaller(buffer),
+		ext.DecodeCall(buffer),
+	)
+	return c
+}
+
+func (c DispatchAsCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c DispatchAsCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c DispatchAsCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c DispatchAsCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c DispatchAsCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+// The weight of the dispatched call is added to the weight of `dispatch_as`.
+func (_ DispatchAsCall) BaseWeight(args ...any) types.Weight {
+	call := args[0].(sc.VaryingData)[1].(types.Call)
+	return dispatchAsWeight().SaturatingAdd(types.GetDispatchInfo(call).Weight)
+}
+
+func (_ DispatchAsCall) IsInherent() bool {
+	return false
+}
+
+func (_ DispatchAsCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+// The dispatch class of the dispatched call.
+func (c DispatchAsCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	if len(c.Arguments) == 0 {
+		return types.NewDispatchClassNormal()
+	}
+
+	return types.GetDispatchInfo(c.Arguments[1].(types.Call)).Class
+}
+
+func (_ DispatchAsCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ DispatchAsCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	return dispatchAs(origin, args[0].(types.OriginCaller), args[1].(types.Call))
+}
+
+// dispatchAs dispatches the call with the given origin and emits `DispatchedAs` with its outcome.
+// Can only be called by ROOT.
+func dispatchAs(origin types.RuntimeOrigin, asOrigin types.OriginCaller, call types.Call) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	if !origin.IsRootOrigin() {
+		return errorResult(types.NewDispatchErrorBadOrigin(), types.PostDispatchInfo{})
+	}
+
+	result := dispatchWithStorageLayer(asOrigin.AsSystem(), call)
+
+	outcome := types.NewDispatchOutcome(nil)
+	if result.HasError {
+		outcome = types.NewDispatchOutcome(result.Err.Error)
+	}
+	system.DepositEvent(utility.NewEventDispatchedAs(outcome))
+
+	return okResult(types.PostDispatchInfo{})
+}
@@ -0,0 +1,128 @@
+package dispatchables
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	cu "github.com/LimeChain/gosemble/constants/utility"
+	ext "github.com/LimeChain/gosemble/execution/types"
+	"github.com/LimeChain/gosemble/frame/system"
+	"github.com/LimeChain/gosemble/frame/utility"
+	"github.com/LimeChain/gosemble/primitives/types"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type ForceBatchCall struct {
+	primitives.Callable
+}
+
+func NewForceBatchCall(args sc.VaryingData) ForceBatchCall {
+	call := ForceBatchCall{
+		Callable: primitives.Callable{
+			ModuleId:   cu.ModuleIndex,
+			FunctionId: cu.FunctionForceBatchIndex,
+		},
+	}
+
+	if len(args) != 0 {
+		call.Arguments = args
+	}
+
+	return call
+}
+
+func (c ForceBatchCall) DecodeArgs(buffer *bytes.Buffer) primitives.Call {
+	c.Arguments = sc.NewVaryingData(sc.DecodeSequenceWith(buffer, ext.DecodeCall))
+	return c
+}
+
+func (c ForceBatchCall) Encode(buffer *bytes.Buffer) {
+	c.Callable.Encode(buffer)
+}
+
+func (c ForceBatchCall) Bytes() []byte {
+	return c.Callable.Bytes()
+}
+
+func (c ForceBatchCall) ModuleIndex() sc.U8 {
+	return c.Callable.ModuleIndex()
+}
+
+func (c ForceBatchCall) FunctionIndex() sc.U8 {
+	return c.Callable.FunctionIndex()
+}
+
+func (c ForceBatchCall) Args() sc.VaryingData {
+	return c.Callable.Args()
+}
+
+// The weights of the batched calls are added to the weight of `force_batch`.
+func (_ ForceBatchCall) BaseWeight(args ...any) types.Weight {
+	calls := args[0].(sc.VaryingData)[0].(sc.Sequence[types.Call])
+	weight, _ := callsWeightAndClass(calls)
+	return weight.SaturatingAdd(forceBatchWeight(sc.U64(len(calls))))
+}
+
+func (_ ForceBatchCall) IsInherent() bool {
+	return false
+}
+
+func (_ ForceBatchCall) WeightInfo(baseWeight types.Weight) types.Weight {
+	return types.WeightFromParts(baseWeight.RefTime, 0)
+}
+
+// The dispatch class is `Operational`, if all batched calls are operational.
+func (c ForceBatchCall) ClassifyDispatch(baseWeight types.Weight) types.DispatchClass {
+	if len(c.Arguments) == 0 {
+		return types.NewDispatchClassNormal()
+	}
+
+	_, class := callsWeightAndClass(c.Arguments[0].(sc.Sequence[types.Call]))
+	return class
+}
+
+func (_ ForceBatchCall) PaysFee(baseWeight types.Weight) types.Pays {
+	return types.NewPaysYes()
+}
+
+func (_ ForceBatchCall) Dispatch(origin types.RuntimeOrigin, args sc.VaryingData) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	return forceBatch(origin, args[0].(sc.Sequence[types.Call]))
+}
+
+// forceBatch dispatches the calls one by one with the given origin and does not stop at failed calls.
+// It emits `ItemFailed` for each failed call and `BatchCompletedWithErrors` at the end, if any of the calls failed.
+// Accepts any origin, except `None`.
+func forceBatch(origin types.RuntimeOrigin, calls sc.Sequence[types.Call]) types.DispatchResultWithPostInfo[types.PostDispatchInfo] {
+	if origin.IsNoneOrigin() {
+		return errorResult(types.NewDispatchErrorBadOrigin(), types.PostDispatchInfo{})
+	}
+
+	err := ensureCallsLimit(calls)
+	if err != nil {
+		return errorResult(err, types.PostDispatchInfo{})
+	}
+
+	weight := types.WeightZero()
+	hasError := false
+	for _, call := range calls {
+		info := types.GetDispatchInfo(call)
+		result := dispatchWithStorageLayer(origin, call)
+		weight = weight.SaturatingAdd(types.ExtractActualWeight(&result, &info))
+
+		if result.HasError {
+			hasError = true
+			system.DepositEvent(utility.NewEventItemFailed(result.Err.Error))
+		} else {
+			system.DepositEvent(utility.NewEventItemCompleted())
+		}
+	}
+
+	if hasError {
+		system.DepositEvent(utility.NewEventBatchCompletedWithErrors())
+	} else {
+		system.DepositEvent(utility.NewEventBatchCompleted())
+	}
+
+	baseWeight := forceBatchWeight(sc.U64(len(calls)))
+	return okResult(actualWeightPostInfo(baseWeight.SaturatingAdd(weight)))
+}
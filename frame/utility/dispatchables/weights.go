@@ -0,0 +1,55 @@
+package dispatchables
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// The range of component `c` is `[0, 1000]`.
+func batchWeight(c sc.U64) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `0`
+	//  Estimated: `0`
+	// Minimum execution time: 6_763 nanoseconds.
+	// Standard Error: 2_286
+	return types.WeightFromParts(6_763_000, 0).
+		SaturatingAdd(types.WeightFromParts(4_089_000, 0).SaturatingMul(c))
+}
+
+func asDerivativeWeight() types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `0`
+	//  Estimated: `0`
+	// Minimum execution time: 4_843 nanoseconds.
+	return types.WeightFromParts(4_843_000, 0)
+}
+
+// The range of component `c` is `[0, 1000]`.
+func batchAllWeight(c sc.U64) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `0`
+	//  Estimated: `0`
+	// Minimum execution time: 6_790 nanoseconds.
+	// Standard Error: 2_105
+	return types.WeightFromParts(6_790_000, 0).
+		SaturatingAdd(types.WeightFromParts(4_349_000, 0).SaturatingMul(c))
+}
+
+func dispatchAsWeight() types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `0`
+	//  Estimated: `0`
+	// Minimum execution time: 8_324 nanoseconds.
+	return types.WeightFromParts(8_324_000, 0)
+}
+
+// The range of component `c` is `[0, 1000]`.
+func forceBatchWeight(c sc.U64) types.Weight {
+	// Proof Size summary in bytes:
+	//  Measured:  `0`
+	//  Estimated: `0`
+	// Minimum execution time: 6_807 nanoseconds.
+	// Standard Error: 2_010
+	return types.WeightFromParts(6_807_000, 0).
+		SaturatingAdd(types.WeightFromParts(4_067_000, 0).SaturatingMul(c))
+}
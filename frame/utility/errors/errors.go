@@ -0,0 +1,8 @@
+package errors
+
+import sc "github.com/LimeChain/goscale"
+
+// Utility module errors.
+const (
+	ErrorTooManyCalls sc.U8 = iota
+)
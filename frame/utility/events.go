@@ -0,0 +1,76 @@
+package utility
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/utility"
+	"github.com/LimeChain/gosemble/primitives/log"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// Utility module events.
+const (
+	EventBatchInterrupted sc.U8 = iota
+	EventBatchCompleted
+	EventBatchCompletedWithErrors
+	EventItemCompleted
+	EventItemFailed
+	EventDispatchedAs
+)
+
+func NewEventBatchInterrupted(index sc.U32, dispatchError types.DispatchError) types.Event {
+	return types.NewEvent(utility.ModuleIndex, EventBatchInterrupted, index, dispatchError)
+}
+
+func NewEventBatchCompleted() types.Event {
+	return types.NewEvent(utility.ModuleIndex, EventBatchCompleted)
+}
+
+func NewEventBatchCompletedWithErrors() types.Event {
+	return types.NewEvent(utility.ModuleIndex, EventBatchCompletedWithErrors)
+}
+
+func NewEventItemCompleted() types.Event {
+	return types.NewEvent(utility.ModuleIndex, EventItemCompleted)
+}
+
+func NewEventItemFailed(dispatchError types.DispatchError) types.Event {
+	return types.NewEvent(utility.ModuleIndex, EventItemFailed, dispatchError)
+}
+
+func NewEventDispatchedAs(result types.DispatchOutcome) types.Event {
+	return types.NewEvent(utility.ModuleIndex, EventDispatchedAs, result)
+}
+
+func DecodeEvent(buffer *bytes.Buffer) types.Event {
+	moduleIndex := sc.DecodeU8(buffer)
+	if moduleIndex != utility.ModuleIndex {
+		log.Critical("invalid utility.Event")
+	}
+
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case EventBatchInterrupted:
+		index := sc.DecodeU32(buffer)
+		dispatchError := types.DecodeDispatchError(buffer)
+		return NewEventBatchInterrupted(index, dispatchError)
+	case EventBatchCompleted:
+		return NewEventBatchCompleted()
+	case EventBatchCompletedWithErrors:
+		return NewEventBatchCompletedWithErrors()
+	case EventItemCompleted:
+		return NewEventItemCompleted()
+	case EventItemFailed:
+		dispatchError := types.DecodeDispatchError(buffer)
+		return NewEventItemFailed(dispatchError)
+	case EventDispatchedAs:
+		result := types.DecodeDispatchOutcome(buffer)
+		return NewEventDispatchedAs(result)
+	default:
+		log.Critical("invalid utility.Event type")
+	}
+
+	panic("unreachable")
+}
@@ -0,0 +1,218 @@
+package module
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	cu "github.com/LimeChain/gosemble/constants/utility"
+	"github.com/LimeChain/gosemble/frame/support"
+	"github.com/LimeChain/gosemble/frame/utility"
+	"github.com/LimeChain/gosemble/frame/utility/dispatchables"
+	"github.com/LimeChain/gosemble/frame/utility/errors"
+	primitives "github.com/LimeChain/gosemble/primitives/types"
+)
+
+type UtilityModule struct {
+	primitives.DefaultHooks
+	functions map[sc.U8]primitives.Call
+}
+
+func NewUtilityModule() UtilityModule {
+	functions := make(map[sc.U8]primitives.Call)
+	functions[cu.FunctionBatchIndex] = dispatchables.NewBatchCall(nil)
+	functions[cu.FunctionAsDerivativeIndex] = dispatchables.NewAsDerivativeCall(nil)
+	functions[cu.FunctionBatchAllIndex] = dispatchables.NewBatchAllCall(nil)
+	functions[cu.FunctionDispatchAsIndex] = dispatchables.NewDispatchAsCall(nil)
+	functions[cu.FunctionForceBatchIndex] = dispatchables.NewForceBatchCall(nil)
+
+	return UtilityModule{
+		functions: functions,
+	}
+}
+
+func (um UtilityModule) Functions() map[sc.U8]primitives.Call {
+	return um.functions
+}
+
+func (um UtilityModule) PreDispatch(_ primitives.Call) (sc.Empty, primitives.TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (um UtilityModule) ValidateUnsigned(_ primitives.TransactionSource, _ primitives.Call) (primitives.ValidTransaction, primitives.TransactionValidityError) {
+	return primitives.ValidTransaction{}, primitives.NewTransactionValidityError(primitives.NewUnknownTransactionNoUnsignedValidator())
+}
+
+func (um UtilityModule) Metadata() (sc.Sequence[primitives.MetadataType], primitives.MetadataModule) {
+	declaredTypes, metadataModule := um.declaration().Metadata()
+
+	return append(um.metadataTypes(), declaredTypes...), metadataModule
+}
+
+// declaration declares the calls, events, errors and constants of the module,
+// from which its metadata is derived.
+func (um UtilityModule) declaration() support.ModuleDeclaration {
+	return support.ModuleDeclaration{
+		Name:  "Utility",
+		Index: cu.ModuleIndex,
+		Path:  "pallet_utility",
+		Calls: &support.EnumDeclaration{
+			TypeId:   metadata.UtilityCalls,
+			Variants: callDeclarations,
+		},
+		Events: &support.EnumDeclaration{
+			TypeId:   metadata.TypesUtilityEvent,
+			Variants: eventDeclarations,
+		},
+		Errors: &support.EnumDeclaration{
+			TypeId:   metadata.TypesUtilityErrors,
+			Variants: errorDeclarations,
+		},
+		Constants: []support.ConstantDeclaration{
+			{
+				Name:  "batched_calls_limit",
+				Value: sc.U32(cu.BatchedCallsLimit),
+				Docs:  "The limit on the number of batched calls.",
+			},
+		},
+	}
+}
+
+func (um UtilityModule) metadataTypes() sc.Sequence[primitives.MetadataType] {
+	return sc.Sequence[primitives.MetadataType]{
+		primitives.NewMetadataType(metadata.TypesSequenceRuntimeCall, "Vec<RuntimeCall>",
+			primitives.NewMetadataTypeDefinitionSequence(sc.ToCompact(metadata.RuntimeCall))),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesRawOrigin,
+			"RawOrigin",
+			sc.Sequence[sc.Str]{"frame_support", "dispatch", "RawOrigin"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"Root",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						primitives.RawOriginRoot,
+						"RawOrigin.Root"),
+					primitives.NewMetadataDefinitionVariant(
+						"Signed",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionField(metadata.TypesAddress32),
+						},
+						primitives.RawOriginSigned,
+						"RawOrigin.Signed"),
+					primitives.NewMetadataDefinitionVariant(
+						"None",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{},
+						primitives.RawOriginNone,
+						"RawOrigin.None"),
+				})),
+
+		primitives.NewMetadataTypeWithPath(metadata.TypesOriginCaller,
+			"OriginCaller",
+			sc.Sequence[sc.Str]{"node_template_runtime", "OriginCaller"},
+			primitives.NewMetadataTypeDefinitionVariant(
+				sc.Sequence[primitives.MetadataDefinitionVariant]{
+					primitives.NewMetadataDefinitionVariant(
+						"system",
+						sc.Sequence[primitives.MetadataTypeDefinitionField]{
+							primitives.NewMetadataTypeDefinitionField(metadata.TypesRawOrigin),
+						},
+						primitives.OriginCallerSystem,
+						"OriginCaller.system"),
+				})),
+	}
+}
+
+var callDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "batch",
+		Index: cu.FunctionBatchIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceRuntimeCall, "calls", "Vec<<T as Config>::RuntimeCall>"),
+		},
+		Docs: "Send a batch of dispatch calls. Stops at the first failed call.",
+	},
+	{
+		Name:  "as_derivative",
+		Index: cu.FunctionAsDerivativeIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU16, "index", "u16"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.RuntimeCall, "call", "Box<<T as Config>::RuntimeCall>"),
+		},
+		Docs: "Send a call through an indexed pseudonym of the sender.",
+	},
+	{
+		Name:  "batch_all",
+		Index: cu.FunctionBatchAllIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceRuntimeCall, "calls", "Vec<<T as Config>::RuntimeCall>"),
+		},
+		Docs: "Send a batch of dispatch calls and atomically execute them. The whole transaction will rollback and fail if any of the calls failed.",
+	},
+	{
+		Name:  "dispatch_as",
+		Index: cu.FunctionDispatchAsIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesOriginCaller, "as_origin", "Box<T::PalletsOrigin>"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.RuntimeCall, "call", "Box<<T as Config>::RuntimeCall>"),
+		},
+		Docs: "Dispatches a function call with a provided origin.",
+	},
+	{
+		Name:  "force_batch",
+		Index: cu.FunctionForceBatchIndex,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesSequenceRuntimeCall, "calls", "Vec<<T as Config>::RuntimeCall>"),
+		},
+		Docs: "Send a batch of dispatch calls. Unlike `batch`, it allows errors and won't interrupt.",
+	},
+}
+
+var eventDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "BatchInterrupted",
+		Index: utility.EventBatchInterrupted,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.PrimitiveTypesU32, "index", "u32"),
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesDispatchError, "error", "DispatchError"),
+		},
+		Docs: "Batch of dispatches did not complete fully. Index of first failing dispatch given, as well as the error.",
+	},
+	{
+		Name:  "BatchCompleted",
+		Index: utility.EventBatchCompleted,
+		Docs:  "Batch of dispatches completed fully with no error.",
+	},
+	{
+		Name:  "BatchCompletedWithErrors",
+		Index: utility.EventBatchCompletedWithErrors,
+		Docs:  "Batch of dispatches completed but has errors.",
+	},
+	{
+		Name:  "ItemCompleted",
+		Index: utility.EventItemCompleted,
+		Docs:  "A single item within a Batch of dispatches has completed with no error.",
+	},
+	{
+		Name:  "ItemFailed",
+		Index: utility.EventItemFailed,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesDispatchError, "error", "DispatchError"),
+		},
+		Docs: "A single item within a Batch of dispatches has completed with error.",
+	},
+	{
+		Name:  "DispatchedAs",
+		Index: utility.EventDispatchedAs,
+		Fields: sc.Sequence[primitives.MetadataTypeDefinitionField]{
+			primitives.NewMetadataTypeDefinitionFieldWithNames(metadata.TypesResultEmptyTupleDispatchError, "result", "DispatchResult"),
+		},
+		Docs: "A call was dispatched.",
+	},
+}
+
+var errorDeclarations = []support.VariantDeclaration{
+	{
+		Name:  "TooManyCalls",
+		Index: errors.ErrorTooManyCalls,
+		Docs:  "Too many calls batched.",
+	},
+}
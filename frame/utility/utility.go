@@ -0,0 +1,20 @@
+package utility
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/types"
+)
+
+// derivativePrefix is the prefix of the entropy, from which derivative accounts are computed.
+var derivativePrefix = []byte("modlpy/utilisuba")
+
+// DerivativeAccountId derives a sub-account id from the account `who` and the `index`.
+// The sub-account id is the blake2_256 hash of the prefix, `who` and `index`, SCALE encoded.
+func DerivativeAccountId(who types.Address32, index sc.U16) types.Address32 {
+	entropy := append([]byte{}, derivativePrefix...)
+	entropy = append(entropy, who.Bytes()...)
+	entropy = append(entropy, index.Bytes()...)
+
+	return types.NewAddress32(sc.BytesToSequenceU8(hashing.Blake256(entropy))...)
+}
@@ -29,6 +29,20 @@ func ExtCryptoSr25519VerifyVersion2(signature []byte, message []byte, pubKey []b
 	) == 1
 }
 
+// ExtCryptoSecp256k1EcdsaRecoverCompressedVersion2 recovers the 33-byte compressed public key from
+// a 65-byte signature and a 32-byte message hash. Returns false if the signature is invalid.
+func ExtCryptoSecp256k1EcdsaRecoverCompressedVersion2(signature []byte, msgHash []byte) ([]byte, bool) {
+	r := env.ExtCryptoSecp256k1EcdsaRecoverCompressedVersion2(utils.Offset32(signature), utils.Offset32(msgHash))
+	offset, size := utils.Int64ToOffsetAndSize(r)
+	// Result<[u8; 33], EcdsaVerifyError>
+	result := utils.ToWasmMemorySlice(offset, size)
+	if len(result) != 34 || result[0] != 0 {
+		return nil, false
+	}
+
+	return result[1:], true
+}
+
 func ExtCryptoStartBatchVerify() {
 	env.ExtCryptoStartBatchVerifyVersion1()
 }
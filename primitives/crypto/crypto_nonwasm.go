@@ -3,31 +3,55 @@
 package crypto
 
 import (
-	"crypto/ed25519"
+	"bytes"
 
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/host"
 )
 
 func ExtCryptoEd25519GenerateVersion1(keyTypeId []byte, seed []byte) []byte {
-	panic("not implemented")
+	return host.DefaultKeystore().Generate(host.SchemeEd25519, keyTypeId, decodeSeed(seed))
 }
 
 func ExtCryptoEd25519VerifyVersion1(signature []byte, message []byte, pubKey []byte) sc.Bool {
-	return sc.Bool(ed25519.Verify(pubKey, message, signature))
+	return sc.Bool(host.DefaultBatchVerifier().Record(host.Ed25519Verify(signature, message, pubKey)))
 }
 
 func ExtCryptoSr25519GenerateVersion1(keyTypeId []byte, seed []byte) []byte {
-	panic("not implemented")
+	return host.DefaultKeystore().Generate(host.SchemeSr25519, keyTypeId, decodeSeed(seed))
 }
 
 func ExtCryptoSr25519VerifyVersion2(signature []byte, message []byte, pubKey []byte) sc.Bool {
-	panic("not implemented")
+	return sc.Bool(host.DefaultBatchVerifier().Record(host.Sr25519Verify(signature, message, pubKey)))
+}
+
+// ExtCryptoSecp256k1EcdsaRecoverCompressedVersion2 recovers the 33-byte compressed public key from
+// a 65-byte signature and a 32-byte message hash. Returns false if the signature is invalid.
+func ExtCryptoSecp256k1EcdsaRecoverCompressedVersion2(signature []byte, msgHash []byte) ([]byte, bool) {
+	return host.EcdsaRecoverCompressed(signature, msgHash)
 }
 
 func ExtCryptoStartBatchVerify() {
-	panic("not implemented")
+	host.DefaultBatchVerifier().StartBatchVerify()
 }
 
 func ExtCryptoFinishBatchVerify() int32 {
-	panic("not implemented")
+	if host.DefaultBatchVerifier().FinishBatchVerify() {
+		return 1
+	}
+	return 0
+}
+
+// decodeSeed decodes the SCALE-encoded optional seed, passed to the generate functions.
+func decodeSeed(seed []byte) []byte {
+	if len(seed) == 0 {
+		return nil
+	}
+
+	option := sc.DecodeOptionWith(bytes.NewBuffer(seed), sc.DecodeSequence[sc.U8])
+	if !option.HasValue {
+		return nil
+	}
+
+	return sc.SequenceU8ToBytes(option.Value)
 }
@@ -3,25 +3,25 @@
 package hashing
 
 import (
-	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/LimeChain/gosemble/primitives/host"
 )
 
 func Twox128(value []byte) []byte {
-	h, _ := common.Twox128Hash(value)
-	return h[:]
+	return host.Twox128(value)
 }
 
 func Twox64(value []byte) []byte {
-	h, _ := common.Twox64(value)
-	return h[:]
+	return host.Twox64(value)
 }
 
 func Blake128(value []byte) []byte {
-	h, _ := common.Blake2b128(value)
-	return h[:]
+	return host.Blake2b128(value)
 }
 
 func Blake256(value []byte) []byte {
-	h, _ := common.Blake2bHash(value)
-	return h[:]
+	return host.Blake2b256(value)
+}
+
+func Keccak256(value []byte) []byte {
+	return host.Keccak256(value)
 }
@@ -0,0 +1,256 @@
+//go:build nonwasmenv
+
+package host
+
+import (
+	stded25519 "crypto/ed25519"
+
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/crypto/ed25519"
+	"github.com/ChainSafe/gossamer/lib/crypto/secp256k1"
+	"github.com/ChainSafe/gossamer/lib/crypto/sr25519"
+)
+
+type Scheme uint8
+
+const (
+	SchemeEd25519 Scheme = iota
+	SchemeSr25519
+	SchemeEcdsa
+)
+
+type keyPair interface {
+	publicKey() []byte
+	sign(msg []byte) ([]byte, error)
+}
+
+type ed25519KeyPair struct{ *ed25519.Keypair }
+
+func (k ed25519KeyPair) publicKey() []byte               { return k.Public().Encode() }
+func (k ed25519KeyPair) sign(msg []byte) ([]byte, error) { return k.Sign(msg) }
+
+type sr25519KeyPair struct{ *sr25519.Keypair }
+
+func (k sr25519KeyPair) publicKey() []byte               { return k.Public().Encode() }
+func (k sr25519KeyPair) sign(msg []byte) ([]byte, error) { return k.Sign(msg) }
+
+// ecdsaKeyPair signs the blake2-256 hash of the message, as the ecdsa host functions do.
+type ecdsaKeyPair struct{ *secp256k1.Keypair }
+
+func (k ecdsaKeyPair) publicKey() []byte { return k.Public().Encode() }
+func (k ecdsaKeyPair) sign(msg []byte) ([]byte, error) {
+	hash, err := common.Blake2bHash(msg)
+	if err != nil {
+		return nil, err
+	}
+	return k.Sign(hash[:])
+}
+
+type keystoreKey struct {
+	scheme    Scheme
+	keyTypeId [4]byte
+}
+
+// Keystore holds the key pairs generated by the runtime, grouped by scheme and key type id.
+type Keystore struct {
+	keys map[keystoreKey][]keyPair
+}
+
+func NewKeystore() *Keystore {
+	return &Keystore{keys: map[keystoreKey][]keyPair{}}
+}
+
+// Generate generates a new key pair for the given scheme and key type id and returns its public key.
+// When a seed is given, the key pair is derived deterministically from it. Seeds that are not 32 bytes
+// long are blake2-256 hashed first, derivation paths like "//Alice" are not supported.
+func (k *Keystore) Generate(scheme Scheme, keyTypeId []byte, seed []byte) []byte {
+	var secret []byte
+	if seed != nil {
+		secret = seed
+		if len(secret) != 32 {
+			hash, err := common.Blake2bHash(seed)
+			if err != nil {
+				panic(err)
+			}
+			secret = hash[:]
+		}
+	}
+
+	pair, err := newKeyPair(scheme, secret)
+	if err != nil {
+		panic(err)
+	}
+
+	key := keystoreKey{scheme, toKeyTypeId(keyTypeId)}
+	k.keys[key] = append(k.keys[key], pair)
+
+	return pair.publicKey()
+}
+
+// PublicKeys returns the public keys stored for the given scheme and key type id.
+func (k *Keystore) PublicKeys(scheme Scheme, keyTypeId []byte) [][]byte {
+	var keys [][]byte
+	for _, pair := range k.keys[keystoreKey{scheme, toKeyTypeId(keyTypeId)}] {
+		keys = append(keys, pair.publicKey())
+	}
+	return keys
+}
+
+// Sign signs the message with the key pair matching the public key.
+// Returns false if there is no such key pair in the keystore.
+func (k *Keystore) Sign(scheme Scheme, keyTypeId []byte, pubKey []byte, msg []byte) ([]byte, bool) {
+	for _, pair := range k.keys[keystoreKey{scheme, toKeyTypeId(keyTypeId)}] {
+		if string(pair.publicKey()) != string(pubKey) {
+			continue
+		}
+
+		signature, err := pair.sign(msg)
+		if err != nil {
+			return nil, false
+		}
+		return signature, true
+	}
+
+	return nil, false
+}
+
+func newKeyPair(scheme Scheme, secret []byte) (keyPair, error) {
+	switch scheme {
+	case SchemeEd25519:
+		pair, err := ed25519.GenerateKeypair()
+		if secret != nil {
+			pair, err = ed25519.NewKeypairFromSeed(secret)
+		}
+		return ed25519KeyPair{pair}, err
+	case SchemeSr25519:
+		pair, err := sr25519.GenerateKeypair()
+		if secret != nil {
+			pair, err = sr25519.NewKeypairFromSeed(secret)
+		}
+		return sr25519KeyPair{pair}, err
+	case SchemeEcdsa:
+		if secret == nil {
+			pair, err := secp256k1.GenerateKeypair()
+			return ecdsaKeyPair{pair}, err
+		}
+		private, err := secp256k1.NewPrivateKey(secret)
+		if err != nil {
+			return nil, err
+		}
+		pair, err := secp256k1.NewKeypairFromPrivate(private)
+		return ecdsaKeyPair{pair}, err
+	default:
+		panic("unsupported crypto scheme")
+	}
+}
+
+func toKeyTypeId(keyTypeId []byte) [4]byte {
+	var id [4]byte
+	copy(id[:], keyTypeId)
+	return id
+}
+
+// Ed25519Verify verifies an ed25519 signature.
+func Ed25519Verify(signature []byte, message []byte, pubKey []byte) bool {
+	if len(pubKey) != stded25519.PublicKeySize || len(signature) != stded25519.SignatureSize {
+		return false
+	}
+	return stded25519.Verify(pubKey, message, signature)
+}
+
+// Sr25519Verify verifies an sr25519 signature.
+func Sr25519Verify(signature []byte, message []byte, pubKey []byte) bool {
+	key, err := sr25519.NewPublicKey(pubKey)
+	if err != nil {
+		return false
+	}
+
+	ok, err := key.Verify(message, signature)
+	return err == nil && ok
+}
+
+// EcdsaRecover recovers the 64-byte uncompressed public key from a 65-byte signature
+// and a 32-byte message hash.
+func EcdsaRecover(signature []byte, msgHash []byte) ([]byte, bool) {
+	pubKey, err := secp256k1.RecoverPublicKey(msgHash, signature)
+	if err != nil || len(pubKey) != 65 {
+		return nil, false
+	}
+	// strip the 0x04 prefix of uncompressed keys
+	return pubKey[1:], true
+}
+
+// EcdsaRecoverCompressed recovers the 33-byte compressed public key from a 65-byte signature
+// and a 32-byte message hash.
+func EcdsaRecoverCompressed(signature []byte, msgHash []byte) ([]byte, bool) {
+	pubKey, err := secp256k1.RecoverPublicKeyCompressed(msgHash, signature)
+	if err != nil {
+		return nil, false
+	}
+	return pubKey, true
+}
+
+// EcdsaVerify verifies a 65-byte ecdsa signature of the blake2-256 hash of the message
+// against a 33-byte compressed public key.
+func EcdsaVerify(signature []byte, message []byte, pubKey []byte) bool {
+	hash, err := common.Blake2bHash(message)
+	if err != nil {
+		return false
+	}
+
+	recovered, ok := EcdsaRecoverCompressed(signature, hash[:])
+	return ok && string(recovered) == string(pubKey)
+}
+
+// EcdsaAvailable reports whether the secp256k1 backend recovers the signer of its own signatures.
+// Builds, which link a secp256k1 library without recovery support, cannot verify ecdsa signatures.
+func EcdsaAvailable() bool {
+	pair, err := newKeyPair(SchemeEcdsa, nil)
+	if err != nil {
+		return false
+	}
+
+	message := []byte("ecdsa")
+	signature, err := pair.sign(message)
+	if err != nil {
+		return false
+	}
+
+	return EcdsaVerify(signature, message, pair.publicKey())
+}
+
+// BatchVerifier defers the verification results while a batch verification is in progress.
+type BatchVerifier struct {
+	active bool
+	valid  bool
+}
+
+// StartBatchVerify starts a batch verification. Until FinishBatchVerify is called,
+// verifications are recorded and reported as successful.
+func (b *BatchVerifier) StartBatchVerify() {
+	if b.active {
+		panic("`StartBatchVerify` called twice without `FinishBatchVerify`")
+	}
+	b.active = true
+	b.valid = true
+}
+
+// FinishBatchVerify finishes the batch verification and returns whether all
+// recorded verifications succeeded.
+func (b *BatchVerifier) FinishBatchVerify() bool {
+	if !b.active {
+		panic("`FinishBatchVerify` called without `StartBatchVerify`")
+	}
+	b.active = false
+	return b.valid
+}
+
+// Record records the result of a verification. Returns the value the
+// verification function should report.
+func (b *BatchVerifier) Record(valid bool) bool {
+	if !b.active {
+		return valid
+	}
+	b.valid = b.valid && valid
+	return true
+}
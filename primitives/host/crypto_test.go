@@ -0,0 +1,64 @@
+//go:build nonwasmenv
+
+package host
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var keyTypeId = []byte("test")
+
+func Test_Keystore_SignAndVerify(t *testing.T) {
+	var testExamples = []struct {
+		label  string
+		scheme Scheme
+		verify func(signature []byte, message []byte, pubKey []byte) bool
+	}{
+		{label: "Ed25519", scheme: SchemeEd25519, verify: Ed25519Verify},
+		{label: "Sr25519", scheme: SchemeSr25519, verify: Sr25519Verify},
+		{label: "Ecdsa", scheme: SchemeEcdsa, verify: EcdsaVerify},
+	}
+
+	message := []byte("message")
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			if testExample.scheme == SchemeEcdsa && !EcdsaAvailable() {
+				t.Skip("secp256k1 backend does not support recovery")
+			}
+
+			keystore := NewKeystore()
+			pubKey := keystore.Generate(testExample.scheme, keyTypeId, nil)
+
+			assert.Equal(t, [][]byte{pubKey}, keystore.PublicKeys(testExample.scheme, keyTypeId))
+
+			signature, ok := keystore.Sign(testExample.scheme, keyTypeId, pubKey, message)
+			assert.True(t, ok)
+
+			assert.True(t, testExample.verify(signature, message, pubKey))
+			assert.False(t, testExample.verify(signature, []byte("other"), pubKey))
+		})
+	}
+}
+
+func Test_Keystore_GenerateFromSeed(t *testing.T) {
+	seed := []byte("seed")
+
+	first := NewKeystore().Generate(SchemeSr25519, keyTypeId, seed)
+	second := NewKeystore().Generate(SchemeSr25519, keyTypeId, seed)
+
+	assert.Equal(t, first, second)
+}
+
+func Test_BatchVerifier(t *testing.T) {
+	verifier := &BatchVerifier{}
+
+	verifier.StartBatchVerify()
+	assert.True(t, verifier.Record(true))
+	assert.True(t, verifier.Record(false))
+	assert.False(t, verifier.FinishBatchVerify())
+
+	assert.False(t, verifier.Record(false))
+}
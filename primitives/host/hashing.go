@@ -0,0 +1,44 @@
+//go:build nonwasmenv
+
+package host
+
+import (
+	"github.com/ChainSafe/gossamer/lib/common"
+)
+
+func Twox64(value []byte) []byte {
+	h, err := common.Twox64(value)
+	if err != nil {
+		panic(err)
+	}
+	return h[:]
+}
+
+func Twox128(value []byte) []byte {
+	h, err := common.Twox128Hash(value)
+	if err != nil {
+		panic(err)
+	}
+	return h[:]
+}
+
+func Blake2b128(value []byte) []byte {
+	h, err := common.Blake2b128(value)
+	if err != nil {
+		panic(err)
+	}
+	return h[:]
+}
+
+func Blake2b256(value []byte) []byte {
+	h, err := common.Blake2bHash(value)
+	if err != nil {
+		panic(err)
+	}
+	return h[:]
+}
+
+func Keccak256(value []byte) []byte {
+	h := common.Keccak256(value)
+	return h[:]
+}
@@ -0,0 +1,45 @@
+//go:build nonwasmenv
+
+/*
+Package host provides an in-memory implementation of the host environment,
+used by the primitives when the runtime is built with the `nonwasmenv` tag.
+This allows the frame logic to be tested with plain `go test -tags nonwasmenv`.
+*/
+package host
+
+var (
+	storage  = NewStorage()
+	keystore = NewKeystore()
+	verifier = &BatchVerifier{}
+	pool     = &TransactionPool{}
+)
+
+// DefaultStorage returns the storage used by the primitives.
+func DefaultStorage() *Storage {
+	return storage
+}
+
+// DefaultKeystore returns the keystore used by the primitives.
+func DefaultKeystore() *Keystore {
+	return keystore
+}
+
+// DefaultBatchVerifier returns the batch verifier used by the primitives.
+func DefaultBatchVerifier() *BatchVerifier {
+	return verifier
+}
+
+// DefaultTransactionPool returns the transaction pool used by the primitives.
+func DefaultTransactionPool() *TransactionPool {
+	return pool
+}
+
+// Reset discards the storage, the keystore, the registered runtime versions,
+// the submitted transactions and any batch verification in progress.
+func Reset() {
+	storage = NewStorage()
+	keystore = NewKeystore()
+	verifier = &BatchVerifier{}
+	pool = &TransactionPool{}
+	runtimeVersions = map[string][]byte{}
+}
@@ -0,0 +1,18 @@
+//go:build nonwasmenv
+
+package host
+
+// runtimeVersions maps Wasm blobs to their SCALE-encoded runtime versions,
+// since the in-memory host cannot execute `Core_version` of a blob.
+var runtimeVersions = map[string][]byte{}
+
+// SetRuntimeVersion registers the SCALE-encoded runtime version, returned for the given code.
+func SetRuntimeVersion(code []byte, version []byte) {
+	runtimeVersions[string(code)] = version
+}
+
+// RuntimeVersion returns the SCALE-encoded runtime version registered for the given code.
+func RuntimeVersion(code []byte) ([]byte, bool) {
+	version, ok := runtimeVersions[string(code)]
+	return version, ok
+}
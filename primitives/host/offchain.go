@@ -0,0 +1,18 @@
+//go:build nonwasmenv
+
+package host
+
+// TransactionPool records the extrinsics, submitted by the runtime from the offchain context.
+type TransactionPool struct {
+	extrinsics [][]byte
+}
+
+// Submit records the SCALE-encoded extrinsic.
+func (p *TransactionPool) Submit(extrinsic []byte) {
+	p.extrinsics = append(p.extrinsics, extrinsic)
+}
+
+// Extrinsics returns the submitted extrinsics in the order of their submission.
+func (p *TransactionPool) Extrinsics() [][]byte {
+	return p.extrinsics
+}
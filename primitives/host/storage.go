@@ -0,0 +1,168 @@
+//go:build nonwasmenv
+
+package host
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// kvStore is a single key/value map of the main trie.
+type kvStore map[string][]byte
+
+func (s kvStore) clone() kvStore {
+	c := make(kvStore, len(s))
+	for k, v := range s {
+		c[k] = v
+	}
+	return c
+}
+
+// sortedKeys returns the keys of the store in lexicographic order.
+func (s kvStore) sortedKeys() []string {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (s kvStore) nextKey(key []byte) ([]byte, bool) {
+	keys := s.sortedKeys()
+	i := sort.SearchStrings(keys, string(key))
+	if i < len(keys) && keys[i] == string(key) {
+		i++
+	}
+	if i == len(keys) {
+		return nil, false
+	}
+	return []byte(keys[i]), true
+}
+
+// clearPrefix removes up to limit keys starting with prefix, in lexicographic order.
+// A nil limit removes all of them. It returns the number of removed keys and whether
+// no keys with that prefix remain.
+func (s kvStore) clearPrefix(prefix []byte, limit *uint32) (uint32, bool) {
+	removed := uint32(0)
+	for _, k := range s.sortedKeys() {
+		if !strings.HasPrefix(k, string(prefix)) {
+			continue
+		}
+		if limit != nil && removed >= *limit {
+			return removed, false
+		}
+		delete(s, k)
+		removed++
+	}
+	return removed, true
+}
+
+// Storage is an ordered in-memory key/value store, which mimics the storage
+// host functions. It supports nested transactions.
+type Storage struct {
+	top          kvStore
+	transactions []kvStore
+}
+
+func NewStorage() *Storage {
+	return &Storage{
+		top: kvStore{},
+	}
+}
+
+func (s *Storage) Get(key []byte) ([]byte, bool) {
+	value, ok := s.top[string(key)]
+	return value, ok
+}
+
+func (s *Storage) Set(key []byte, value []byte) {
+	s.top[string(key)] = append([]byte{}, value...)
+}
+
+func (s *Storage) Clear(key []byte) {
+	delete(s.top, string(key))
+}
+
+func (s *Storage) Exists(key []byte) bool {
+	_, ok := s.top[string(key)]
+	return ok
+}
+
+// Append appends the SCALE-encoded value to the SCALE-encoded sequence stored under key.
+// If there is no value, or it is not a valid sequence, a new sequence with a single
+// element is stored.
+func (s *Storage) Append(key []byte, value []byte) {
+	current, ok := s.top[string(key)]
+	if !ok || len(current) == 0 {
+		s.Set(key, append(sc.ToCompact(uint64(1)).Bytes(), value...))
+		return
+	}
+
+	buffer := bytes.NewBuffer(current)
+	length := sc.DecodeCompact(buffer).ToBigInt().Uint64()
+
+	result := append(sc.ToCompact(length+1).Bytes(), buffer.Bytes()...)
+	s.Set(key, append(result, value...))
+}
+
+// ClearPrefix removes up to limit keys starting with prefix from the main trie.
+// It returns the number of removed keys and whether all keys have been removed.
+func (s *Storage) ClearPrefix(prefix []byte, limit *uint32) (uint32, bool) {
+	return s.top.clearPrefix(prefix, limit)
+}
+
+// NextKey returns the next key in lexicographic order after the given key.
+func (s *Storage) NextKey(key []byte) ([]byte, bool) {
+	return s.top.nextKey(key)
+}
+
+// Root returns the root of the main trie in the trie layout of the state version.
+func (s *Storage) Root(version int32) []byte {
+	return trieRoot(s.top, version)
+}
+
+// Keys returns all keys of the main trie in lexicographic order.
+func (s *Storage) Keys() [][]byte {
+	var keys [][]byte
+	for _, k := range s.top.sortedKeys() {
+		keys = append(keys, []byte(k))
+	}
+	return keys
+}
+
+// StartTransaction starts a new nested transaction.
+func (s *Storage) StartTransaction() {
+	s.transactions = append(s.transactions, s.top.clone())
+}
+
+// RollbackTransaction discards all changes made since the last StartTransaction.
+// Panics if there is no open transaction.
+func (s *Storage) RollbackTransaction() {
+	s.top = s.popTransaction("rolled back")
+}
+
+// CommitTransaction keeps all changes made since the last StartTransaction.
+// Panics if there is no open transaction.
+func (s *Storage) CommitTransaction() {
+	s.popTransaction("committed")
+}
+
+// TransactionDepth returns the number of open transactions.
+func (s *Storage) TransactionDepth() int {
+	return len(s.transactions)
+}
+
+func (s *Storage) popTransaction(action string) kvStore {
+	if len(s.transactions) == 0 {
+		panic("No open transaction that can be " + action + ".")
+	}
+
+	last := s.transactions[len(s.transactions)-1]
+	s.transactions = s.transactions[:len(s.transactions)-1]
+
+	return last
+}
@@ -0,0 +1,139 @@
+//go:build nonwasmenv
+
+package host
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Storage_Transactions(t *testing.T) {
+	storage := NewStorage()
+	storage.Set([]byte("a"), []byte{1})
+
+	storage.StartTransaction()
+	storage.Set([]byte("b"), []byte{2})
+
+	storage.StartTransaction()
+	storage.Clear([]byte("a"))
+	storage.RollbackTransaction()
+
+	storage.CommitTransaction()
+
+	a, ok := storage.Get([]byte("a"))
+	assert.True(t, ok)
+	assert.Equal(t, []byte{1}, a)
+
+	b, ok := storage.Get([]byte("b"))
+	assert.True(t, ok)
+	assert.Equal(t, []byte{2}, b)
+
+	assert.Equal(t, 0, storage.TransactionDepth())
+	assert.Panics(t, func() { storage.CommitTransaction() })
+}
+
+func Test_Storage_NextKey(t *testing.T) {
+	storage := NewStorage()
+	storage.Set([]byte("abc"), []byte{})
+	storage.Set([]byte("abd"), []byte{})
+	storage.Set([]byte("b"), []byte{})
+
+	var testExamples = []struct {
+		label       string
+		input       []byte
+		expectation []byte
+		found       bool
+	}{
+		{label: "NextKey(\"\")", input: []byte(""), expectation: []byte("abc"), found: true},
+		{label: "NextKey(\"abc\")", input: []byte("abc"), expectation: []byte("abd"), found: true},
+		{label: "NextKey(\"abca\")", input: []byte("abca"), expectation: []byte("abd"), found: true},
+		{label: "NextKey(\"b\")", input: []byte("b"), expectation: nil, found: false},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			next, ok := storage.NextKey(testExample.input)
+
+			assert.Equal(t, testExample.found, ok)
+			assert.Equal(t, testExample.expectation, next)
+		})
+	}
+}
+
+func Test_Storage_ClearPrefix(t *testing.T) {
+	storage := NewStorage()
+	storage.Set([]byte("pa"), []byte{})
+	storage.Set([]byte("pb"), []byte{})
+	storage.Set([]byte("pc"), []byte{})
+	storage.Set([]byte("q"), []byte{})
+
+	limit := uint32(2)
+	removed, allRemoved := storage.ClearPrefix([]byte("p"), &limit)
+	assert.Equal(t, uint32(2), removed)
+	assert.False(t, allRemoved)
+
+	removed, allRemoved = storage.ClearPrefix([]byte("p"), nil)
+	assert.Equal(t, uint32(1), removed)
+	assert.True(t, allRemoved)
+
+	assert.Equal(t, [][]byte{[]byte("q")}, storage.Keys())
+}
+
+func Test_Storage_Append(t *testing.T) {
+	storage := NewStorage()
+
+	storage.Append([]byte("k"), sc.U32(1).Bytes())
+	storage.Append([]byte("k"), sc.U32(2).Bytes())
+
+	value, _ := storage.Get([]byte("k"))
+	expectation := sc.Sequence[sc.U32]{1, 2}.Bytes()
+
+	assert.Equal(t, expectation, value)
+}
+
+func Test_Storage_Root(t *testing.T) {
+	storage := NewStorage()
+	emptyRoot := storage.Root(1)
+	assert.Equal(t, Blake2b256([]byte{0}), emptyRoot)
+
+	storage.Set([]byte{0xaa}, []byte{0xbb})
+	assert.Equal(t, Blake2b256([]byte{0x42, 0xaa, 0x04, 0xbb}), storage.Root(0))
+	assert.Equal(t, storage.Root(0), storage.Root(1))
+
+	storage.StartTransaction()
+	storage.Clear([]byte{0xaa})
+	assert.Equal(t, emptyRoot, storage.Root(1))
+	storage.RollbackTransaction()
+
+	assert.Equal(t, Blake2b256([]byte{0x42, 0xaa, 0x04, 0xbb}), storage.Root(0))
+}
+
+func Test_Storage_Root_StateVersion(t *testing.T) {
+	storage := NewStorage()
+	value := bytes.Repeat([]byte{1}, 33)
+	storage.Set([]byte{0xaa}, value)
+
+	leafV0 := append([]byte{0x42, 0xaa, 0x84}, value...)
+	leafV1 := append([]byte{0x22, 0xaa}, Blake2b256(value)...)
+
+	assert.Equal(t, Blake2b256(leafV0), storage.Root(0))
+	assert.Equal(t, Blake2b256(leafV1), storage.Root(1))
+}
+
+func Test_Storage_Root_Branch(t *testing.T) {
+	storage := NewStorage()
+	storage.Set([]byte{0x10}, []byte{1})
+	storage.Set([]byte{0x12}, []byte{2})
+
+	// the branch with partial key 1 inlines the leaves under its children 0 and 2
+	leaf0 := []byte{0x40, 0x04, 0x01}
+	leaf2 := []byte{0x40, 0x04, 0x02}
+	branch := []byte{0x81, 0x01, 0b00000101, 0x00}
+	branch = append(append(branch, 0x0c), leaf0...)
+	branch = append(append(branch, 0x0c), leaf2...)
+
+	assert.Equal(t, Blake2b256(branch), storage.Root(1))
+}
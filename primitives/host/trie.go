@@ -0,0 +1,186 @@
+//go:build nonwasmenv
+
+package host
+
+import (
+	"bytes"
+	"sort"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// Node header prefixes of the substrate trie layout. The hashed value prefixes are used
+// by state version 1, which stores the hash of values longer than maxInlineValue.
+const (
+	prefixLeaf                  = 0b01 << 6
+	prefixBranchWithoutValue    = 0b10 << 6
+	prefixBranchWithValue       = 0b11 << 6
+	prefixLeafHashedValue       = 0b001 << 5
+	prefixBranchWithHashedValue = 0b0001 << 4
+	emptyTrieNode               = 0
+	maxInlineValue              = 32
+)
+
+type trieEntry struct {
+	nibbles []byte
+	value   []byte
+}
+
+// trieRoot returns the blake2-256 merkle root of the entries in the substrate trie layout
+// of the given state version.
+func trieRoot(entries map[string][]byte, version int32) []byte {
+	sorted := make([]trieEntry, 0, len(entries))
+	for k, v := range entries {
+		sorted = append(sorted, trieEntry{nibbles: toNibbles([]byte(k)), value: v})
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].nibbles, sorted[j].nibbles) < 0
+	})
+
+	if len(sorted) == 0 {
+		return Blake2b256([]byte{emptyTrieNode})
+	}
+
+	return Blake2b256(encodeTrieNode(sorted, 0, version))
+}
+
+// OrderedRoot returns the root of the trie, which maps the compact-encoded index of each value to it.
+func OrderedRoot(values [][]byte, version int32) []byte {
+	entries := map[string][]byte{}
+	for i, value := range values {
+		entries[string(sc.ToCompact(i).Bytes())] = value
+	}
+
+	return trieRoot(entries, version)
+}
+
+// encodeTrieNode encodes the node of the entries, whose keys share the first depth nibbles.
+func encodeTrieNode(entries []trieEntry, depth int, version int32) []byte {
+	if len(entries) == 1 {
+		partial := entries[0].nibbles[depth:]
+		if hashValue(entries[0].value, version) {
+			return encodeTrieNodeParts(prefixLeafHashedValue, 3, partial, nil, entries[0].value, true)
+		}
+		return encodeTrieNodeParts(prefixLeaf, 2, partial, nil, entries[0].value, false)
+	}
+
+	end := depth + commonPrefixLength(entries, depth)
+	partial := entries[0].nibbles[depth:end]
+
+	hasValue := len(entries[0].nibbles) == end
+	value := entries[0].value
+	if hasValue {
+		entries = entries[1:]
+	}
+
+	var children [16][]byte
+	for start := 0; start < len(entries); {
+		nibble := entries[start].nibbles[end]
+		stop := start
+		for stop < len(entries) && entries[stop].nibbles[end] == nibble {
+			stop++
+		}
+		children[nibble] = encodeTrieNode(entries[start:stop], end+1, version)
+		start = stop
+	}
+
+	switch {
+	case !hasValue:
+		return encodeTrieNodeParts(prefixBranchWithoutValue, 2, partial, &children, nil, false)
+	case hashValue(value, version):
+		return encodeTrieNodeParts(prefixBranchWithHashedValue, 4, partial, &children, value, true)
+	default:
+		return encodeTrieNodeParts(prefixBranchWithValue, 2, partial, &children, value, false)
+	}
+}
+
+// encodeTrieNodeParts encodes the header, the partial key, the children bitmap of branches,
+// the value and the references to the children of a node.
+func encodeTrieNodeParts(prefix byte, prefixBits uint, partial []byte, children *[16][]byte, value []byte, hashed bool) []byte {
+	buffer := &bytes.Buffer{}
+	buffer.Write(encodeTrieHeader(prefix, prefixBits, len(partial)))
+
+	if len(partial)%2 == 1 {
+		buffer.WriteByte(partial[0])
+		partial = partial[1:]
+	}
+	for i := 0; i < len(partial); i += 2 {
+		buffer.WriteByte(partial[i]<<4 | partial[i+1])
+	}
+
+	if children != nil {
+		bitmap := uint16(0)
+		for i, child := range children {
+			if child != nil {
+				bitmap |= 1 << i
+			}
+		}
+		buffer.Write([]byte{byte(bitmap), byte(bitmap >> 8)})
+	}
+
+	if value != nil {
+		if hashed {
+			buffer.Write(Blake2b256(value))
+		} else {
+			buffer.Write(sc.ToCompact(len(value)).Bytes())
+			buffer.Write(value)
+		}
+	}
+
+	if children != nil {
+		for _, child := range children {
+			if child == nil {
+				continue
+			}
+			if len(child) >= 32 {
+				child = Blake2b256(child)
+			}
+			buffer.Write(sc.ToCompact(len(child)).Bytes())
+			buffer.Write(child)
+		}
+	}
+
+	return buffer.Bytes()
+}
+
+// encodeTrieHeader encodes the node prefix together with the number of nibbles of the partial key.
+func encodeTrieHeader(prefix byte, prefixBits uint, nibbleCount int) []byte {
+	maxValue := 255 >> prefixBits
+	first := nibbleCount
+	if first > maxValue {
+		first = maxValue
+	}
+
+	header := []byte{prefix | byte(first)}
+	if first < maxValue {
+		return header
+	}
+
+	for remaining := nibbleCount - first; ; remaining -= 255 {
+		if remaining < 255 {
+			return append(header, byte(remaining))
+		}
+		header = append(header, 255)
+	}
+}
+
+func hashValue(value []byte, version int32) bool {
+	return version >= 1 && len(value) > maxInlineValue
+}
+
+func commonPrefixLength(entries []trieEntry, depth int) int {
+	first, last := entries[0].nibbles[depth:], entries[len(entries)-1].nibbles[depth:]
+	length := 0
+	for length < len(first) && length < len(last) && first[length] == last[length] {
+		length++
+	}
+	return length
+}
+
+func toNibbles(key []byte) []byte {
+	nibbles := make([]byte, 0, len(key)*2)
+	for _, b := range key {
+		nibbles = append(nibbles, b>>4, b&0x0f)
+	}
+	return nibbles
+}
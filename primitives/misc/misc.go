@@ -0,0 +1,26 @@
+//go:build !nonwasmenv
+
+package misc
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/env"
+	"github.com/LimeChain/gosemble/utils"
+)
+
+// RuntimeVersion extracts the runtime version of the given Wasm blob by calling `Core_version`.
+// Returns `None` if calling the function failed for any reason, or `Some` of the
+// SCALE-encoded runtime version.
+func RuntimeVersion(code []byte) sc.Option[sc.Sequence[sc.U8]] {
+	codeOffsetSize := utils.BytesToOffsetAndSize(code)
+	valueOffsetSize := env.ExtMiscRuntimeVersionVersion1(codeOffsetSize)
+	offset, size := utils.Int64ToOffsetAndSize(valueOffsetSize)
+	value := utils.ToWasmMemorySlice(offset, size)
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(value)
+
+	return sc.DecodeOption[sc.Sequence[sc.U8]](buffer)
+}
@@ -0,0 +1,17 @@
+//go:build nonwasmenv
+
+package misc
+
+import (
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/host"
+)
+
+func RuntimeVersion(code []byte) sc.Option[sc.Sequence[sc.U8]] {
+	version, ok := host.RuntimeVersion(code)
+	if !ok {
+		return sc.NewOption[sc.Sequence[sc.U8]](nil)
+	}
+
+	return sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(version))
+}
@@ -0,0 +1,22 @@
+//go:build !nonwasmenv
+
+package offchain
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/env"
+	"github.com/LimeChain/gosemble/utils"
+)
+
+// SubmitTransaction submits the SCALE-encoded extrinsic to the transaction pool of the node.
+// Returns false, if the extrinsic could not be submitted.
+func SubmitTransaction(extrinsic []byte) bool {
+	r := env.ExtOffchainSubmitTransactionVersion1(utils.BytesToOffsetAndSize(extrinsic))
+	offset, size := utils.Int64ToOffsetAndSize(r)
+	// Result<(), ()>
+	result := utils.ToWasmMemorySlice(offset, size)
+
+	return sc.DecodeU8(bytes.NewBuffer(result)) == 0
+}
@@ -0,0 +1,10 @@
+//go:build nonwasmenv
+
+package offchain
+
+import "github.com/LimeChain/gosemble/primitives/host"
+
+func SubmitTransaction(extrinsic []byte) bool {
+	host.DefaultTransactionPool().Submit(extrinsic)
+	return true
+}
@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// GetDecode gets the storage value and returns it decoded. The result from Get is Option<sc.Sequence[sc.U8]>.
+// If the option is empty, it returns the default value T.
+// If the option is not empty, it decodes it using decodeFunc and returns it.
+func GetDecode[T sc.Encodable](key []byte, decodeFunc func(buffer *bytes.Buffer) T) T {
+	option := Get(key)
+
+	if !option.HasValue {
+		return *new(T)
+	}
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(sc.SequenceU8ToBytes(option.Value))
+
+	return decodeFunc(buffer)
+}
+
+func GetDecodeOnEmpty[T sc.Encodable](key []byte, decodeFunc func(buffer *bytes.Buffer) T, onEmpty T) T {
+	option := Get(key)
+
+	if !option.HasValue {
+		return onEmpty
+	}
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(sc.SequenceU8ToBytes(option.Value))
+
+	return decodeFunc(buffer)
+}
+
+// TakeBytes gets the storage value. The result from Get is Option<sc.Sequence[sc.U8]>.
+// If the option is empty, it returns nil.
+// If the option is not empty, it clears it and returns the sequence as bytes.
+func TakeBytes(key []byte) []byte {
+	option := Get(key)
+
+	if !option.HasValue {
+		return nil
+	}
+
+	Clear(key)
+
+	return sc.SequenceU8ToBytes(option.Value)
+}
+
+// TakeDecode gets the storage value and returns it decoded. The result from Get is Option<sc.Sequence[sc.U8]>.
+// If the option is empty, it returns default value T.
+// If the option is not empty, it clears it and returns decodeFunc(value).
+func TakeDecode[T sc.Encodable](key []byte, decodeFunc func(buffer *bytes.Buffer) T) T {
+	option := Get(key)
+
+	if !option.HasValue {
+		return *new(T)
+	}
+
+	Clear(key)
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(sc.SequenceU8ToBytes(option.Value))
+
+	return decodeFunc(buffer)
+}
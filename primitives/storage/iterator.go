@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// PrefixIterator iterates over the storage keys starting with a given prefix, in lexicographic order.
+type PrefixIterator struct {
+	prefix      []byte
+	previousKey []byte
+	done        bool
+}
+
+// NewPrefixIterator returns an iterator over all storage keys starting with the prefix.
+func NewPrefixIterator(prefix []byte) *PrefixIterator {
+	return NewPrefixIteratorFrom(prefix, prefix)
+}
+
+// NewPrefixIteratorFrom returns an iterator over the storage keys starting with the prefix,
+// which are after previousKey. It allows resuming an iteration stopped in an earlier block.
+func NewPrefixIteratorFrom(prefix []byte, previousKey []byte) *PrefixIterator {
+	return &PrefixIterator{
+		prefix:      prefix,
+		previousKey: previousKey,
+	}
+}
+
+// Next returns the next key with the prefix, or false if there are no more keys.
+func (pi *PrefixIterator) Next() ([]byte, bool) {
+	if pi.done {
+		return nil, false
+	}
+
+	next := NextKey(pi.previousKey)
+	if !next.HasValue {
+		pi.done = true
+		return nil, false
+	}
+
+	key := sc.SequenceU8ToBytes(next.Value)
+	if !bytes.HasPrefix(key, pi.prefix) {
+		pi.done = true
+		return nil, false
+	}
+
+	pi.previousKey = key
+	return key, true
+}
+
+// PreviousKey returns the last key returned by the iterator, to be used as a cursor
+// in NewPrefixIteratorFrom.
+func (pi *PrefixIterator) PreviousKey() []byte {
+	return pi.previousKey
+}
+
+// IterateKeys calls f with each storage key starting with the prefix, in lexicographic order,
+// until f returns false.
+func IterateKeys(prefix []byte, f func(key []byte) bool) {
+	iterator := NewPrefixIterator(prefix)
+	for {
+		key, ok := iterator.Next()
+		if !ok || !f(key) {
+			return
+		}
+	}
+}
+
+// IteratePairs calls f with each storage key starting with the prefix and its value,
+// in lexicographic order, until f returns false.
+func IteratePairs(prefix []byte, f func(key []byte, value []byte) bool) {
+	IterateKeys(prefix, func(key []byte) bool {
+		value := Get(key)
+		if !value.HasValue {
+			return true
+		}
+
+		return f(key, sc.SequenceU8ToBytes(value.Value))
+	})
+}
+
+// IterateValues calls f with each storage key starting with the prefix and its decoded value,
+// in lexicographic order, until f returns false.
+func IterateValues[T sc.Encodable](prefix []byte, decodeFunc func(buffer *bytes.Buffer) T, f func(key []byte, value T) bool) {
+	IteratePairs(prefix, func(key []byte, value []byte) bool {
+		return f(key, decodeFunc(bytes.NewBuffer(value)))
+	})
+}
@@ -0,0 +1,103 @@
+//go:build nonwasmenv
+
+package storage
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupPrefix() {
+	host.Reset()
+
+	Set([]byte("a"), sc.U32(0).Bytes())
+	Set([]byte("prefix1"), sc.U32(1).Bytes())
+	Set([]byte("prefix2"), sc.U32(2).Bytes())
+	Set([]byte("prefix3"), sc.U32(3).Bytes())
+	Set([]byte("z"), sc.U32(4).Bytes())
+}
+
+func Test_IterateKeys(t *testing.T) {
+	setupPrefix()
+
+	var keys []string
+	IterateKeys([]byte("prefix"), func(key []byte) bool {
+		keys = append(keys, string(key))
+		return true
+	})
+
+	assert.Equal(t, []string{"prefix1", "prefix2", "prefix3"}, keys)
+}
+
+func Test_IterateValues(t *testing.T) {
+	setupPrefix()
+
+	var values []sc.U32
+	IterateValues([]byte("prefix"), sc.DecodeU32, func(_ []byte, value sc.U32) bool {
+		values = append(values, value)
+		return len(values) < 2
+	})
+
+	assert.Equal(t, []sc.U32{1, 2}, values)
+}
+
+func Test_PrefixIterator_Resume(t *testing.T) {
+	setupPrefix()
+
+	iterator := NewPrefixIterator([]byte("prefix"))
+	key, ok := iterator.Next()
+	assert.True(t, ok)
+	assert.Equal(t, []byte("prefix1"), key)
+
+	resumed := NewPrefixIteratorFrom([]byte("prefix"), iterator.PreviousKey())
+	key, ok = resumed.Next()
+	assert.True(t, ok)
+	assert.Equal(t, []byte("prefix2"), key)
+}
+
+func Test_ClearPrefix(t *testing.T) {
+	var testExamples = []struct {
+		label          string
+		limit          sc.Option[sc.U32]
+		expectedCursor bool
+		expectedUnique sc.U32
+	}{
+		{
+			label:          "ClearPrefix(limit reached)",
+			limit:          sc.NewOption[sc.U32](sc.U32(2)),
+			expectedCursor: true,
+			expectedUnique: 2,
+		},
+		{
+			label:          "ClearPrefix(limit not reached)",
+			limit:          sc.NewOption[sc.U32](sc.U32(5)),
+			expectedCursor: false,
+			expectedUnique: 3,
+		},
+		{
+			label:          "ClearPrefix(no limit)",
+			limit:          sc.NewOption[sc.U32](nil),
+			expectedCursor: false,
+			expectedUnique: 3,
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			setupPrefix()
+
+			result := ClearPrefix([]byte("prefix"), testExample.limit, sc.NewOption[sc.Sequence[sc.U8]](nil))
+
+			assert.Equal(t, testExample.expectedCursor, bool(result.MaybeCursor.HasValue))
+			if result.MaybeCursor.HasValue {
+				assert.Equal(t, sc.BytesToSequenceU8([]byte("prefix")), result.MaybeCursor.Value)
+			}
+			assert.Equal(t, testExample.expectedUnique, result.Unique)
+			assert.Equal(t, int32(1), Exists([]byte("a")))
+			assert.Equal(t, int32(1), Exists([]byte("z")))
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/env"
+	"github.com/LimeChain/gosemble/primitives/types"
 	"github.com/LimeChain/gosemble/utils"
 )
 
@@ -25,10 +26,21 @@ func Clear(key []byte) {
 	env.ExtStorageClearVersion1(keyOffsetSize)
 }
 
-func ClearPrefix(key []byte, limit []byte) {
-	keyOffsetSize := utils.BytesToOffsetAndSize(key)
-	limitOffsetSize := utils.BytesToOffsetAndSize(limit)
-	env.ExtStorageClearPrefixVersion2(keyOffsetSize, limitOffsetSize)
+// ClearPrefix removes up to `limit` keys starting with the given prefix, or all of them
+// if the limit is `None`. If the returned cursor is `Some`, keys under the prefix remain
+// and the cursor must be passed to the next call to continue the removal.
+//
+// It is built on ext_storage_clear_prefix_version_2, which removes the keys from the overlay
+// right away, so the removal always continues from the prefix and the cursor is not passed to the host.
+func ClearPrefix(prefix []byte, limit sc.Option[sc.U32], _ sc.Option[sc.Sequence[sc.U8]]) types.MultiRemovalResults {
+	prefixOffsetSize := utils.BytesToOffsetAndSize(prefix)
+	limitOffsetSize := utils.BytesToOffsetAndSize(limit.Bytes())
+
+	resultOffsetSize := env.ExtStorageClearPrefixVersion2(prefixOffsetSize, limitOffsetSize)
+	offset, size := utils.Int64ToOffsetAndSize(resultOffsetSize)
+	value := utils.ToWasmMemorySlice(offset, size)
+
+	return types.DecodeKillStorageResult(bytes.NewBuffer(value)).MultiRemovalResults(prefix)
 }
 
 func Exists(key []byte) int32 {
@@ -45,37 +57,18 @@ func Get(key []byte) sc.Option[sc.Sequence[sc.U8]] {
 	return sc.DecodeOption[sc.Sequence[sc.U8]](buffer)
 }
 
-// GetDecode gets the storage value and returns it decoded. The result from Get is Option<sc.Sequence[sc.U8]>.
-// If the option is empty, it returns the default value T.
-// If the option is not empty, it decodes it using decodeFunc and returns it.
-func GetDecode[T sc.Encodable](key []byte, decodeFunc func(buffer *bytes.Buffer) T) T {
-	option := Get(key)
-
-	if !option.HasValue {
-		return *new(T)
-	}
-
-	buffer := &bytes.Buffer{}
-	buffer.Write(sc.SequenceU8ToBytes(option.Value))
-
-	return decodeFunc(buffer)
-}
-
-func GetDecodeOnEmpty[T sc.Encodable](key []byte, decodeFunc func(buffer *bytes.Buffer) T, onEmpty T) T {
-	option := Get(key)
-
-	if !option.HasValue {
-		return onEmpty
-	}
+// NextKey returns the next key in storage after the given one in lexicographic order,
+// or `None` if there is no such key.
+func NextKey(key []byte) sc.Option[sc.Sequence[sc.U8]] {
+	keyOffsetSize := utils.BytesToOffsetAndSize(key)
+	valueOffsetSize := env.ExtStorageNextKeyVersion1(keyOffsetSize)
+	offset, size := utils.Int64ToOffsetAndSize(valueOffsetSize)
+	value := utils.ToWasmMemorySlice(offset, size)
 
 	buffer := &bytes.Buffer{}
-	buffer.Write(sc.SequenceU8ToBytes(option.Value))
-
-	return decodeFunc(buffer)
-}
+	buffer.Write(value)
 
-func NextKey(key int64) int64 {
-	panic("not implemented")
+	return sc.DecodeOption[sc.Sequence[sc.U8]](buffer)
 }
 
 // StartTransaction Start a new nested transaction.
@@ -138,39 +131,6 @@ func Set(key []byte, value []byte) {
 	env.ExtStorageSetVersion1(keyOffsetSize, valueOffsetSize)
 }
 
-// TakeBytes gets the storage value. The result from Get is Option<sc.Sequence[sc.U8]>.
-// If the option is empty, it returns nil.
-// If the option is not empty, it clears it and returns the sequence as bytes.
-func TakeBytes(key []byte) []byte {
-	option := Get(key)
-
-	if !option.HasValue {
-		return nil
-	}
-
-	Clear(key)
-
-	return sc.SequenceU8ToBytes(option.Value)
-}
-
-// TakeDecode gets the storage value and returns it decoded. The result from Get is Option<sc.Sequence[sc.U8]>.
-// If the option is empty, it returns default value T.
-// If the option is not empty, it clears it and returns decodeFunc(value).
-func TakeDecode[T sc.Encodable](key []byte, decodeFunc func(buffer *bytes.Buffer) T) T {
-	option := Get(key)
-
-	if !option.HasValue {
-		return *new(T)
-	}
-
-	Clear(key)
-
-	buffer := &bytes.Buffer{}
-	buffer.Write(sc.SequenceU8ToBytes(option.Value))
-
-	return decodeFunc(buffer)
-}
-
 // get gets the value from storage by the provided key. The wasm memory slice (value)
 // represents an encoded Option<sc.Sequence[sc.U8]> (option of encoded slice).
 func get(key []byte) []byte {
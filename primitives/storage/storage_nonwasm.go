@@ -3,75 +3,94 @@
 package storage
 
 import (
-	"bytes"
-
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/LimeChain/gosemble/primitives/types"
 )
 
 func Append(key []byte, value []byte) {
-	panic("not implemented")
-}
-
-func ChangesRoot(parent_hash int64) int64 {
-	panic("not implemented")
+	host.DefaultStorage().Append(key, value)
 }
 
 func Clear(key []byte) {
-	panic("not implemented")
+	host.DefaultStorage().Clear(key)
 }
 
-func ClearPrefix(key []byte, limit []byte) {
-	panic("not implemented")
+// ClearPrefix removes up to `limit` keys starting with the given prefix, or all of them
+// if the limit is `None`. It reports the same results as ext_storage_clear_prefix_version_2.
+func ClearPrefix(prefix []byte, limit sc.Option[sc.U32], _ sc.Option[sc.Sequence[sc.U8]]) types.MultiRemovalResults {
+	var maxKeys *uint32
+	if limit.HasValue {
+		value := uint32(limit.Value)
+		maxKeys = &value
+	}
+
+	removed, allRemoved := host.DefaultStorage().ClearPrefix(prefix, maxKeys)
+
+	return types.KillStorageResult{
+		AllRemoved: sc.Bool(allRemoved),
+		Removed:    sc.U32(removed),
+	}.MultiRemovalResults(prefix)
 }
 
 func Exists(key []byte) int32 {
-	panic("not implemented")
+	if host.DefaultStorage().Exists(key) {
+		return 1
+	}
+	return 0
 }
 
 func Get(key []byte) sc.Option[sc.Sequence[sc.U8]] {
-	panic("not implemented")
-}
+	value, ok := host.DefaultStorage().Get(key)
+	if !ok {
+		return sc.NewOption[sc.Sequence[sc.U8]](nil)
+	}
 
-func GetDecode[T sc.Encodable](key []byte, decodeFunc func(buffer *bytes.Buffer) T) T {
-	panic("not implemented")
+	return sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(value))
 }
 
-func GetDecodeOnEmpty[T sc.Encodable](key []byte, decodeFunc func(buffer *bytes.Buffer) T, onEmpty T) T {
-	panic("not implemented")
-}
+func NextKey(key []byte) sc.Option[sc.Sequence[sc.U8]] {
+	next, ok := host.DefaultStorage().NextKey(key)
+	if !ok {
+		return sc.NewOption[sc.Sequence[sc.U8]](nil)
+	}
 
-func NextKey(key int64) int64 {
-	panic("not implemented")
+	return sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(next))
 }
 
 func Read(key []byte, valueOut []byte, offset int32) sc.Option[sc.U32] {
-	panic("not implemented")
-}
+	value, ok := host.DefaultStorage().Get(key)
+	if !ok {
+		return sc.NewOption[sc.U32](nil)
+	}
 
-func Root(key int32) []byte {
-	panic("not implemented")
-}
+	start := int(offset)
+	if start > len(value) {
+		start = len(value)
+	}
 
-func Set(key []byte, value []byte) {
-	panic("not implemented")
+	data := value[start:]
+	copy(valueOut, data)
+
+	return sc.NewOption[sc.U32](sc.U32(len(data)))
 }
 
-func TakeBytes(key []byte) []byte {
-	panic("not implemented")
+func Root(version int32) []byte {
+	return host.DefaultStorage().Root(version)
 }
 
-func TakeDecode[T sc.Encodable](key []byte, decodeFunc func(buffer *bytes.Buffer) T) T {
-	panic("not implemented")
+func Set(key []byte, value []byte) {
+	host.DefaultStorage().Set(key, value)
 }
 
 func StartTransaction() {
-	panic("not implemented")
+	host.DefaultStorage().StartTransaction()
 }
 
 func RollbackTransaction() {
-	panic("not implemented")
+	host.DefaultStorage().RollbackTransaction()
 }
 
 func CommitTransaction() {
-	panic("not implemented")
+	host.DefaultStorage().CommitTransaction()
 }
@@ -49,7 +49,7 @@ func (ai AccountInfo) Frozen(reasons Reasons) *big.Int {
 	case ReasonsMisc:
 		return big.NewInt(0).Set(ai.Data.MiscFrozen.ToBigInt())
 	case ReasonsFee:
-		return big.NewInt(0).Set(ai.Data.MiscFrozen.ToBigInt())
+		return big.NewInt(0).Set(ai.Data.FeeFrozen.ToBigInt())
 	}
 
 	return big.NewInt(0)
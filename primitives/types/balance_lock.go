@@ -0,0 +1,69 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// LockIdentifier identifies a balance lock. It is 8 bytes long.
+type LockIdentifier = sc.FixedSequence[sc.U8]
+
+func DecodeLockIdentifier(buffer *bytes.Buffer) LockIdentifier {
+	return sc.DecodeFixedSequence[sc.U8](8, buffer)
+}
+
+// BalanceLock is a single lock on a balance. There can be many of these on an account and
+// they "overlap", so the same balance is frozen by multiple locks.
+type BalanceLock struct {
+	Id      LockIdentifier
+	Amount  Balance
+	Reasons Reasons
+}
+
+func (bl BalanceLock) Encode(buffer *bytes.Buffer) {
+	bl.Id.Encode(buffer)
+	bl.Amount.Encode(buffer)
+	bl.Reasons.Encode(buffer)
+}
+
+func (bl BalanceLock) Bytes() []byte {
+	return sc.EncodedBytes(bl)
+}
+
+func DecodeBalanceLock(buffer *bytes.Buffer) BalanceLock {
+	return BalanceLock{
+		Id:      DecodeLockIdentifier(buffer),
+		Amount:  sc.DecodeU128(buffer),
+		Reasons: DecodeReasons(buffer),
+	}
+}
+
+// ReserveIdentifier identifies a named reserve. It is 8 bytes long.
+type ReserveIdentifier = sc.FixedSequence[sc.U8]
+
+func DecodeReserveIdentifier(buffer *bytes.Buffer) ReserveIdentifier {
+	return sc.DecodeFixedSequence[sc.U8](8, buffer)
+}
+
+// ReserveData is a named amount of the reserved balance of an account.
+type ReserveData struct {
+	Id     ReserveIdentifier
+	Amount Balance
+}
+
+func (rd ReserveData) Encode(buffer *bytes.Buffer) {
+	rd.Id.Encode(buffer)
+	rd.Amount.Encode(buffer)
+}
+
+func (rd ReserveData) Bytes() []byte {
+	return sc.EncodedBytes(rd)
+}
+
+func DecodeReserveData(buffer *bytes.Buffer) ReserveData {
+	return ReserveData{
+		Id:     DecodeReserveIdentifier(buffer),
+		Amount: sc.DecodeU128(buffer),
+	}
+}
@@ -16,10 +16,11 @@ func (ae AccountIdExtra) Encode(buffer *bytes.Buffer) {
 	ae.SignedExtra.Encode(buffer)
 }
 
-func DecodeAccountIdExtra(buffer *bytes.Buffer) AccountIdExtra {
+// DecodeAccountIdExtra decodes the account and the extra, which is decoded by the signed extensions of `extra`.
+func DecodeAccountIdExtra(extra SignedExtra, buffer *bytes.Buffer) AccountIdExtra {
 	ae := AccountIdExtra{}
 	ae.Address32 = DecodeAddress32(buffer)
-	ae.SignedExtra = DecodeExtra(buffer)
+	ae.SignedExtra = extra.Decode(buffer)
 	return ae
 }
 
@@ -0,0 +1,41 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+// ReservableCurrency is implemented by a module, which keeps the native balances and can reserve
+// part of them, e.g. as deposits of other modules.
+type ReservableCurrency interface {
+	// ReservedBalance returns the reserved balance of `who`.
+	ReservedBalance(who Address32) Balance
+
+	// Reserve moves `value` from the free balance of `who` to its reserved balance.
+	// Fails if the free balance is not sufficient or is restricted by locks.
+	Reserve(who Address32, value Balance) DispatchError
+
+	// Unreserve moves up to `value` from the reserved balance of `who` to its free balance.
+	// Returns the amount, which could not be unreserved.
+	Unreserve(who Address32, value Balance) Balance
+
+	// SlashReserved deducts up to `value` from the reserved balance of `who` and removes it from
+	// the total issuance. Returns the amount, which could not be slashed.
+	SlashReserved(who Address32, value Balance) Balance
+
+	// RepatriateReserved moves up to `value` from the reserved balance of `slashed` to the free or reserved
+	// balance of `beneficiary`, depending on `status`. Returns the amount, which could not be moved.
+	// Fails if `beneficiary` does not exist.
+	RepatriateReserved(slashed Address32, beneficiary Address32, value Balance, status BalanceStatus) (Balance, DispatchError)
+}
+
+// LockableCurrency is implemented by a module, which keeps the native balances and can lock
+// part of them against being withdrawn for the given withdraw reasons.
+type LockableCurrency interface {
+	// SetLock creates a new lock of `amount` of the balance of `who`, or replaces the existing one with the same `id`.
+	SetLock(id LockIdentifier, who Address32, amount Balance, reasons sc.U8)
+
+	// ExtendLock changes the lock with the same `id`, so that it locks at least `amount` for at least
+	// the given `reasons`. Creates a new lock, if there is none with the `id`.
+	ExtendLock(id LockIdentifier, who Address32, amount Balance, reasons sc.U8)
+
+	// RemoveLock removes the lock with the given `id` from `who`.
+	RemoveLock(id LockIdentifier, who Address32)
+}
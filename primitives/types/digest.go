@@ -6,40 +6,37 @@ import (
 	sc "github.com/LimeChain/goscale"
 )
 
-const (
-	DigestTypeConsensusMessage           = 4
-	DigestTypeSeal                       = 5
-	DigestTypePreRuntime                 = 6
-	DigestTypeRuntimeEnvironmentUpgraded = 8
-)
+// Digest is the header digest, which holds the logs of the block in the order they were deposited.
+type Digest struct {
+	Logs sc.Sequence[DigestItem]
+}
+
+func (d Digest) Encode(buffer *bytes.Buffer) {
+	d.Logs.Encode(buffer)
+}
 
-type Digest = sc.Dictionary[sc.U8, sc.FixedSequence[DigestItem]]
+func (d Digest) Bytes() []byte {
+	return sc.EncodedBytes(d)
+}
 
 func DecodeDigest(buffer *bytes.Buffer) Digest {
-	compactSize := sc.DecodeCompact(buffer)
-	size := int(compactSize.ToBigInt().Int64())
-
-	decoder := sc.Decoder{Reader: buffer}
-
-	result := Digest{}
-	for i := 0; i < size; i++ {
-		digestType := decoder.DecodeByte()
-
-		switch digestType {
-		case DigestTypeConsensusMessage:
-			consensusDigest := DecodeDigestItem(buffer)
-			result[DigestTypeConsensusMessage] = append(result[DigestTypeConsensusMessage], consensusDigest)
-		case DigestTypeSeal:
-			seal := DecodeDigestItem(buffer)
-			result[DigestTypeSeal] = append(result[DigestTypeSeal], seal)
-		case DigestTypePreRuntime:
-			preRuntimeDigest := DecodeDigestItem(buffer)
-			result[DigestTypePreRuntime] = append(result[DigestTypePreRuntime], preRuntimeDigest)
-		case DigestTypeRuntimeEnvironmentUpgraded:
-			sc.DecodeU8(buffer)
-			// TODO:
-		}
+	return Digest{
+		Logs: sc.DecodeSequenceWith(buffer, DecodeDigestItem),
 	}
+}
+
+// Push appends the item to the logs of the digest.
+func (d *Digest) Push(item DigestItem) {
+	d.Logs = append(d.Logs, item)
+}
 
-	return result
+// OfType returns the logs of the given digest type, in the order they were deposited.
+func (d Digest) OfType(digestType sc.U8) []DigestItem {
+	var items []DigestItem
+	for _, item := range d.Logs {
+		if item.Type == digestType {
+			items = append(items, item)
+		}
+	}
+	return items
 }
@@ -4,16 +4,55 @@ import (
 	"bytes"
 
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/log"
 )
 
+const (
+	DigestTypeOther                      = 0
+	DigestTypeConsensusMessage           = 4
+	DigestTypeSeal                       = 5
+	DigestTypePreRuntime                 = 6
+	DigestTypeRuntimeEnvironmentUpgraded = 8
+)
+
+// DigestItem is a single log of the header digest. The engine is set for the pre-runtime,
+// consensus and seal items, and the payload for all but `RuntimeEnvironmentUpgraded`.
 type DigestItem struct {
+	Type    sc.U8
 	Engine  sc.FixedSequence[sc.U8]
 	Payload sc.Sequence[sc.U8]
 }
 
+func NewDigestItemPreRuntime(engine sc.FixedSequence[sc.U8], payload sc.Sequence[sc.U8]) DigestItem {
+	return DigestItem{Type: DigestTypePreRuntime, Engine: engine, Payload: payload}
+}
+
+func NewDigestItemConsensusMessage(engine sc.FixedSequence[sc.U8], payload sc.Sequence[sc.U8]) DigestItem {
+	return DigestItem{Type: DigestTypeConsensusMessage, Engine: engine, Payload: payload}
+}
+
+func NewDigestItemSeal(engine sc.FixedSequence[sc.U8], payload sc.Sequence[sc.U8]) DigestItem {
+	return DigestItem{Type: DigestTypeSeal, Engine: engine, Payload: payload}
+}
+
+func NewDigestItemOther(payload sc.Sequence[sc.U8]) DigestItem {
+	return DigestItem{Type: DigestTypeOther, Payload: payload}
+}
+
+func NewDigestItemRuntimeEnvironmentUpgraded() DigestItem {
+	return DigestItem{Type: DigestTypeRuntimeEnvironmentUpgraded}
+}
+
 func (di DigestItem) Encode(buffer *bytes.Buffer) {
-	di.Engine.Encode(buffer)
-	di.Payload.Encode(buffer)
+	di.Type.Encode(buffer)
+
+	switch di.Type {
+	case DigestTypePreRuntime, DigestTypeConsensusMessage, DigestTypeSeal:
+		di.Engine.Encode(buffer)
+		di.Payload.Encode(buffer)
+	case DigestTypeOther:
+		di.Payload.Encode(buffer)
+	}
 }
 
 func (di DigestItem) Bytes() []byte {
@@ -24,8 +63,22 @@ func (di DigestItem) Bytes() []byte {
 }
 
 func DecodeDigestItem(buffer *bytes.Buffer) DigestItem {
-	return DigestItem{
-		Engine:  sc.DecodeFixedSequence[sc.U8](4, buffer),
-		Payload: sc.DecodeSequence[sc.U8](buffer),
+	digestType := sc.DecodeU8(buffer)
+
+	switch digestType {
+	case DigestTypePreRuntime, DigestTypeConsensusMessage, DigestTypeSeal:
+		return DigestItem{
+			Type:    digestType,
+			Engine:  sc.DecodeFixedSequence[sc.U8](4, buffer),
+			Payload: sc.DecodeSequence[sc.U8](buffer),
+		}
+	case DigestTypeOther:
+		return NewDigestItemOther(sc.DecodeSequence[sc.U8](buffer))
+	case DigestTypeRuntimeEnvironmentUpgraded:
+		return NewDigestItemRuntimeEnvironmentUpgraded()
+	default:
+		log.Critical("invalid DigestItem type")
 	}
+
+	panic("unreachable")
 }
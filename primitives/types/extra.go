@@ -6,34 +6,133 @@ import (
 	sc "github.com/LimeChain/goscale"
 )
 
-// SignedExtra implements SignedExtension
-// Extra data, E, is a tuple containing additional metadata about the extrinsic and the system it is meant to be executed in.
+// SignedExtra is the ordered tuple of signed extensions, configured by the runtime.
+// Its data is the extra of a signed extrinsic, which contains additional metadata about the extrinsic
+// and the system it is meant to be executed in, such as the era, the nonce and the tip.
 type SignedExtra struct {
-	Era Era
-
-	// a compact integer containing the nonce of the sender.
-	// The nonce must be incremented by one for each extrinsic created,
-	// otherwise the Polkadot network will reject the extrinsic.
-	Nonce sc.U32 // encode as Compact
+	Extensions []SignedExtension
+}
 
-	// a compact integer containing the transactor pay including tip.
-	Fee sc.U128 // encode as Compact
+func NewSignedExtra(extensions ...SignedExtension) SignedExtra {
+	return SignedExtra{Extensions: extensions}
 }
 
 func (e SignedExtra) Encode(buffer *bytes.Buffer) {
-	e.Era.Encode(buffer)
-	sc.ToCompact(e.Nonce).Encode(buffer)
-	sc.Compact(e.Fee).Encode(buffer)
+	for _, extension := range e.Extensions {
+		extension.Encode(buffer)
+	}
 }
 
-func DecodeExtra(buffer *bytes.Buffer) SignedExtra {
-	e := SignedExtra{}
-	e.Era = DecodeEra(buffer)
-	e.Nonce = sc.U32(sc.U128(sc.DecodeCompact(buffer)).ToBigInt().Uint64())
-	e.Fee = sc.U128(sc.DecodeCompact(buffer))
-	return e
+// Decode decodes the extra of a signed extrinsic by the extensions of `e`, in their order.
+func (e SignedExtra) Decode(buffer *bytes.Buffer) SignedExtra {
+	extensions := make([]SignedExtension, 0, len(e.Extensions))
+	for _, extension := range e.Extensions {
+		extensions = append(extensions, extension.Decode(buffer))
+	}
+
+	return NewSignedExtra(extensions...)
 }
 
 func (e SignedExtra) Bytes() []byte {
 	return sc.EncodedBytes(e)
 }
+
+// AdditionalSigned returns the additional signed data of all extensions, in their order.
+func (e SignedExtra) AdditionalSigned() (AdditionalSigned, TransactionValidityError) {
+	additionalSigned := AdditionalSigned{}
+
+	for _, extension := range e.Extensions {
+		ok, err := extension.AdditionalSigned()
+		if err != nil {
+			return nil, err
+		}
+		additionalSigned = append(additionalSigned, ok)
+	}
+
+	return additionalSigned, nil
+}
+
+// Validate returns the information on a transaction's validity, combined from all extensions.
+func (e SignedExtra) Validate(who *Address32, call *Call, info *DispatchInfo, length sc.Compact) (ValidTransaction, TransactionValidityError) {
+	valid := DefaultValidTransaction()
+
+	for _, extension := range e.Extensions {
+		ok, err := extension.Validate(who, call, info, length)
+		if err != nil {
+			return ok, err
+		}
+		valid = valid.CombineWith(ok)
+	}
+
+	return valid, nil
+}
+
+func (e SignedExtra) ValidateUnsigned(call *Call, info *DispatchInfo, length sc.Compact) (ValidTransaction, TransactionValidityError) {
+	valid := DefaultValidTransaction()
+
+	for _, extension := range e.Extensions {
+		ok, err := extension.ValidateUnsigned(call, info, length)
+		if err != nil {
+			return ok, err
+		}
+		valid = valid.CombineWith(ok)
+	}
+
+	return valid, nil
+}
+
+// PreDispatch does any pre-flight stuff for a signed transaction and returns the output of each extension, in their order.
+//
+// Make sure to perform the same checks as in [`Validate`].
+func (e SignedExtra) PreDispatch(who *Address32, call *Call, info *DispatchInfo, length sc.Compact) (sc.Sequence[Pre], TransactionValidityError) {
+	pre := sc.Sequence[Pre]{}
+
+	for _, extension := range e.Extensions {
+		ok, err := extension.PreDispatch(who, call, info, length)
+		if err != nil {
+			return nil, err
+		}
+		pre = append(pre, ok)
+	}
+
+	return pre, nil
+}
+
+func (e SignedExtra) PreDispatchUnsigned(call *Call, info *DispatchInfo, length sc.Compact) TransactionValidityError {
+	for _, extension := range e.Extensions {
+		_, err := extension.PreDispatchUnsigned(call, info, length)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PostDispatch does any post-flight stuff for an extrinsic. If the transaction is signed, pre contains
+// the output of PreDispatch, which is passed to the extension it originates from.
+func (e SignedExtra) PostDispatch(pre sc.Option[sc.Sequence[Pre]], info *DispatchInfo, postInfo *PostDispatchInfo, length sc.Compact, result *DispatchResult) TransactionValidityError {
+	for i, extension := range e.Extensions {
+		extensionPre := sc.NewOption[Pre](nil)
+		if pre.HasValue {
+			extensionPre = sc.NewOption[Pre](pre.Value[i])
+		}
+
+		err := extension.PostDispatch(extensionPre, info, postInfo, length, result)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Metadata returns the metadata of all extensions, in their order.
+func (e SignedExtra) Metadata() sc.Sequence[MetadataSignedExtension] {
+	metadata := sc.Sequence[MetadataSignedExtension]{}
+	for _, extension := range e.Extensions {
+		metadata = append(metadata, extension.Metadata())
+	}
+
+	return metadata
+}
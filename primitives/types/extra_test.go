@@ -0,0 +1,150 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/constants/metadata"
+	"github.com/stretchr/testify/assert"
+)
+
+// testExtension is a signed extension, which encodes a single byte and validates with a priority equal to it.
+type testExtension struct {
+	DefaultSignedExtension
+	Value sc.U8
+}
+
+func (te testExtension) Encode(buffer *bytes.Buffer) {
+	te.Value.Encode(buffer)
+}
+
+func (te testExtension) Decode(buffer *bytes.Buffer) SignedExtension {
+	return testExtension{Value: sc.DecodeU8(buffer)}
+}
+
+func (te testExtension) Bytes() []byte {
+	return sc.EncodedBytes(te)
+}
+
+func (te testExtension) Identifier() sc.Str {
+	return "TestExtension"
+}
+
+func (te testExtension) AdditionalSigned() (sc.Encodable, TransactionValidityError) {
+	return sc.U32(te.Value), nil
+}
+
+func (te testExtension) Validate(_ *Address32, _ *Call, _ *DispatchInfo, _ sc.Compact) (ValidTransaction, TransactionValidityError) {
+	if te.Value == 0 {
+		return ValidTransaction{}, NewTransactionValidityError(NewInvalidTransactionCall())
+	}
+
+	valid := DefaultValidTransaction()
+	valid.Priority = TransactionPriority(te.Value)
+	valid.Longevity = TransactionLongevity(te.Value)
+	return valid, nil
+}
+
+func (te testExtension) PreDispatch(_ *Address32, _ *Call, _ *DispatchInfo, _ sc.Compact) (Pre, TransactionValidityError) {
+	return te.Value, nil
+}
+
+func (te testExtension) PostDispatch(pre sc.Option[Pre], _ *DispatchInfo, _ *PostDispatchInfo, _ sc.Compact, _ *DispatchResult) TransactionValidityError {
+	if pre.HasValue && pre.Value.(sc.U8) != te.Value {
+		return NewTransactionValidityError(NewInvalidTransactionCall())
+	}
+	return nil
+}
+
+func (te testExtension) Metadata() MetadataSignedExtension {
+	return NewMetadataSignedExtension(te.Identifier(), metadata.PrimitiveTypesU8, metadata.PrimitiveTypesU32)
+}
+
+func Test_SignedExtra_Encode_Decode(t *testing.T) {
+	extra := NewSignedExtra(testExtension{Value: 1}, testExtension{Value: 2})
+
+	assert.Equal(t, []byte{1, 2}, extra.Bytes())
+	assert.Equal(t, extra, NewSignedExtra(testExtension{}, testExtension{}).Decode(bytes.NewBuffer([]byte{1, 2})))
+}
+
+func Test_SignedExtra_AdditionalSigned(t *testing.T) {
+	extra := NewSignedExtra(testExtension{Value: 1}, testExtension{Value: 2})
+
+	additionalSigned, err := extra.AdditionalSigned()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1, 0, 0, 0, 2, 0, 0, 0}, additionalSigned.Bytes())
+}
+
+func Test_SignedExtra_Validate(t *testing.T) {
+	who := NewAddress32(0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1)
+
+	var testExamples = []struct {
+		label         string
+		input         SignedExtra
+		expectation   ValidTransaction
+		expectedError TransactionValidityError
+	}{
+		{
+			label: "combines the validity of all extensions",
+			input: NewSignedExtra(testExtension{Value: 3}, testExtension{Value: 2}),
+			expectation: ValidTransaction{
+				Priority:  5,
+				Requires:  sc.Sequence[TransactionTag]{},
+				Provides:  sc.Sequence[TransactionTag]{},
+				Longevity: 2,
+				Propagate: true,
+			},
+		},
+		{
+			label:         "fails if any extension fails",
+			input:         NewSignedExtra(testExtension{Value: 3}, testExtension{Value: 0}),
+			expectation:   ValidTransaction{},
+			expectedError: NewTransactionValidityError(NewInvalidTransactionCall()),
+		},
+		{
+			label:       "without extensions",
+			input:       NewSignedExtra(),
+			expectation: DefaultValidTransaction(),
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			valid, err := testExample.input.Validate(&who, nil, &DispatchInfo{}, sc.ToCompact(0))
+
+			assert.Equal(t, testExample.expectedError, err)
+			assert.Equal(t, testExample.expectation, valid)
+		})
+	}
+}
+
+func Test_SignedExtra_PreDispatch_PostDispatch(t *testing.T) {
+	who := NewAddress32(0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1)
+	extra := NewSignedExtra(testExtension{Value: 1}, testExtension{Value: 2})
+
+	pre, err := extra.PreDispatch(&who, nil, &DispatchInfo{}, sc.ToCompact(0))
+
+	assert.Nil(t, err)
+	assert.Equal(t, sc.Sequence[Pre]{sc.U8(1), sc.U8(2)}, pre)
+
+	// Each extension receives the output of its own pre dispatch.
+	err = extra.PostDispatch(sc.NewOption[sc.Sequence[Pre]](pre), &DispatchInfo{}, &PostDispatchInfo{}, sc.ToCompact(0), &DispatchResult{})
+	assert.Nil(t, err)
+
+	swapped := sc.Sequence[Pre]{pre[1], pre[0]}
+	err = extra.PostDispatch(sc.NewOption[sc.Sequence[Pre]](swapped), &DispatchInfo{}, &PostDispatchInfo{}, sc.ToCompact(0), &DispatchResult{})
+	assert.Equal(t, NewTransactionValidityError(NewInvalidTransactionCall()), err)
+}
+
+func Test_SignedExtra_Metadata(t *testing.T) {
+	extra := NewSignedExtra(testExtension{}, testExtension{})
+
+	expect := sc.Sequence[MetadataSignedExtension]{
+		NewMetadataSignedExtension("TestExtension", metadata.PrimitiveTypesU8, metadata.PrimitiveTypesU32),
+		NewMetadataSignedExtension("TestExtension", metadata.PrimitiveTypesU8, metadata.PrimitiveTypesU32),
+	}
+
+	assert.Equal(t, expect, extra.Metadata())
+}
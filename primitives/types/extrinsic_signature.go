@@ -22,11 +22,12 @@ func (s ExtrinsicSignature) Encode(buffer *bytes.Buffer) {
 	s.Extra.Encode(buffer)
 }
 
-func DecodeExtrinsicSignature(buffer *bytes.Buffer) ExtrinsicSignature {
+// DecodeExtrinsicSignature decodes the signature of an extrinsic, whose extra is decoded by the signed extensions of `extra`.
+func DecodeExtrinsicSignature(extra SignedExtra, buffer *bytes.Buffer) ExtrinsicSignature {
 	s := ExtrinsicSignature{}
 	s.Signer = DecodeMultiAddress(buffer)
 	s.Signature = DecodeMultiSignature(buffer)
-	s.Extra = DecodeExtra(buffer)
+	s.Extra = extra.Decode(buffer)
 	return s
 }
 
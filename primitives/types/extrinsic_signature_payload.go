@@ -21,27 +21,24 @@ type SignedPayload struct {
 	AdditionalSigned
 }
 
-type AdditionalSigned struct {
-	SpecVersion sc.U32
-	// FormatVersion sc.U32
+// AdditionalSigned is the additional data of the signed extensions, which goes into the signed payload,
+// but is not part of the extrinsic, in the order of the extensions.
+type AdditionalSigned []sc.Encodable
 
-	// Hh(G): a 32-byte array containing the genesis hash.
-	GenesisHash H256 // size 32
-
-	// Hh(B): a 32-byte array containing the hash of the block which starts the mortality period, as described in
-	BlockHash H256 // size 32
+func (as AdditionalSigned) Encode(buffer *bytes.Buffer) {
+	for _, additionalSigned := range as {
+		additionalSigned.Encode(buffer)
+	}
+}
 
-	TransactionVersion sc.U32
+func (as AdditionalSigned) Bytes() []byte {
+	return sc.EncodedBytes(as)
 }
 
 func (sp SignedPayload) Encode(buffer *bytes.Buffer) {
 	sp.Call.Encode(buffer)
 	sp.Extra.Encode(buffer)
-	sp.SpecVersion.Encode(buffer)
-	sp.TransactionVersion.Encode(buffer)
-	// sp.FormatVersion.Encode(buffer)
-	sp.GenesisHash.Encode(buffer)
-	sp.BlockHash.Encode(buffer)
+	sp.AdditionalSigned.Encode(buffer)
 }
 
 func (sp SignedPayload) Bytes() []byte {
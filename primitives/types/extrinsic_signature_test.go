@@ -19,11 +19,7 @@ func Test_EncodeExtrinsicSignature(t *testing.T) {
 			input: ExtrinsicSignature{
 				Signer:    NewMultiAddressId(AccountId{NewAddress32(0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1)}),
 				Signature: NewMultiSignatureEd25519(NewEd25519(sc.FixedSequence[sc.U8]{0x00, 0x62, 0x37, 0x61, 0x33, 0x63, 0x31, 0x32, 0x64, 0x63, 0x30, 0x63, 0x38, 0x63, 0x37, 0x34, 0x38, 0x61, 0x62, 0x30, 0x37, 0x35, 0x32, 0x35, 0x62, 0x37, 0x30, 0x31, 0x31, 0x32, 0x32, 0x62, 0x38, 0x38, 0x62, 0x64, 0x37, 0x38, 0x66, 0x36, 0x30, 0x30, 0x63, 0x37, 0x36, 0x33, 0x34, 0x32, 0x64, 0x32, 0x37, 0x66, 0x32, 0x35, 0x65, 0x35, 0x66, 0x39, 0x32, 0x34, 0x34, 0x34, 0x63, 0x64}...)),
-				Extra: SignedExtra{
-					Era:   NewImmortalEra(),
-					Nonce: 0,
-					Fee:   sc.NewU128FromUint64(0),
-				},
+				Extra:     NewSignedExtra(testExtension{}, testExtension{}, testExtension{}),
 			},
 			expectation: []byte{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x0, 0x0, 0x62, 0x37, 0x61, 0x33, 0x63, 0x31, 0x32, 0x64, 0x63, 0x30, 0x63, 0x38, 0x63, 0x37, 0x34, 0x38, 0x61, 0x62, 0x30, 0x37, 0x35, 0x32, 0x35, 0x62, 0x37, 0x30, 0x31, 0x31, 0x32, 0x32, 0x62, 0x38, 0x38, 0x62, 0x64, 0x37, 0x38, 0x66, 0x36, 0x30, 0x30, 0x63, 0x37, 0x36, 0x33, 0x34, 0x32, 0x64, 0x32, 0x37, 0x66, 0x32, 0x35, 0x65, 0x35, 0x66, 0x39, 0x32, 0x34, 0x34, 0x34, 0x63, 0x64, 0x0, 0x0, 0x0},
 		},
@@ -52,11 +48,7 @@ func Test_DecodeExtrinsicSignature(t *testing.T) {
 			expectation: ExtrinsicSignature{
 				Signer:    NewMultiAddressId(AccountId{NewAddress32(0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1)}),
 				Signature: NewMultiSignatureEd25519(NewEd25519(sc.FixedSequence[sc.U8]{0x00, 0x62, 0x37, 0x61, 0x33, 0x63, 0x31, 0x32, 0x64, 0x63, 0x30, 0x63, 0x38, 0x63, 0x37, 0x34, 0x38, 0x61, 0x62, 0x30, 0x37, 0x35, 0x32, 0x35, 0x62, 0x37, 0x30, 0x31, 0x31, 0x32, 0x32, 0x62, 0x38, 0x38, 0x62, 0x64, 0x37, 0x38, 0x66, 0x36, 0x30, 0x30, 0x63, 0x37, 0x36, 0x33, 0x34, 0x32, 0x64, 0x32, 0x37, 0x66, 0x32, 0x35, 0x65, 0x35, 0x66, 0x39, 0x32, 0x34, 0x34, 0x34, 0x63, 0x64}...)),
-				Extra: SignedExtra{
-					Era:   NewImmortalEra(),
-					Nonce: 0,
-					Fee:   sc.NewU128FromUint64(0),
-				},
+				Extra:     NewSignedExtra(testExtension{}, testExtension{}, testExtension{}),
 			},
 		},
 	}
@@ -66,9 +58,10 @@ func Test_DecodeExtrinsicSignature(t *testing.T) {
 			buffer := &bytes.Buffer{}
 			buffer.Write(testExample.input)
 
-			s := DecodeExtrinsicSignature(buffer)
+			s := DecodeExtrinsicSignature(NewSignedExtra(testExtension{}, testExtension{}, testExtension{}), buffer)
 
 			assert.Equal(t, testExample.expectation.Signer, s.Signer)
+			assert.Equal(t, testExample.expectation.Extra, s.Extra)
 		})
 	}
 }
@@ -0,0 +1,381 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+var (
+	// fixedAccuracy is the number, which the inner values of the fixed-point numbers are divided by.
+	fixedAccuracy = big.NewInt(1_000_000_000_000_000_000)
+
+	maxU128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+	minI128 = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+	maxI128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+)
+
+// fixedMul returns the inner value of the product of two fixed-point numbers, rounded to the nearest value.
+func fixedMul(a, b *big.Int) *big.Int {
+	return mulDivRounded(a, b, fixedAccuracy, RoundingNearestPrefDown)
+}
+
+// fixedDiv returns the inner value of the quotient of two fixed-point numbers, rounded to the nearest value.
+// `b` must not be zero.
+func fixedDiv(a, b *big.Int) *big.Int {
+	return mulDivRounded(a, fixedAccuracy, b, RoundingNearestPrefDown)
+}
+
+// fixedSaturatingPow returns the inner value of the fixed-point number `base` to the power of `exp`,
+// saturating at the bounds.
+func fixedSaturatingPow(base *big.Int, exp uint32, min, max *big.Int) *big.Int {
+	negative := base.Sign() < 0 && exp&1 == 1
+	saturated := max
+	if negative {
+		saturated = min
+	}
+
+	result := new(big.Int).Set(fixedAccuracy)
+	square := new(big.Int).Abs(base)
+	for exp > 0 {
+		if exp&1 == 1 {
+			result = fixedMul(result, square)
+			if result.Cmp(max) > 0 {
+				return new(big.Int).Set(saturated)
+			}
+		}
+
+		exp >>= 1
+		if exp > 0 {
+			square = fixedMul(square, square)
+			if square.Cmp(max) > 0 {
+				return new(big.Int).Set(saturated)
+			}
+		}
+	}
+
+	if negative {
+		result.Neg(result)
+	}
+
+	return clamp(result, min, max)
+}
+
+// FixedU128 is an unsigned fixed-point number with 18 decimals, stored as the number multiplied by `10^18`.
+type FixedU128 struct {
+	sc.U128
+}
+
+// NewFixedU128FromInner returns the fixed-point number, whose inner value is `inner`.
+func NewFixedU128FromInner(inner sc.U128) FixedU128 {
+	return FixedU128{inner}
+}
+
+// NewFixedU128FromUint64 returns the fixed-point number of the integer `n`.
+func NewFixedU128FromUint64(n uint64) FixedU128 {
+	return newFixedU128Saturating(new(big.Int).Mul(new(big.Int).SetUint64(n), fixedAccuracy))
+}
+
+// NewFixedU128FromRational returns the fixed-point number `n / d`, rounded with the given mode,
+// or none if `d` is zero or the number is out of bounds.
+func NewFixedU128FromRational(n, d *big.Int, rounding Rounding) sc.Option[FixedU128] {
+	if d.Sign() == 0 {
+		return sc.NewOption[FixedU128](nil)
+	}
+
+	return checkedFixedU128(mulDivRounded(n, fixedAccuracy, d, rounding))
+}
+
+// NewFixedU128FromPerThing returns the fixed-point number of the fraction `p`, rounded to the nearest value.
+func NewFixedU128FromPerThing(p PerThing) FixedU128 {
+	return newFixedU128Saturating(mulDivRounded(p.Deconstruct(), fixedAccuracy, p.Accuracy(), RoundingNearestPrefDown))
+}
+
+// FixedU128One returns the fixed-point number one.
+func FixedU128One() FixedU128 {
+	return NewFixedU128FromUint64(1)
+}
+
+// FixedU128Max returns the maximum fixed-point number.
+func FixedU128Max() FixedU128 {
+	return FixedU128{sc.NewU128FromBigInt(maxU128)}
+}
+
+func DecodeFixedU128(buffer *bytes.Buffer) FixedU128 {
+	return FixedU128{sc.DecodeU128(buffer)}
+}
+
+func newFixedU128Saturating(inner *big.Int) FixedU128 {
+	return FixedU128{sc.NewU128FromBigInt(clamp(inner, big.NewInt(0), maxU128))}
+}
+
+func checkedFixedU128(inner *big.Int) sc.Option[FixedU128] {
+	if inner.Sign() < 0 || inner.Cmp(maxU128) > 0 {
+		return sc.NewOption[FixedU128](nil)
+	}
+
+	return sc.NewOption[FixedU128](FixedU128{sc.NewU128FromBigInt(inner)})
+}
+
+// IsZero checks whether the number is zero.
+func (f FixedU128) IsZero() bool {
+	return f.ToBigInt().Sign() == 0
+}
+
+// IsOne checks whether the number is one.
+func (f FixedU128) IsOne() bool {
+	return f.ToBigInt().Cmp(fixedAccuracy) == 0
+}
+
+// Cmp compares the number with `other` and returns -1, 0 or +1.
+func (f FixedU128) Cmp(other FixedU128) int {
+	return f.ToBigInt().Cmp(other.ToBigInt())
+}
+
+// CheckedAdd returns `f + other`, or none if it overflows.
+func (f FixedU128) CheckedAdd(other FixedU128) sc.Option[FixedU128] {
+	return checkedFixedU128(new(big.Int).Add(f.ToBigInt(), other.ToBigInt()))
+}
+
+// CheckedSub returns `f - other`, or none if it underflows.
+func (f FixedU128) CheckedSub(other FixedU128) sc.Option[FixedU128] {
+	return checkedFixedU128(new(big.Int).Sub(f.ToBigInt(), other.ToBigInt()))
+}
+
+// CheckedMul returns `f * other`, rounded to the nearest value, or none if it overflows.
+func (f FixedU128) CheckedMul(other FixedU128) sc.Option[FixedU128] {
+	return checkedFixedU128(fixedMul(f.ToBigInt(), other.ToBigInt()))
+}
+
+// CheckedDiv returns `f / other`, rounded to the nearest value, or none if `other` is zero or it overflows.
+func (f FixedU128) CheckedDiv(other FixedU128) sc.Option[FixedU128] {
+	if other.IsZero() {
+		return sc.NewOption[FixedU128](nil)
+	}
+
+	return checkedFixedU128(fixedDiv(f.ToBigInt(), other.ToBigInt()))
+}
+
+// SaturatingAdd returns `f + other`, saturating at the maximum value.
+func (f FixedU128) SaturatingAdd(other FixedU128) FixedU128 {
+	return newFixedU128Saturating(new(big.Int).Add(f.ToBigInt(), other.ToBigInt()))
+}
+
+// SaturatingSub returns `f - other`, saturating at zero.
+func (f FixedU128) SaturatingSub(other FixedU128) FixedU128 {
+	return newFixedU128Saturating(new(big.Int).Sub(f.ToBigInt(), other.ToBigInt()))
+}
+
+// SaturatingMul returns `f * other`, rounded to the nearest value and saturating at the maximum value.
+func (f FixedU128) SaturatingMul(other FixedU128) FixedU128 {
+	return newFixedU128Saturating(fixedMul(f.ToBigInt(), other.ToBigInt()))
+}
+
+// SaturatingPow returns `f` to the power of `exp`, saturating at the maximum value.
+func (f FixedU128) SaturatingPow(exp uint32) FixedU128 {
+	return newFixedU128Saturating(fixedSaturatingPow(f.ToBigInt(), exp, big.NewInt(0), maxU128))
+}
+
+// CheckedMulInt returns the integer `f * n`, rounded down, or none if it overflows.
+func (f FixedU128) CheckedMulInt(n sc.U128) sc.Option[sc.U128] {
+	product := mulDivRounded(f.ToBigInt(), n.ToBigInt(), fixedAccuracy, RoundingDown)
+	if product.Cmp(maxU128) > 0 {
+		return sc.NewOption[sc.U128](nil)
+	}
+
+	return sc.NewOption[sc.U128](sc.NewU128FromBigInt(product))
+}
+
+// SaturatingMulInt returns the integer `f * n`, rounded down and saturating at the maximum value.
+func (f FixedU128) SaturatingMulInt(n sc.U128) sc.U128 {
+	product := mulDivRounded(f.ToBigInt(), n.ToBigInt(), fixedAccuracy, RoundingDown)
+
+	return sc.NewU128FromBigInt(clamp(product, big.NewInt(0), maxU128))
+}
+
+// CheckedDivInt returns the integer `n / f`, rounded down, or none if `f` is zero or it overflows.
+func (f FixedU128) CheckedDivInt(n sc.U128) sc.Option[sc.U128] {
+	if f.IsZero() {
+		return sc.NewOption[sc.U128](nil)
+	}
+
+	quotient := mulDivRounded(n.ToBigInt(), fixedAccuracy, f.ToBigInt(), RoundingDown)
+	if quotient.Cmp(maxU128) > 0 {
+		return sc.NewOption[sc.U128](nil)
+	}
+
+	return sc.NewOption[sc.U128](sc.NewU128FromBigInt(quotient))
+}
+
+// ToFixedI128 converts the number to a signed fixed-point number, saturating at its maximum value.
+func (f FixedU128) ToFixedI128() FixedI128 {
+	return newFixedI128Saturating(f.ToBigInt())
+}
+
+// FixedI128 is a signed fixed-point number with 18 decimals, stored as the number multiplied by `10^18`
+// and encoded as a 128-bit two's complement integer.
+type FixedI128 struct {
+	inner *big.Int
+}
+
+// NewFixedI128FromInner returns the fixed-point number, whose inner value is `inner`,
+// saturating at the bounds of a 128-bit signed integer.
+func NewFixedI128FromInner(inner *big.Int) FixedI128 {
+	return newFixedI128Saturating(inner)
+}
+
+// NewFixedI128FromInt64 returns the fixed-point number of the integer `n`.
+func NewFixedI128FromInt64(n int64) FixedI128 {
+	return newFixedI128Saturating(new(big.Int).Mul(big.NewInt(n), fixedAccuracy))
+}
+
+// NewFixedI128FromRational returns the fixed-point number `n / d`, rounded with the given mode,
+// or none if `d` is zero or the number is out of bounds.
+func NewFixedI128FromRational(n, d *big.Int, rounding Rounding) sc.Option[FixedI128] {
+	if d.Sign() == 0 {
+		return sc.NewOption[FixedI128](nil)
+	}
+
+	return checkedFixedI128(mulDivRounded(n, fixedAccuracy, d, rounding))
+}
+
+// NewFixedI128FromPerThing returns the fixed-point number of the fraction `p`, rounded to the nearest value.
+func NewFixedI128FromPerThing(p PerThing) FixedI128 {
+	return newFixedI128Saturating(mulDivRounded(p.Deconstruct(), fixedAccuracy, p.Accuracy(), RoundingNearestPrefDown))
+}
+
+// FixedI128One returns the fixed-point number one.
+func FixedI128One() FixedI128 {
+	return NewFixedI128FromInt64(1)
+}
+
+func DecodeFixedI128(buffer *bytes.Buffer) FixedI128 {
+	le := sc.FixedSequenceU8ToBytes(sc.DecodeFixedSequence[sc.U8](16, buffer))
+
+	be := make([]byte, len(le))
+	for i, b := range le {
+		be[len(le)-1-i] = b
+	}
+
+	inner := new(big.Int).SetBytes(be)
+	if inner.Cmp(maxI128) > 0 {
+		inner.Sub(inner, new(big.Int).Lsh(big.NewInt(1), 128))
+	}
+
+	return FixedI128{inner}
+}
+
+func newFixedI128Saturating(inner *big.Int) FixedI128 {
+	return FixedI128{new(big.Int).Set(clamp(inner, minI128, maxI128))}
+}
+
+func checkedFixedI128(inner *big.Int) sc.Option[FixedI128] {
+	if inner.Cmp(minI128) < 0 || inner.Cmp(maxI128) > 0 {
+		return sc.NewOption[FixedI128](nil)
+	}
+
+	return sc.NewOption[FixedI128](FixedI128{inner})
+}
+
+func (f FixedI128) Encode(buffer *bytes.Buffer) {
+	inner := f.ToBigInt()
+	if inner.Sign() < 0 {
+		inner.Add(inner, new(big.Int).Lsh(big.NewInt(1), 128))
+	}
+
+	be := inner.FillBytes(make([]byte, 16))
+	for i := len(be) - 1; i >= 0; i-- {
+		buffer.WriteByte(be[i])
+	}
+}
+
+func (f FixedI128) Bytes() []byte {
+	return sc.EncodedBytes(f)
+}
+
+// ToBigInt returns a copy of the inner value of the number.
+func (f FixedI128) ToBigInt() *big.Int {
+	if f.inner == nil {
+		return big.NewInt(0)
+	}
+
+	return new(big.Int).Set(f.inner)
+}
+
+// IsZero checks whether the number is zero.
+func (f FixedI128) IsZero() bool {
+	return f.ToBigInt().Sign() == 0
+}
+
+// IsOne checks whether the number is one.
+func (f FixedI128) IsOne() bool {
+	return f.ToBigInt().Cmp(fixedAccuracy) == 0
+}
+
+// IsNegative checks whether the number is less than zero.
+func (f FixedI128) IsNegative() bool {
+	return f.ToBigInt().Sign() < 0
+}
+
+// Cmp compares the number with `other` and returns -1, 0 or +1.
+func (f FixedI128) Cmp(other FixedI128) int {
+	return f.ToBigInt().Cmp(other.ToBigInt())
+}
+
+// CheckedAdd returns `f + other`, or none if it overflows.
+func (f FixedI128) CheckedAdd(other FixedI128) sc.Option[FixedI128] {
+	return checkedFixedI128(new(big.Int).Add(f.ToBigInt(), other.ToBigInt()))
+}
+
+// CheckedSub returns `f - other`, or none if it overflows.
+func (f FixedI128) CheckedSub(other FixedI128) sc.Option[FixedI128] {
+	return checkedFixedI128(new(big.Int).Sub(f.ToBigInt(), other.ToBigInt()))
+}
+
+// CheckedMul returns `f * other`, rounded to the nearest value, or none if it overflows.
+func (f FixedI128) CheckedMul(other FixedI128) sc.Option[FixedI128] {
+	return checkedFixedI128(fixedMul(f.ToBigInt(), other.ToBigInt()))
+}
+
+// CheckedDiv returns `f / other`, rounded to the nearest value, or none if `other` is zero or it overflows.
+func (f FixedI128) CheckedDiv(other FixedI128) sc.Option[FixedI128] {
+	if other.IsZero() {
+		return sc.NewOption[FixedI128](nil)
+	}
+
+	return checkedFixedI128(fixedDiv(f.ToBigInt(), other.ToBigInt()))
+}
+
+// SaturatingAdd returns `f + other`, saturating at the bounds.
+func (f FixedI128) SaturatingAdd(other FixedI128) FixedI128 {
+	return newFixedI128Saturating(new(big.Int).Add(f.ToBigInt(), other.ToBigInt()))
+}
+
+// SaturatingSub returns `f - other`, saturating at the bounds.
+func (f FixedI128) SaturatingSub(other FixedI128) FixedI128 {
+	return newFixedI128Saturating(new(big.Int).Sub(f.ToBigInt(), other.ToBigInt()))
+}
+
+// SaturatingMul returns `f * other`, rounded to the nearest value and saturating at the bounds.
+func (f FixedI128) SaturatingMul(other FixedI128) FixedI128 {
+	return newFixedI128Saturating(fixedMul(f.ToBigInt(), other.ToBigInt()))
+}
+
+// SaturatingPow returns `f` to the power of `exp`, saturating at the bounds.
+func (f FixedI128) SaturatingPow(exp uint32) FixedI128 {
+	return newFixedI128Saturating(fixedSaturatingPow(f.ToBigInt(), exp, minI128, maxI128))
+}
+
+// SaturatingMulInt returns the integer `f * n`, rounded towards zero and saturating at the bounds
+// of a 128-bit signed integer.
+func (f FixedI128) SaturatingMulInt(n *big.Int) *big.Int {
+	product := mulDivRounded(f.ToBigInt(), n, fixedAccuracy, RoundingDown)
+
+	return clamp(product, minI128, maxI128)
+}
+
+// ToFixedU128 converts the number to an unsigned fixed-point number, or none if it is negative.
+func (f FixedI128) ToFixedU128() sc.Option[FixedU128] {
+	return checkedFixedU128(f.ToBigInt())
+}
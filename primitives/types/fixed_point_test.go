@@ -0,0 +1,103 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+func fixedU128(n, d int64) FixedU128 {
+	return NewFixedU128FromRational(big.NewInt(n), big.NewInt(d), RoundingDown).Value
+}
+
+func fixedI128(n, d int64) FixedI128 {
+	return NewFixedI128FromRational(big.NewInt(n), big.NewInt(d), RoundingDown).Value
+}
+
+func Test_FixedU128_Arithmetic(t *testing.T) {
+	half := fixedU128(1, 2)
+	one := FixedU128One()
+	two := NewFixedU128FromUint64(2)
+	max := NewFixedU128FromInner(sc.NewU128FromBigInt(maxU128))
+
+	assert.True(t, one.IsOne())
+	assert.Equal(t, sc.NewU128FromUint64(1_000_000_000_000_000_000), one.U128)
+	assert.Equal(t, fixedU128(3, 2), one.CheckedAdd(half).Value)
+	assert.False(t, bool(max.CheckedAdd(one).HasValue))
+	assert.Equal(t, max, max.SaturatingAdd(one))
+	assert.Equal(t, half, one.CheckedSub(half).Value)
+	assert.False(t, bool(half.CheckedSub(one).HasValue))
+	assert.True(t, half.SaturatingSub(one).IsZero())
+	assert.Equal(t, one, two.CheckedMul(half).Value)
+	assert.False(t, bool(max.CheckedMul(two).HasValue))
+	assert.Equal(t, max, max.SaturatingMul(two))
+	assert.Equal(t, NewFixedU128FromUint64(4), two.CheckedDiv(half).Value)
+	assert.False(t, bool(two.CheckedDiv(NewFixedU128FromUint64(0)).HasValue))
+	assert.Equal(t, NewFixedU128FromUint64(8), two.SaturatingPow(3))
+	assert.Equal(t, one, two.SaturatingPow(0))
+	assert.Equal(t, max, two.SaturatingPow(200))
+	assert.Equal(t, fixedU128(1, 8), half.SaturatingPow(3))
+	assert.Equal(t, -1, half.Cmp(one))
+}
+
+func Test_FixedU128_Int(t *testing.T) {
+	oneAndHalf := fixedU128(3, 2)
+
+	assert.Equal(t, sc.NewU128FromUint64(15), oneAndHalf.SaturatingMulInt(sc.NewU128FromUint64(10)))
+	assert.Equal(t, sc.NewU128FromUint64(1), oneAndHalf.SaturatingMulInt(sc.NewU128FromUint64(1)))
+	assert.Equal(t, sc.NewU128FromUint64(15), oneAndHalf.CheckedMulInt(sc.NewU128FromUint64(10)).Value)
+	assert.False(t, bool(NewFixedU128FromUint64(2).CheckedMulInt(sc.NewU128FromBigInt(maxU128)).HasValue))
+	assert.Equal(t, sc.NewU128FromBigInt(maxU128), NewFixedU128FromUint64(2).SaturatingMulInt(sc.NewU128FromBigInt(maxU128)))
+	assert.Equal(t, sc.NewU128FromUint64(20), oneAndHalf.CheckedDivInt(sc.NewU128FromUint64(30)).Value)
+	assert.False(t, bool(NewFixedU128FromUint64(0).CheckedDivInt(sc.NewU128FromUint64(30)).HasValue))
+}
+
+func Test_FixedU128_Conversions(t *testing.T) {
+	assert.Equal(t, fixedU128(3, 4), NewFixedU128FromPerThing(NewPerbillFromPercent(75)))
+	assert.Equal(t, fixedI128(3, 4), fixedU128(3, 4).ToFixedI128())
+	assert.Equal(t, fixedU128(3, 4), fixedI128(3, 4).ToFixedU128().Value)
+	assert.False(t, bool(fixedI128(-3, 4).ToFixedU128().HasValue))
+	assert.False(t, bool(NewFixedU128FromRational(big.NewInt(1), big.NewInt(0), RoundingDown).HasValue))
+	assert.Equal(t, sc.NewU128FromUint64(333_333_333_333_333_334), NewFixedU128FromRational(big.NewInt(1), big.NewInt(3), RoundingUp).Value.U128)
+	assert.Equal(t, fixedU128(3, 4), DecodeFixedU128(bytes.NewBuffer(fixedU128(3, 4).Bytes())))
+}
+
+func Test_FixedI128_Arithmetic(t *testing.T) {
+	half := fixedI128(1, 2)
+	minusOne := NewFixedI128FromInt64(-1)
+	two := NewFixedI128FromInt64(2)
+	max := NewFixedI128FromInner(maxI128)
+	min := NewFixedI128FromInner(minI128)
+
+	assert.True(t, minusOne.IsNegative())
+	assert.Equal(t, fixedI128(-1, 2), minusOne.CheckedAdd(half).Value)
+	assert.Equal(t, fixedI128(-3, 2), minusOne.SaturatingSub(half))
+	assert.False(t, bool(min.CheckedSub(half).HasValue))
+	assert.Equal(t, min, min.SaturatingSub(half))
+	assert.Equal(t, max, max.SaturatingAdd(half))
+	assert.Equal(t, fixedI128(-1, 2), minusOne.CheckedMul(half).Value)
+	assert.Equal(t, NewFixedI128FromInt64(-2), minusOne.CheckedDiv(half).Value)
+	assert.False(t, bool(minusOne.CheckedDiv(NewFixedI128FromInt64(0)).HasValue))
+	assert.Equal(t, NewFixedI128FromInt64(-8), NewFixedI128FromInt64(-2).SaturatingPow(3))
+	assert.Equal(t, NewFixedI128FromInt64(16), NewFixedI128FromInt64(-2).SaturatingPow(4))
+	assert.Equal(t, min, NewFixedI128FromInt64(-2).SaturatingPow(201))
+	assert.Equal(t, max, two.SaturatingPow(200))
+	assert.Equal(t, big.NewInt(-15), fixedI128(-3, 2).SaturatingMulInt(big.NewInt(10)))
+	assert.Equal(t, big.NewInt(-1), fixedI128(-3, 2).SaturatingMulInt(big.NewInt(1)))
+}
+
+func Test_FixedI128_Encoding(t *testing.T) {
+	minusOne := NewFixedI128FromInt64(-1)
+
+	encoded := minusOne.Bytes()
+
+	assert.Equal(t, 16, len(encoded))
+	assert.Equal(t, byte(0x00), encoded[0])
+	assert.Equal(t, byte(0xff), encoded[15])
+	assert.Equal(t, minusOne, DecodeFixedI128(bytes.NewBuffer(encoded)))
+	assert.Equal(t, fixedI128(3, 4), DecodeFixedI128(bytes.NewBuffer(fixedI128(3, 4).Bytes())))
+	assert.Equal(t, NewFixedI128FromInner(minI128), DecodeFixedI128(bytes.NewBuffer(NewFixedI128FromInner(minI128).Bytes())))
+}
@@ -0,0 +1,30 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+// AssetId is the identifier of a non-native asset.
+type AssetId = sc.U32
+
+// FungibleAssets is implemented by a module, which keeps the balances of non-native assets.
+type FungibleAssets interface {
+	// MinimumBalance returns the minimum balance of an account in the asset, or none if the asset does not exist.
+	MinimumBalance(id AssetId) sc.Option[Balance]
+
+	// IsSufficient returns whether an account in the asset can exist without any native balance.
+	IsSufficient(id AssetId) bool
+
+	// TotalIssuance returns the total supply of the asset.
+	TotalIssuance(id AssetId) Balance
+
+	// Balance returns the balance of `who` in the asset.
+	Balance(id AssetId, who Address32) Balance
+
+	// Withdraw removes `amount` of the asset from the balance of `who`. Fails if the account would be reaped.
+	Withdraw(id AssetId, who Address32, amount Balance) DispatchError
+
+	// Deposit adds `amount` of the asset to the balance of `who`.
+	Deposit(id AssetId, who Address32, amount Balance) DispatchError
+
+	// Transfer moves `amount` of the asset from `source` to `dest`. With `keepAlive`, it fails if the account of `source` would be reaped.
+	Transfer(id AssetId, source Address32, dest Address32, amount Balance, keepAlive bool) DispatchError
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	engineBabe    = sc.BytesToFixedSequenceU8([]byte("BABE"))
+	engineGrandpa = sc.BytesToFixedSequenceU8([]byte("FRNK"))
+)
+
+func Test_Header_Encode_Decode(t *testing.T) {
+	header := Header{
+		ParentHash:     NewBlake2bHash(sc.BytesToSequenceU8(bytes.Repeat([]byte{1}, 32))...),
+		Number:         5,
+		StateRoot:      NewH256(sc.BytesToSequenceU8(bytes.Repeat([]byte{2}, 32))...),
+		ExtrinsicsRoot: NewH256(sc.BytesToSequenceU8(bytes.Repeat([]byte{3}, 32))...),
+		Digest: Digest{
+			Logs: sc.Sequence[DigestItem]{
+				NewDigestItemPreRuntime(engineBabe, sc.Sequence[sc.U8]{1, 2, 3}),
+				NewDigestItemConsensusMessage(engineGrandpa, sc.Sequence[sc.U8]{1, 1}),
+				NewDigestItemConsensusMessage(engineBabe, sc.Sequence[sc.U8]{2}),
+				NewDigestItemRuntimeEnvironmentUpgraded(),
+			},
+		},
+	}
+
+	expectation := append(bytes.Repeat([]byte{1}, 32), 0x14)
+	expectation = append(expectation, bytes.Repeat([]byte{2}, 32)...)
+	expectation = append(expectation, bytes.Repeat([]byte{3}, 32)...)
+	expectation = append(expectation,
+		0x10,
+		DigestTypePreRuntime, 'B', 'A', 'B', 'E', 0x0c, 1, 2, 3,
+		DigestTypeConsensusMessage, 'F', 'R', 'N', 'K', 0x08, 1, 1,
+		DigestTypeConsensusMessage, 'B', 'A', 'B', 'E', 0x04, 2,
+		DigestTypeRuntimeEnvironmentUpgraded,
+	)
+
+	assert.Equal(t, expectation, header.Bytes())
+
+	buffer := bytes.NewBuffer(expectation)
+	assert.Equal(t, header, DecodeHeader(buffer))
+	assert.Equal(t, 0, buffer.Len())
+}
+
+func Test_Digest_OfType(t *testing.T) {
+	preRuntime := NewDigestItemPreRuntime(engineBabe, sc.Sequence[sc.U8]{1})
+	first := NewDigestItemConsensusMessage(engineBabe, sc.Sequence[sc.U8]{2})
+	second := NewDigestItemConsensusMessage(engineBabe, sc.Sequence[sc.U8]{3})
+
+	digest := Digest{}
+	digest.Push(first)
+	digest.Push(preRuntime)
+	digest.Push(second)
+
+	assert.Equal(t, []DigestItem{first, second}, digest.OfType(DigestTypeConsensusMessage))
+	assert.Equal(t, []DigestItem{preRuntime}, digest.OfType(DigestTypePreRuntime))
+	assert.Empty(t, digest.OfType(DigestTypeSeal))
+}
@@ -0,0 +1,46 @@
+package types
+
+// Hooks are called by the executive at specific points of the block execution.
+type Hooks interface {
+	// OnInitialize is called when a block is initialized and returns the weight it consumed.
+	OnInitialize(n BlockNumber) Weight
+	// OnFinalize is called when a block is finalized.
+	OnFinalize(n BlockNumber)
+	// OnIdle is called when a block is finalized, but before OnFinalize, with the weight which
+	// is left in the block. It returns the weight it consumed, which must not exceed the remaining weight.
+	OnIdle(n BlockNumber, remainingWeight Weight) Weight
+	// OnRuntimeUpgrade is called when the runtime is upgraded, before any other hook, and returns the weight it consumed.
+	OnRuntimeUpgrade() Weight
+	// OffchainWorker is called after a block is imported.
+	OffchainWorker(n BlockNumber)
+	// IntegrityTest checks the consistency of the module configuration. It is not called during block execution.
+	IntegrityTest()
+	// TryState checks the invariants of the module storage and returns an error if any of them is violated.
+	// It is not called during block execution and is meant to be run by the try-runtime checks.
+	TryState(n BlockNumber) DispatchError
+}
+
+// DefaultHooks implements all hooks with no effect. Modules embed it and override only the hooks they need.
+type DefaultHooks struct{}
+
+func (dh DefaultHooks) OnInitialize(_ BlockNumber) Weight {
+	return WeightZero()
+}
+
+func (dh DefaultHooks) OnFinalize(_ BlockNumber) {}
+
+func (dh DefaultHooks) OnIdle(_ BlockNumber, _ Weight) Weight {
+	return WeightZero()
+}
+
+func (dh DefaultHooks) OnRuntimeUpgrade() Weight {
+	return WeightZero()
+}
+
+func (dh DefaultHooks) OffchainWorker(_ BlockNumber) {}
+
+func (dh DefaultHooks) IntegrityTest() {}
+
+func (dh DefaultHooks) TryState(_ BlockNumber) DispatchError {
+	return nil
+}
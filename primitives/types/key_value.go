@@ -0,0 +1,29 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// KeyValue is a raw storage key and its value.
+type KeyValue struct {
+	Key   sc.Sequence[sc.U8]
+	Value sc.Sequence[sc.U8]
+}
+
+func (kv KeyValue) Encode(buffer *bytes.Buffer) {
+	kv.Key.Encode(buffer)
+	kv.Value.Encode(buffer)
+}
+
+func DecodeKeyValue(buffer *bytes.Buffer) KeyValue {
+	return KeyValue{
+		Key:   sc.DecodeSequence[sc.U8](buffer),
+		Value: sc.DecodeSequence[sc.U8](buffer),
+	}
+}
+
+func (kv KeyValue) Bytes() []byte {
+	return sc.EncodedBytes(kv)
+}
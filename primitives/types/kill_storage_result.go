@@ -0,0 +1,68 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/log"
+)
+
+const (
+	// KillStorageResultAllRemoved is returned when no keys remain under the prefix.
+	KillStorageResultAllRemoved sc.U8 = iota
+	// KillStorageResultSomeRemaining is returned when the limit was reached and keys remain under the prefix.
+	KillStorageResultSomeRemaining
+)
+
+// KillStorageResult is the result of ext_storage_clear_prefix_version_2, which holds
+// whether keys remain under the prefix and the number of keys removed from the backend.
+type KillStorageResult struct {
+	AllRemoved sc.Bool
+	Removed    sc.U32
+}
+
+func (ksr KillStorageResult) Encode(buffer *bytes.Buffer) {
+	if ksr.AllRemoved {
+		KillStorageResultAllRemoved.Encode(buffer)
+	} else {
+		KillStorageResultSomeRemaining.Encode(buffer)
+	}
+	ksr.Removed.Encode(buffer)
+}
+
+func DecodeKillStorageResult(buffer *bytes.Buffer) KillStorageResult {
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case KillStorageResultAllRemoved, KillStorageResultSomeRemaining:
+		return KillStorageResult{
+			AllRemoved: b == KillStorageResultAllRemoved,
+			Removed:    sc.DecodeU32(buffer),
+		}
+	default:
+		log.Critical("invalid KillStorageResult type")
+	}
+
+	panic("unreachable")
+}
+
+func (ksr KillStorageResult) Bytes() []byte {
+	return sc.EncodedBytes(ksr)
+}
+
+// MultiRemovalResults converts the result of clearing `prefix` to MultiRemovalResults. The host only
+// reports the number of keys removed from the backend, which is used for all counts. If keys remain,
+// the cursor is the prefix itself, since the removed keys are gone and the next call starts from it.
+func (ksr KillStorageResult) MultiRemovalResults(prefix []byte) MultiRemovalResults {
+	maybeCursor := sc.NewOption[sc.Sequence[sc.U8]](nil)
+	if !ksr.AllRemoved {
+		maybeCursor = sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(prefix))
+	}
+
+	return MultiRemovalResults{
+		MaybeCursor: maybeCursor,
+		Backend:     ksr.Removed,
+		Unique:      ksr.Removed,
+		Loops:       ksr.Removed,
+	}
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_KillStorageResult_MultiRemovalResults(t *testing.T) {
+	prefix := []byte("prefix")
+
+	var testExamples = []struct {
+		label       string
+		input       []byte
+		expectation MultiRemovalResults
+	}{
+		{
+			label: "AllRemoved",
+			input: []byte{0, 3, 0, 0, 0},
+			expectation: MultiRemovalResults{
+				MaybeCursor: sc.NewOption[sc.Sequence[sc.U8]](nil),
+				Backend:     3,
+				Unique:      3,
+				Loops:       3,
+			},
+		},
+		{
+			label: "SomeRemaining",
+			input: []byte{1, 2, 0, 0, 0},
+			expectation: MultiRemovalResults{
+				MaybeCursor: sc.NewOption[sc.Sequence[sc.U8]](sc.BytesToSequenceU8(prefix)),
+				Backend:     2,
+				Unique:      2,
+				Loops:       2,
+			},
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			buffer := bytes.NewBuffer(testExample.input)
+
+			result := DecodeKillStorageResult(buffer)
+
+			assert.Equal(t, testExample.input, result.Bytes())
+			assert.Equal(t, testExample.expectation, result.MultiRemovalResults(prefix))
+			assert.Equal(t, 0, buffer.Len())
+		})
+	}
+}
@@ -32,7 +32,7 @@ func LookupAddress(a MultiAddress) sc.Option[Address32] { // TODO: MultiAddress[
 	}
 
 	if a.IsAccountIndex() {
-		return sc.NewOption[Address32](LookupIndex(a.AsAccountIndex()))
+		return LookupIndex(a.AsAccountIndex())
 	}
 
 	return sc.NewOption[Address32](nil)
@@ -40,6 +40,23 @@ func LookupAddress(a MultiAddress) sc.Option[Address32] { // TODO: MultiAddress[
 
 // LookupIndex Lookup an T::AccountIndex to get an Id, if there's one there.
 func LookupIndex(index AccountIndex) sc.Option[Address32] {
-	// TODO:
-	return sc.NewOption[Address32](nil)
+	if accountIndexLookup == nil {
+		return sc.NewOption[Address32](nil)
+	}
+
+	return accountIndexLookup.LookupIndex(index)
+}
+
+// AccountIndexLookup resolves an account index to the account it is assigned to.
+type AccountIndexLookup interface {
+	LookupIndex(index AccountIndex) sc.Option[Address32]
+}
+
+// accountIndexLookup is the lookup registered by the runtime configuration.
+// It is kept here, so that address lookups do not depend on the module, which stores the indices.
+var accountIndexLookup AccountIndexLookup
+
+// RegisterAccountIndexLookup sets the lookup, which is used to resolve account indices.
+func RegisterAccountIndexLookup(lookup AccountIndexLookup) {
+	accountIndexLookup = lookup
 }
@@ -0,0 +1,340 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+const (
+	MetadataVersion15 sc.U8 = 15
+)
+
+type MetadataV15 struct {
+	Data RuntimeMetadataV15
+}
+
+func NewMetadataV15(data RuntimeMetadataV15) MetadataV15 {
+	return MetadataV15{Data: data}
+}
+
+func (m MetadataV15) Encode(buffer *bytes.Buffer) {
+	MetadataReserved.Encode(buffer)
+	MetadataVersion15.Encode(buffer)
+	m.Data.Encode(buffer)
+}
+
+func DecodeMetadataV15(buffer *bytes.Buffer) (MetadataV15, error) {
+	metaReserved := sc.DecodeU32(buffer)
+	if metaReserved != MetadataReserved {
+		return MetadataV15{}, errors.New(fmt.Sprintf("metadata reserved mismatch: expect [%d], actual [%d]", MetadataReserved, metaReserved))
+	}
+
+	version := sc.DecodeU8(buffer)
+	if version != MetadataVersion15 {
+		return MetadataV15{}, errors.New(fmt.Sprintf("metadata version mismatch: expect [%d], actual [%d]", MetadataVersion15, version))
+	}
+
+	return MetadataV15{
+		Data: DecodeRuntimeMetadataV15(buffer),
+	}, nil
+}
+
+func (m MetadataV15) Bytes() []byte {
+	return sc.EncodedBytes(m)
+}
+
+type RuntimeMetadataV15 struct {
+	Types      sc.Sequence[MetadataType]
+	Modules    sc.Sequence[MetadataModuleV15]
+	Extrinsic  MetadataExtrinsicV15
+	Type       sc.Compact
+	Apis       sc.Sequence[RuntimeApiMetadata]
+	OuterEnums OuterEnums
+	Custom     CustomMetadata
+}
+
+func (rm RuntimeMetadataV15) Encode(buffer *bytes.Buffer) {
+	rm.Types.Encode(buffer)
+	rm.Modules.Encode(buffer)
+	rm.Extrinsic.Encode(buffer)
+	rm.Type.Encode(buffer)
+	rm.Apis.Encode(buffer)
+	rm.OuterEnums.Encode(buffer)
+	rm.Custom.Encode(buffer)
+}
+
+func DecodeRuntimeMetadataV15(buffer *bytes.Buffer) RuntimeMetadataV15 {
+	return RuntimeMetadataV15{
+		Types:      sc.DecodeSequenceWith(buffer, DecodeMetadataType),
+		Modules:    sc.DecodeSequenceWith(buffer, DecodeMetadataModuleV15),
+		Extrinsic:  DecodeMetadataExtrinsicV15(buffer),
+		Type:       sc.DecodeCompact(buffer),
+		Apis:       sc.DecodeSequenceWith(buffer, DecodeRuntimeApiMetadata),
+		OuterEnums: DecodeOuterEnums(buffer),
+		Custom:     DecodeCustomMetadata(buffer),
+	}
+}
+
+func (rm RuntimeMetadataV15) Bytes() []byte {
+	return sc.EncodedBytes(rm)
+}
+
+// MetadataModuleV15 extends the module metadata with the documentation of the module.
+type MetadataModuleV15 struct {
+	Module MetadataModule
+	Docs   sc.Sequence[sc.Str]
+}
+
+func (mm MetadataModuleV15) Encode(buffer *bytes.Buffer) {
+	mm.Module.Encode(buffer)
+	mm.Docs.Encode(buffer)
+}
+
+func DecodeMetadataModuleV15(buffer *bytes.Buffer) MetadataModuleV15 {
+	return MetadataModuleV15{
+		Module: DecodeMetadataModule(buffer),
+		Docs:   sc.DecodeSequence[sc.Str](buffer),
+	}
+}
+
+func (mm MetadataModuleV15) Bytes() []byte {
+	return sc.EncodedBytes(mm)
+}
+
+// MetadataExtrinsicV15 describes the extrinsic format by the types of its address, call, signature and extra,
+// instead of the single extrinsic type in V14.
+type MetadataExtrinsicV15 struct {
+	Version          sc.U8
+	Address          sc.Compact
+	Call             sc.Compact
+	Signature        sc.Compact
+	Extra            sc.Compact
+	SignedExtensions sc.Sequence[MetadataSignedExtension]
+}
+
+func (me MetadataExtrinsicV15) Encode(buffer *bytes.Buffer) {
+	me.Version.Encode(buffer)
+	me.Address.Encode(buffer)
+	me.Call.Encode(buffer)
+	me.Signature.Encode(buffer)
+	me.Extra.Encode(buffer)
+	me.SignedExtensions.Encode(buffer)
+}
+
+func DecodeMetadataExtrinsicV15(buffer *bytes.Buffer) MetadataExtrinsicV15 {
+	return MetadataExtrinsicV15{
+		Version:          sc.DecodeU8(buffer),
+		Address:          sc.DecodeCompact(buffer),
+		Call:             sc.DecodeCompact(buffer),
+		Signature:        sc.DecodeCompact(buffer),
+		Extra:            sc.DecodeCompact(buffer),
+		SignedExtensions: sc.DecodeSequenceWith(buffer, DecodeMetadataSignedExtension),
+	}
+}
+
+func (me MetadataExtrinsicV15) Bytes() []byte {
+	return sc.EncodedBytes(me)
+}
+
+type RuntimeApiMetadata struct {
+	Name    sc.Str
+	Methods sc.Sequence[RuntimeApiMethodMetadata]
+	Docs    sc.Sequence[sc.Str]
+}
+
+func NewRuntimeApiMetadata(name string, methods sc.Sequence[RuntimeApiMethodMetadata], docs string) RuntimeApiMetadata {
+	return RuntimeApiMetadata{
+		Name:    sc.Str(name),
+		Methods: methods,
+		Docs:    sc.Sequence[sc.Str]{sc.Str(docs)},
+	}
+}
+
+func (ram RuntimeApiMetadata) Encode(buffer *bytes.Buffer) {
+	ram.Name.Encode(buffer)
+	ram.Methods.Encode(buffer)
+	ram.Docs.Encode(buffer)
+}
+
+func DecodeRuntimeApiMetadata(buffer *bytes.Buffer) RuntimeApiMetadata {
+	return RuntimeApiMetadata{
+		Name:    sc.DecodeStr(buffer),
+		Methods: sc.DecodeSequenceWith(buffer, DecodeRuntimeApiMethodMetadata),
+		Docs:    sc.DecodeSequence[sc.Str](buffer),
+	}
+}
+
+func (ram RuntimeApiMetadata) Bytes() []byte {
+	return sc.EncodedBytes(ram)
+}
+
+type RuntimeApiMethodMetadata struct {
+	Name   sc.Str
+	Inputs sc.Sequence[RuntimeApiMethodParamMetadata]
+	Output sc.Compact
+	Docs   sc.Sequence[sc.Str]
+}
+
+func NewRuntimeApiMethodMetadata(name string, inputs sc.Sequence[RuntimeApiMethodParamMetadata], output int, docs string) RuntimeApiMethodMetadata {
+	return RuntimeApiMethodMetadata{
+		Name:   sc.Str(name),
+		Inputs: inputs,
+		Output: sc.ToCompact(output),
+		Docs:   sc.Sequence[sc.Str]{sc.Str(docs)},
+	}
+}
+
+func (ramm RuntimeApiMethodMetadata) Encode(buffer *bytes.Buffer) {
+	ramm.Name.Encode(buffer)
+	ramm.Inputs.Encode(buffer)
+	ramm.Output.Encode(buffer)
+	ramm.Docs.Encode(buffer)
+}
+
+func DecodeRuntimeApiMethodMetadata(buffer *bytes.Buffer) RuntimeApiMethodMetadata {
+	return RuntimeApiMethodMetadata{
+		Name:   sc.DecodeStr(buffer),
+		Inputs: sc.DecodeSequenceWith(buffer, DecodeRuntimeApiMethodParamMetadata),
+		Output: sc.DecodeCompact(buffer),
+		Docs:   sc.DecodeSequence[sc.Str](buffer),
+	}
+}
+
+func (ramm RuntimeApiMethodMetadata) Bytes() []byte {
+	return sc.EncodedBytes(ramm)
+}
+
+type RuntimeApiMethodParamMetadata struct {
+	Name sc.Str
+	Type sc.Compact
+}
+
+func NewRuntimeApiMethodParamMetadata(name string, id int) RuntimeApiMethodParamMetadata {
+	return RuntimeApiMethodParamMetadata{
+		Name: sc.Str(name),
+		Type: sc.ToCompact(id),
+	}
+}
+
+func (rampm RuntimeApiMethodParamMetadata) Encode(buffer *bytes.Buffer) {
+	rampm.Name.Encode(buffer)
+	rampm.Type.Encode(buffer)
+}
+
+func DecodeRuntimeApiMethodParamMetadata(buffer *bytes.Buffer) RuntimeApiMethodParamMetadata {
+	return RuntimeApiMethodParamMetadata{
+		Name: sc.DecodeStr(buffer),
+		Type: sc.DecodeCompact(buffer),
+	}
+}
+
+func (rampm RuntimeApiMethodParamMetadata) Bytes() []byte {
+	return sc.EncodedBytes(rampm)
+}
+
+// OuterEnums contains the type ids of the enums, which aggregate the calls, events and errors of all modules.
+type OuterEnums struct {
+	CallEnumType  sc.Compact
+	EventEnumType sc.Compact
+	ErrorEnumType sc.Compact
+}
+
+func NewOuterEnums(callEnumType, eventEnumType, errorEnumType int) OuterEnums {
+	return OuterEnums{
+		CallEnumType:  sc.ToCompact(callEnumType),
+		EventEnumType: sc.ToCompact(eventEnumType),
+		ErrorEnumType: sc.ToCompact(errorEnumType),
+	}
+}
+
+func (oe OuterEnums) Encode(buffer *bytes.Buffer) {
+	oe.CallEnumType.Encode(buffer)
+	oe.EventEnumType.Encode(buffer)
+	oe.ErrorEnumType.Encode(buffer)
+}
+
+func DecodeOuterEnums(buffer *bytes.Buffer) OuterEnums {
+	return OuterEnums{
+		CallEnumType:  sc.DecodeCompact(buffer),
+		EventEnumType: sc.DecodeCompact(buffer),
+		ErrorEnumType: sc.DecodeCompact(buffer),
+	}
+}
+
+func (oe OuterEnums) Bytes() []byte {
+	return sc.EncodedBytes(oe)
+}
+
+// CustomMetadata holds custom values, sorted by their name, which are not part of any module.
+type CustomMetadata struct {
+	Map sc.Sequence[CustomMetadataEntry]
+}
+
+func (cm CustomMetadata) Encode(buffer *bytes.Buffer) {
+	cm.Map.Encode(buffer)
+}
+
+func DecodeCustomMetadata(buffer *bytes.Buffer) CustomMetadata {
+	return CustomMetadata{
+		Map: sc.DecodeSequenceWith(buffer, DecodeCustomMetadataEntry),
+	}
+}
+
+func (cm CustomMetadata) Bytes() []byte {
+	return sc.EncodedBytes(cm)
+}
+
+type CustomMetadataEntry struct {
+	Name  sc.Str
+	Value CustomValueMetadata
+}
+
+func NewCustomMetadataEntry(name string, id int, value sc.Encodable) CustomMetadataEntry {
+	return CustomMetadataEntry{
+		Name: sc.Str(name),
+		Value: CustomValueMetadata{
+			Type:  sc.ToCompact(id),
+			Value: sc.BytesToSequenceU8(value.Bytes()),
+		},
+	}
+}
+
+func (cme CustomMetadataEntry) Encode(buffer *bytes.Buffer) {
+	cme.Name.Encode(buffer)
+	cme.Value.Encode(buffer)
+}
+
+func DecodeCustomMetadataEntry(buffer *bytes.Buffer) CustomMetadataEntry {
+	return CustomMetadataEntry{
+		Name:  sc.DecodeStr(buffer),
+		Value: DecodeCustomValueMetadata(buffer),
+	}
+}
+
+func (cme CustomMetadataEntry) Bytes() []byte {
+	return sc.EncodedBytes(cme)
+}
+
+type CustomValueMetadata struct {
+	Type  sc.Compact
+	Value sc.Sequence[sc.U8]
+}
+
+func (cvm CustomValueMetadata) Encode(buffer *bytes.Buffer) {
+	cvm.Type.Encode(buffer)
+	cvm.Value.Encode(buffer)
+}
+
+func DecodeCustomValueMetadata(buffer *bytes.Buffer) CustomValueMetadata {
+	return CustomValueMetadata{
+		Type:  sc.DecodeCompact(buffer),
+		Value: sc.DecodeSequence[sc.U8](buffer),
+	}
+}
+
+func (cvm CustomValueMetadata) Bytes() []byte {
+	return sc.EncodedBytes(cvm)
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sc "github.com/LimeChain/goscale"
+)
+
+// SteppedMigration migrates the storage of a module from one storage version to the next one.
+// The migration is executed in steps, which can be spread over multiple blocks.
+type SteppedMigration interface {
+	// Id uniquely identifies the migration.
+	Id() sc.Sequence[sc.U8]
+	// ModulePrefix is the storage prefix of the migrated module, under which its storage version is stored.
+	ModulePrefix() []byte
+	// FromVersion is the storage version, the migration is applied to.
+	FromVersion() sc.U16
+	// ToVersion is the storage version of the module after the migration.
+	ToVersion() sc.U16
+	// Step executes the step of the migration after the cursor, or its first step if there is no cursor.
+	// The weight of the step is consumed from the meter, and a step, which does not fit in the remaining
+	// weight, returns an insufficient weight error without changing the storage.
+	// The cursor of the next step is returned, or none if the migration is completed.
+	Step(cursor sc.Option[sc.Sequence[sc.U8]], meter *WeightMeter) (sc.Option[sc.Sequence[sc.U8]], MigrationError)
+	// PreUpgrade is executed before the migration by the upgrade checks and returns the state,
+	// which is passed to PostUpgrade. It is not called during block execution.
+	PreUpgrade() (sc.Sequence[sc.U8], MigrationError)
+	// PostUpgrade checks the storage after the migration against the state returned by PreUpgrade.
+	// It is not called during block execution.
+	PostUpgrade(state sc.Sequence[sc.U8]) MigrationError
+}
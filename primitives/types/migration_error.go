@@ -0,0 +1,30 @@
+package types
+
+import (
+	sc "github.com/LimeChain/goscale"
+)
+
+const (
+	MigrationErrorInsufficientWeight sc.U8 = iota
+	MigrationErrorInvalidCursor
+	MigrationErrorFailed
+)
+
+// MigrationError is returned by a step of a migration, which could not be executed.
+type MigrationError = sc.VaryingData
+
+// NewMigrationErrorInsufficientWeight is returned when the remaining weight is not enough
+// for the next step, which requires the given weight.
+func NewMigrationErrorInsufficientWeight(required Weight) MigrationError {
+	return sc.NewVaryingData(MigrationErrorInsufficientWeight, required)
+}
+
+// NewMigrationErrorInvalidCursor is returned when the cursor of the migration could not be decoded.
+func NewMigrationErrorInvalidCursor() MigrationError {
+	return sc.NewVaryingData(MigrationErrorInvalidCursor)
+}
+
+// NewMigrationErrorFailed is returned when the migration failed and cannot be continued.
+func NewMigrationErrorFailed() MigrationError {
+	return sc.NewVaryingData(MigrationErrorFailed)
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// MultiRemovalResults is the result of a limited removal of storage keys under a prefix.
+type MultiRemovalResults struct {
+	// MaybeCursor is `Some` if the removal was limited and keys under the prefix remain.
+	// It must be passed to the next removal call to continue where this one stopped.
+	MaybeCursor sc.Option[sc.Sequence[sc.U8]]
+	// Backend is the number of items removed from the backend database.
+	Backend sc.U32
+	// Unique is the number of unique keys removed, taking into account both the backend and the overlay.
+	Unique sc.U32
+	// Loops is the number of iterations (each requiring a storage seek/read) done.
+	Loops sc.U32
+}
+
+func (mrr MultiRemovalResults) Encode(buffer *bytes.Buffer) {
+	mrr.MaybeCursor.Encode(buffer)
+	mrr.Backend.Encode(buffer)
+	mrr.Unique.Encode(buffer)
+	mrr.Loops.Encode(buffer)
+}
+
+func DecodeMultiRemovalResults(buffer *bytes.Buffer) MultiRemovalResults {
+	return MultiRemovalResults{
+		MaybeCursor: sc.DecodeOptionWith(buffer, sc.DecodeSequence[sc.U8]),
+		Backend:     sc.DecodeU32(buffer),
+		Unique:      sc.DecodeU32(buffer),
+		Loops:       sc.DecodeU32(buffer),
+	}
+}
+
+func (mrr MultiRemovalResults) Bytes() []byte {
+	return sc.EncodedBytes(mrr)
+}
@@ -78,7 +78,7 @@ func (s MultiSignature) IsEcdsa() sc.Bool {
 
 func (s MultiSignature) AsEcdsa() Ecdsa {
 	if s.IsEcdsa() {
-		return s.VaryingData[0].(Ecdsa)
+		return s.VaryingData[1].(Ecdsa)
 	} else {
 		log.Critical("not a Ecdsa signature type")
 	}
@@ -109,15 +109,7 @@ func (s MultiSignature) Verify(msg sc.Sequence[sc.U8], signer Address32) sc.Bool
 	} else if s.IsSr25519() {
 		return s.AsSr25519().Verify(msg, signer)
 	} else if s.IsEcdsa() {
-		// TODO:
-		return true
-		// let m = sp_io::hashing::blake2_256(msg.get());
-		// match sp_io::crypto::secp256k1_ecdsa_recover_compressed(sig.as_ref(), &m) {
-		// 	Ok(pubkey) =>
-		// 		&sp_io::hashing::blake2_256(pubkey.as_ref()) ==
-		// 			<dyn AsRef<[u8; 32]>>::as_ref(who),
-		// 	_ => false,
-		// }
+		return s.AsEcdsa().Verify(msg, signer)
 	} else {
 		log.Critical("invalid MultiSignature type in Verify")
 	}
@@ -0,0 +1,9 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+// OnTimestampSet is notified by the timestamp module when the timestamp of the block is set.
+type OnTimestampSet interface {
+	// OnTimestampSet is called with the timestamp `now` of the block, in milliseconds. It must be `O(1)`.
+	OnTimestampSet(now sc.U64)
+}
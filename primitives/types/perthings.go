@@ -2,37 +2,126 @@ package types
 
 import (
 	"bytes"
+	"math/big"
 
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/primitives/log"
 )
 
-type Perbill struct {
-	Percentage sc.U32
+// PerThing is a fraction between zero and one with a fixed accuracy, stored as the number
+// of parts of the whole. It is implemented by Percent, Permill, Perbill and Perquintill.
+type PerThing interface {
+	sc.Encodable
+	// Deconstruct returns the parts of the fraction.
+	Deconstruct() *big.Int
+	// Accuracy returns the number of parts, which make the whole.
+	Accuracy() *big.Int
 }
 
-func (p Perbill) Encode(buffer *bytes.Buffer) {
-	p.Percentage.Encode(buffer)
+var (
+	accuracyPercent     = big.NewInt(100)
+	accuracyPermill     = big.NewInt(1_000_000)
+	accuracyPerbill     = big.NewInt(1_000_000_000)
+	accuracyPerquintill = big.NewInt(1_000_000_000_000_000_000)
+)
+
+// perThingFromRational returns the parts of `n / d` with the given accuracy, or false
+// if `d` is zero or the fraction is not between zero and one.
+func perThingFromRational(n, d, accuracy *big.Int, rounding Rounding) (*big.Int, bool) {
+	if d.Sign() == 0 || n.Sign() < 0 || d.Sign() < 0 || n.Cmp(d) > 0 {
+		return nil, false
+	}
+
+	return mulDivRounded(n, accuracy, d, rounding), true
 }
 
-func DecodePerbill(buffer *bytes.Buffer) Perbill {
-	p := Perbill{}
-	p.Percentage = sc.DecodeU32(buffer)
-	return p
+// perThingFromPercent returns the parts of `percent` per cent with the given accuracy, saturating at one.
+func perThingFromPercent(percent uint64, accuracy *big.Int) *big.Int {
+	parts := new(big.Int).Mul(new(big.Int).SetUint64(percent), accuracy)
+	parts.Quo(parts, accuracyPercent)
+
+	return clamp(parts, big.NewInt(0), accuracy)
 }
 
-func (p Perbill) Bytes() []byte {
-	return sc.EncodedBytes(p)
+// perThingFromPerThing returns the parts of `p` with the given accuracy, rounded to the nearest value.
+func perThingFromPerThing(p PerThing, accuracy *big.Int) *big.Int {
+	return mulDivRounded(p.Deconstruct(), accuracy, p.Accuracy(), RoundingNearestPrefDown)
 }
 
-func (p Perbill) Mul(v sc.Encodable) sc.Encodable {
+// perThingSaturatingAdd returns the parts of `p + q`, saturating at one.
+func perThingSaturatingAdd(p, q PerThing) *big.Int {
+	sum := new(big.Int).Add(p.Deconstruct(), q.Deconstruct())
+
+	return clamp(sum, big.NewInt(0), p.Accuracy())
+}
+
+// perThingSaturatingSub returns the parts of `p - q`, saturating at zero.
+func perThingSaturatingSub(p, q PerThing) *big.Int {
+	difference := new(big.Int).Sub(p.Deconstruct(), q.Deconstruct())
+
+	return clamp(difference, big.NewInt(0), p.Accuracy())
+}
+
+// perThingSaturatingMul returns the parts of `p * q`, rounded down.
+func perThingSaturatingMul(p, q PerThing) *big.Int {
+	return mulDivRounded(p.Deconstruct(), q.Deconstruct(), p.Accuracy(), RoundingDown)
+}
+
+// perThingSaturatingDiv returns the parts of `p / q`, saturating at one.
+func perThingSaturatingDiv(p, q PerThing, rounding Rounding) *big.Int {
+	if q.Deconstruct().Sign() == 0 {
+		return new(big.Int).Set(p.Accuracy())
+	}
+
+	quotient := mulDivRounded(p.Deconstruct(), p.Accuracy(), q.Deconstruct(), rounding)
+
+	return clamp(quotient, big.NewInt(0), p.Accuracy())
+}
+
+// perThingSaturatingPow returns the parts of `p` to the power of `exp`, rounded down after each multiplication.
+func perThingSaturatingPow(p PerThing, exp uint32) *big.Int {
+	accuracy := p.Accuracy()
+	result := new(big.Int).Set(accuracy)
+	base := p.Deconstruct()
+
+	for exp > 0 {
+		if exp&1 == 1 {
+			result = mulDivRounded(result, base, accuracy, RoundingDown)
+		}
+		base = mulDivRounded(base, base, accuracy, RoundingDown)
+		exp >>= 1
+	}
+
+	return result
+}
+
+// perThingComplement returns the parts of `1 - p`.
+func perThingComplement(p PerThing) *big.Int {
+	return new(big.Int).Sub(p.Accuracy(), p.Deconstruct())
+}
+
+// perThingMul returns `p * v`, rounded with the given mode. `v` can be an unsigned integer,
+// a U128 or a Weight, whose components are multiplied separately.
+func perThingMul(p PerThing, v sc.Encodable, rounding Rounding) sc.Encodable {
+	mul := func(n *big.Int) *big.Int {
+		return mulDivRounded(n, p.Deconstruct(), p.Accuracy(), rounding)
+	}
+
 	switch v := v.(type) {
+	case sc.U8:
+		return sc.U8(mul(new(big.Int).SetUint64(uint64(v))).Uint64())
+	case sc.U16:
+		return sc.U16(mul(new(big.Int).SetUint64(uint64(v))).Uint64())
 	case sc.U32:
-		return ((v / 100) * p.Percentage)
+		return sc.U32(mul(new(big.Int).SetUint64(uint64(v))).Uint64())
+	case sc.U64:
+		return sc.U64(mul(new(big.Int).SetUint64(uint64(v))).Uint64())
+	case sc.U128:
+		return sc.NewU128FromBigInt(mul(v.ToBigInt()))
 	case Weight:
 		return Weight{
-			RefTime:   (v.RefTime / 100) * sc.U64(p.Percentage),
-			ProofSize: (v.ProofSize / 100) * sc.U64(p.Percentage),
+			RefTime:   sc.U64(mul(new(big.Int).SetUint64(uint64(v.RefTime))).Uint64()),
+			ProofSize: sc.U64(mul(new(big.Int).SetUint64(uint64(v.ProofSize))).Uint64()),
 		}
 	default:
 		log.Critical("unsupported type")
@@ -40,3 +129,411 @@ func (p Perbill) Mul(v sc.Encodable) sc.Encodable {
 
 	panic("unreachable")
 }
+
+// Percent is a fraction between zero and one with an accuracy of `100` parts.
+type Percent struct {
+	Parts sc.U8
+}
+
+// NewPercentFromParts returns the fraction with the given parts, saturating at one.
+func NewPercentFromParts(parts sc.U8) Percent {
+	return Percent{Parts: sc.U8(clamp(new(big.Int).SetUint64(uint64(parts)), big.NewInt(0), accuracyPercent).Uint64())}
+}
+
+// NewPercentFromPercent returns the fraction of `percent` per cent, saturating at one.
+func NewPercentFromPercent(percent sc.U8) Percent {
+	return Percent{Parts: sc.U8(perThingFromPercent(uint64(percent), accuracyPercent).Uint64())}
+}
+
+// NewPercentFromRational returns the fraction `n / d` rounded with the given mode,
+// or none if `d` is zero or the fraction is not between zero and one.
+func NewPercentFromRational(n, d *big.Int, rounding Rounding) sc.Option[Percent] {
+	parts, ok := perThingFromRational(n, d, accuracyPercent, rounding)
+	if !ok {
+		return sc.NewOption[Percent](nil)
+	}
+
+	return sc.NewOption[Percent](Percent{Parts: sc.U8(parts.Uint64())})
+}
+
+// NewPercentFromPerThing converts the fraction `p` to the accuracy of Percent, rounded to the nearest value.
+func NewPercentFromPerThing(p PerThing) Percent {
+	return Percent{Parts: sc.U8(perThingFromPerThing(p, accuracyPercent).Uint64())}
+}
+
+// PercentOne returns the whole.
+func PercentOne() Percent {
+	return Percent{Parts: sc.U8(accuracyPercent.Uint64())}
+}
+
+func DecodePercent(buffer *bytes.Buffer) Percent {
+	return Percent{Parts: sc.DecodeU8(buffer)}
+}
+
+func (p Percent) Encode(buffer *bytes.Buffer) {
+	p.Parts.Encode(buffer)
+}
+
+func (p Percent) Bytes() []byte {
+	return sc.EncodedBytes(p)
+}
+
+func (p Percent) Deconstruct() *big.Int {
+	return new(big.Int).SetUint64(uint64(p.Parts))
+}
+
+func (p Percent) Accuracy() *big.Int {
+	return new(big.Int).Set(accuracyPercent)
+}
+
+// Mul returns `p * v`, rounded to the nearest value, preferring down. `v` can be an unsigned
+// integer, a U128 or a Weight.
+func (p Percent) Mul(v sc.Encodable) sc.Encodable {
+	return perThingMul(p, v, RoundingNearestPrefDown)
+}
+
+// MulFloor returns `p * v`, rounded down.
+func (p Percent) MulFloor(v sc.Encodable) sc.Encodable {
+	return perThingMul(p, v, RoundingDown)
+}
+
+// MulCeil returns `p * v`, rounded up.
+func (p Percent) MulCeil(v sc.Encodable) sc.Encodable {
+	return perThingMul(p, v, RoundingUp)
+}
+
+// SaturatingAdd returns `p + q`, saturating at one.
+func (p Percent) SaturatingAdd(q Percent) Percent {
+	return Percent{Parts: sc.U8(perThingSaturatingAdd(p, q).Uint64())}
+}
+
+// SaturatingSub returns `p - q`, saturating at zero.
+func (p Percent) SaturatingSub(q Percent) Percent {
+	return Percent{Parts: sc.U8(perThingSaturatingSub(p, q).Uint64())}
+}
+
+// SaturatingMul returns `p * q`, rounded down.
+func (p Percent) SaturatingMul(q Percent) Percent {
+	return Percent{Parts: sc.U8(perThingSaturatingMul(p, q).Uint64())}
+}
+
+// SaturatingDiv returns `p / q` rounded with the given mode, saturating at one.
+func (p Percent) SaturatingDiv(q Percent, rounding Rounding) Percent {
+	return Percent{Parts: sc.U8(perThingSaturatingDiv(p, q, rounding).Uint64())}
+}
+
+// SaturatingPow returns `p` to the power of `exp`, rounded down.
+func (p Percent) SaturatingPow(exp uint32) Percent {
+	return Percent{Parts: sc.U8(perThingSaturatingPow(p, exp).Uint64())}
+}
+
+// Complement returns `1 - p`.
+func (p Percent) Complement() Percent {
+	return Percent{Parts: sc.U8(perThingComplement(p).Uint64())}
+}
+
+// Permill is a fraction between zero and one with an accuracy of `1_000_000` parts.
+type Permill struct {
+	Parts sc.U32
+}
+
+// NewPermillFromParts returns the fraction with the given parts, saturating at one.
+func NewPermillFromParts(parts sc.U32) Permill {
+	return Permill{Parts: sc.U32(clamp(new(big.Int).SetUint64(uint64(parts)), big.NewInt(0), accuracyPermill).Uint64())}
+}
+
+// NewPermillFromPercent returns the fraction of `percent` per cent, saturating at one.
+func NewPermillFromPercent(percent sc.U32) Permill {
+	return Permill{Parts: sc.U32(perThingFromPercent(uint64(percent), accuracyPermill).Uint64())}
+}
+
+// NewPermillFromRational returns the fraction `n / d` rounded with the given mode,
+// or none if `d` is zero or the fraction is not between zero and one.
+func NewPermillFromRational(n, d *big.Int, rounding Rounding) sc.Option[Permill] {
+	parts, ok := perThingFromRational(n, d, accuracyPermill, rounding)
+	if !ok {
+		return sc.NewOption[Permill](nil)
+	}
+
+	return sc.NewOption[Permill](Permill{Parts: sc.U32(parts.Uint64())})
+}
+
+// NewPermillFromPerThing converts the fraction `p` to the accuracy of Permill, rounded to the nearest value.
+func NewPermillFromPerThing(p PerThing) Permill {
+	return Permill{Parts: sc.U32(perThingFromPerThing(p, accuracyPermill).Uint64())}
+}
+
+// PermillOne returns the whole.
+func PermillOne() Permill {
+	return Permill{Parts: sc.U32(accuracyPermill.Uint64())}
+}
+
+func DecodePermill(buffer *bytes.Buffer) Permill {
+	return Permill{Parts: sc.DecodeU32(buffer)}
+}
+
+func (p Permill) Encode(buffer *bytes.Buffer) {
+	p.Parts.Encode(buffer)
+}
+
+func (p Permill) Bytes() []byte {
+	return sc.EncodedBytes(p)
+}
+
+func (p Permill) Deconstruct() *big.Int {
+	return new(big.Int).SetUint64(uint64(p.Parts))
+}
+
+func (p Permill) Accuracy() *big.Int {
+	return new(big.Int).Set(accuracyPermill)
+}
+
+// Mul returns `p * v`, rounded to the nearest value, preferring down. `v` can be an unsigned
+// integer, a U128 or a Weight.
+func (p Permill) Mul(v sc.Encodable) sc.Encodable {
+	return perThingMul(p, v, RoundingNearestPrefDown)
+}
+
+// MulFloor returns `p * v`, rounded down.
+func (p Permill) MulFloor(v sc.Encodable) sc.Encodable {
+	return perThingMul(p, v, RoundingDown)
+}
+
+// MulCeil returns `p * v`, rounded up.
+func (p Permill) MulCeil(v sc.Encodable) sc.Encodable {
+	return perThingMul(p, v, RoundingUp)
+}
+
+// SaturatingAdd returns `p + q`, saturating at one.
+func (p Permill) SaturatingAdd(q Permill) Permill {
+	return Permill{Parts: sc.U32(perThingSaturatingAdd(p, q).Uint64())}
+}
+
+// SaturatingSub returns `p - q`, saturating at zero.
+func (p Permill) SaturatingSub(q Permill) Permill {
+	return Permill{Parts: sc.U32(perThingSaturatingSub(p, q).Uint64())}
+}
+
+// SaturatingMul returns `p * q`, rounded down.
+func (p Permill) SaturatingMul(q Permill) Permill {
+	return Permill{Parts: sc.U32(perThingSaturatingMul(p, q).Uint64())}
+}
+
+// SaturatingDiv returns `p / q` rounded with the given mode, saturating at one.
+func (p Permill) SaturatingDiv(q Permill, rounding Rounding) Permill {
+	return Permill{Parts: sc.U32(perThingSaturatingDiv(p, q, rounding).Uint64())}
+}
+
+// SaturatingPow returns `p` to the power of `exp`, rounded down.
+func (p Permill) SaturatingPow(exp uint32) Permill {
+	return Permill{Parts: sc.U32(perThingSaturatingPow(p, exp).Uint64())}
+}
+
+// Complement returns `1 - p`.
+func (p Permill) Complement() Permill {
+	return Permill{Parts: sc.U32(perThingComplement(p).Uint64())}
+}
+
+// Perbill is a fraction between zero and one with an accuracy of `1_000_000_000` parts.
+type Perbill struct {
+	Parts sc.U32
+}
+
+// NewPerbillFromParts returns the fraction with the given parts, saturating at one.
+func NewPerbillFromParts(parts sc.U32) Perbill {
+	return Perbill{Parts: sc.U32(clamp(new(big.Int).SetUint64(uint64(parts)), big.NewInt(0), accuracyPerbill).Uint64())}
+}
+
+// NewPerbillFromPercent returns the fraction of `percent` per cent, saturating at one.
+func NewPerbillFromPercent(percent sc.U32) Perbill {
+	return Perbill{Parts: sc.U32(perThingFromPercent(uint64(percent), accuracyPerbill).Uint64())}
+}
+
+// NewPerbillFromRational returns the fraction `n / d` rounded with the given mode,
+// or none if `d` is zero or the fraction is not between zero and one.
+func NewPerbillFromRational(n, d *big.Int, rounding Rounding) sc.Option[Perbill] {
+	parts, ok := perThingFromRational(n, d, accuracyPerbill, rounding)
+	if !ok {
+		return sc.NewOption[Perbill](nil)
+	}
+
+	return sc.NewOption[Perbill](Perbill{Parts: sc.U32(parts.Uint64())})
+}
+
+// NewPerbillFromPerThing converts the fraction `p` to the accuracy of Perbill, rounded to the nearest value.
+func NewPerbillFromPerThing(p PerThing) Perbill {
+	return Perbill{Parts: sc.U32(perThingFromPerThing(p, accuracyPerbill).Uint64())}
+}
+
+// PerbillOne returns the whole.
+func PerbillOne() Perbill {
+	return Perbill{Parts: sc.U32(accuracyPerbill.Uint64())}
+}
+
+func DecodePerbill(buffer *bytes.Buffer) Perbill {
+	return Perbill{Parts: sc.DecodeU32(buffer)}
+}
+
+func (p Perbill) Encode(buffer *bytes.Buffer) {
+	p.Parts.Encode(buffer)
+}
+
+func (p Perbill) Bytes() []byte {
+	return sc.EncodedBytes(p)
+}
+
+func (p Perbill) Deconstruct() *big.Int {
+	return new(big.Int).SetUint64(uint64(p.Parts))
+}
+
+func (p Perbill) Accuracy() *big.Int {
+	return new(big.Int).Set(accuracyPerbill)
+}
+
+// Mul returns `p * v`, rounded to the nearest value, preferring down. `v` can be an unsigned
+// integer, a U128 or a Weight.
+func (p Perbill) Mul(v sc.Encodable) sc.Encodable {
+	return perThingMul(p, v, RoundingNearestPrefDown)
+}
+
+// MulFloor returns `p * v`, rounded down.
+func (p Perbill) MulFloor(v sc.Encodable) sc.Encodable {
+	return perThingMul(p, v, RoundingDown)
+}
+
+// MulCeil returns `p * v`, rounded up.
+func (p Perbill) MulCeil(v sc.Encodable) sc.Encodable {
+	return perThingMul(p, v, RoundingUp)
+}
+
+// SaturatingAdd returns `p + q`, saturating at one.
+func (p Perbill) SaturatingAdd(q Perbill) Perbill {
+	return Perbill{Parts: sc.U32(perThingSaturatingAdd(p, q).Uint64())}
+}
+
+// SaturatingSub returns `p - q`, saturating at zero.
+func (p Perbill) SaturatingSub(q Perbill) Perbill {
+	return Perbill{Parts: sc.U32(perThingSaturatingSub(p, q).Uint64())}
+}
+
+// SaturatingMul returns `p * q`, rounded down.
+func (p Perbill) SaturatingMul(q Perbill) Perbill {
+	return Perbill{Parts: sc.U32(perThingSaturatingMul(p, q).Uint64())}
+}
+
+// SaturatingDiv returns `p / q` rounded with the given mode, saturating at one.
+func (p Perbill) SaturatingDiv(q Perbill, rounding Rounding) Perbill {
+	return Perbill{Parts: sc.U32(perThingSaturatingDiv(p, q, rounding).Uint64())}
+}
+
+// SaturatingPow returns `p` to the power of `exp`, rounded down.
+func (p Perbill) SaturatingPow(exp uint32) Perbill {
+	return Perbill{Parts: sc.U32(perThingSaturatingPow(p, exp).Uint64())}
+}
+
+// Complement returns `1 - p`.
+func (p Perbill) Complement() Perbill {
+	return Perbill{Parts: sc.U32(perThingComplement(p).Uint64())}
+}
+
+// Perquintill is a fraction between zero and one with an accuracy of `1_000_000_000_000_000_000` parts.
+type Perquintill struct {
+	Parts sc.U64
+}
+
+// NewPerquintillFromParts returns the fraction with the given parts, saturating at one.
+func NewPerquintillFromParts(parts sc.U64) Perquintill {
+	return Perquintill{Parts: sc.U64(clamp(new(big.Int).SetUint64(uint64(parts)), big.NewInt(0), accuracyPerquintill).Uint64())}
+}
+
+// NewPerquintillFromPercent returns the fraction of `percent` per cent, saturating at one.
+func NewPerquintillFromPercent(percent sc.U64) Perquintill {
+	return Perquintill{Parts: sc.U64(perThingFromPercent(uint64(percent), accuracyPerquintill).Uint64())}
+}
+
+// NewPerquintillFromRational returns the fraction `n / d` rounded with the given mode,
+// or none if `d` is zero or the fraction is not between zero and one.
+func NewPerquintillFromRational(n, d *big.Int, rounding Rounding) sc.Option[Perquintill] {
+	parts, ok := perThingFromRational(n, d, accuracyPerquintill, rounding)
+	if !ok {
+		return sc.NewOption[Perquintill](nil)
+	}
+
+	return sc.NewOption[Perquintill](Perquintill{Parts: sc.U64(parts.Uint64())})
+}
+
+// NewPerquintillFromPerThing converts the fraction `p` to the accuracy of Perquintill, rounded to the nearest value.
+func NewPerquintillFromPerThing(p PerThing) Perquintill {
+	return Perquintill{Parts: sc.U64(perThingFromPerThing(p, accuracyPerquintill).Uint64())}
+}
+
+// PerquintillOne returns the whole.
+func PerquintillOne() Perquintill {
+	return Perquintill{Parts: sc.U64(accuracyPerquintill.Uint64())}
+}
+
+func DecodePerquintill(buffer *bytes.Buffer) Perquintill {
+	return Perquintill{Parts: sc.DecodeU64(buffer)}
+}
+
+func (p Perquintill) Encode(buffer *bytes.Buffer) {
+	p.Parts.Encode(buffer)
+}
+
+func (p Perquintill) Bytes() []byte {
+	return sc.EncodedBytes(p)
+}
+
+func (p Perquintill) Deconstruct() *big.Int {
+	return new(big.Int).SetUint64(uint64(p.Parts))
+}
+
+func (p Perquintill) Accuracy() *big.Int {
+	return new(big.Int).Set(accuracyPerquintill)
+}
+
+// Mul returns `p * v`, rounded to the nearest value, preferring down. `v` can be an unsigned
+// integer, a U128 or a Weight.
+func (p Perquintill) Mul(v sc.Encodable) sc.Encodable {
+	return perThingMul(p, v, RoundingNearestPrefDown)
+}
+
+// MulFloor returns `p * v`, rounded down.
+func (p Perquintill) MulFloor(v sc.Encodable) sc.Encodable {
+	return perThingMul(p, v, RoundingDown)
+}
+
+// MulCeil returns `p * v`, rounded up.
+func (p Perquintill) MulCeil(v sc.Encodable) sc.Encodable {
+	return perThingMul(p, v, RoundingUp)
+}
+
+// SaturatingAdd returns `p + q`, saturating at one.
+func (p Perquintill) SaturatingAdd(q Perquintill) Perquintill {
+	return Perquintill{Parts: sc.U64(perThingSaturatingAdd(p, q).Uint64())}
+}
+
+// SaturatingSub returns `p - q`, saturating at zero.
+func (p Perquintill) SaturatingSub(q Perquintill) Perquintill {
+	return Perquintill{Parts: sc.U64(perThingSaturatingSub(p, q).Uint64())}
+}
+
+// SaturatingMul returns `p * q`, rounded down.
+func (p Perquintill) SaturatingMul(q Perquintill) Perquintill {
+	return Perquintill{Parts: sc.U64(perThingSaturatingMul(p, q).Uint64())}
+}
+
+// SaturatingDiv returns `p / q` rounded with the given mode, saturating at one.
+func (p Perquintill) SaturatingDiv(q Perquintill, rounding Rounding) Perquintill {
+	return Perquintill{Parts: sc.U64(perThingSaturatingDiv(p, q, rounding).Uint64())}
+}
+
+// SaturatingPow returns `p` to the power of `exp`, rounded down.
+func (p Perquintill) SaturatingPow(exp uint32) Perquintill {
+	return Perquintill{Parts: sc.U64(perThingSaturatingPow(p, exp).Uint64())}
+}
+
+// Complement returns `1 - p`.
+func (p Perquintill) Complement() Perquintill {
+	return Perquintill{Parts: sc.U64(perThingComplement(p).Uint64())}
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_PerThing_Constructors(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		input       PerThing
+		expectation *big.Int
+	}{
+		{label: "Percent from percent", input: NewPercentFromPercent(50), expectation: big.NewInt(50)},
+		{label: "Permill from percent", input: NewPermillFromPercent(50), expectation: big.NewInt(500_000)},
+		{label: "Perbill from percent", input: NewPerbillFromPercent(75), expectation: big.NewInt(750_000_000)},
+		{label: "Perquintill from percent", input: NewPerquintillFromPercent(1), expectation: big.NewInt(10_000_000_000_000_000)},
+		{label: "Perbill from percent saturating", input: NewPerbillFromPercent(150), expectation: big.NewInt(1_000_000_000)},
+		{label: "Permill from parts saturating", input: NewPermillFromParts(2_000_000), expectation: big.NewInt(1_000_000)},
+		{label: "Perbill from Percent", input: NewPerbillFromPerThing(NewPercentFromPercent(30)), expectation: big.NewInt(300_000_000)},
+		{label: "Percent from Perbill rounded", input: NewPercentFromPerThing(NewPerbillFromParts(305_000_000)), expectation: big.NewInt(30)},
+		{label: "Percent from Perbill rounded up", input: NewPercentFromPerThing(NewPerbillFromParts(305_000_001)), expectation: big.NewInt(31)},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			assert.Equal(t, testExample.expectation, testExample.input.Deconstruct())
+		})
+	}
+}
+
+func Test_PerThing_FromRational(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		n, d        int64
+		rounding    Rounding
+		expectation sc.Option[Percent]
+	}{
+		{label: "Rounded down", n: 1, d: 3, rounding: RoundingDown, expectation: sc.NewOption[Percent](Percent{Parts: 33})},
+		{label: "Rounded up", n: 1, d: 3, rounding: RoundingUp, expectation: sc.NewOption[Percent](Percent{Parts: 34})},
+		{label: "Rounded to nearest", n: 2, d: 3, rounding: RoundingNearestPrefDown, expectation: sc.NewOption[Percent](Percent{Parts: 67})},
+		{label: "Half preferring down", n: 1, d: 200, rounding: RoundingNearestPrefDown, expectation: sc.NewOption[Percent](Percent{Parts: 0})},
+		{label: "Half preferring up", n: 1, d: 200, rounding: RoundingNearestPrefUp, expectation: sc.NewOption[Percent](Percent{Parts: 1})},
+		{label: "Greater than one", n: 3, d: 2, rounding: RoundingDown, expectation: sc.NewOption[Percent](nil)},
+		{label: "Zero denominator", n: 0, d: 0, rounding: RoundingDown, expectation: sc.NewOption[Percent](nil)},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			result := NewPercentFromRational(big.NewInt(testExample.n), big.NewInt(testExample.d), testExample.rounding)
+
+			assert.Equal(t, testExample.expectation, result)
+		})
+	}
+}
+
+func Test_PerThing_Arithmetic(t *testing.T) {
+	half := NewPerbillFromPercent(50)
+	quarter := NewPerbillFromPercent(25)
+
+	assert.Equal(t, NewPerbillFromPercent(75), half.SaturatingAdd(quarter))
+	assert.Equal(t, PerbillOne(), half.SaturatingAdd(half).SaturatingAdd(half))
+	assert.Equal(t, quarter, half.SaturatingSub(quarter))
+	assert.Equal(t, NewPerbillFromParts(0), quarter.SaturatingSub(half))
+	assert.Equal(t, NewPerbillFromParts(125_000_000), half.SaturatingMul(quarter))
+	assert.Equal(t, half, quarter.SaturatingDiv(half, RoundingDown))
+	assert.Equal(t, PerbillOne(), half.SaturatingDiv(quarter, RoundingDown))
+	assert.Equal(t, NewPerbillFromParts(125_000_000), half.SaturatingPow(3))
+	assert.Equal(t, PerbillOne(), half.SaturatingPow(0))
+	assert.Equal(t, NewPerbillFromPercent(75), quarter.Complement())
+}
+
+func Test_PerThing_Mul(t *testing.T) {
+	third := NewPercentFromPercent(33)
+
+	assert.Equal(t, sc.U32(3), third.Mul(sc.U32(10)))
+	assert.Equal(t, sc.U32(3), third.MulFloor(sc.U32(10)))
+	assert.Equal(t, sc.U32(4), third.MulCeil(sc.U32(10)))
+	assert.Equal(t, sc.U64(16), third.Mul(sc.U64(50)))
+	assert.Equal(t, sc.NewU128FromUint64(330), third.Mul(sc.NewU128FromUint64(1_000)))
+	assert.Equal(t, WeightFromParts(33, 66), third.Mul(WeightFromParts(100, 200)))
+	assert.Equal(t, WeightFromParts(1_500_000_000_000, 0), NewPerbillFromPercent(75).Mul(WeightFromParts(2_000_000_000_000, 0)))
+}
+
+func Test_PerThing_Encoding(t *testing.T) {
+	perbill := NewPerbillFromPercent(75)
+	perquintill := NewPerquintillFromPercent(50)
+
+	assert.Equal(t, sc.U32(750_000_000).Bytes(), perbill.Bytes())
+	assert.Equal(t, perbill, DecodePerbill(bytes.NewBuffer(perbill.Bytes())))
+	assert.Equal(t, perquintill, DecodePerquintill(bytes.NewBuffer(perquintill.Bytes())))
+	assert.Equal(t, []byte{50}, NewPercentFromPercent(50).Bytes())
+}
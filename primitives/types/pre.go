@@ -1,24 +1,10 @@
 package types
 
-import (
-	"bytes"
+import sc "github.com/LimeChain/goscale"
 
-	sc "github.com/LimeChain/goscale"
-)
-
-// Pre is the type that encodes information that can be passed from pre_dispatch to post-dispatch.
-type Pre struct {
-	Tip       Balance
-	Who       Address32
-	Imbalance sc.Option[Balance]
-}
-
-func (p Pre) Encode(buffer *bytes.Buffer) {
-	p.Tip.Encode(buffer)
-	p.Who.Encode(buffer)
-	p.Imbalance.Encode(buffer)
-}
-
-func (p Pre) Bytes() []byte {
-	return sc.EncodedBytes(p)
+// Pre is the information, which a signed extension passes from its pre dispatch to its post dispatch.
+// Each extension defines its own type and asserts it in PostDispatch. Extensions, which do not pass
+// any information, use sc.Empty.
+type Pre interface {
+	sc.Encodable
 }
@@ -1,7 +1,13 @@
 package types
 
-import sc "github.com/LimeChain/goscale"
+import (
+	"bytes"
 
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/log"
+)
+
+// Reasons are the reasons, for which a balance lock applies.
 type Reasons sc.U8
 
 const (
@@ -9,3 +15,46 @@ const (
 	ReasonsMisc
 	ReasonsAll
 )
+
+// NewReasonsFromWithdrawReasons converts a set of withdraw reasons to the reasons a lock applies for.
+// Only transaction payments are considered fees; any other withdrawal is restricted by the misc locks.
+func NewReasonsFromWithdrawReasons(withdrawReasons sc.U8) Reasons {
+	if withdrawReasons == WithdrawReasonsTransactionPayment {
+		return ReasonsFee
+	}
+
+	if withdrawReasons&WithdrawReasonsTransactionPayment != 0 {
+		return ReasonsAll
+	}
+
+	return ReasonsMisc
+}
+
+func (r Reasons) Encode(buffer *bytes.Buffer) {
+	sc.U8(r).Encode(buffer)
+}
+
+func (r Reasons) Bytes() []byte {
+	return sc.EncodedBytes(r)
+}
+
+func DecodeReasons(buffer *bytes.Buffer) Reasons {
+	value := Reasons(sc.DecodeU8(buffer))
+	switch value {
+	case ReasonsFee, ReasonsMisc, ReasonsAll:
+		return value
+	default:
+		log.Critical("invalid reasons type")
+	}
+
+	panic("unreachable")
+}
+
+// Or combines the reasons, so that the result applies whenever either of them does.
+func (r Reasons) Or(other Reasons) Reasons {
+	if r == other {
+		return r
+	}
+
+	return ReasonsAll
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"math/big"
+)
+
+// Rounding is the rounding mode of fixed-point and per-thing arithmetic.
+// The modes are applied to the magnitude of the result, so Down rounds negative
+// results towards zero.
+type Rounding uint8
+
+const (
+	// RoundingDown rounds towards zero.
+	RoundingDown Rounding = iota
+	// RoundingUp rounds away from zero.
+	RoundingUp
+	// RoundingNearestPrefDown rounds to the nearest value, or down if both values are equally near.
+	RoundingNearestPrefDown
+	// RoundingNearestPrefUp rounds to the nearest value, or up if both values are equally near.
+	RoundingNearestPrefUp
+)
+
+// mulDivRounded returns `a * b / c`, rounded with the given mode. `c` must not be zero.
+func mulDivRounded(a, b, c *big.Int, rounding Rounding) *big.Int {
+	numerator := new(big.Int).Mul(a, b)
+	return divRounded(numerator, c, rounding)
+}
+
+// divRounded returns `n / d`, rounded with the given mode. `d` must not be zero.
+func divRounded(n, d *big.Int, rounding Rounding) *big.Int {
+	negative := n.Sign()*d.Sign() < 0
+
+	numerator := new(big.Int).Abs(n)
+	denominator := new(big.Int).Abs(d)
+
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+
+	if remainder.Sign() != 0 {
+		switch rounding {
+		case RoundingUp:
+			quotient.Add(quotient, big.NewInt(1))
+		case RoundingNearestPrefDown, RoundingNearestPrefUp:
+			doubleRemainder := new(big.Int).Lsh(remainder, 1)
+			cmp := doubleRemainder.Cmp(denominator)
+			if cmp > 0 || (cmp == 0 && rounding == RoundingNearestPrefUp) {
+				quotient.Add(quotient, big.NewInt(1))
+			}
+		}
+	}
+
+	if negative {
+		quotient.Neg(quotient)
+	}
+
+	return quotient
+}
+
+// clamp returns `v` limited to the range [min, max].
+func clamp(v, min, max *big.Int) *big.Int {
+	if v.Cmp(min) < 0 {
+		return new(big.Int).Set(min)
+	}
+	if v.Cmp(max) > 0 {
+		return new(big.Int).Set(max)
+	}
+
+	return v
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"bytes"
+
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/primitives/log"
 )
@@ -56,3 +58,52 @@ func (o RawOrigin) AsSigned() Address32 {
 }
 
 type RuntimeOrigin = RawOrigin
+
+func DecodeRawOrigin(buffer *bytes.Buffer) RawOrigin {
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case RawOriginRoot:
+		return NewRawOriginRoot()
+	case RawOriginSigned:
+		return NewRawOriginSigned(DecodeAddress32(buffer))
+	case RawOriginNone:
+		return NewRawOriginNone()
+	default:
+		log.Critical("invalid RawOrigin type")
+	}
+
+	panic("unreachable")
+}
+
+const (
+	// OriginCallerSystem is the index of the origin of the System module in the runtime.
+	OriginCallerSystem sc.U8 = iota
+)
+
+// OriginCaller is the origin of the runtime, which aggregates the origins of all modules.
+// Only the origin of the System module is supported.
+type OriginCaller struct {
+	sc.VaryingData
+}
+
+func NewOriginCallerSystem(origin RawOrigin) OriginCaller {
+	return OriginCaller{sc.NewVaryingData(OriginCallerSystem, origin)}
+}
+
+func DecodeOriginCaller(buffer *bytes.Buffer) OriginCaller {
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case OriginCallerSystem:
+		return NewOriginCallerSystem(DecodeRawOrigin(buffer))
+	default:
+		log.Critical("invalid OriginCaller type")
+	}
+
+	panic("unreachable")
+}
+
+func (oc OriginCaller) AsSystem() RawOrigin {
+	return oc.VaryingData[1].(RawOrigin)
+}
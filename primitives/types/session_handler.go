@@ -0,0 +1,27 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+// ValidatorKey is a validator together with its session key of a single key type.
+type ValidatorKey struct {
+	Validator Address32
+	Key       PublicKey
+}
+
+// SessionHandler is notified by the session module when the validator set or the session keys change.
+// Each handler is interested in the session keys of a single key type. The session keys of a validator
+// contain one key for each handler, in the order in which the handlers are configured.
+type SessionHandler interface {
+	// KeyTypeId returns the type of the session key, the handler is interested in.
+	KeyTypeId() [4]byte
+	// OnGenesisSession is called when the genesis session is built, with the initial validators and their keys.
+	OnGenesisSession(validators []ValidatorKey)
+	// OnNewSession is called when a new session starts, with the validators of the session and the validators,
+	// queued for the next session. `changed` is true, if the validators or their keys have changed since the
+	// previous session.
+	OnNewSession(changed bool, validators []ValidatorKey, queuedValidators []ValidatorKey)
+	// OnBeforeSessionEnding is called before the current session ends.
+	OnBeforeSessionEnding()
+	// OnDisabled is called when the validator at `validatorIndex` is disabled for the rest of the session.
+	OnDisabled(validatorIndex sc.U32)
+}
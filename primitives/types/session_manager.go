@@ -0,0 +1,15 @@
+package types
+
+import sc "github.com/LimeChain/goscale"
+
+// SessionManager decides the validator set of each session. It is notified by the session module
+// when a session is planned, ends and starts.
+type SessionManager interface {
+	// NewSession plans the session at `newIndex` and returns its validators. The validators are queued
+	// and become the validators of the session, when it starts. Returns none, if the validators do not change.
+	NewSession(newIndex sc.U32) sc.Option[sc.Sequence[Address32]]
+	// EndSession is called when the session at `endIndex` ends.
+	EndSession(endIndex sc.U32)
+	// StartSession is called when the session at `startIndex` starts.
+	StartSession(startIndex sc.U32)
+}
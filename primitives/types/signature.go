@@ -5,6 +5,7 @@ import (
 
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/primitives/crypto"
+	"github.com/LimeChain/gosemble/primitives/hashing"
 )
 
 type Ed25519 struct {
@@ -73,9 +74,18 @@ func NewEcdsa(values ...sc.U8) Ecdsa {
 	return Ecdsa{sc.NewFixedSequence(65, values...)}
 }
 
+// Verify recovers the public key from the signature of the blake2-256 hash of the message
+// and checks that the blake2-256 hash of the compressed public key is the signer.
 func (s Ecdsa) Verify(msg sc.Sequence[sc.U8], signer Address32) sc.Bool {
-	// TODO:
-	return true
+	sig := sc.FixedSequenceU8ToBytes(s.FixedSequence)
+	msgHash := hashing.Blake256(sc.SequenceU8ToBytes(msg))
+
+	pubKey, ok := crypto.ExtCryptoSecp256k1EcdsaRecoverCompressedVersion2(sig, msgHash)
+	if !ok {
+		return false
+	}
+
+	return sc.Bool(bytes.Equal(hashing.Blake256(pubKey), sc.FixedSequenceU8ToBytes(signer.FixedSequence)))
 }
 
 func (s Ecdsa) Encode(buffer *bytes.Buffer) {
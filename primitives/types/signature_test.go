@@ -0,0 +1,81 @@
+//go:build nonwasmenv
+
+package types
+
+import (
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/hashing"
+	"github.com/LimeChain/gosemble/primitives/host"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_MultiSignature_VerifyEcdsa(t *testing.T) {
+	if !host.EcdsaAvailable() {
+		t.Skip("secp256k1 backend does not support recovery")
+	}
+
+	keyTypeId := []byte("test")
+	message := []byte("message")
+
+	keystore := host.NewKeystore()
+	pubKey := keystore.Generate(host.SchemeEcdsa, keyTypeId, nil)
+	signature, ok := keystore.Sign(host.SchemeEcdsa, keyTypeId, pubKey, message)
+	assert.True(t, ok)
+
+	signer := NewAddress32(sc.BytesToSequenceU8(hashing.Blake256(pubKey))...)
+	otherSigner := NewAddress32(sc.BytesToSequenceU8(hashing.Blake256([]byte("other")))...)
+	validSignature := NewMultiSignatureEcdsa(NewEcdsa(sc.BytesToSequenceU8(signature)...))
+
+	var testExamples = []struct {
+		label       string
+		signature   MultiSignature
+		message     []byte
+		signer      Address32
+		expectation sc.Bool
+	}{
+		{
+			label:       "Verify(valid signature)",
+			signature:   validSignature,
+			message:     message,
+			signer:      signer,
+			expectation: true,
+		},
+		{
+			label:       "Verify(other signer)",
+			signature:   validSignature,
+			message:     message,
+			signer:      otherSigner,
+			expectation: false,
+		},
+		{
+			label:       "Verify(other message)",
+			signature:   validSignature,
+			message:     []byte("other"),
+			signer:      signer,
+			expectation: false,
+		},
+		{
+			label:       "Verify(invalid signature)",
+			signature:   NewMultiSignatureEcdsa(NewEcdsa(make([]sc.U8, 65)...)),
+			message:     message,
+			signer:      signer,
+			expectation: false,
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			result := testExample.signature.Verify(sc.BytesToSequenceU8(testExample.message), testExample.signer)
+
+			assert.Equal(t, testExample.expectation, result)
+		})
+	}
+}
+
+func Test_MultiSignature_AsEcdsa(t *testing.T) {
+	signature := NewEcdsa(make([]sc.U8, 65)...)
+
+	assert.Equal(t, signature, NewMultiSignatureEcdsa(signature).AsEcdsa())
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// SignedExtension is a means by which a transaction may be extended. It embodies both the data,
+// which is part of the extra of a signed extrinsic, and the logic that should be additionally
+// associated with the transaction.
+type SignedExtension interface {
+	sc.Encodable
+
+	// Decode returns a new instance of the extension, decoded from the extra of an extrinsic.
+	Decode(buffer *bytes.Buffer) SignedExtension
+
+	// Identifier is the unique identifier of the extension, which is exposed in the metadata.
+	Identifier() sc.Str
+
+	// AdditionalSigned constructs any additional data that should be in the signed payload of the transaction.
+	// It can also perform any pre-signature-verification checks and return an error if needed.
+	AdditionalSigned() (ok sc.Encodable, err TransactionValidityError)
+
+	// Validate validates a signed transaction for the transaction queue.
+	//
+	// Make sure to perform the same checks in PreDispatch.
+	Validate(who *Address32, call *Call, info *DispatchInfo, length sc.Compact) (ok ValidTransaction, err TransactionValidityError)
+
+	// ValidateUnsigned validates an unsigned transaction for the transaction queue.
+	//
+	// Make sure to perform the same checks in PreDispatchUnsigned.
+	ValidateUnsigned(call *Call, info *DispatchInfo, length sc.Compact) (ok ValidTransaction, err TransactionValidityError)
+
+	// PreDispatch does any pre-flight stuff for a signed transaction.
+	PreDispatch(who *Address32, call *Call, info *DispatchInfo, length sc.Compact) (ok Pre, err TransactionValidityError)
+
+	// PreDispatchUnsigned does any pre-flight stuff for an unsigned transaction.
+	PreDispatchUnsigned(call *Call, info *DispatchInfo, length sc.Compact) (ok Pre, err TransactionValidityError)
+
+	// PostDispatch does any post-flight stuff for an extrinsic.
+	//
+	// If the transaction is signed, pre contains the output of PreDispatch of the extension, and none otherwise.
+	//
+	// It is dangerous to return an error here, because it invalidates the transaction and any block it is included in.
+	PostDispatch(pre sc.Option[Pre], info *DispatchInfo, postInfo *PostDispatchInfo, length sc.Compact, result *DispatchResult) TransactionValidityError
+
+	// Metadata returns the identifier of the extension, along with the metadata type ids of its data and additional signed data.
+	Metadata() MetadataSignedExtension
+}
+
+// DefaultSignedExtension implements the checks of a signed extension with no effect and with no additional signed data.
+// Extensions embed it and override only the checks they need.
+type DefaultSignedExtension struct{}
+
+func (dse DefaultSignedExtension) AdditionalSigned() (sc.Encodable, TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (dse DefaultSignedExtension) Validate(_ *Address32, _ *Call, _ *DispatchInfo, _ sc.Compact) (ValidTransaction, TransactionValidityError) {
+	return DefaultValidTransaction(), nil
+}
+
+func (dse DefaultSignedExtension) ValidateUnsigned(_ *Call, _ *DispatchInfo, _ sc.Compact) (ValidTransaction, TransactionValidityError) {
+	return DefaultValidTransaction(), nil
+}
+
+func (dse DefaultSignedExtension) PreDispatch(_ *Address32, _ *Call, _ *DispatchInfo, _ sc.Compact) (Pre, TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (dse DefaultSignedExtension) PreDispatchUnsigned(_ *Call, _ *DispatchInfo, _ sc.Compact) (Pre, TransactionValidityError) {
+	return sc.Empty{}, nil
+}
+
+func (dse DefaultSignedExtension) PostDispatch(_ sc.Option[Pre], _ *DispatchInfo, _ *PostDispatchInfo, _ sc.Compact, _ *DispatchResult) TransactionValidityError {
+	return nil
+}
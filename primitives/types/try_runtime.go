@@ -0,0 +1,121 @@
+package types
+
+import (
+	"bytes"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/primitives/log"
+)
+
+const (
+	// UpgradeCheckSelectNone executes no checks.
+	UpgradeCheckSelectNone sc.U8 = iota
+	// UpgradeCheckSelectAll executes the PreUpgrade and PostUpgrade checks of the migrations and the TryState checks of all modules.
+	UpgradeCheckSelectAll
+	// UpgradeCheckSelectPreAndPost executes the PreUpgrade and PostUpgrade checks of the migrations.
+	UpgradeCheckSelectPreAndPost
+	// UpgradeCheckSelectTryState executes the TryState checks of all modules.
+	UpgradeCheckSelectTryState
+)
+
+// UpgradeCheckSelect selects the checks, which are executed by a try-runtime upgrade.
+type UpgradeCheckSelect struct {
+	sc.VaryingData
+}
+
+func NewUpgradeCheckSelectNone() UpgradeCheckSelect {
+	return UpgradeCheckSelect{sc.NewVaryingData(UpgradeCheckSelectNone)}
+}
+
+func NewUpgradeCheckSelectAll() UpgradeCheckSelect {
+	return UpgradeCheckSelect{sc.NewVaryingData(UpgradeCheckSelectAll)}
+}
+
+func NewUpgradeCheckSelectPreAndPost() UpgradeCheckSelect {
+	return UpgradeCheckSelect{sc.NewVaryingData(UpgradeCheckSelectPreAndPost)}
+}
+
+func NewUpgradeCheckSelectTryState() UpgradeCheckSelect {
+	return UpgradeCheckSelect{sc.NewVaryingData(UpgradeCheckSelectTryState)}
+}
+
+func DecodeUpgradeCheckSelect(buffer *bytes.Buffer) UpgradeCheckSelect {
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case UpgradeCheckSelectNone:
+		return NewUpgradeCheckSelectNone()
+	case UpgradeCheckSelectAll:
+		return NewUpgradeCheckSelectAll()
+	case UpgradeCheckSelectPreAndPost:
+		return NewUpgradeCheckSelectPreAndPost()
+	case UpgradeCheckSelectTryState:
+		return NewUpgradeCheckSelectTryState()
+	default:
+		log.Critical("invalid UpgradeCheckSelect type")
+	}
+
+	panic("unreachable")
+}
+
+// PreAndPost checks whether the PreUpgrade and PostUpgrade checks of the migrations are selected.
+func (ucs UpgradeCheckSelect) PreAndPost() bool {
+	return ucs.VaryingData[0] == UpgradeCheckSelectAll || ucs.VaryingData[0] == UpgradeCheckSelectPreAndPost
+}
+
+// TryState checks whether the TryState checks of the modules are selected.
+func (ucs UpgradeCheckSelect) TryState() bool {
+	return ucs.VaryingData[0] == UpgradeCheckSelectAll || ucs.VaryingData[0] == UpgradeCheckSelectTryState
+}
+
+const (
+	// TryStateSelectNone executes no TryState checks.
+	TryStateSelectNone sc.U8 = iota
+	// TryStateSelectAll executes the TryState checks of all modules.
+	TryStateSelectAll
+	// TryStateSelectRoundRobin executes the TryState checks of the given number of modules,
+	// starting from a module selected by the block number.
+	TryStateSelectRoundRobin
+	// TryStateSelectOnly executes the TryState checks of the modules with the given names.
+	TryStateSelectOnly
+)
+
+// TryStateSelect selects the modules, whose TryState checks are executed.
+type TryStateSelect struct {
+	sc.VaryingData
+}
+
+func NewTryStateSelectNone() TryStateSelect {
+	return TryStateSelect{sc.NewVaryingData(TryStateSelectNone)}
+}
+
+func NewTryStateSelectAll() TryStateSelect {
+	return TryStateSelect{sc.NewVaryingData(TryStateSelectAll)}
+}
+
+func NewTryStateSelectRoundRobin(count sc.U32) TryStateSelect {
+	return TryStateSelect{sc.NewVaryingData(TryStateSelectRoundRobin, count)}
+}
+
+func NewTryStateSelectOnly(names sc.Sequence[sc.Sequence[sc.U8]]) TryStateSelect {
+	return TryStateSelect{sc.NewVaryingData(TryStateSelectOnly, names)}
+}
+
+func DecodeTryStateSelect(buffer *bytes.Buffer) TryStateSelect {
+	b := sc.DecodeU8(buffer)
+
+	switch b {
+	case TryStateSelectNone:
+		return NewTryStateSelectNone()
+	case TryStateSelectAll:
+		return NewTryStateSelectAll()
+	case TryStateSelectRoundRobin:
+		return NewTryStateSelectRoundRobin(sc.DecodeU32(buffer))
+	case TryStateSelectOnly:
+		return NewTryStateSelectOnly(sc.DecodeSequenceWith(buffer, sc.DecodeSequence[sc.U8]))
+	default:
+		log.Critical("invalid TryStateSelect type")
+	}
+
+	panic("unreachable")
+}
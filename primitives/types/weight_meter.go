@@ -0,0 +1,46 @@
+package types
+
+// WeightMeter tracks the weight consumed by an operation, which must not exceed a limit.
+type WeightMeter struct {
+	Consumed Weight
+	Limit    Weight
+}
+
+// NewWeightMeter returns a meter with nothing consumed and the given limit.
+func NewWeightMeter(limit Weight) WeightMeter {
+	return WeightMeter{
+		Consumed: WeightZero(),
+		Limit:    limit,
+	}
+}
+
+// Remaining returns the weight, which can still be consumed.
+func (wm WeightMeter) Remaining() Weight {
+	return wm.Limit.SaturatingSub(wm.Consumed)
+}
+
+// CanConsume checks whether the weight can be consumed without exceeding the limit.
+func (wm WeightMeter) CanConsume(weight Weight) bool {
+	consumed := wm.Consumed.CheckedAdd(weight)
+	if !consumed.HasValue {
+		return false
+	}
+
+	return !bool(consumed.Value.AnyGt(wm.Limit))
+}
+
+// TryConsume consumes the weight if it does not exceed the limit and reports whether it was consumed.
+func (wm *WeightMeter) TryConsume(weight Weight) bool {
+	if !wm.CanConsume(weight) {
+		return false
+	}
+
+	wm.Consumed = wm.Consumed.SaturatingAdd(weight)
+
+	return true
+}
+
+// Consume consumes the weight, even if it exceeds the limit.
+func (wm *WeightMeter) Consume(weight Weight) {
+	wm.Consumed = wm.Consumed.SaturatingAdd(weight)
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"math/big"
+
+	sc "github.com/LimeChain/goscale"
+)
+
+// WeightToFeeCoefficient is a single term of a WeightToFeePolynomial,
+// which evaluates to `(CoeffInteger + CoeffFrac) * x^Degree`.
+type WeightToFeeCoefficient struct {
+	// The integral part of the coefficient.
+	CoeffInteger Balance
+	// The fractional part of the coefficient.
+	CoeffFrac Perbill
+	// Whether the term is subtracted from the sum of the previous terms.
+	Negative sc.Bool
+	// The degree (exponent) of the term.
+	Degree sc.U8
+}
+
+// WeightToFeePolynomial implements WeightToFee by evaluating a polynomial
+// of the ref time of a weight. The terms are applied in order, and the result
+// saturates at zero and at the numeric bounds after each term.
+type WeightToFeePolynomial struct {
+	Coefficients []WeightToFeeCoefficient
+}
+
+func NewWeightToFeePolynomial(coefficients ...WeightToFeeCoefficient) WeightToFeePolynomial {
+	return WeightToFeePolynomial{
+		Coefficients: coefficients,
+	}
+}
+
+func (p WeightToFeePolynomial) WeightToFee(weight Weight) Balance {
+	return p.Evaluate(weight.RefTime)
+}
+
+// Evaluate returns the value of the polynomial for `x`.
+func (p WeightToFeePolynomial) Evaluate(x sc.U64) Balance {
+	bnX := new(big.Int).SetUint64(uint64(x))
+
+	acc := big.NewInt(0)
+	for _, coefficient := range p.Coefficients {
+		w := clamp(new(big.Int).Exp(bnX, big.NewInt(int64(coefficient.Degree)), nil), big.NewInt(0), maxU128)
+
+		frac := mulDivRounded(w, coefficient.CoeffFrac.Deconstruct(), coefficient.CoeffFrac.Accuracy(), RoundingNearestPrefDown)
+		integer := new(big.Int).Mul(coefficient.CoeffInteger.ToBigInt(), w)
+
+		if coefficient.Negative {
+			acc.Sub(acc, frac)
+			acc = clamp(acc, big.NewInt(0), maxU128)
+			acc.Sub(acc, integer)
+		} else {
+			acc.Add(acc, frac)
+			acc = clamp(acc, big.NewInt(0), maxU128)
+			acc.Add(acc, integer)
+		}
+		acc = clamp(acc, big.NewInt(0), maxU128)
+	}
+
+	return sc.NewU128FromBigInt(acc)
+}
+
+// TwoDimensionalWeightToFee implements WeightToFee by charging for both
+// the ref time and the proof size of a weight, each with its own polynomial.
+// The resulting fee is the larger of the two.
+type TwoDimensionalWeightToFee struct {
+	RefTime   WeightToFeePolynomial
+	ProofSize WeightToFeePolynomial
+}
+
+func NewTwoDimensionalWeightToFee(refTime WeightToFeePolynomial, proofSize WeightToFeePolynomial) TwoDimensionalWeightToFee {
+	return TwoDimensionalWeightToFee{
+		RefTime:   refTime,
+		ProofSize: proofSize,
+	}
+}
+
+func (t TwoDimensionalWeightToFee) WeightToFee(weight Weight) Balance {
+	refTimeFee := t.RefTime.Evaluate(weight.RefTime)
+	proofSizeFee := t.ProofSize.Evaluate(weight.ProofSize)
+
+	if refTimeFee.ToBigInt().Cmp(proofSizeFee.ToBigInt()) < 0 {
+		return proofSizeFee
+	}
+
+	return refTimeFee
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	sc "github.com/LimeChain/goscale"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	// 2.3x^3 + 7x^2 + 0.5x - 10_000
+	testPolynomial = NewWeightToFeePolynomial(
+		WeightToFeeCoefficient{CoeffInteger: sc.NewU128FromUint64(2), CoeffFrac: NewPerbillFromPercent(30), Negative: false, Degree: 3},
+		WeightToFeeCoefficient{CoeffInteger: sc.NewU128FromUint64(7), CoeffFrac: NewPerbillFromParts(0), Negative: false, Degree: 2},
+		WeightToFeeCoefficient{CoeffInteger: sc.NewU128FromUint64(0), CoeffFrac: NewPerbillFromPercent(50), Negative: false, Degree: 1},
+		WeightToFeeCoefficient{CoeffInteger: sc.NewU128FromUint64(10_000), CoeffFrac: NewPerbillFromParts(0), Negative: true, Degree: 0},
+	)
+	identityPolynomial = NewWeightToFeePolynomial(
+		WeightToFeeCoefficient{CoeffInteger: sc.NewU128FromUint64(1), CoeffFrac: NewPerbillFromParts(0), Negative: false, Degree: 1},
+	)
+	doublePolynomial = NewWeightToFeePolynomial(
+		WeightToFeeCoefficient{CoeffInteger: sc.NewU128FromUint64(2), CoeffFrac: NewPerbillFromParts(0), Negative: false, Degree: 1},
+	)
+)
+
+func Test_WeightToFeePolynomial_WeightToFee(t *testing.T) {
+	var testExamples = []struct {
+		label       string
+		weight      Weight
+		expectation Balance
+	}{
+		{label: "Zero weight", weight: WeightZero(), expectation: sc.NewU128FromUint64(0)},
+		{label: "Negative sum saturates at zero", weight: WeightFromParts(10, 0), expectation: sc.NewU128FromUint64(0)},
+		{label: "Weight of 100", weight: WeightFromParts(100, 0), expectation: sc.NewU128FromUint64(2_360_050)},
+		{label: "Weight of 1000", weight: WeightFromParts(1000, 0), expectation: sc.NewU128FromUint64(2_306_990_500)},
+		{label: "Proof size is ignored", weight: WeightFromParts(100, 1000), expectation: sc.NewU128FromUint64(2_360_050)},
+		{label: "Maximum weight saturates", weight: WeightFromParts(math.MaxUint64, 0), expectation: sc.NewU128FromBigInt(new(big.Int).Sub(maxU128, big.NewInt(10_000)))},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			assert.Equal(t, testExample.expectation, testPolynomial.WeightToFee(testExample.weight))
+		})
+	}
+}
+
+func Test_WeightToFeePolynomial_Empty(t *testing.T) {
+	assert.Equal(t, sc.NewU128FromUint64(0), NewWeightToFeePolynomial().WeightToFee(WeightFromParts(1000, 1000)))
+}
+
+func Test_TwoDimensionalWeightToFee_WeightToFee(t *testing.T) {
+	twoDimensional := NewTwoDimensionalWeightToFee(identityPolynomial, doublePolynomial)
+
+	var testExamples = []struct {
+		label       string
+		weight      Weight
+		expectation Balance
+	}{
+		{label: "Zero weight", weight: WeightZero(), expectation: sc.NewU128FromUint64(0)},
+		{label: "Ref time fee is larger", weight: WeightFromParts(1000, 100), expectation: sc.NewU128FromUint64(1000)},
+		{label: "Proof size fee is larger", weight: WeightFromParts(100, 1000), expectation: sc.NewU128FromUint64(2000)},
+		{label: "Equal fees", weight: WeightFromParts(200, 100), expectation: sc.NewU128FromUint64(200)},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			assert.Equal(t, testExample.expectation, twoDimensional.WeightToFee(testExample.weight))
+		})
+	}
+}
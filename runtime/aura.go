@@ -0,0 +1,17 @@
+//go:build !babe
+
+package main
+
+import (
+	"github.com/LimeChain/gosemble/frame/aura"
+)
+
+//go:export AuraApi_slot_duration
+func AuraApiSlotDuration(_, _ int32) int64 {
+	return aura.SlotDuration()
+}
+
+//go:export AuraApi_authorities
+func AuraApiAuthorities(_, _ int32) int64 {
+	return aura.Authorities()
+}
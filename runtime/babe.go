@@ -0,0 +1,37 @@
+//go:build babe
+
+package main
+
+import (
+	"github.com/LimeChain/gosemble/frame/babe"
+)
+
+//go:export BabeApi_configuration
+func BabeApiConfiguration(_, _ int32) int64 {
+	return babe.Configuration()
+}
+
+//go:export BabeApi_current_epoch_start
+func BabeApiCurrentEpochStart(_, _ int32) int64 {
+	return babe.CurrentEpochStart()
+}
+
+//go:export BabeApi_current_epoch
+func BabeApiCurrentEpoch(_, _ int32) int64 {
+	return babe.CurrentEpoch()
+}
+
+//go:export BabeApi_next_epoch
+func BabeApiNextEpoch(_, _ int32) int64 {
+	return babe.NextEpoch()
+}
+
+//go:export BabeApi_generate_key_ownership_proof
+func BabeApiGenerateKeyOwnershipProof(dataPtr int32, dataLen int32) int64 {
+	return babe.GenerateKeyOwnershipProof(dataPtr, dataLen)
+}
+
+//go:export BabeApi_submit_report_equivocation_unsigned_extrinsic
+func BabeApiSubmitReportEquivocationUnsignedExtrinsic(dataPtr int32, dataLen int32) int64 {
+	return babe.SubmitReportEquivocationUnsignedExtrinsic(dataPtr, dataLen)
+}
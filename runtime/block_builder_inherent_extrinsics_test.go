@@ -7,6 +7,7 @@ import (
 
 	gossamertypes "github.com/ChainSafe/gossamer/dot/types"
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/config"
 	"github.com/LimeChain/gosemble/execution/types"
 	timestamp "github.com/LimeChain/gosemble/frame/timestamp/dispatchables"
 	"github.com/stretchr/testify/assert"
@@ -41,7 +42,7 @@ func Test_BlockBuilder_Inherent_Extrinsics(t *testing.T) {
 	buffer.Reset()
 
 	buffer.Write(inherentExt[1:])
-	extrinsic := types.DecodeUncheckedExtrinsic(buffer)
+	extrinsic := types.DecodeUncheckedExtrinsic(config.SignedExtra, buffer)
 
 	assert.Equal(t, expectedExtrinsic, extrinsic)
 }
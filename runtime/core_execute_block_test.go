@@ -9,6 +9,7 @@ import (
 	"github.com/ChainSafe/gossamer/lib/common"
 	"github.com/ChainSafe/gossamer/pkg/scale"
 	sc "github.com/LimeChain/goscale"
+	"github.com/LimeChain/gosemble/config"
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/aura"
 	"github.com/LimeChain/gosemble/execution/types"
@@ -110,7 +111,7 @@ func Test_BlockExecution(t *testing.T) {
 	buffer.Reset()
 
 	buffer.Write(inherentExt[1:])
-	extrinsic := types.DecodeUncheckedExtrinsic(buffer)
+	extrinsic := types.DecodeUncheckedExtrinsic(config.SignedExtra, buffer)
 	buffer.Reset()
 
 	assert.Equal(t, expectedExtrinsic, extrinsic)
@@ -195,7 +196,7 @@ func Test_ExecuteBlock(t *testing.T) {
 	buffer.Reset()
 
 	buffer.Write(inherentExt[1:])
-	extrinsic := types.DecodeUncheckedExtrinsic(buffer)
+	extrinsic := types.DecodeUncheckedExtrinsic(config.SignedExtra, buffer)
 
 	assert.Equal(t, expectedExtrinsic, extrinsic)
 
@@ -5,6 +5,7 @@ import (
 	sc "github.com/LimeChain/goscale"
 	"github.com/LimeChain/gosemble/constants"
 	"github.com/LimeChain/gosemble/constants/grandpa"
+	"github.com/LimeChain/gosemble/primitives/hashing"
 	"github.com/LimeChain/gosemble/primitives/types"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -47,3 +48,19 @@ func Test_Grandpa_Authorities(t *testing.T) {
 
 	assert.Equal(t, storageAuthorityList.AuthorityList.Bytes(), result)
 }
+
+func Test_Grandpa_CurrentSetId(t *testing.T) {
+	rt, storage := newTestRuntime(t)
+
+	result, err := rt.Exec("GrandpaApi_current_set_id", []byte{})
+	assert.NoError(t, err)
+	assert.Equal(t, sc.U64(0).Bytes(), result)
+
+	keyCurrentSetId := append(hashing.Twox128(constants.KeyGrandpa), hashing.Twox128(constants.KeyCurrentSetId)...)
+	err = (*storage).Put(keyCurrentSetId, sc.U64(5).Bytes())
+	assert.NoError(t, err)
+
+	result, err = rt.Exec("GrandpaApi_current_set_id", []byte{})
+	assert.NoError(t, err)
+	assert.Equal(t, sc.U64(5).Bytes(), result)
+}
@@ -38,3 +38,62 @@ func Test_Metadata_Encoding_Success(t *testing.T) {
 
 	assert.Equal(t, metadata.Bytes(), bGossamerMetadata)
 }
+
+func Test_Metadata_Versions(t *testing.T) {
+	rt, _ := newTestRuntime(t)
+
+	result, err := rt.Exec("Metadata_metadata_versions", []byte{})
+	assert.NoError(t, err)
+
+	versions := sc.DecodeSequence[sc.U32](bytes.NewBuffer(result))
+	assert.Equal(t, sc.Sequence[sc.U32]{sc.U32(types.MetadataVersion), sc.U32(types.MetadataVersion15)}, versions)
+}
+
+func Test_Metadata_At_Version(t *testing.T) {
+	rt, _ := newTestRuntime(t)
+
+	bMetadata, err := rt.Metadata()
+	assert.NoError(t, err)
+
+	var testExamples = []struct {
+		label       string
+		version     sc.U32
+		expectation func(t *testing.T, result sc.Option[sc.Sequence[sc.U8]])
+	}{
+		{
+			label:   "V14",
+			version: sc.U32(types.MetadataVersion),
+			expectation: func(t *testing.T, result sc.Option[sc.Sequence[sc.U8]]) {
+				assert.True(t, bool(result.HasValue))
+				assert.Equal(t, bMetadata, result.Value.Bytes())
+			},
+		},
+		{
+			label:   "V15",
+			version: sc.U32(types.MetadataVersion15),
+			expectation: func(t *testing.T, result sc.Option[sc.Sequence[sc.U8]]) {
+				assert.True(t, bool(result.HasValue))
+
+				metadata, err := types.DecodeMetadataV15(bytes.NewBuffer(sc.SequenceU8ToBytes(result.Value)))
+				assert.NoError(t, err)
+				assert.NotEmpty(t, metadata.Data.Apis)
+			},
+		},
+		{
+			label:   "unsupported version",
+			version: sc.U32(13),
+			expectation: func(t *testing.T, result sc.Option[sc.Sequence[sc.U8]]) {
+				assert.False(t, bool(result.HasValue))
+			},
+		},
+	}
+
+	for _, testExample := range testExamples {
+		t.Run(testExample.label, func(t *testing.T) {
+			result, err := rt.Exec("Metadata_metadata_at_version", testExample.version.Bytes())
+			assert.NoError(t, err)
+
+			testExample.expectation(t, sc.DecodeOptionWith(bytes.NewBuffer(result), sc.DecodeSequence[sc.U8]))
+		})
+	}
+}
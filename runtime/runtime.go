@@ -4,8 +4,8 @@ Targets WebAssembly MVP
 package main
 
 import (
+	"github.com/LimeChain/gosemble/config"
 	"github.com/LimeChain/gosemble/frame/account_nonce"
-	"github.com/LimeChain/gosemble/frame/aura"
 	blockbuilder "github.com/LimeChain/gosemble/frame/block_builder"
 	"github.com/LimeChain/gosemble/frame/core"
 	"github.com/LimeChain/gosemble/frame/grandpa"
@@ -65,16 +65,6 @@ func TaggedTransactionQueueValidateTransaction(dataPtr int32, dataLen int32) int
 	return taggedtransactionqueue.ValidateTransaction(dataPtr, dataLen)
 }
 
-//go:export AuraApi_slot_duration
-func AuraApiSlotDuration(_, _ int32) int64 {
-	return aura.SlotDuration()
-}
-
-//go:export AuraApi_authorities
-func AuraApiAuthorities(_, _ int32) int64 {
-	return aura.Authorities()
-}
-
 //go:export AccountNonceApi_account_nonce
 func AccountNonceApiAccountNonce(dataPtr int32, dataLen int32) int64 {
 	return account_nonce.AccountNonce(dataPtr, dataLen)
@@ -82,12 +72,12 @@ func AccountNonceApiAccountNonce(dataPtr int32, dataLen int32) int64 {
 
 //go:export TransactionPaymentApi_query_info
 func TransactionPaymentApiQueryInfo(dataPtr int32, dataLen int32) int64 {
-	return transaction_payment.QueryInfo(dataPtr, dataLen)
+	return transaction_payment.QueryInfo(config.SignedExtra, dataPtr, dataLen)
 }
 
 //go:export TransactionPaymentApi_query_fee_details
 func TransactionPaymentApiQueryFeeDetails(dataPtr int32, dataLen int32) int64 {
-	return transaction_payment.QueryFeeDetails(dataPtr, dataLen)
+	return transaction_payment.QueryFeeDetails(config.SignedExtra, dataPtr, dataLen)
 }
 
 //go:export TransactionPaymentCallApi_query_call_info
@@ -105,6 +95,16 @@ func Metadata(_, _ int32) int64 {
 	return metadata.Metadata()
 }
 
+//go:export Metadata_metadata_at_version
+func MetadataAtVersion(dataPtr int32, dataLen int32) int64 {
+	return metadata.MetadataAtVersion(dataPtr, dataLen)
+}
+
+//go:export Metadata_metadata_versions
+func MetadataVersions(_, _ int32) int64 {
+	return metadata.MetadataVersions()
+}
+
 //go:export SessionKeys_generate_session_keys
 func SessionKeysGenerateSessionKeys(dataPtr int32, dataLen int32) int64 {
 	return session_keys.GenerateSessionKeys(dataPtr, dataLen)
@@ -120,6 +120,21 @@ func GrandpaApiAuthorities(_, _ int32) int64 {
 	return grandpa.Authorities()
 }
 
+//go:export GrandpaApi_current_set_id
+func GrandpaApiCurrentSetId(_, _ int32) int64 {
+	return grandpa.CurrentSetId()
+}
+
+//go:export GrandpaApi_submit_report_equivocation_unsigned_extrinsic
+func GrandpaApiSubmitReportEquivocationUnsignedExtrinsic(dataPtr int32, dataLen int32) int64 {
+	return grandpa.SubmitReportEquivocationUnsignedExtrinsic(dataPtr, dataLen)
+}
+
+//go:export GrandpaApi_generate_key_ownership_proof
+func GrandpaApiGenerateKeyOwnershipProof(dataPtr int32, dataLen int32) int64 {
+	return grandpa.GenerateKeyOwnershipProof(dataPtr, dataLen)
+}
+
 //go:export OffchainWorkerApi_offchain_worker
 func OffchainWorkerApiOffchainWorker(dataPtr int32, dataLen int32) int64 {
 	offchain_worker.OffchainWorker(dataPtr, dataLen)
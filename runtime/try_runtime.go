@@ -0,0 +1,17 @@
+//go:build tryruntime
+
+package main
+
+import (
+	"github.com/LimeChain/gosemble/frame/try_runtime"
+)
+
+//go:export TryRuntime_on_runtime_upgrade
+func TryRuntimeOnRuntimeUpgrade(dataPtr int32, dataLen int32) int64 {
+	return try_runtime.OnRuntimeUpgrade(dataPtr, dataLen)
+}
+
+//go:export TryRuntime_execute_block
+func TryRuntimeExecuteBlock(dataPtr int32, dataLen int32) int64 {
+	return try_runtime.ExecuteBlock(dataPtr, dataLen)
+}
@@ -0,0 +1,19 @@
+//go:build !nonwasmenv
+
+package utils
+
+import (
+	"unsafe"
+)
+
+func SliceToOffset(data []byte) uintptr {
+	if len(data) == 0 {
+		return uintptr(unsafe.Pointer(nil))
+	}
+
+	return uintptr(unsafe.Pointer(&data[0]))
+}
+
+func ToWasmMemorySlice(offset int32, size int32) []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(uintptr(offset))), uintptr(size))
+}
@@ -0,0 +1,48 @@
+//go:build nonwasmenv
+
+package utils
+
+import (
+	"unsafe"
+)
+
+// Outside of Wasm, the 64-bit addresses of the Go heap do not fit in the 32-bit offsets,
+// passed to and returned from the env functions. Instead, the slices are registered as
+// memory regions, addressed by virtual offsets. A slice keeps its offset, so the env
+// functions write to the memory of the caller, as they do in Wasm.
+var (
+	regions    = map[uintptr]int32{}
+	memory     = map[int32][]byte{}
+	nextOffset = int32(1)
+)
+
+func SliceToOffset(data []byte) uintptr {
+	if len(data) == 0 {
+		return 0
+	}
+
+	ptr := uintptr(unsafe.Pointer(&data[0]))
+	if offset, ok := regions[ptr]; ok && len(memory[offset]) >= len(data) {
+		return uintptr(offset)
+	}
+
+	offset := nextOffset
+	nextOffset += int32(len(data))
+	regions[ptr] = offset
+	memory[offset] = data
+
+	return uintptr(offset)
+}
+
+func ToWasmMemorySlice(offset int32, size int32) []byte {
+	if size == 0 {
+		return []byte{}
+	}
+
+	data, ok := memory[offset]
+	if !ok || int(size) > len(data) {
+		panic("invalid memory offset")
+	}
+
+	return data[:size]
+}
@@ -0,0 +1,31 @@
+//go:build nonwasmenv
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_BytesToOffsetAndSize(t *testing.T) {
+	data := []byte{1, 2, 3}
+
+	offset, size := Int64ToOffsetAndSize(BytesToOffsetAndSize(data))
+
+	assert.Equal(t, int32(3), size)
+	assert.Equal(t, offset, Offset32(data))
+	assert.Equal(t, data, ToWasmMemorySlice(offset, size))
+}
+
+func Test_ToWasmMemorySlice_SharesMemory(t *testing.T) {
+	data := make([]byte, 2)
+
+	ToWasmMemorySlice(Offset32(data), 2)[1] = 7
+
+	assert.Equal(t, []byte{0, 7}, data)
+}
+
+func Test_ToWasmMemorySlice_Empty(t *testing.T) {
+	assert.Equal(t, []byte{}, ToWasmMemorySlice(Offset32(nil), 0))
+}
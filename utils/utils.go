@@ -24,20 +24,8 @@ func Offset32(data []byte) int32 {
 	return int32(SliceToOffset(data))
 }
 
-func SliceToOffset(data []byte) uintptr {
-	if len(data) == 0 {
-		return uintptr(unsafe.Pointer(nil))
-	}
-
-	return uintptr(unsafe.Pointer(&data[0]))
-}
-
 func BytesToOffsetAndSize(data []byte) int64 {
 	offset := SliceToOffset(data)
 	size := len(data)
 	return OffsetAndSizeToInt64(int32(offset), int32(size))
 }
-
-func ToWasmMemorySlice(offset int32, size int32) []byte {
-	return unsafe.Slice((*byte)(unsafe.Pointer(uintptr(offset))), uintptr(size))
-}